@@ -14,7 +14,7 @@
 
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.36.10
+// 	protoc-gen-go v1.36.11
 // 	protoc        v6.33.4
 // source: csp/v1alpha1/provider.proto
 
@@ -23,6 +23,7 @@ package cspv1alpha1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -307,11 +308,551 @@ func (x *SendTerminateSignalResponse) GetRequestId() string {
 	return ""
 }
 
+type RequestReplacementNodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeName      string                 `protobuf:"bytes,1,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestReplacementNodeRequest) Reset() {
+	*x = RequestReplacementNodeRequest{}
+	mi := &file_csp_v1alpha1_provider_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestReplacementNodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestReplacementNodeRequest) ProtoMessage() {}
+
+func (x *RequestReplacementNodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_csp_v1alpha1_provider_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestReplacementNodeRequest.ProtoReflect.Descriptor instead.
+func (*RequestReplacementNodeRequest) Descriptor() ([]byte, []int) {
+	return file_csp_v1alpha1_provider_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RequestReplacementNodeRequest) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
+	}
+	return ""
+}
+
+type RequestReplacementNodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestReplacementNodeResponse) Reset() {
+	*x = RequestReplacementNodeResponse{}
+	mi := &file_csp_v1alpha1_provider_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestReplacementNodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestReplacementNodeResponse) ProtoMessage() {}
+
+func (x *RequestReplacementNodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_csp_v1alpha1_provider_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestReplacementNodeResponse.ProtoReflect.Descriptor instead.
+func (*RequestReplacementNodeResponse) Descriptor() ([]byte, []int) {
+	return file_csp_v1alpha1_provider_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RequestReplacementNodeResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type GetReplacementNodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeName      string                 `protobuf:"bytes,1,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	RequestId     string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReplacementNodeRequest) Reset() {
+	*x = GetReplacementNodeRequest{}
+	mi := &file_csp_v1alpha1_provider_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReplacementNodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReplacementNodeRequest) ProtoMessage() {}
+
+func (x *GetReplacementNodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_csp_v1alpha1_provider_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReplacementNodeRequest.ProtoReflect.Descriptor instead.
+func (*GetReplacementNodeRequest) Descriptor() ([]byte, []int) {
+	return file_csp_v1alpha1_provider_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetReplacementNodeRequest) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
+	}
+	return ""
+}
+
+func (x *GetReplacementNodeRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type GetReplacementNodeResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ReplacementNodeName string                 `protobuf:"bytes,1,opt,name=replacement_node_name,json=replacementNodeName,proto3" json:"replacement_node_name,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GetReplacementNodeResponse) Reset() {
+	*x = GetReplacementNodeResponse{}
+	mi := &file_csp_v1alpha1_provider_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReplacementNodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReplacementNodeResponse) ProtoMessage() {}
+
+func (x *GetReplacementNodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_csp_v1alpha1_provider_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReplacementNodeResponse.ProtoReflect.Descriptor instead.
+func (*GetReplacementNodeResponse) Descriptor() ([]byte, []int) {
+	return file_csp_v1alpha1_provider_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetReplacementNodeResponse) GetReplacementNodeName() string {
+	if x != nil {
+		return x.ReplacementNodeName
+	}
+	return ""
+}
+
+type GetInstanceStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeName      string                 `protobuf:"bytes,1,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInstanceStatusRequest) Reset() {
+	*x = GetInstanceStatusRequest{}
+	mi := &file_csp_v1alpha1_provider_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInstanceStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstanceStatusRequest) ProtoMessage() {}
+
+func (x *GetInstanceStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_csp_v1alpha1_provider_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstanceStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetInstanceStatusRequest) Descriptor() ([]byte, []int) {
+	return file_csp_v1alpha1_provider_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetInstanceStatusRequest) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
+	}
+	return ""
+}
+
+type ScheduledEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	NotBefore     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"`
+	NotAfter      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduledEvent) Reset() {
+	*x = ScheduledEvent{}
+	mi := &file_csp_v1alpha1_provider_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduledEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledEvent) ProtoMessage() {}
+
+func (x *ScheduledEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_csp_v1alpha1_provider_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledEvent.ProtoReflect.Descriptor instead.
+func (*ScheduledEvent) Descriptor() ([]byte, []int) {
+	return file_csp_v1alpha1_provider_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ScheduledEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ScheduledEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ScheduledEvent) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ScheduledEvent) GetNotBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NotBefore
+	}
+	return nil
+}
+
+func (x *ScheduledEvent) GetNotAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NotAfter
+	}
+	return nil
+}
+
+type GetInstanceStatusResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ProviderState    string                 `protobuf:"bytes,1,opt,name=provider_state,json=providerState,proto3" json:"provider_state,omitempty"`
+	UnderMaintenance bool                   `protobuf:"varint,2,opt,name=under_maintenance,json=underMaintenance,proto3" json:"under_maintenance,omitempty"`
+	ScheduledEvents  []*ScheduledEvent      `protobuf:"bytes,3,rep,name=scheduled_events,json=scheduledEvents,proto3" json:"scheduled_events,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetInstanceStatusResponse) Reset() {
+	*x = GetInstanceStatusResponse{}
+	mi := &file_csp_v1alpha1_provider_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInstanceStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstanceStatusResponse) ProtoMessage() {}
+
+func (x *GetInstanceStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_csp_v1alpha1_provider_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstanceStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetInstanceStatusResponse) Descriptor() ([]byte, []int) {
+	return file_csp_v1alpha1_provider_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetInstanceStatusResponse) GetProviderState() string {
+	if x != nil {
+		return x.ProviderState
+	}
+	return ""
+}
+
+func (x *GetInstanceStatusResponse) GetUnderMaintenance() bool {
+	if x != nil {
+		return x.UnderMaintenance
+	}
+	return false
+}
+
+func (x *GetInstanceStatusResponse) GetScheduledEvents() []*ScheduledEvent {
+	if x != nil {
+		return x.ScheduledEvents
+	}
+	return nil
+}
+
+type SendReimageSignalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeName      string                 `protobuf:"bytes,1,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendReimageSignalRequest) Reset() {
+	*x = SendReimageSignalRequest{}
+	mi := &file_csp_v1alpha1_provider_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendReimageSignalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendReimageSignalRequest) ProtoMessage() {}
+
+func (x *SendReimageSignalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_csp_v1alpha1_provider_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendReimageSignalRequest.ProtoReflect.Descriptor instead.
+func (*SendReimageSignalRequest) Descriptor() ([]byte, []int) {
+	return file_csp_v1alpha1_provider_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SendReimageSignalRequest) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
+	}
+	return ""
+}
+
+type SendReimageSignalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendReimageSignalResponse) Reset() {
+	*x = SendReimageSignalResponse{}
+	mi := &file_csp_v1alpha1_provider_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendReimageSignalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendReimageSignalResponse) ProtoMessage() {}
+
+func (x *SendReimageSignalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_csp_v1alpha1_provider_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendReimageSignalResponse.ProtoReflect.Descriptor instead.
+func (*SendReimageSignalResponse) Descriptor() ([]byte, []int) {
+	return file_csp_v1alpha1_provider_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SendReimageSignalResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type CancelRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeName      string                 `protobuf:"bytes,1,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	RequestId     string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelRequestRequest) Reset() {
+	*x = CancelRequestRequest{}
+	mi := &file_csp_v1alpha1_provider_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelRequestRequest) ProtoMessage() {}
+
+func (x *CancelRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_csp_v1alpha1_provider_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelRequestRequest.ProtoReflect.Descriptor instead.
+func (*CancelRequestRequest) Descriptor() ([]byte, []int) {
+	return file_csp_v1alpha1_provider_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CancelRequestRequest) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
+	}
+	return ""
+}
+
+func (x *CancelRequestRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type CancelRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelRequestResponse) Reset() {
+	*x = CancelRequestResponse{}
+	mi := &file_csp_v1alpha1_provider_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelRequestResponse) ProtoMessage() {}
+
+func (x *CancelRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_csp_v1alpha1_provider_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelRequestResponse.ProtoReflect.Descriptor instead.
+func (*CancelRequestResponse) Descriptor() ([]byte, []int) {
+	return file_csp_v1alpha1_provider_proto_rawDescGZIP(), []int{16}
+}
+
 var File_csp_v1alpha1_provider_proto protoreflect.FileDescriptor
 
 const file_csp_v1alpha1_provider_proto_rawDesc = "" +
 	"\n" +
-	"\x1bcsp/v1alpha1/provider.proto\x12\"nvidia.nvsentinel.janitor.v1alpha1\"6\n" +
+	"\x1bcsp/v1alpha1/provider.proto\x12\"nvidia.nvsentinel.janitor.v1alpha1\x1a\x1fgoogle/protobuf/timestamp.proto\"6\n" +
 	"\x17SendRebootSignalRequest\x12\x1b\n" +
 	"\tnode_name\x18\x01 \x01(\tR\bnodeName\"9\n" +
 	"\x18SendRebootSignalResponse\x12\x1d\n" +
@@ -327,11 +868,50 @@ const file_csp_v1alpha1_provider_proto_rawDesc = "" +
 	"\tnode_name\x18\x01 \x01(\tR\bnodeName\"<\n" +
 	"\x1bSendTerminateSignalResponse\x12\x1d\n" +
 	"\n" +
-	"request_id\x18\x01 \x01(\tR\trequestId2\xc4\x03\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\"<\n" +
+	"\x1dRequestReplacementNodeRequest\x12\x1b\n" +
+	"\tnode_name\x18\x01 \x01(\tR\bnodeName\"?\n" +
+	"\x1eRequestReplacementNodeResponse\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\"W\n" +
+	"\x19GetReplacementNodeRequest\x12\x1b\n" +
+	"\tnode_name\x18\x01 \x01(\tR\bnodeName\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x02 \x01(\tR\trequestId\"P\n" +
+	"\x1aGetReplacementNodeResponse\x122\n" +
+	"\x15replacement_node_name\x18\x01 \x01(\tR\x13replacementNodeName\"7\n" +
+	"\x18GetInstanceStatusRequest\x12\x1b\n" +
+	"\tnode_name\x18\x01 \x01(\tR\bnodeName\"\xca\x01\n" +
+	"\x0eScheduledEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x129\n" +
+	"\n" +
+	"not_before\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tnotBefore\x127\n" +
+	"\tnot_after\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bnotAfter\"\xce\x01\n" +
+	"\x19GetInstanceStatusResponse\x12%\n" +
+	"\x0eprovider_state\x18\x01 \x01(\tR\rproviderState\x12+\n" +
+	"\x11under_maintenance\x18\x02 \x01(\bR\x10underMaintenance\x12]\n" +
+	"\x10scheduled_events\x18\x03 \x03(\v22.nvidia.nvsentinel.janitor.v1alpha1.ScheduledEventR\x0fscheduledEvents\"7\n" +
+	"\x18SendReimageSignalRequest\x12\x1b\n" +
+	"\tnode_name\x18\x01 \x01(\tR\bnodeName\":\n" +
+	"\x19SendReimageSignalResponse\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\"R\n" +
+	"\x14CancelRequestRequest\x12\x1b\n" +
+	"\tnode_name\x18\x01 \x01(\tR\bnodeName\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x02 \x01(\tR\trequestId\"\x17\n" +
+	"\x15CancelRequestResponse2\xb3\t\n" +
 	"\x12CSPProviderService\x12\x8f\x01\n" +
 	"\x10SendRebootSignal\x12;.nvidia.nvsentinel.janitor.v1alpha1.SendRebootSignalRequest\x1a<.nvidia.nvsentinel.janitor.v1alpha1.SendRebootSignalResponse\"\x00\x12\x80\x01\n" +
 	"\vIsNodeReady\x126.nvidia.nvsentinel.janitor.v1alpha1.IsNodeReadyRequest\x1a7.nvidia.nvsentinel.janitor.v1alpha1.IsNodeReadyResponse\"\x00\x12\x98\x01\n" +
-	"\x13SendTerminateSignal\x12>.nvidia.nvsentinel.janitor.v1alpha1.SendTerminateSignalRequest\x1a?.nvidia.nvsentinel.janitor.v1alpha1.SendTerminateSignalResponse\"\x00BCZAgithub.com/nvidia/nvsentinel/janitor/api/csp/v1alpha1;cspv1alpha1b\x06proto3"
+	"\x13SendTerminateSignal\x12>.nvidia.nvsentinel.janitor.v1alpha1.SendTerminateSignalRequest\x1a?.nvidia.nvsentinel.janitor.v1alpha1.SendTerminateSignalResponse\"\x00\x12\xa1\x01\n" +
+	"\x16RequestReplacementNode\x12A.nvidia.nvsentinel.janitor.v1alpha1.RequestReplacementNodeRequest\x1aB.nvidia.nvsentinel.janitor.v1alpha1.RequestReplacementNodeResponse\"\x00\x12\x95\x01\n" +
+	"\x12GetReplacementNode\x12=.nvidia.nvsentinel.janitor.v1alpha1.GetReplacementNodeRequest\x1a>.nvidia.nvsentinel.janitor.v1alpha1.GetReplacementNodeResponse\"\x00\x12\x92\x01\n" +
+	"\x11GetInstanceStatus\x12<.nvidia.nvsentinel.janitor.v1alpha1.GetInstanceStatusRequest\x1a=.nvidia.nvsentinel.janitor.v1alpha1.GetInstanceStatusResponse\"\x00\x12\x92\x01\n" +
+	"\x11SendReimageSignal\x12<.nvidia.nvsentinel.janitor.v1alpha1.SendReimageSignalRequest\x1a=.nvidia.nvsentinel.janitor.v1alpha1.SendReimageSignalResponse\"\x00\x12\x86\x01\n" +
+	"\rCancelRequest\x128.nvidia.nvsentinel.janitor.v1alpha1.CancelRequestRequest\x1a9.nvidia.nvsentinel.janitor.v1alpha1.CancelRequestResponse\"\x00BCZAgithub.com/nvidia/nvsentinel/janitor/api/csp/v1alpha1;cspv1alpha1b\x06proto3"
 
 var (
 	file_csp_v1alpha1_provider_proto_rawDescOnce sync.Once
@@ -345,27 +925,52 @@ func file_csp_v1alpha1_provider_proto_rawDescGZIP() []byte {
 	return file_csp_v1alpha1_provider_proto_rawDescData
 }
 
-var file_csp_v1alpha1_provider_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_csp_v1alpha1_provider_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_csp_v1alpha1_provider_proto_goTypes = []any{
-	(*SendRebootSignalRequest)(nil),     // 0: nvidia.nvsentinel.janitor.v1alpha1.SendRebootSignalRequest
-	(*SendRebootSignalResponse)(nil),    // 1: nvidia.nvsentinel.janitor.v1alpha1.SendRebootSignalResponse
-	(*IsNodeReadyRequest)(nil),          // 2: nvidia.nvsentinel.janitor.v1alpha1.IsNodeReadyRequest
-	(*IsNodeReadyResponse)(nil),         // 3: nvidia.nvsentinel.janitor.v1alpha1.IsNodeReadyResponse
-	(*SendTerminateSignalRequest)(nil),  // 4: nvidia.nvsentinel.janitor.v1alpha1.SendTerminateSignalRequest
-	(*SendTerminateSignalResponse)(nil), // 5: nvidia.nvsentinel.janitor.v1alpha1.SendTerminateSignalResponse
+	(*SendRebootSignalRequest)(nil),        // 0: nvidia.nvsentinel.janitor.v1alpha1.SendRebootSignalRequest
+	(*SendRebootSignalResponse)(nil),       // 1: nvidia.nvsentinel.janitor.v1alpha1.SendRebootSignalResponse
+	(*IsNodeReadyRequest)(nil),             // 2: nvidia.nvsentinel.janitor.v1alpha1.IsNodeReadyRequest
+	(*IsNodeReadyResponse)(nil),            // 3: nvidia.nvsentinel.janitor.v1alpha1.IsNodeReadyResponse
+	(*SendTerminateSignalRequest)(nil),     // 4: nvidia.nvsentinel.janitor.v1alpha1.SendTerminateSignalRequest
+	(*SendTerminateSignalResponse)(nil),    // 5: nvidia.nvsentinel.janitor.v1alpha1.SendTerminateSignalResponse
+	(*RequestReplacementNodeRequest)(nil),  // 6: nvidia.nvsentinel.janitor.v1alpha1.RequestReplacementNodeRequest
+	(*RequestReplacementNodeResponse)(nil), // 7: nvidia.nvsentinel.janitor.v1alpha1.RequestReplacementNodeResponse
+	(*GetReplacementNodeRequest)(nil),      // 8: nvidia.nvsentinel.janitor.v1alpha1.GetReplacementNodeRequest
+	(*GetReplacementNodeResponse)(nil),     // 9: nvidia.nvsentinel.janitor.v1alpha1.GetReplacementNodeResponse
+	(*GetInstanceStatusRequest)(nil),       // 10: nvidia.nvsentinel.janitor.v1alpha1.GetInstanceStatusRequest
+	(*ScheduledEvent)(nil),                 // 11: nvidia.nvsentinel.janitor.v1alpha1.ScheduledEvent
+	(*GetInstanceStatusResponse)(nil),      // 12: nvidia.nvsentinel.janitor.v1alpha1.GetInstanceStatusResponse
+	(*SendReimageSignalRequest)(nil),       // 13: nvidia.nvsentinel.janitor.v1alpha1.SendReimageSignalRequest
+	(*SendReimageSignalResponse)(nil),      // 14: nvidia.nvsentinel.janitor.v1alpha1.SendReimageSignalResponse
+	(*CancelRequestRequest)(nil),           // 15: nvidia.nvsentinel.janitor.v1alpha1.CancelRequestRequest
+	(*CancelRequestResponse)(nil),          // 16: nvidia.nvsentinel.janitor.v1alpha1.CancelRequestResponse
+	(*timestamppb.Timestamp)(nil),          // 17: google.protobuf.Timestamp
 }
 var file_csp_v1alpha1_provider_proto_depIdxs = []int32{
-	0, // 0: nvidia.nvsentinel.janitor.v1alpha1.CSPProviderService.SendRebootSignal:input_type -> nvidia.nvsentinel.janitor.v1alpha1.SendRebootSignalRequest
-	2, // 1: nvidia.nvsentinel.janitor.v1alpha1.CSPProviderService.IsNodeReady:input_type -> nvidia.nvsentinel.janitor.v1alpha1.IsNodeReadyRequest
-	4, // 2: nvidia.nvsentinel.janitor.v1alpha1.CSPProviderService.SendTerminateSignal:input_type -> nvidia.nvsentinel.janitor.v1alpha1.SendTerminateSignalRequest
-	1, // 3: nvidia.nvsentinel.janitor.v1alpha1.CSPProviderService.SendRebootSignal:output_type -> nvidia.nvsentinel.janitor.v1alpha1.SendRebootSignalResponse
-	3, // 4: nvidia.nvsentinel.janitor.v1alpha1.CSPProviderService.IsNodeReady:output_type -> nvidia.nvsentinel.janitor.v1alpha1.IsNodeReadyResponse
-	5, // 5: nvidia.nvsentinel.janitor.v1alpha1.CSPProviderService.SendTerminateSignal:output_type -> nvidia.nvsentinel.janitor.v1alpha1.SendTerminateSignalResponse
-	3, // [3:6] is the sub-list for method output_type
-	0, // [0:3] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	17, // 0: nvidia.nvsentinel.janitor.v1alpha1.ScheduledEvent.not_before:type_name -> google.protobuf.Timestamp
+	17, // 1: nvidia.nvsentinel.janitor.v1alpha1.ScheduledEvent.not_after:type_name -> google.protobuf.Timestamp
+	11, // 2: nvidia.nvsentinel.janitor.v1alpha1.GetInstanceStatusResponse.scheduled_events:type_name -> nvidia.nvsentinel.janitor.v1alpha1.ScheduledEvent
+	0,  // 3: nvidia.nvsentinel.janitor.v1alpha1.CSPProviderService.SendRebootSignal:input_type -> nvidia.nvsentinel.janitor.v1alpha1.SendRebootSignalRequest
+	2,  // 4: nvidia.nvsentinel.janitor.v1alpha1.CSPProviderService.IsNodeReady:input_type -> nvidia.nvsentinel.janitor.v1alpha1.IsNodeReadyRequest
+	4,  // 5: nvidia.nvsentinel.janitor.v1alpha1.CSPProviderService.SendTerminateSignal:input_type -> nvidia.nvsentinel.janitor.v1alpha1.SendTerminateSignalRequest
+	6,  // 6: nvidia.nvsentinel.janitor.v1alpha1.CSPProviderService.RequestReplacementNode:input_type -> nvidia.nvsentinel.janitor.v1alpha1.RequestReplacementNodeRequest
+	8,  // 7: nvidia.nvsentinel.janitor.v1alpha1.CSPProviderService.GetReplacementNode:input_type -> nvidia.nvsentinel.janitor.v1alpha1.GetReplacementNodeRequest
+	10, // 8: nvidia.nvsentinel.janitor.v1alpha1.CSPProviderService.GetInstanceStatus:input_type -> nvidia.nvsentinel.janitor.v1alpha1.GetInstanceStatusRequest
+	13, // 9: nvidia.nvsentinel.janitor.v1alpha1.CSPProviderService.SendReimageSignal:input_type -> nvidia.nvsentinel.janitor.v1alpha1.SendReimageSignalRequest
+	15, // 10: nvidia.nvsentinel.janitor.v1alpha1.CSPProviderService.CancelRequest:input_type -> nvidia.nvsentinel.janitor.v1alpha1.CancelRequestRequest
+	1,  // 11: nvidia.nvsentinel.janitor.v1alpha1.CSPProviderService.SendRebootSignal:output_type -> nvidia.nvsentinel.janitor.v1alpha1.SendRebootSignalResponse
+	3,  // 12: nvidia.nvsentinel.janitor.v1alpha1.CSPProviderService.IsNodeReady:output_type -> nvidia.nvsentinel.janitor.v1alpha1.IsNodeReadyResponse
+	5,  // 13: nvidia.nvsentinel.janitor.v1alpha1.CSPProviderService.SendTerminateSignal:output_type -> nvidia.nvsentinel.janitor.v1alpha1.SendTerminateSignalResponse
+	7,  // 14: nvidia.nvsentinel.janitor.v1alpha1.CSPProviderService.RequestReplacementNode:output_type -> nvidia.nvsentinel.janitor.v1alpha1.RequestReplacementNodeResponse
+	9,  // 15: nvidia.nvsentinel.janitor.v1alpha1.CSPProviderService.GetReplacementNode:output_type -> nvidia.nvsentinel.janitor.v1alpha1.GetReplacementNodeResponse
+	12, // 16: nvidia.nvsentinel.janitor.v1alpha1.CSPProviderService.GetInstanceStatus:output_type -> nvidia.nvsentinel.janitor.v1alpha1.GetInstanceStatusResponse
+	14, // 17: nvidia.nvsentinel.janitor.v1alpha1.CSPProviderService.SendReimageSignal:output_type -> nvidia.nvsentinel.janitor.v1alpha1.SendReimageSignalResponse
+	16, // 18: nvidia.nvsentinel.janitor.v1alpha1.CSPProviderService.CancelRequest:output_type -> nvidia.nvsentinel.janitor.v1alpha1.CancelRequestResponse
+	11, // [11:19] is the sub-list for method output_type
+	3,  // [3:11] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_csp_v1alpha1_provider_proto_init() }
@@ -379,7 +984,7 @@ func file_csp_v1alpha1_provider_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_csp_v1alpha1_provider_proto_rawDesc), len(file_csp_v1alpha1_provider_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -33,9 +33,14 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CSPProviderService_SendRebootSignal_FullMethodName    = "/nvidia.nvsentinel.janitor.v1alpha1.CSPProviderService/SendRebootSignal"
-	CSPProviderService_IsNodeReady_FullMethodName         = "/nvidia.nvsentinel.janitor.v1alpha1.CSPProviderService/IsNodeReady"
-	CSPProviderService_SendTerminateSignal_FullMethodName = "/nvidia.nvsentinel.janitor.v1alpha1.CSPProviderService/SendTerminateSignal"
+	CSPProviderService_SendRebootSignal_FullMethodName       = "/nvidia.nvsentinel.janitor.v1alpha1.CSPProviderService/SendRebootSignal"
+	CSPProviderService_IsNodeReady_FullMethodName            = "/nvidia.nvsentinel.janitor.v1alpha1.CSPProviderService/IsNodeReady"
+	CSPProviderService_SendTerminateSignal_FullMethodName    = "/nvidia.nvsentinel.janitor.v1alpha1.CSPProviderService/SendTerminateSignal"
+	CSPProviderService_RequestReplacementNode_FullMethodName = "/nvidia.nvsentinel.janitor.v1alpha1.CSPProviderService/RequestReplacementNode"
+	CSPProviderService_GetReplacementNode_FullMethodName     = "/nvidia.nvsentinel.janitor.v1alpha1.CSPProviderService/GetReplacementNode"
+	CSPProviderService_GetInstanceStatus_FullMethodName      = "/nvidia.nvsentinel.janitor.v1alpha1.CSPProviderService/GetInstanceStatus"
+	CSPProviderService_SendReimageSignal_FullMethodName      = "/nvidia.nvsentinel.janitor.v1alpha1.CSPProviderService/SendReimageSignal"
+	CSPProviderService_CancelRequest_FullMethodName          = "/nvidia.nvsentinel.janitor.v1alpha1.CSPProviderService/CancelRequest"
 )
 
 // CSPProviderServiceClient is the client API for CSPProviderService service.
@@ -45,6 +50,11 @@ type CSPProviderServiceClient interface {
 	SendRebootSignal(ctx context.Context, in *SendRebootSignalRequest, opts ...grpc.CallOption) (*SendRebootSignalResponse, error)
 	IsNodeReady(ctx context.Context, in *IsNodeReadyRequest, opts ...grpc.CallOption) (*IsNodeReadyResponse, error)
 	SendTerminateSignal(ctx context.Context, in *SendTerminateSignalRequest, opts ...grpc.CallOption) (*SendTerminateSignalResponse, error)
+	RequestReplacementNode(ctx context.Context, in *RequestReplacementNodeRequest, opts ...grpc.CallOption) (*RequestReplacementNodeResponse, error)
+	GetReplacementNode(ctx context.Context, in *GetReplacementNodeRequest, opts ...grpc.CallOption) (*GetReplacementNodeResponse, error)
+	GetInstanceStatus(ctx context.Context, in *GetInstanceStatusRequest, opts ...grpc.CallOption) (*GetInstanceStatusResponse, error)
+	SendReimageSignal(ctx context.Context, in *SendReimageSignalRequest, opts ...grpc.CallOption) (*SendReimageSignalResponse, error)
+	CancelRequest(ctx context.Context, in *CancelRequestRequest, opts ...grpc.CallOption) (*CancelRequestResponse, error)
 }
 
 type cSPProviderServiceClient struct {
@@ -85,6 +95,56 @@ func (c *cSPProviderServiceClient) SendTerminateSignal(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *cSPProviderServiceClient) RequestReplacementNode(ctx context.Context, in *RequestReplacementNodeRequest, opts ...grpc.CallOption) (*RequestReplacementNodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestReplacementNodeResponse)
+	err := c.cc.Invoke(ctx, CSPProviderService_RequestReplacementNode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cSPProviderServiceClient) GetReplacementNode(ctx context.Context, in *GetReplacementNodeRequest, opts ...grpc.CallOption) (*GetReplacementNodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReplacementNodeResponse)
+	err := c.cc.Invoke(ctx, CSPProviderService_GetReplacementNode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cSPProviderServiceClient) GetInstanceStatus(ctx context.Context, in *GetInstanceStatusRequest, opts ...grpc.CallOption) (*GetInstanceStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetInstanceStatusResponse)
+	err := c.cc.Invoke(ctx, CSPProviderService_GetInstanceStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cSPProviderServiceClient) SendReimageSignal(ctx context.Context, in *SendReimageSignalRequest, opts ...grpc.CallOption) (*SendReimageSignalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendReimageSignalResponse)
+	err := c.cc.Invoke(ctx, CSPProviderService_SendReimageSignal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cSPProviderServiceClient) CancelRequest(ctx context.Context, in *CancelRequestRequest, opts ...grpc.CallOption) (*CancelRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelRequestResponse)
+	err := c.cc.Invoke(ctx, CSPProviderService_CancelRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CSPProviderServiceServer is the server API for CSPProviderService service.
 // All implementations must embed UnimplementedCSPProviderServiceServer
 // for forward compatibility.
@@ -92,6 +152,11 @@ type CSPProviderServiceServer interface {
 	SendRebootSignal(context.Context, *SendRebootSignalRequest) (*SendRebootSignalResponse, error)
 	IsNodeReady(context.Context, *IsNodeReadyRequest) (*IsNodeReadyResponse, error)
 	SendTerminateSignal(context.Context, *SendTerminateSignalRequest) (*SendTerminateSignalResponse, error)
+	RequestReplacementNode(context.Context, *RequestReplacementNodeRequest) (*RequestReplacementNodeResponse, error)
+	GetReplacementNode(context.Context, *GetReplacementNodeRequest) (*GetReplacementNodeResponse, error)
+	GetInstanceStatus(context.Context, *GetInstanceStatusRequest) (*GetInstanceStatusResponse, error)
+	SendReimageSignal(context.Context, *SendReimageSignalRequest) (*SendReimageSignalResponse, error)
+	CancelRequest(context.Context, *CancelRequestRequest) (*CancelRequestResponse, error)
 	mustEmbedUnimplementedCSPProviderServiceServer()
 }
 
@@ -111,6 +176,21 @@ func (UnimplementedCSPProviderServiceServer) IsNodeReady(context.Context, *IsNod
 func (UnimplementedCSPProviderServiceServer) SendTerminateSignal(context.Context, *SendTerminateSignalRequest) (*SendTerminateSignalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendTerminateSignal not implemented")
 }
+func (UnimplementedCSPProviderServiceServer) RequestReplacementNode(context.Context, *RequestReplacementNodeRequest) (*RequestReplacementNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestReplacementNode not implemented")
+}
+func (UnimplementedCSPProviderServiceServer) GetReplacementNode(context.Context, *GetReplacementNodeRequest) (*GetReplacementNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReplacementNode not implemented")
+}
+func (UnimplementedCSPProviderServiceServer) GetInstanceStatus(context.Context, *GetInstanceStatusRequest) (*GetInstanceStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstanceStatus not implemented")
+}
+func (UnimplementedCSPProviderServiceServer) SendReimageSignal(context.Context, *SendReimageSignalRequest) (*SendReimageSignalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendReimageSignal not implemented")
+}
+func (UnimplementedCSPProviderServiceServer) CancelRequest(context.Context, *CancelRequestRequest) (*CancelRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRequest not implemented")
+}
 func (UnimplementedCSPProviderServiceServer) mustEmbedUnimplementedCSPProviderServiceServer() {}
 func (UnimplementedCSPProviderServiceServer) testEmbeddedByValue()                            {}
 
@@ -186,6 +266,96 @@ func _CSPProviderService_SendTerminateSignal_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CSPProviderService_RequestReplacementNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestReplacementNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CSPProviderServiceServer).RequestReplacementNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CSPProviderService_RequestReplacementNode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CSPProviderServiceServer).RequestReplacementNode(ctx, req.(*RequestReplacementNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CSPProviderService_GetReplacementNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReplacementNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CSPProviderServiceServer).GetReplacementNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CSPProviderService_GetReplacementNode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CSPProviderServiceServer).GetReplacementNode(ctx, req.(*GetReplacementNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CSPProviderService_GetInstanceStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInstanceStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CSPProviderServiceServer).GetInstanceStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CSPProviderService_GetInstanceStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CSPProviderServiceServer).GetInstanceStatus(ctx, req.(*GetInstanceStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CSPProviderService_SendReimageSignal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendReimageSignalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CSPProviderServiceServer).SendReimageSignal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CSPProviderService_SendReimageSignal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CSPProviderServiceServer).SendReimageSignal(ctx, req.(*SendReimageSignalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CSPProviderService_CancelRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CSPProviderServiceServer).CancelRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CSPProviderService_CancelRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CSPProviderServiceServer).CancelRequest(ctx, req.(*CancelRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CSPProviderService_ServiceDesc is the grpc.ServiceDesc for CSPProviderService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -205,6 +375,26 @@ var CSPProviderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendTerminateSignal",
 			Handler:    _CSPProviderService_SendTerminateSignal_Handler,
 		},
+		{
+			MethodName: "RequestReplacementNode",
+			Handler:    _CSPProviderService_RequestReplacementNode_Handler,
+		},
+		{
+			MethodName: "GetReplacementNode",
+			Handler:    _CSPProviderService_GetReplacementNode_Handler,
+		},
+		{
+			MethodName: "GetInstanceStatus",
+			Handler:    _CSPProviderService_GetInstanceStatus_Handler,
+		},
+		{
+			MethodName: "SendReimageSignal",
+			Handler:    _CSPProviderService_SendReimageSignal_Handler,
+		},
+		{
+			MethodName: "CancelRequest",
+			Handler:    _CSPProviderService_CancelRequest_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "csp/v1alpha1/provider.proto",
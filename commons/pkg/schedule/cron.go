@@ -0,0 +1,218 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronSpec is a parsed five-field cron expression (minute hour day-of-month month day-of-week).
+// Each field is stored as the set of values it matches.
+type cronSpec struct {
+	minutes     []int
+	hours       []int
+	daysOfMonth map[int]bool
+	months      map[int]bool
+	daysOfWeek  map[int]bool
+
+	// Vixie cron semantics: when both day fields are restricted a day matches if either does. A field
+	// starting with "*" (including steps such as "*/2") is unrestricted.
+	domRestricted bool
+	dowRestricted bool
+}
+
+type cronField struct {
+	name  string
+	min   int
+	max   int
+	names map[string]int
+}
+
+var (
+	minuteField = cronField{name: "minute", min: 0, max: 59}
+	hourField   = cronField{name: "hour", min: 0, max: 23}
+	domField    = cronField{name: "day-of-month", min: 1, max: 31}
+	monthField  = cronField{name: "month", min: 1, max: 12, names: map[string]int{
+		"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+		"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+	}}
+	// 7 is accepted as an alias for Sunday and folded onto 0 after parsing.
+	dowField = cronField{name: "day-of-week", min: 0, max: 7, names: map[string]int{
+		"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+	}}
+)
+
+// parseCron parses a standard five-field cron expression. Each field supports '*', single values,
+// ranges (a-b), steps (*/n, a-b/n) and comma separated lists. Month and day-of-week fields also
+// accept three letter names (JAN, MON).
+func parseCron(expr string) (*cronSpec, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("cron expression %q must have 5 fields, got %d", expr, len(fields))
+	}
+
+	minutes, err := minuteField.parse(fields[0])
+	if err != nil {
+		return nil, err
+	}
+
+	hours, err := hourField.parse(fields[1])
+	if err != nil {
+		return nil, err
+	}
+
+	doms, err := domField.parse(fields[2])
+	if err != nil {
+		return nil, err
+	}
+
+	months, err := monthField.parse(fields[3])
+	if err != nil {
+		return nil, err
+	}
+
+	dows, err := dowField.parse(fields[4])
+	if err != nil {
+		return nil, err
+	}
+
+	if dows[7] {
+		dows[0] = true
+		delete(dows, 7)
+	}
+
+	return &cronSpec{
+		minutes:       sortedValues(minutes, minuteField),
+		hours:         sortedValues(hours, hourField),
+		daysOfMonth:   doms,
+		months:        months,
+		daysOfWeek:    dows,
+		domRestricted: !strings.HasPrefix(fields[2], "*"),
+		dowRestricted: !strings.HasPrefix(fields[4], "*"),
+	}, nil
+}
+
+func (f cronField) parse(field string) (map[int]bool, error) {
+	values := make(map[int]bool)
+
+	for _, part := range strings.Split(field, ",") {
+		if err := f.parsePart(part, values); err != nil {
+			return nil, fmt.Errorf("invalid %s field %q: %w", f.name, field, err)
+		}
+	}
+
+	return values, nil
+}
+
+func (f cronField) parsePart(part string, values map[int]bool) error {
+	rangePart, step := part, 1
+
+	if idx := strings.Index(part, "/"); idx != -1 {
+		rangePart = part[:idx]
+
+		s, err := strconv.Atoi(part[idx+1:])
+		if err != nil || s <= 0 {
+			return fmt.Errorf("invalid step %q", part[idx+1:])
+		}
+
+		step = s
+	}
+
+	low, high := f.min, f.max
+
+	switch {
+	case rangePart == "*":
+	case strings.Contains(rangePart, "-"):
+		bounds := strings.SplitN(rangePart, "-", 2)
+
+		var err error
+
+		if low, err = f.value(bounds[0]); err != nil {
+			return err
+		}
+
+		if high, err = f.value(bounds[1]); err != nil {
+			return err
+		}
+
+		if low > high {
+			return fmt.Errorf("range start %d is after range end %d", low, high)
+		}
+	default:
+		v, err := f.value(rangePart)
+		if err != nil {
+			return err
+		}
+
+		low = v
+		if step == 1 {
+			high = v
+		}
+	}
+
+	for v := low; v <= high; v += step {
+		values[v] = true
+	}
+
+	return nil
+}
+
+func (f cronField) value(s string) (int, error) {
+	if v, ok := f.names[strings.ToUpper(s)]; ok {
+		return v, nil
+	}
+
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", s)
+	}
+
+	if v < f.min || v > f.max {
+		return 0, fmt.Errorf("value %d out of range [%d, %d]", v, f.min, f.max)
+	}
+
+	return v, nil
+}
+
+func sortedValues(values map[int]bool, f cronField) []int {
+	sorted := make([]int, 0, len(values))
+
+	for v := f.min; v <= f.max; v++ {
+		if values[v] {
+			sorted = append(sorted, v)
+		}
+	}
+
+	return sorted
+}
+
+// matchesDay reports whether the cron expression fires on the given calendar day.
+func (c *cronSpec) matchesDay(day time.Time) bool {
+	if !c.months[int(day.Month())] {
+		return false
+	}
+
+	domMatch := c.daysOfMonth[day.Day()]
+	dowMatch := c.daysOfWeek[int(day.Weekday())]
+
+	if c.domRestricted && c.dowRestricted {
+		return domMatch || dowMatch
+	}
+
+	return domMatch && dowMatch
+}
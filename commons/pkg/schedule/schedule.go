@@ -0,0 +1,204 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package schedule implements maintenance windows shared by the fault-quarantine and
+// fault-remediation modules. A schedule is a set of cron-like windows evaluated in a time zone,
+// each opening when its cron expression fires and staying open for a fixed duration. Windows
+// that would open on a blackout date are skipped.
+package schedule
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	blackoutDateLayout = "2006-01-02"
+
+	// searchHorizon bounds how far ahead NextOpen looks for a window. Schedules whose cron
+	// expressions never fire within this horizon (e.g. "0 0 30 2 *") are treated as never opening.
+	searchHorizon = 366 * 24 * time.Hour
+)
+
+// Window is a recurring maintenance window. Cron is a five-field cron expression giving the
+// window start time; Duration is how long the window stays open, e.g. "4h".
+type Window struct {
+	Cron     string `toml:"cron"`
+	Duration string `toml:"duration"`
+}
+
+// Config is the TOML representation of a named maintenance schedule.
+type Config struct {
+	Name string `toml:"name"`
+	// TimeZone is an IANA time zone name such as "America/Los_Angeles". Defaults to UTC.
+	TimeZone string   `toml:"timeZone"`
+	Windows  []Window `toml:"windows"`
+	// BlackoutDates lists dates (YYYY-MM-DD, in TimeZone) on which no window opens.
+	BlackoutDates []string `toml:"blackoutDates"`
+}
+
+type window struct {
+	cron     *cronSpec
+	duration time.Duration
+}
+
+// Schedule is a compiled maintenance schedule.
+type Schedule struct {
+	name        string
+	location    *time.Location
+	windows     []window
+	blackouts   map[string]bool
+	maxDuration time.Duration
+}
+
+// New compiles and validates a schedule configuration.
+func New(cfg Config) (*Schedule, error) {
+	if cfg.Name == "" {
+		return nil, fmt.Errorf("schedule name must be non-empty")
+	}
+
+	if len(cfg.Windows) == 0 {
+		return nil, fmt.Errorf("schedule %q must define at least one window", cfg.Name)
+	}
+
+	location := time.UTC
+
+	if cfg.TimeZone != "" {
+		loc, err := time.LoadLocation(cfg.TimeZone)
+		if err != nil {
+			return nil, fmt.Errorf("schedule %q has invalid timeZone %q: %w", cfg.Name, cfg.TimeZone, err)
+		}
+
+		location = loc
+	}
+
+	s := &Schedule{
+		name:      cfg.Name,
+		location:  location,
+		windows:   make([]window, 0, len(cfg.Windows)),
+		blackouts: make(map[string]bool, len(cfg.BlackoutDates)),
+	}
+
+	for i, w := range cfg.Windows {
+		spec, err := parseCron(w.Cron)
+		if err != nil {
+			return nil, fmt.Errorf("schedule %q window %d: %w", cfg.Name, i, err)
+		}
+
+		duration, err := time.ParseDuration(w.Duration)
+		if err != nil {
+			return nil, fmt.Errorf("schedule %q window %d has invalid duration %q: %w", cfg.Name, i, w.Duration, err)
+		}
+
+		if duration <= 0 {
+			return nil, fmt.Errorf("schedule %q window %d duration must be positive", cfg.Name, i)
+		}
+
+		s.windows = append(s.windows, window{cron: spec, duration: duration})
+		s.maxDuration = max(s.maxDuration, duration)
+	}
+
+	for _, date := range cfg.BlackoutDates {
+		if _, err := time.ParseInLocation(blackoutDateLayout, date, location); err != nil {
+			return nil, fmt.Errorf("schedule %q has invalid blackout date %q: %w", cfg.Name, date, err)
+		}
+
+		s.blackouts[date] = true
+	}
+
+	return s, nil
+}
+
+// NewSet compiles a list of schedule configurations keyed by name, rejecting duplicate names.
+func NewSet(cfgs []Config) (map[string]*Schedule, error) {
+	schedules := make(map[string]*Schedule, len(cfgs))
+
+	for _, cfg := range cfgs {
+		if _, exists := schedules[cfg.Name]; exists {
+			return nil, fmt.Errorf("duplicate schedule name %q", cfg.Name)
+		}
+
+		s, err := New(cfg)
+		if err != nil {
+			return nil, err
+		}
+
+		schedules[cfg.Name] = s
+	}
+
+	return schedules, nil
+}
+
+// Name returns the schedule name.
+func (s *Schedule) Name() string {
+	return s.name
+}
+
+// IsOpen reports whether a maintenance window is open at t.
+func (s *Schedule) IsOpen(t time.Time) bool {
+	next, ok := s.NextOpen(t)
+
+	return ok && !next.After(t)
+}
+
+// NextOpen returns the earliest instant at or after t at which a window is open. If a window is
+// already open at t, t itself is returned. The boolean is false when no window opens within
+// the search horizon.
+func (s *Schedule) NextOpen(t time.Time) (time.Time, bool) {
+	var (
+		earliest time.Time
+		found    bool
+	)
+
+	for _, w := range s.windows {
+		next, ok := s.nextOpenForWindow(w, t)
+		if ok && (!found || next.Before(earliest)) {
+			earliest, found = next, true
+		}
+	}
+
+	return earliest, found
+}
+
+func (s *Schedule) nextOpenForWindow(w window, t time.Time) (time.Time, bool) {
+	local := t.In(s.location)
+
+	// Start from the day on which a window still open at t could have started.
+	start := local.Add(-w.duration)
+	day := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, s.location)
+	limit := local.Add(searchHorizon)
+
+	for ; !day.After(limit); day = day.AddDate(0, 0, 1) {
+		if !w.cron.matchesDay(day) || s.blackouts[day.Format(blackoutDateLayout)] {
+			continue
+		}
+
+		for _, hour := range w.cron.hours {
+			for _, minute := range w.cron.minutes {
+				opens := time.Date(day.Year(), day.Month(), day.Day(), hour, minute, 0, 0, s.location)
+				if !opens.Add(w.duration).After(t) {
+					continue
+				}
+
+				if opens.After(t) {
+					return opens, true
+				}
+
+				return t, true
+			}
+		}
+	}
+
+	return time.Time{}, false
+}
@@ -0,0 +1,245 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustTime(t *testing.T, value string) time.Time {
+	t.Helper()
+
+	parsed, err := time.Parse(time.RFC3339, value)
+	require.NoError(t, err)
+
+	return parsed
+}
+
+func TestNewValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     Config
+		wantErr bool
+	}{
+		{
+			name: "valid weekday window",
+			cfg: Config{
+				Name:     "weekday-nights",
+				TimeZone: "America/Los_Angeles",
+				Windows:  []Window{{Cron: "0 2 * * MON-FRI", Duration: "4h"}},
+			},
+		},
+		{
+			name:    "missing name",
+			cfg:     Config{Windows: []Window{{Cron: "0 2 * * *", Duration: "4h"}}},
+			wantErr: true,
+		},
+		{
+			name:    "no windows",
+			cfg:     Config{Name: "empty"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid time zone",
+			cfg:     Config{Name: "tz", TimeZone: "Mars/Olympus", Windows: []Window{{Cron: "0 2 * * *", Duration: "4h"}}},
+			wantErr: true,
+		},
+		{
+			name:    "wrong field count",
+			cfg:     Config{Name: "cron", Windows: []Window{{Cron: "0 2 * *", Duration: "4h"}}},
+			wantErr: true,
+		},
+		{
+			name:    "hour out of range",
+			cfg:     Config{Name: "cron", Windows: []Window{{Cron: "0 24 * * *", Duration: "4h"}}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid duration",
+			cfg:     Config{Name: "duration", Windows: []Window{{Cron: "0 2 * * *", Duration: "-1h"}}},
+			wantErr: true,
+		},
+		{
+			name: "invalid blackout date",
+			cfg: Config{
+				Name:          "blackout",
+				Windows:       []Window{{Cron: "0 2 * * *", Duration: "4h"}},
+				BlackoutDates: []string{"25-12-2026"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := New(tt.cfg)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNewSetRejectsDuplicates(t *testing.T) {
+	cfg := Config{Name: "nightly", Windows: []Window{{Cron: "0 2 * * *", Duration: "4h"}}}
+
+	_, err := NewSet([]Config{cfg, cfg})
+	assert.Error(t, err)
+
+	schedules, err := NewSet([]Config{cfg})
+	require.NoError(t, err)
+	assert.Contains(t, schedules, "nightly")
+}
+
+func TestNextOpen(t *testing.T) {
+	s, err := New(Config{
+		Name:          "weekday-nights",
+		TimeZone:      "America/Los_Angeles",
+		Windows:       []Window{{Cron: "0 2 * * 1-5", Duration: "4h"}},
+		BlackoutDates: []string{"2026-12-25"},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		now      string
+		wantNext string
+		wantOpen bool
+	}{
+		{
+			name:     "inside window",
+			now:      "2026-10-20T03:30:00-07:00", // Tuesday 03:30 PDT
+			wantNext: "2026-10-20T03:30:00-07:00",
+			wantOpen: true,
+		},
+		{
+			name:     "window end is exclusive",
+			now:      "2026-10-20T06:00:00-07:00",
+			wantNext: "2026-10-21T02:00:00-07:00",
+		},
+		{
+			name:     "before window opens",
+			now:      "2026-10-20T01:00:00-07:00",
+			wantNext: "2026-10-20T02:00:00-07:00",
+		},
+		{
+			name:     "weekend waits for Monday",
+			now:      "2026-10-24T12:00:00-07:00", // Saturday
+			wantNext: "2026-10-26T02:00:00-07:00",
+		},
+		{
+			name:     "blackout date is skipped",
+			now:      "2026-12-24T12:00:00-08:00", // Thursday before Christmas
+			wantNext: "2026-12-28T02:00:00-08:00",
+		},
+		{
+			name:     "evaluated in schedule time zone",
+			now:      "2026-10-20T10:30:00Z", // 03:30 PDT
+			wantNext: "2026-10-20T10:30:00Z",
+			wantOpen: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := mustTime(t, tt.now)
+
+			next, ok := s.NextOpen(now)
+			require.True(t, ok)
+			assert.True(t, mustTime(t, tt.wantNext).Equal(next), "expected %s, got %s", tt.wantNext, next)
+			assert.Equal(t, tt.wantOpen, s.IsOpen(now))
+		})
+	}
+}
+
+func TestNextOpenWindowSpanningMidnight(t *testing.T) {
+	s, err := New(Config{
+		Name:    "overnight",
+		Windows: []Window{{Cron: "0 22 * * *", Duration: "6h"}},
+	})
+	require.NoError(t, err)
+
+	now := mustTime(t, "2026-10-20T01:00:00Z")
+	assert.True(t, s.IsOpen(now))
+
+	next, ok := s.NextOpen(mustTime(t, "2026-10-20T04:00:00Z"))
+	require.True(t, ok)
+	assert.True(t, mustTime(t, "2026-10-20T22:00:00Z").Equal(next))
+}
+
+func TestNextOpenMultipleWindows(t *testing.T) {
+	s, err := New(Config{
+		Name: "split",
+		Windows: []Window{
+			{Cron: "0 2 * * SAT", Duration: "2h"},
+			{Cron: "30 14 15 * *", Duration: "1h"},
+		},
+	})
+	require.NoError(t, err)
+
+	// Thursday 2026-10-15 is both a weekday (not Saturday) and the 15th of the month.
+	next, ok := s.NextOpen(mustTime(t, "2026-10-15T00:00:00Z"))
+	require.True(t, ok)
+	assert.True(t, mustTime(t, "2026-10-15T14:30:00Z").Equal(next))
+
+	next, ok = s.NextOpen(mustTime(t, "2026-10-15T16:00:00Z"))
+	require.True(t, ok)
+	assert.True(t, mustTime(t, "2026-10-17T02:00:00Z").Equal(next))
+}
+
+func TestNextOpenNeverFires(t *testing.T) {
+	s, err := New(Config{
+		Name:    "never",
+		Windows: []Window{{Cron: "0 0 30 2 *", Duration: "1h"}},
+	})
+	require.NoError(t, err)
+
+	_, ok := s.NextOpen(mustTime(t, "2026-10-15T00:00:00Z"))
+	assert.False(t, ok)
+}
+
+func TestParseCronFields(t *testing.T) {
+	spec, err := parseCron("*/15 2-4 * JAN,JUL 7")
+	require.NoError(t, err)
+
+	assert.Equal(t, []int{0, 15, 30, 45}, spec.minutes)
+	assert.Equal(t, []int{2, 3, 4}, spec.hours)
+	assert.True(t, spec.months[1])
+	assert.True(t, spec.months[7])
+	assert.False(t, spec.months[2])
+	assert.True(t, spec.daysOfWeek[0], "7 should be folded onto Sunday")
+}
+
+func TestMatchesDayStepIsUnrestricted(t *testing.T) {
+	// "*/2" restricts nothing for day matching, so only Mondays on odd days match
+	spec, err := parseCron("0 0 */2 * MON")
+	require.NoError(t, err)
+
+	assert.True(t, spec.matchesDay(mustTime(t, "2026-10-19T00:00:00Z")), "Monday the 19th")
+	assert.False(t, spec.matchesDay(mustTime(t, "2026-10-26T00:00:00Z")), "Monday the 26th")
+	assert.False(t, spec.matchesDay(mustTime(t, "2026-10-21T00:00:00Z")), "Wednesday the 21st")
+
+	spec, err = parseCron("0 0 1 * MON")
+	require.NoError(t, err)
+
+	assert.True(t, spec.matchesDay(mustTime(t, "2026-10-01T00:00:00Z")), "restricted fields match either day")
+	assert.True(t, spec.matchesDay(mustTime(t, "2026-10-26T00:00:00Z")), "restricted fields match either day")
+}
@@ -80,12 +80,16 @@
 //
 //	Drain Phase:
 //	  quarantined → draining       (node-drainer starts drain)
+//	  quarantined → drain-waiting  (node-drainer: drain concurrency limit reached)
+//	  drain-waiting → draining     (node-drainer: drain slot released)
 //	  quarantined → drain-succeeded (node-drainer: no pods to drain)
 //	  draining → drain-succeeded   (node-drainer: drain completed)
 //	  draining → drain-failed      (node-drainer: drain failed)
 //
 //	Remediation Phase:
 //	  drain-succeeded → remediating              (fault-remediation starts remediation)
+//	  drain-succeeded → remediation-waiting      (fault-remediation: rate limit or emergency stop)
+//	  remediation-waiting → remediating          (fault-remediation: remediation admitted)
 //	  remediating → remediation-succeeded        (fault-remediation: success)
 //	  remediating → remediation-failed           (fault-remediation: failure)
 //
@@ -167,10 +171,12 @@ const (
 
 	// Label values applied by the node-drainer:
 	DrainingLabelValue       NVSentinelStateLabelValue = "draining"
+	DrainWaitingLabelValue   NVSentinelStateLabelValue = "drain-waiting"
 	DrainSucceededLabelValue NVSentinelStateLabelValue = "drain-succeeded"
 	DrainFailedLabelValue    NVSentinelStateLabelValue = "drain-failed"
 
 	// Label values applied by the fault-remediation:
+	RemediationWaitingLabelValue   NVSentinelStateLabelValue = "remediation-waiting"
 	RemediatingLabelValue          NVSentinelStateLabelValue = "remediating"
 	RemediationSucceededLabelValue NVSentinelStateLabelValue = "remediation-succeeded"
 	RemediationFailedLabelValue    NVSentinelStateLabelValue = "remediation-failed"
@@ -320,9 +326,11 @@ func validateStateTransition(nodeName, currentValue string, exists bool, targetS
 
 	// Define expected transitions based on the normal state machine flow
 	validTransitions := map[NVSentinelStateLabelValue][]NVSentinelStateLabelValue{
-		QuarantinedLabelValue:          {DrainingLabelValue, DrainSucceededLabelValue},
+		QuarantinedLabelValue:          {DrainingLabelValue, DrainWaitingLabelValue, DrainSucceededLabelValue},
+		DrainWaitingLabelValue:         {DrainingLabelValue, DrainSucceededLabelValue},
 		DrainingLabelValue:             {DrainSucceededLabelValue, DrainFailedLabelValue},
-		DrainSucceededLabelValue:       {RemediatingLabelValue},
+		DrainSucceededLabelValue:       {RemediatingLabelValue, RemediationWaitingLabelValue},
+		RemediationWaitingLabelValue:   {RemediatingLabelValue},
 		DrainFailedLabelValue:          {}, // Terminal state - fault-remediation doesn't consume drain-failed
 		RemediatingLabelValue:          {RemediationSucceededLabelValue, RemediationFailedLabelValue},
 		RemediationSucceededLabelValue: {}, // Terminal state
@@ -218,6 +218,10 @@ func TestStateTransitionValidProgression(t *testing.T) {
 		{"Remediating to RemediationSucceeded", string(RemediatingLabelValue), RemediationSucceededLabelValue, true, false},
 		{"Remediating to RemediationFailed", string(RemediatingLabelValue), RemediationFailedLabelValue, true, false},
 		{"Quarantined to DrainSucceeded", string(QuarantinedLabelValue), DrainSucceededLabelValue, true, false},
+		{"Quarantined to DrainWaiting", string(QuarantinedLabelValue), DrainWaitingLabelValue, true, false},
+		{"DrainWaiting to Draining", string(DrainWaitingLabelValue), DrainingLabelValue, true, false},
+		{"DrainSucceeded to RemediationWaiting", string(DrainSucceededLabelValue), RemediationWaitingLabelValue, true, false},
+		{"RemediationWaiting to Remediating", string(RemediationWaitingLabelValue), RemediatingLabelValue, true, false},
 
 		// Unexpected progressions (return error but label is still updated)
 		// This allows callers to emit error metrics while labels reflect reality
@@ -228,7 +232,9 @@ func TestStateTransitionValidProgression(t *testing.T) {
 		// DrainFailed is a terminal state - fault-remediation only consumes drain-succeeded
 		{"DrainFailed to Remediating", string(DrainFailedLabelValue), RemediatingLabelValue, true, true},
 		{"DrainSucceeded to DrainFailed", string(DrainSucceededLabelValue), DrainFailedLabelValue, true, true},
+		{"DrainWaiting to Remediating", string(DrainWaitingLabelValue), RemediatingLabelValue, true, true},
 		{"RemediationSucceeded to Draining", string(RemediationSucceededLabelValue), DrainingLabelValue, true, true},
+		{"Quarantined to RemediationWaiting", string(QuarantinedLabelValue), RemediationWaitingLabelValue, true, true},
 	}
 
 	for _, tt := range tests {
@@ -14,6 +14,12 @@
 
 package model
 
+import "time"
+
+// GPUMetadataAnnotationName is the node annotation in which metadata-collector publishes a summary of the
+// GPU metadata it collected, so that other modules can tell when the metadata of a node was last collected
+const GPUMetadataAnnotationName = "dgxc.nvidia.com/gpu-metadata"
+
 type GPUMetadata struct {
 	Version       string    `json:"version"`
 	Timestamp     string    `json:"timestamp"`
@@ -52,3 +58,10 @@ type NVLink struct {
 	RemotePCIAddress string `json:"remote_pci_address"`
 	RemoteLinkID     int    `json:"remote_link_id"`
 }
+
+// GPUMetadataAnnotation is the value of the GPUMetadataAnnotationName node annotation
+type GPUMetadataAnnotation struct {
+	CollectedAt   time.Time `json:"collectedAt"`
+	GPUCount      int       `json:"gpuCount"`
+	DriverVersion string    `json:"driverVersion"`
+}
@@ -35,6 +35,9 @@ const (
 	Quarantined        Status = "Quarantined"
 	AlreadyQuarantined Status = "AlreadyQuarantined"
 	Cancelled          Status = "Cancelled"
+	// PendingMaintenanceWindow marks a non-fatal event whose matching rule sets are gated by a
+	// maintenance schedule that is currently closed. The event is reprocessed when the window opens.
+	PendingMaintenanceWindow Status = "PendingMaintenanceWindow"
 )
 
 type HealthEventWithStatus struct {
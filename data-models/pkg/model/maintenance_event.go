@@ -37,6 +37,10 @@ type MaintenanceEvent struct {
 	RecommendedAction      string            `json:"recommendedAction"            bson:"recommendedAction"`
 	Metadata               map[string]string `json:"metadata,omitempty"           bson:"metadata,omitempty"`
 	NodeName               string            `json:"nodeName,omitempty"           bson:"nodeName,omitemtpy"`
+	// Outcome of asking the CSP to start the maintenance early, once the node has been drained.
+	ExpediteStatus      ExpediteStatus `json:"expediteStatus,omitempty"      bson:"expediteStatus,omitempty"`
+	ExpediteMessage     string         `json:"expediteMessage,omitempty"     bson:"expediteMessage,omitempty"`
+	ExpediteRequestedAt *time.Time     `json:"expediteRequestedAt,omitempty" bson:"expediteRequestedAt,omitempty"`
 }
 
 // CSP represents the Cloud Service Provider identifier as an enum.
@@ -51,6 +55,9 @@ type InternalStatus string
 // ProviderStatus captures the status reported by the CSP provider.
 type ProviderStatus string
 
+// ExpediteStatus captures the outcome of asking the CSP to start a maintenance early.
+type ExpediteStatus string
+
 // Constants for CSP types
 const (
 	CSPGCP CSP = "gcp"
@@ -84,3 +91,10 @@ const (
 	CSPStatusCompleted ProviderStatus = "COMPLETED"
 	CSPStatusCancelled ProviderStatus = "CANCELLED"
 )
+
+// Constants for expedite statuses
+const (
+	ExpediteStatusRequested   ExpediteStatus = "REQUESTED"
+	ExpediteStatusFailed      ExpediteStatus = "FAILED"
+	ExpediteStatusUnsupported ExpediteStatus = "UNSUPPORTED"
+)
@@ -20,6 +20,9 @@ type RuleEvaluationResult int
 const (
 	RuleEvaluationSuccess RuleEvaluationResult = iota
 	RuleEvaluationFailed
+	// RuleEvaluationDeferred means a Workload rule held the event back; it is evaluated again
+	// when the pods on the node change
+	RuleEvaluationDeferred
 )
 
 const (
@@ -33,5 +36,25 @@ const (
 	QuarantinedNodeIsUntaintedManuallyAnnotationKey    = "quarantinedNodeUntaintedManually"
 	QuarantinedNodeIsUntaintedManuallyAnnotationValue  = "True"
 
+	// Annotation keys for events held until a maintenance window opens, and the comma separated
+	// schedules that held them
+	QuarantinePendingHealthEventsAnnotationKey         = "quarantinePendingHealthEvents"
+	QuarantineNextMaintenanceWindowAnnotationKey       = "quarantineNextMaintenanceWindow"
+	QuarantinePendingMaintenanceSchedulesAnnotationKey = "quarantinePendingMaintenanceSchedules"
+
+	// Annotation key for events held back by Workload rules until the pods on the node change
+	QuarantineWorkloadDeferredHealthEventsAnnotationKey = "quarantineWorkloadDeferredHealthEvents"
+
+	// Annotation keys for device-level quarantine: the events tracked per device and the
+	// comma separated UUIDs of the devices currently quarantined on the node
+	QuarantineDeviceHealthEventsAnnotationKey = "quarantineDeviceHealthEvents"
+	QuarantinedDevicesAnnotationKey           = "quarantinedDevices"
+
+	// Annotation keys for probation before uncordon: when the node's probation started, the healthy
+	// event that started it and, if the validation job failed, why the node stays quarantined
+	QuarantineProbationStartTimeAnnotationKey   = "quarantineProbationStartTime"
+	QuarantineProbationHealthEventAnnotationKey = "quarantineProbationHealthEvent"
+	QuarantineProbationFailedAnnotationKey      = "quarantineProbationFailed"
+
 	ServiceName = "NVSentinel"
 )
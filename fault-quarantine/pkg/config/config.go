@@ -14,9 +14,22 @@
 
 package config
 
+import "github.com/nvidia/nvsentinel/commons/pkg/schedule"
+
+// Outcomes of a Workload rule whose expression evaluates to false
+const (
+	// WorkloadOnFalseDefer holds the event back until the pods on the node change
+	WorkloadOnFalseDefer = "defer"
+	// WorkloadOnFalseSkip lets the rule fail, so that the rule set does not act on the event
+	WorkloadOnFalseSkip = "skip"
+)
+
 type Rule struct {
 	Kind       string `toml:"kind"`
 	Expression string `toml:"expression"`
+	// OnFalse selects the outcome of a Workload rule evaluating to false: "defer" (the default) or
+	// "skip". It is not supported by the other rule kinds.
+	OnFalse string `toml:"onFalse"`
 }
 
 type Taint struct {
@@ -47,10 +60,60 @@ type RuleSet struct {
 	Match    Match  `toml:"match"`
 	Taint    Taint  `toml:"taint"`
 	Cordon   Cordon `toml:"cordon"`
+	// Schedule optionally names a maintenance schedule. Non-fatal events matching this rule set
+	// are held until the schedule's next window opens; fatal events are always acted on immediately.
+	Schedule string `toml:"schedule"`
+	// DeviceQuarantine isolates only the impacted GPU_UUID entities instead of the whole node
+	DeviceQuarantine DeviceQuarantine `toml:"deviceQuarantine"`
+}
+
+// DeviceQuarantine enables device-level quarantine for a rule set. Events without GPU_UUID
+// entities fall back to the rule set's cordon and taint settings.
+type DeviceQuarantine struct {
+	Enabled bool `toml:"enabled"`
+}
+
+// DeviceQuarantineConfig controls how device-level quarantine is published to the cluster
+type DeviceQuarantineConfig struct {
+	// Mode must be "DRA", which is also the default
+	Mode string `toml:"mode"`
+	// Driver is the DRA driver publishing the GPUs, used in DRA mode
+	Driver string `toml:"driver"`
+	// UUIDAttribute is the ResourceSlice device attribute holding the GPU UUID, used in DRA mode
+	UUIDAttribute string `toml:"uuidAttribute"`
+	TaintKey      string `toml:"taintKey"`
+	TaintEffect   string `toml:"taintEffect"`
+}
+
+// Probation keeps a node cordoned after all of its checks recovered until it has stayed healthy for
+// MinHealthyDuration and, when enabled, a validation job has passed on it
+type Probation struct {
+	Enabled            bool                `toml:"enabled"`
+	MinHealthyDuration string              `toml:"minHealthyDuration"`
+	Validation         ProbationValidation `toml:"validation"`
+}
+
+// ProbationValidation describes the job run on the node at the end of its probation. The job is
+// bound to the node and tolerates all taints so that it runs while the node is still quarantined.
+type ProbationValidation struct {
+	Enabled            bool              `toml:"enabled"`
+	Namespace          string            `toml:"namespace"`
+	Image              string            `toml:"image"`
+	Command            []string          `toml:"command"`
+	Args               []string          `toml:"args"`
+	Env                map[string]string `toml:"env"`
+	ServiceAccountName string            `toml:"serviceAccountName"`
+	// ResourceLimits maps resource names such as nvidia.com/gpu to quantities
+	ResourceLimits map[string]string `toml:"resourceLimits"`
+	Timeout        string            `toml:"timeout"`
 }
 
 type TomlConfig struct {
-	LabelPrefix    string         `toml:"label-prefix"`
-	CircuitBreaker CircuitBreaker `toml:"circuitBreaker"`
-	RuleSets       []RuleSet      `toml:"rule-sets"`
+	LabelPrefix    string            `toml:"label-prefix"`
+	CircuitBreaker CircuitBreaker    `toml:"circuitBreaker"`
+	RuleSets       []RuleSet         `toml:"rule-sets"`
+	Schedules      []schedule.Config `toml:"schedules"`
+
+	DeviceQuarantine DeviceQuarantineConfig `toml:"deviceQuarantine"`
+	Probation        Probation              `toml:"probation"`
 }
@@ -0,0 +1,196 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package devicequarantine
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	resourcev1 "k8s.io/api/resource/v1"
+	resourcev1alpha3 "k8s.io/api/resource/v1alpha3"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/nvidia/nvsentinel/fault-quarantine/pkg/config"
+)
+
+const (
+	managedByLabelKey   = "nvsentinel.nvidia.com/managed-by"
+	managedByLabelValue = "fault-quarantine"
+	nodeLabelKey        = "nvsentinel.nvidia.com/node"
+)
+
+// draPublisher taints quarantined devices with one DeviceTaintRule per device. ResourceSlices
+// are owned by the DRA driver, so devices are selected by driver, pool and device name rather
+// than by modifying the slices directly.
+type draPublisher struct {
+	clientset     kubernetes.Interface
+	driver        string
+	uuidAttribute resourcev1.QualifiedName
+	taint         resourcev1alpha3.DeviceTaint
+}
+
+func newDRAPublisher(cfg config.DeviceQuarantineConfig, clientset kubernetes.Interface) (*draPublisher, error) {
+	effect := resourcev1alpha3.DeviceTaintEffect(cfg.TaintEffect)
+
+	switch effect {
+	case resourcev1alpha3.DeviceTaintEffectNone,
+		resourcev1alpha3.DeviceTaintEffectNoSchedule,
+		resourcev1alpha3.DeviceTaintEffectNoExecute:
+	default:
+		return nil, fmt.Errorf("unsupported device taint effect %q", cfg.TaintEffect)
+	}
+
+	if err := checkDeviceTaintRuleAPI(clientset); err != nil {
+		return nil, err
+	}
+
+	return &draPublisher{
+		clientset:     clientset,
+		driver:        cfg.Driver,
+		uuidAttribute: resourcev1.QualifiedName(cfg.UUIDAttribute),
+		taint: resourcev1alpha3.DeviceTaint{
+			Key:    cfg.TaintKey,
+			Value:  "true",
+			Effect: effect,
+		},
+	}, nil
+}
+
+// checkDeviceTaintRuleAPI verifies that the cluster serves the alpha DeviceTaintRule API, which is
+// only available with the DRADeviceTaints feature gate and the resource.k8s.io/v1alpha3 API enabled
+func checkDeviceTaintRuleAPI(clientset kubernetes.Interface) error {
+	groupVersion := resourcev1alpha3.SchemeGroupVersion.String()
+
+	resources, err := clientset.Discovery().ServerResourcesForGroupVersion(groupVersion)
+	if err != nil {
+		return fmt.Errorf("DRA device quarantine requires the %s API; enable it together with the "+
+			"DRADeviceTaints feature gate: %w", groupVersion, err)
+	}
+
+	for _, resource := range resources.APIResources {
+		if resource.Name == "devicetaintrules" {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("DRA device quarantine requires DeviceTaintRules in %s; enable the DRADeviceTaints "+
+		"feature gate", groupVersion)
+}
+
+func (p *draPublisher) Quarantine(ctx context.Context, nodeName string, uuids []string) error {
+	devices, err := p.findDevices(ctx, nodeName)
+	if err != nil {
+		return err
+	}
+
+	for _, uuid := range uuids {
+		device, ok := devices[uuid]
+		if !ok {
+			return fmt.Errorf("device %s not found in ResourceSlices of driver %s on node %s", uuid, p.driver, nodeName)
+		}
+
+		rule := &resourcev1alpha3.DeviceTaintRule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: deviceTaintRuleName(nodeName, uuid),
+				Labels: map[string]string{
+					managedByLabelKey: managedByLabelValue,
+					nodeLabelKey:      nodeName,
+				},
+			},
+			Spec: resourcev1alpha3.DeviceTaintRuleSpec{
+				DeviceSelector: &resourcev1alpha3.DeviceTaintSelector{
+					Driver: &p.driver,
+					Pool:   &device.pool,
+					Device: &device.name,
+				},
+				Taint: p.taint,
+			},
+		}
+
+		_, err := p.clientset.ResourceV1alpha3().DeviceTaintRules().Create(ctx, rule, metav1.CreateOptions{})
+		if err != nil && !errors.IsAlreadyExists(err) {
+			return fmt.Errorf("failed to create DeviceTaintRule for device %s on node %s: %w", uuid, nodeName, err)
+		}
+
+		slog.InfoContext(ctx, "Tainted quarantined device", "node", nodeName, "uuid", uuid,
+			"pool", device.pool, "device", device.name)
+	}
+
+	return nil
+}
+
+func (p *draPublisher) Release(ctx context.Context, nodeName string, uuids []string) error {
+	for _, uuid := range uuids {
+		err := p.clientset.ResourceV1alpha3().DeviceTaintRules().Delete(
+			ctx, deviceTaintRuleName(nodeName, uuid), metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete DeviceTaintRule for device %s on node %s: %w", uuid, nodeName, err)
+		}
+
+		slog.InfoContext(ctx, "Removed taint from recovered device", "node", nodeName, "uuid", uuid)
+	}
+
+	return nil
+}
+
+type sliceDevice struct {
+	pool string
+	name string
+}
+
+// findDevices maps the UUID attribute of every device the driver publishes for the node to the
+// device's pool and name
+func (p *draPublisher) findDevices(ctx context.Context, nodeName string) (map[string]sliceDevice, error) {
+	selector := fields.AndSelectors(
+		fields.OneTermEqualSelector(resourcev1.ResourceSliceSelectorNodeName, nodeName),
+		fields.OneTermEqualSelector(resourcev1.ResourceSliceSelectorDriver, p.driver),
+	)
+
+	slices, err := p.clientset.ResourceV1().ResourceSlices().List(ctx, metav1.ListOptions{
+		FieldSelector: selector.String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ResourceSlices for node %s: %w", nodeName, err)
+	}
+
+	devices := make(map[string]sliceDevice)
+
+	for _, slice := range slices.Items {
+		if slice.Spec.NodeName == nil || *slice.Spec.NodeName != nodeName || slice.Spec.Driver != p.driver {
+			continue
+		}
+
+		for _, device := range slice.Spec.Devices {
+			attr, ok := device.Attributes[p.uuidAttribute]
+			if !ok || attr.StringValue == nil {
+				continue
+			}
+
+			devices[*attr.StringValue] = sliceDevice{pool: slice.Spec.Pool.Name, name: device.Name}
+		}
+	}
+
+	return devices, nil
+}
+
+// deviceTaintRuleName derives a stable rule name so that quarantine and release are idempotent
+func deviceTaintRuleName(nodeName, uuid string) string {
+	return strings.ToLower("nvsentinel-" + nodeName + "-" + uuid)
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package devicequarantine isolates individual GPUs instead of cordoning the whole node.
+// Quarantined GPU UUIDs are always published as a node annotation, which device plugin based
+// integrations consume to report the devices as unhealthy. In DRA mode the devices are
+// additionally tainted with DeviceTaintRules so the scheduler stops allocating them.
+package devicequarantine
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/nvidia/nvsentinel/fault-quarantine/pkg/config"
+)
+
+const (
+	// ModeDRA taints quarantined devices in the DRA driver's ResourceSlices
+	ModeDRA = "DRA"
+
+	// EntityType is the impacted entity type that identifies a device
+	EntityType = "GPU_UUID"
+
+	defaultDriver        = "gpu.nvidia.com"
+	defaultUUIDAttribute = "uuid"
+	defaultTaintKey      = "nvsentinel.nvidia.com/unhealthy"
+	defaultTaintEffect   = "NoSchedule"
+)
+
+// Publisher isolates devices on a node and releases them once they recover.
+type Publisher interface {
+	// Quarantine isolates the devices with the given UUIDs. Quarantining an already isolated
+	// device is a no-op.
+	Quarantine(ctx context.Context, nodeName string, uuids []string) error
+	// Release lifts the isolation of the devices with the given UUIDs. Releasing a device that
+	// is not isolated is a no-op.
+	Release(ctx context.Context, nodeName string, uuids []string) error
+}
+
+// NewPublisher returns the publisher for the configured mode, applying defaults to unset fields.
+// Only DRA isolates devices from the scheduler, so it is the default and the only supported mode.
+func NewPublisher(cfg config.DeviceQuarantineConfig, clientset kubernetes.Interface) (Publisher, error) {
+	switch cfg.Mode {
+	case "", ModeDRA:
+		if cfg.Driver == "" {
+			cfg.Driver = defaultDriver
+		}
+
+		if cfg.UUIDAttribute == "" {
+			cfg.UUIDAttribute = defaultUUIDAttribute
+		}
+
+		if cfg.TaintKey == "" {
+			cfg.TaintKey = defaultTaintKey
+		}
+
+		if cfg.TaintEffect == "" {
+			cfg.TaintEffect = defaultTaintEffect
+		}
+
+		return newDRAPublisher(cfg, clientset)
+	default:
+		return nil, fmt.Errorf("unsupported device quarantine mode %q, must be %q", cfg.Mode, ModeDRA)
+	}
+}
+
+// Disabled returns the publisher used when no rule set quarantines devices
+func Disabled() Publisher {
+	return disabledPublisher{}
+}
+
+type disabledPublisher struct{}
+
+func (disabledPublisher) Quarantine(context.Context, string, []string) error {
+	return nil
+}
+
+func (disabledPublisher) Release(context.Context, string, []string) error {
+	return nil
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package devicequarantine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	resourcev1 "k8s.io/api/resource/v1"
+	resourcev1alpha3 "k8s.io/api/resource/v1alpha3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	"github.com/nvidia/nvsentinel/fault-quarantine/pkg/config"
+)
+
+func gpuSlice(name, nodeName string, uuids map[string]string) *resourcev1.ResourceSlice {
+	slice := &resourcev1.ResourceSlice{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: resourcev1.ResourceSliceSpec{
+			Driver:   defaultDriver,
+			Pool:     resourcev1.ResourcePool{Name: nodeName, ResourceSliceCount: 1},
+			NodeName: ptr.To(nodeName),
+		},
+	}
+
+	for device, uuid := range uuids {
+		slice.Spec.Devices = append(slice.Spec.Devices, resourcev1.Device{
+			Name: device,
+			Attributes: map[resourcev1.QualifiedName]resourcev1.DeviceAttribute{
+				defaultUUIDAttribute: {StringValue: ptr.To(uuid)},
+			},
+		})
+	}
+
+	return slice
+}
+
+// draClientset returns a fake clientset whose discovery serves the DeviceTaintRule API
+func draClientset(objects ...runtime.Object) *fake.Clientset {
+	clientset := fake.NewSimpleClientset(objects...)
+	clientset.Discovery().(*fakediscovery.FakeDiscovery).Resources = []*metav1.APIResourceList{{
+		GroupVersion: resourcev1alpha3.SchemeGroupVersion.String(),
+		APIResources: []metav1.APIResource{{Name: "devicetaintrules", Kind: "DeviceTaintRule"}},
+	}}
+
+	return clientset
+}
+
+func TestNewPublisher(t *testing.T) {
+	clientset := draClientset()
+
+	publisher, err := NewPublisher(config.DeviceQuarantineConfig{}, clientset)
+	require.NoError(t, err)
+	require.IsType(t, &draPublisher{}, publisher)
+	assert.Equal(t, defaultDriver, publisher.(*draPublisher).driver)
+	assert.Equal(t, resourcev1alpha3.DeviceTaintEffectNoSchedule, publisher.(*draPublisher).taint.Effect)
+
+	_, err = NewPublisher(config.DeviceQuarantineConfig{Mode: "Annotation"}, clientset)
+	assert.Error(t, err, "annotation only mode does not isolate devices")
+
+	_, err = NewPublisher(config.DeviceQuarantineConfig{Mode: ModeDRA, TaintEffect: "PreferNoSchedule"}, clientset)
+	assert.Error(t, err)
+}
+
+func TestNewPublisherWithoutDeviceTaintRuleAPI(t *testing.T) {
+	_, err := NewPublisher(config.DeviceQuarantineConfig{Mode: ModeDRA}, fake.NewSimpleClientset())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "DRADeviceTaints")
+}
+
+func TestDRAPublisherQuarantineAndRelease(t *testing.T) {
+	ctx := context.Background()
+	clientset := draClientset(
+		gpuSlice("node-1-gpus", "node-1", map[string]string{"gpu-0": "GPU-AAA", "gpu-1": "GPU-BBB"}),
+		gpuSlice("node-2-gpus", "node-2", map[string]string{"gpu-0": "GPU-CCC"}),
+	)
+
+	publisher, err := NewPublisher(config.DeviceQuarantineConfig{Mode: ModeDRA}, clientset)
+	require.NoError(t, err)
+
+	require.NoError(t, publisher.Quarantine(ctx, "node-1", []string{"GPU-BBB"}))
+	// Quarantining the same device again must be idempotent.
+	require.NoError(t, publisher.Quarantine(ctx, "node-1", []string{"GPU-BBB"}))
+
+	rule, err := clientset.ResourceV1alpha3().DeviceTaintRules().Get(ctx, "nvsentinel-node-1-gpu-bbb", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, defaultDriver, *rule.Spec.DeviceSelector.Driver)
+	assert.Equal(t, "node-1", *rule.Spec.DeviceSelector.Pool)
+	assert.Equal(t, "gpu-1", *rule.Spec.DeviceSelector.Device)
+	assert.Equal(t, defaultTaintKey, rule.Spec.Taint.Key)
+	assert.Equal(t, "node-1", rule.Labels[nodeLabelKey])
+
+	// Devices published for another node are not matched.
+	assert.Error(t, publisher.Quarantine(ctx, "node-1", []string{"GPU-CCC"}))
+
+	require.NoError(t, publisher.Release(ctx, "node-1", []string{"GPU-BBB"}))
+	require.NoError(t, publisher.Release(ctx, "node-1", []string{"GPU-BBB"}))
+
+	rules, err := clientset.ResourceV1alpha3().DeviceTaintRules().List(ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, rules.Items)
+}
@@ -23,16 +23,20 @@ import (
 
 	"github.com/google/cel-go/cel"
 	"github.com/google/cel-go/ext"
+	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	corelisters "k8s.io/client-go/listers/core/v1"
 
+	"github.com/nvidia/nvsentinel/data-models/pkg/model"
 	"github.com/nvidia/nvsentinel/data-models/pkg/protos"
 	"github.com/nvidia/nvsentinel/fault-quarantine/pkg/common"
+	"github.com/nvidia/nvsentinel/fault-quarantine/pkg/config"
 )
 
 const (
 	eventObjKey = "event"
 	nodeObjKey  = "node"
+	podsObjKey  = "pods"
 )
 
 type RuleEvaluator interface {
@@ -50,6 +54,19 @@ type NodeRuleEvaluator struct {
 	nodeLister corelisters.NodeLister
 }
 
+// PodsOnNodeLister lists the pods scheduled on a node, typically backed by an informer cache
+type PodsOnNodeLister interface {
+	ListPodsOnNode(nodeName string) ([]*v1.Pod, error)
+}
+
+type WorkloadRuleEvaluator struct {
+	expression string
+	program    cel.Program
+	podLister  PodsOnNodeLister
+	// falseResult is returned when the expression evaluates to false
+	falseResult common.RuleEvaluationResult
+}
+
 // NewHealthEventRuleEvaluator creates a new HealthEventRuleEvaluator with dynamic declarations
 func NewHealthEventRuleEvaluator(expression string) (*HealthEventRuleEvaluator, error) {
 	slog.Info("Creating HealthEventRuleEvaluator", "expression", expression)
@@ -189,6 +206,169 @@ func (nm *NodeRuleEvaluator) getNode(nodeName string) (map[string]interface{}, e
 	}, nil
 }
 
+// NewWorkloadRuleEvaluator creates a new WorkloadRuleEvaluator. The expression has access to the
+// 'pods' list describing the non-terminated pods on the node and to the triggering 'event'. onFalse
+// selects whether an expression evaluating to false defers the event (the default) or skips it.
+func NewWorkloadRuleEvaluator(
+	expression string,
+	onFalse string,
+	podLister PodsOnNodeLister,
+) (*WorkloadRuleEvaluator, error) {
+	slog.Info("Creating WorkloadRuleEvaluator", "expression", expression, "onFalse", onFalse)
+
+	var falseResult common.RuleEvaluationResult
+
+	switch onFalse {
+	case "", config.WorkloadOnFalseDefer:
+		falseResult = common.RuleEvaluationDeferred
+	case config.WorkloadOnFalseSkip:
+		falseResult = common.RuleEvaluationFailed
+	default:
+		return nil, fmt.Errorf("invalid onFalse %q, must be %q or %q", onFalse,
+			config.WorkloadOnFalseDefer, config.WorkloadOnFalseSkip)
+	}
+
+	env, err := cel.NewEnv(
+		cel.Variable(podsObjKey, cel.ListType(cel.DynType)),
+		cel.Variable(eventObjKey, cel.AnyType),
+		ext.Strings(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create CEL environment: %w", err)
+	}
+
+	ast, issues := env.Parse(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, fmt.Errorf("failed to parse expression: %w", issues.Err())
+	}
+
+	checkedAst, issues := env.Check(ast)
+
+	if issues != nil && issues.Err() != nil {
+		return nil, fmt.Errorf("failed to check expression: %w", issues.Err())
+	}
+
+	program, err := env.Program(checkedAst)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile expression: %w", err)
+	}
+
+	return &WorkloadRuleEvaluator{
+		expression:  expression,
+		program:     program,
+		podLister:   podLister,
+		falseResult: falseResult,
+	}, nil
+}
+
+// Evaluate the CEL expression against the workloads running on the event's node
+func (we *WorkloadRuleEvaluator) Evaluate(event *protos.HealthEvent) (common.RuleEvaluationResult, error) {
+	slog.Info("Evaluating WorkloadRuleEvaluator for node", "node", event.NodeName)
+
+	pods, err := we.podLister.ListPodsOnNode(event.NodeName)
+	if err != nil {
+		return common.RuleEvaluationFailed, fmt.Errorf("failed to list pods on node: %w", err)
+	}
+
+	eventObj, err := RoundTrip(event)
+	if err != nil {
+		return common.RuleEvaluationFailed, fmt.Errorf("error roundtripping event: %w", err)
+	}
+
+	out, _, err := we.program.Eval(map[string]interface{}{
+		podsObjKey:  podsToWorkloadObjs(pods),
+		eventObjKey: eventObj,
+	})
+	if err != nil {
+		return common.RuleEvaluationFailed, fmt.Errorf("failed to evaluate expression: %w", err)
+	}
+
+	result, ok := out.Value().(bool)
+	if !ok {
+		return common.RuleEvaluationFailed, fmt.Errorf("expression did not return a boolean: %v", out)
+	}
+
+	if result {
+		return common.RuleEvaluationSuccess, nil
+	}
+
+	return we.falseResult, nil
+}
+
+// podsToWorkloadObjs converts pods into the flattened view exposed to Workload rules. Pods that
+// have already terminated are skipped as they no longer hold any node resources.
+func podsToWorkloadObjs(pods []*v1.Pod) []interface{} {
+	objs := make([]interface{}, 0, len(pods))
+
+	for _, pod := range pods {
+		if pod.Status.Phase == v1.PodSucceeded || pod.Status.Phase == v1.PodFailed {
+			continue
+		}
+
+		objs = append(objs, podToWorkloadObj(pod))
+	}
+
+	return objs
+}
+
+func podToWorkloadObj(pod *v1.Pod) map[string]interface{} {
+	labels := pod.Labels
+	if labels == nil {
+		labels = map[string]string{}
+	}
+
+	annotations := pod.Annotations
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+
+	ownerKinds := make([]string, 0, len(pod.OwnerReferences))
+	for _, ref := range pod.OwnerReferences {
+		ownerKinds = append(ownerKinds, ref.Kind)
+	}
+
+	var priority int64
+	if pod.Spec.Priority != nil {
+		priority = int64(*pod.Spec.Priority)
+	}
+
+	return map[string]interface{}{
+		"name":              pod.Name,
+		"namespace":         pod.Namespace,
+		"labels":            labels,
+		"annotations":       annotations,
+		"phase":             string(pod.Status.Phase),
+		"priorityClassName": pod.Spec.PriorityClassName,
+		"priority":          priority,
+		"ownerKinds":        ownerKinds,
+		"gpuDevices":        podGPUDevices(pod),
+	}
+}
+
+// podGPUDevices returns the GPU UUIDs that metadata-collector recorded in the pod's device annotation
+func podGPUDevices(pod *v1.Pod) []string {
+	devices := []string{}
+
+	deviceAnnotationJSON, ok := pod.Annotations[model.PodDeviceAnnotationName]
+	if !ok {
+		return devices
+	}
+
+	var deviceAnnotation model.DeviceAnnotation
+	if err := json.Unmarshal([]byte(deviceAnnotationJSON), &deviceAnnotation); err != nil {
+		slog.Warn("Failed to parse pod device annotation",
+			"pod", pod.Namespace+"/"+pod.Name, "error", err)
+
+		return devices
+	}
+
+	for _, resourceName := range model.EntityTypeToResourceNames["GPU_UUID"] {
+		devices = append(devices, deviceAnnotation.Devices[resourceName]...)
+	}
+
+	return devices
+}
+
 var primitiveKinds = map[reflect.Kind]bool{
 	reflect.Bool:       true,
 	reflect.Int:        true,
@@ -31,6 +31,7 @@ import (
 
 	"github.com/nvidia/nvsentinel/data-models/pkg/protos"
 	"github.com/nvidia/nvsentinel/fault-quarantine/pkg/common"
+	"github.com/nvidia/nvsentinel/fault-quarantine/pkg/config"
 	"github.com/nvidia/nvsentinel/fault-quarantine/pkg/informer"
 	"github.com/nvidia/nvsentinel/store-client/pkg/testutils"
 )
@@ -215,6 +216,148 @@ func TestNodeToSkipLabelRuleEvaluator(t *testing.T) {
 	}
 }
 
+type fakePodsOnNodeLister struct {
+	pods map[string][]*corev1.Pod
+}
+
+func (f *fakePodsOnNodeLister) ListPodsOnNode(nodeName string) ([]*corev1.Pod, error) {
+	return f.pods[nodeName], nil
+}
+
+func TestWorkloadRuleEvaluator(t *testing.T) {
+	trainingPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "trainer-0",
+			Namespace: "team-a",
+			Labels:    map[string]string{"checkpoint-in-progress": "true"},
+			Annotations: map[string]string{
+				"dgxc.nvidia.com/devices": `{"devices":{"nvidia.com/gpu":["GPU-123"]}}`,
+			},
+			OwnerReferences: []metav1.OwnerReference{{Kind: "Job", Name: "trainer"}},
+		},
+		Spec:   corev1.PodSpec{NodeName: "gpu-node", PriorityClassName: "training"},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+
+	systemPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "device-plugin",
+			Namespace:       "kube-system",
+			OwnerReferences: []metav1.OwnerReference{{Kind: "DaemonSet", Name: "device-plugin"}},
+		},
+		Spec:   corev1.PodSpec{NodeName: "gpu-node"},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+
+	completedPod := trainingPod.DeepCopy()
+	completedPod.Status.Phase = corev1.PodSucceeded
+
+	tests := []struct {
+		name           string
+		expression     string
+		onFalse        string
+		pods           []*corev1.Pod
+		event          *protos.HealthEvent
+		expectEvaluate common.RuleEvaluationResult
+		expectError    bool
+	}{
+		{
+			name:           "Defer while checkpoint in progress",
+			expression:     `!pods.exists(p, 'checkpoint-in-progress' in p.labels)`,
+			pods:           []*corev1.Pod{trainingPod, systemPod},
+			event:          &protos.HealthEvent{NodeName: "gpu-node"},
+			expectEvaluate: common.RuleEvaluationDeferred,
+		},
+		{
+			name:           "Terminated pods are ignored",
+			expression:     `!pods.exists(p, 'checkpoint-in-progress' in p.labels)`,
+			pods:           []*corev1.Pod{completedPod, systemPod},
+			event:          &protos.HealthEvent{NodeName: "gpu-node"},
+			expectEvaluate: common.RuleEvaluationSuccess,
+		},
+		{
+			name:           "Defer explicitly while checkpoint in progress",
+			expression:     `!pods.exists(p, 'checkpoint-in-progress' in p.labels)`,
+			onFalse:        config.WorkloadOnFalseDefer,
+			pods:           []*corev1.Pod{trainingPod, systemPod},
+			event:          &protos.HealthEvent{NodeName: "gpu-node"},
+			expectEvaluate: common.RuleEvaluationDeferred,
+		},
+		{
+			name:           "Skip when only system pods are present",
+			expression:     `!pods.all(p, p.namespace == 'kube-system')`,
+			onFalse:        config.WorkloadOnFalseSkip,
+			pods:           []*corev1.Pod{systemPod},
+			event:          &protos.HealthEvent{NodeName: "gpu-node"},
+			expectEvaluate: common.RuleEvaluationFailed,
+		},
+		{
+			name:           "Do not skip when workload pods are present",
+			expression:     `!pods.all(p, p.namespace == 'kube-system')`,
+			onFalse:        config.WorkloadOnFalseSkip,
+			pods:           []*corev1.Pod{trainingPod, systemPod},
+			event:          &protos.HealthEvent{NodeName: "gpu-node"},
+			expectEvaluate: common.RuleEvaluationSuccess,
+		},
+		{
+			name:           "Invalid onFalse",
+			expression:     `pods.size() > 0`,
+			onFalse:        "drop",
+			event:          &protos.HealthEvent{NodeName: "gpu-node"},
+			expectEvaluate: common.RuleEvaluationFailed,
+			expectError:    true,
+		},
+		{
+			name:       "Match pod using the impacted GPU",
+			expression: `pods.exists(p, p.ownerKinds.exists(k, k == 'Job') && p.gpuDevices.exists(d, event.entitiesImpacted.exists(e, e.entityValue == d)))`,
+			pods:       []*corev1.Pod{trainingPod, systemPod},
+			event: &protos.HealthEvent{
+				NodeName:         "gpu-node",
+				EntitiesImpacted: []*protos.Entity{{EntityType: "GPU_UUID", EntityValue: "GPU-123"}},
+			},
+			expectEvaluate: common.RuleEvaluationSuccess,
+		},
+		{
+			name:           "Priority class is exposed",
+			expression:     `pods.exists(p, p.priorityClassName == 'training')`,
+			pods:           []*corev1.Pod{trainingPod},
+			event:          &protos.HealthEvent{NodeName: "gpu-node"},
+			expectEvaluate: common.RuleEvaluationSuccess,
+		},
+		{
+			name:           "Invalid expression",
+			expression:     "pods.invalid(",
+			event:          &protos.HealthEvent{NodeName: "gpu-node"},
+			expectEvaluate: common.RuleEvaluationFailed,
+			expectError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lister := &fakePodsOnNodeLister{pods: map[string][]*corev1.Pod{"gpu-node": tt.pods}}
+
+			evaluator, err := NewWorkloadRuleEvaluator(tt.expression, tt.onFalse, lister)
+			if err != nil {
+				if !tt.expectError {
+					t.Fatalf("Failed to create WorkloadRuleEvaluator: %v", err)
+				}
+
+				return
+			}
+
+			result, err := evaluator.Evaluate(tt.event)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("Unexpected evaluation error for %s: %v", tt.name, err)
+			}
+
+			if result != tt.expectEvaluate {
+				t.Errorf("Expected evaluator %s to return %d but got %d", tt.name, tt.expectEvaluate, result)
+			}
+		})
+	}
+}
+
 func TestRoundTrip(t *testing.T) {
 	eventTime := timestamppb.New(time.Now())
 	event := &protos.HealthEvent{
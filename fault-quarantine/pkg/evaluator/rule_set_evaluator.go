@@ -27,6 +27,7 @@ import (
 func InitializeRuleSetEvaluators(
 	ruleSets []config.RuleSet,
 	nodeInformer *informer.NodeInformer,
+	podInformer *informer.PodInformer,
 ) ([]RuleSetEvaluatorIface, error) {
 	var (
 		ruleSetEvals []RuleSetEvaluatorIface
@@ -40,7 +41,7 @@ func InitializeRuleSetEvaluators(
 		}
 
 		if len(ruleSet.Match.Any) > 0 {
-			evaluators, err := createEvaluators(ruleSet.Match.Any, nodeInformer)
+			evaluators, err := createEvaluators(ruleSet.Match.Any, nodeInformer, podInformer)
 			if err != nil {
 				errs = multierror.Append(errs, err)
 			} else {
@@ -52,7 +53,7 @@ func InitializeRuleSetEvaluators(
 		}
 
 		if len(ruleSet.Match.All) > 0 {
-			evaluators, err := createEvaluators(ruleSet.Match.All, nodeInformer)
+			evaluators, err := createEvaluators(ruleSet.Match.All, nodeInformer, podInformer)
 			if err != nil {
 				errs = multierror.Append(errs, err)
 			} else {
@@ -67,7 +68,11 @@ func InitializeRuleSetEvaluators(
 	return ruleSetEvals, errs.ErrorOrNil()
 }
 
-func createEvaluators(rules []config.Rule, nodeInformer *informer.NodeInformer) ([]RuleEvaluator, error) {
+func createEvaluators(
+	rules []config.Rule,
+	nodeInformer *informer.NodeInformer,
+	podInformer *informer.PodInformer,
+) ([]RuleEvaluator, error) {
 	evaluators := []RuleEvaluator{}
 
 	var errs *multierror.Error
@@ -89,10 +94,21 @@ func createEvaluators(rules []config.Rule, nodeInformer *informer.NodeInformer)
 				eval, err = NewNodeRuleEvaluator(rule.Expression, nodeInformer.Lister())
 			}
 
+		case "Workload":
+			if podInformer == nil {
+				err = fmt.Errorf("PodInformer must be provided for Workload rule kind")
+			} else {
+				eval, err = NewWorkloadRuleEvaluator(rule.Expression, rule.OnFalse, podInformer)
+			}
+
 		default:
 			err = fmt.Errorf("unknown evaluator kind: %s", rule.Kind)
 		}
 
+		if err == nil && rule.OnFalse != "" && rule.Kind != "Workload" {
+			err = fmt.Errorf("onFalse is only supported by Workload rules, got it on a %s rule", rule.Kind)
+		}
+
 		if err != nil {
 			errs = multierror.Append(errs, err)
 			continue
@@ -31,17 +31,29 @@ func (allEval *AllRuleSetEvaluator) Evaluate(
 	healthEvent *protos.HealthEvent) (common.RuleEvaluationResult, error) {
 	var errs *multierror.Error
 
+	deferred := false
+
 	for _, evaluator := range allEval.evaluators {
 		ruleEvaluatedResult, err := evaluator.Evaluate(healthEvent)
 		if err != nil {
 			errs = multierror.Append(errs, err)
 		}
 
+		// A deferred rule only holds the event back if every other rule matches
+		if ruleEvaluatedResult == common.RuleEvaluationDeferred {
+			deferred = true
+			continue
+		}
+
 		if ruleEvaluatedResult != common.RuleEvaluationSuccess {
 			return ruleEvaluatedResult, errs.ErrorOrNil()
 		}
 	}
 
+	if deferred {
+		return common.RuleEvaluationDeferred, errs.ErrorOrNil()
+	}
+
 	return common.RuleEvaluationSuccess, errs.ErrorOrNil()
 }
 
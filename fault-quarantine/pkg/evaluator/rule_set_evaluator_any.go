@@ -33,22 +33,28 @@ func (anyEval *AnyRuleSetEvaluator) Evaluate(
 ) (common.RuleEvaluationResult, error) {
 	var errs *multierror.Error
 
+	result := common.RuleEvaluationFailed
+
 	for _, evaluator := range anyEval.evaluators {
 		ruleEvaluatedResult, err := evaluator.Evaluate(healthEvent)
 		if ruleEvaluatedResult == common.RuleEvaluationSuccess {
 			return common.RuleEvaluationSuccess, nil
 		}
 
+		if ruleEvaluatedResult == common.RuleEvaluationDeferred {
+			result = common.RuleEvaluationDeferred
+		}
+
 		if err != nil {
 			errs = multierror.Append(errs, err)
 		}
 	}
 
 	if errs.ErrorOrNil() != nil {
-		return common.RuleEvaluationFailed, errs
+		return result, errs
 	}
 
-	return common.RuleEvaluationFailed, nil
+	return result, nil
 }
 
 func NewAnyRuleSetEvaluator(evaluators []RuleEvaluator, ruleset config.RuleSet) *AnyRuleSetEvaluator {
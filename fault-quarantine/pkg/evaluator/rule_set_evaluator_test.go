@@ -38,6 +38,12 @@ func (m *MockRuleEvaluator) Evaluate(healthEvent *protos.HealthEvent) (common.Ru
 	return common.RuleEvaluationFailed, m.err
 }
 
+type deferredRuleEvaluator struct{}
+
+func (deferredRuleEvaluator) Evaluate(healthEvent *protos.HealthEvent) (common.RuleEvaluationResult, error) {
+	return common.RuleEvaluationDeferred, nil
+}
+
 func TestAnyRuleSetEvaluator_Evaluate(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -57,6 +63,26 @@ func TestAnyRuleSetEvaluator_Evaluate(t *testing.T) {
 			expected:  common.RuleEvaluationSuccess,
 			expectErr: false,
 		},
+		{
+			name: "Deferred evaluator without a match",
+			evaluators: []RuleEvaluator{
+				&MockRuleEvaluator{result: false, err: nil},
+				deferredRuleEvaluator{},
+			},
+			event:     &protos.HealthEvent{},
+			expected:  common.RuleEvaluationDeferred,
+			expectErr: false,
+		},
+		{
+			name: "Match wins over deferred evaluator",
+			evaluators: []RuleEvaluator{
+				deferredRuleEvaluator{},
+				&MockRuleEvaluator{result: true, err: nil},
+			},
+			event:     &protos.HealthEvent{},
+			expected:  common.RuleEvaluationSuccess,
+			expectErr: false,
+		},
 		{
 			name: "All evaluators return false",
 			evaluators: []RuleEvaluator{
@@ -155,6 +181,26 @@ func TestAllRuleSetEvaluator_Evaluate(t *testing.T) {
 			expected:  common.RuleEvaluationFailed,
 			expectErr: false,
 		},
+		{
+			name: "Deferred evaluator with other rules matching",
+			evaluators: []RuleEvaluator{
+				deferredRuleEvaluator{},
+				&MockRuleEvaluator{result: true, err: nil},
+			},
+			event:     &protos.HealthEvent{},
+			expected:  common.RuleEvaluationDeferred,
+			expectErr: false,
+		},
+		{
+			name: "Failed rule wins over deferred evaluator",
+			evaluators: []RuleEvaluator{
+				deferredRuleEvaluator{},
+				&MockRuleEvaluator{result: false, err: nil},
+			},
+			event:     &protos.HealthEvent{},
+			expected:  common.RuleEvaluationFailed,
+			expectErr: false,
+		},
 		{
 			name: "Evaluator returns error",
 			evaluators: []RuleEvaluator{
@@ -294,7 +340,7 @@ func TestInitializeRuleSetEvaluators(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			evaluators, err := InitializeRuleSetEvaluators(tt.ruleSets, nil)
+			evaluators, err := InitializeRuleSetEvaluators(tt.ruleSets, nil, nil)
 			if len(evaluators) != tt.expectedCount {
 				t.Errorf("Expected %d evaluators, got %d", tt.expectedCount, len(evaluators))
 			}
@@ -328,6 +374,11 @@ func TestCreateEvaluators(t *testing.T) {
 		Expression: "invalid syntax",
 	}
 
+	workloadRule := config.Rule{
+		Kind:       "Workload",
+		Expression: "pods.size() > 0",
+	}
+
 	tests := []struct {
 		name          string
 		rules         []config.Rule
@@ -352,6 +403,22 @@ func TestCreateEvaluators(t *testing.T) {
 			expectedCount: 0,
 			expectErr:     true,
 		},
+		{
+			name:          "Workload rule without pod informer",
+			rules:         []config.Rule{workloadRule},
+			expectedCount: 0,
+			expectErr:     true,
+		},
+		{
+			name: "onFalse on a non-Workload rule",
+			rules: []config.Rule{{
+				Kind:       "HealthEvent",
+				Expression: "event.isHealthy == false",
+				OnFalse:    config.WorkloadOnFalseSkip,
+			}},
+			expectedCount: 0,
+			expectErr:     true,
+		},
 		{
 			name:          "Mixed valid and invalid rules",
 			rules:         []config.Rule{validRule, invalidRule},
@@ -368,7 +435,7 @@ func TestCreateEvaluators(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			evaluators, err := createEvaluators(tt.rules, nil)
+			evaluators, err := createEvaluators(tt.rules, nil, nil)
 			if len(evaluators) != tt.expectedCount {
 				t.Errorf("Expected %d evaluators, got %d", tt.expectedCount, len(evaluators))
 			}
@@ -51,6 +51,7 @@ type EventWatcherInterface interface {
 	SetProcessEventCallback(callback func(ctx context.Context, event *model.HealthEventWithStatus) *model.Status)
 	SetFetchDocIDsFn(fn func(ctx context.Context, nodeName string) []string)
 	CancelLatestQuarantiningEvents(ctx context.Context, nodeName string, reason string) error
+	ReprocessEvent(ctx context.Context, event *model.HealthEventWithStatus) error
 }
 
 func NewEventWatcher(
@@ -225,6 +226,34 @@ func (w *EventWatcher) processEvent(ctx context.Context, event client.Event) err
 	return nil
 }
 
+// ReprocessEvent runs an event that was previously deferred (e.g. until a maintenance window opens)
+// back through the process callback and records the resulting status on the event's original
+// document, identified by HealthEvent.Id.
+func (w *EventWatcher) ReprocessEvent(ctx context.Context, event *model.HealthEventWithStatus) error {
+	ctx, span := tracing.StartSpan(ctx, "fault_quarantine.reprocess_event")
+	defer span.End()
+
+	if event.HealthEvent == nil || event.HealthEvent.GetId() == "" {
+		return fmt.Errorf("cannot reprocess event without a document ID")
+	}
+
+	status := w.processEventCallback(ctx, event)
+	if status == nil {
+		return nil
+	}
+
+	if err := w.updateNodeQuarantineStatus(ctx, event.HealthEvent.GetId(), status); err != nil {
+		metrics.ProcessingErrors.WithLabelValues("update_quarantine_status_error").Inc()
+		tracing.RecordError(span, err)
+
+		return fmt.Errorf("failed to update node quarantine status: %w", err)
+	}
+
+	EmitNodeQuarantineDuration(status, event)
+
+	return nil
+}
+
 func EmitNodeQuarantineDuration(status *model.Status, healthEventWithStatus *model.HealthEventWithStatus) {
 	if status == nil || *status != model.Quarantined {
 		return
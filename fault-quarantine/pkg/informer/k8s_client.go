@@ -47,6 +47,7 @@ type FaultQuarantineClient struct {
 	Clientset                kubernetes.Interface
 	DryRunMode               bool
 	NodeInformer             *NodeInformer
+	PodInformer              *PodInformer
 	cordonedReasonLabelKey   string
 	uncordonedReasonLabelKey string
 	operationMutex           sync.Map // map[string]*sync.Mutex for per-node locking
@@ -82,6 +83,19 @@ func NewFaultQuarantineClient(kubeconfig string, dryRun bool,
 	return client, nil
 }
 
+// EnablePodInformer creates the pod informer used by Workload rules. It must be called before
+// the reconciler starts; clients without a pod informer do not watch pods at all.
+func (c *FaultQuarantineClient) EnablePodInformer(resyncPeriod time.Duration) error {
+	podInformer, err := NewPodInformer(c.Clientset, resyncPeriod)
+	if err != nil {
+		return fmt.Errorf("error creating pod informer: %w", err)
+	}
+
+	c.PodInformer = podInformer
+
+	return nil
+}
+
 func (c *FaultQuarantineClient) EnsureCircuitBreakerConfigMap(ctx context.Context,
 	name, namespace string, initialStatus breaker.State) error {
 	slog.InfoContext(ctx, "Ensuring circuit breaker config map",
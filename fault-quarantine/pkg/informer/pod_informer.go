@@ -0,0 +1,144 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package informer
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	podNodeIndexName = "podNode"
+)
+
+// PodInformer watches pods and indexes them by the node they are scheduled on.
+// It is only created when a rule set references the Workload rule kind.
+type PodInformer struct {
+	informer       cache.SharedIndexInformer
+	informerSynced cache.InformerSynced
+}
+
+// NewPodInformer creates a new PodInformer that watches all pods.
+func NewPodInformer(clientset kubernetes.Interface, resyncPeriod time.Duration) (*PodInformer, error) {
+	informerFactory := informers.NewSharedInformerFactory(clientset, resyncPeriod)
+	podInformerObj := informerFactory.Core().V1().Pods()
+
+	pi := &PodInformer{
+		informer:       podInformerObj.Informer(),
+		informerSynced: podInformerObj.Informer().HasSynced,
+	}
+
+	// managedFields are never used by workload rules and make up a large share of each cached pod
+	if err := pi.informer.SetTransform(stripManagedFields); err != nil {
+		return nil, fmt.Errorf("failed to set pod informer transform: %w", err)
+	}
+
+	err := pi.informer.AddIndexers(cache.Indexers{
+		podNodeIndexName: podNodeIndexFunc,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to add pod node indexer: %w", err)
+	}
+
+	slog.Info("PodInformer created, watching all pods")
+
+	return pi, nil
+}
+
+// Run starts the informer and waits for cache sync.
+func (pi *PodInformer) Run(stopCh <-chan struct{}) error {
+	slog.Info("Starting PodInformer")
+
+	go pi.informer.Run(stopCh)
+
+	if ok := cache.WaitForCacheSync(stopCh, pi.informerSynced); !ok {
+		return fmt.Errorf("failed to wait for pod informer cache to sync")
+	}
+
+	slog.Info("PodInformer cache synced")
+
+	return nil
+}
+
+// HasSynced checks if the informer's cache has been synchronized.
+func (pi *PodInformer) HasSynced() bool {
+	return pi.informerSynced()
+}
+
+// WaitForSync waits for the informer cache to sync with context cancellation support.
+func (pi *PodInformer) WaitForSync(ctx context.Context) bool {
+	slog.InfoContext(ctx, "Waiting for PodInformer cache to sync...")
+
+	if ok := cache.WaitForCacheSync(ctx.Done(), pi.informerSynced); !ok {
+		slog.WarnContext(ctx, "PodInformer cache sync failed or context cancelled")
+		return false
+	}
+
+	slog.InfoContext(ctx, "PodInformer cache synced")
+
+	return true
+}
+
+// GetInformer returns the underlying SharedIndexInformer.
+func (pi *PodInformer) GetInformer() cache.SharedIndexInformer {
+	return pi.informer
+}
+
+// ListPodsOnNode returns all pods from the informer's cache that are scheduled on the given node.
+func (pi *PodInformer) ListPodsOnNode(nodeName string) ([]*v1.Pod, error) {
+	objs, err := pi.informer.GetIndexer().ByIndex(podNodeIndexName, nodeName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods on node %s from index: %w", nodeName, err)
+	}
+
+	pods := make([]*v1.Pod, 0, len(objs))
+
+	for _, obj := range objs {
+		if pod, ok := obj.(*v1.Pod); ok {
+			pods = append(pods, pod)
+		}
+	}
+
+	return pods, nil
+}
+
+// podNodeIndexFunc is the indexer function for pods by spec.nodeName
+func podNodeIndexFunc(obj interface{}) ([]string, error) {
+	pod, ok := obj.(*v1.Pod)
+	if !ok {
+		return nil, fmt.Errorf("expected pod object, got %T", obj)
+	}
+
+	if pod.Spec.NodeName == "" {
+		return []string{}, nil
+	}
+
+	return []string{pod.Spec.NodeName}, nil
+}
+
+func stripManagedFields(obj interface{}) (interface{}, error) {
+	if pod, ok := obj.(*v1.Pod); ok {
+		pod.ManagedFields = nil
+	}
+
+	return obj, nil
+}
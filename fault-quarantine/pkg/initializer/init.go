@@ -24,6 +24,7 @@ import (
 	"github.com/nvidia/nvsentinel/commons/pkg/configmanager"
 	"github.com/nvidia/nvsentinel/fault-quarantine/pkg/breaker"
 	"github.com/nvidia/nvsentinel/fault-quarantine/pkg/config"
+	"github.com/nvidia/nvsentinel/fault-quarantine/pkg/devicequarantine"
 	"github.com/nvidia/nvsentinel/fault-quarantine/pkg/informer"
 	"github.com/nvidia/nvsentinel/fault-quarantine/pkg/reconciler"
 	"github.com/nvidia/nvsentinel/store-client/pkg/client"
@@ -82,11 +83,28 @@ func InitializeAll(ctx context.Context, params InitializationParams) (*Component
 
 	slog.InfoContext(ctx, "Successfully initialized kubernetes client with embedded node informer")
 
+	if usesRuleKind(tomlCfg.RuleSets, "Workload") {
+		if err := k8sClient.EnablePodInformer(30 * time.Minute); err != nil {
+			return nil, fmt.Errorf("error while initializing pod informer: %w", err)
+		}
+
+		slog.InfoContext(ctx, "Enabled pod informer for Workload rules")
+	}
+
 	circuitBreaker, err := setupCircuitBreaker(ctx, params, tomlCfg, k8sClient)
 	if err != nil {
 		return nil, err
 	}
 
+	devicePublisher := devicequarantine.Disabled()
+
+	if usesDeviceQuarantine(tomlCfg.RuleSets) {
+		devicePublisher, err = devicequarantine.NewPublisher(tomlCfg.DeviceQuarantine, k8sClient.Clientset)
+		if err != nil {
+			return nil, fmt.Errorf("error while initializing device quarantine: %w", err)
+		}
+	}
+
 	reconcilerCfg := createReconcilerConfig(
 		tomlCfg,
 		params.DryRun,
@@ -94,6 +112,7 @@ func InitializeAll(ctx context.Context, params InitializationParams) (*Component
 		datastoreConfig,
 		tokenConfig,
 		pipeline,
+		devicePublisher,
 	)
 
 	reconcilerInstance := reconciler.NewReconciler(
@@ -114,6 +133,38 @@ func InitializeAll(ctx context.Context, params InitializationParams) (*Component
 	}, nil
 }
 
+// usesRuleKind reports whether any enabled rule set contains a rule of the given kind
+func usesRuleKind(ruleSets []config.RuleSet, kind string) bool {
+	for _, ruleSet := range ruleSets {
+		if !ruleSet.Enabled {
+			continue
+		}
+
+		rules := make([]config.Rule, 0, len(ruleSet.Match.Any)+len(ruleSet.Match.All))
+		rules = append(rules, ruleSet.Match.Any...)
+		rules = append(rules, ruleSet.Match.All...)
+
+		for _, rule := range rules {
+			if rule.Kind == kind {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// usesDeviceQuarantine reports whether any enabled rule set quarantines devices instead of the node
+func usesDeviceQuarantine(ruleSets []config.RuleSet) bool {
+	for _, ruleSet := range ruleSets {
+		if ruleSet.Enabled && ruleSet.DeviceQuarantine.Enabled {
+			return true
+		}
+	}
+
+	return false
+}
+
 func createReconcilerConfig(
 	tomlCfg config.TomlConfig,
 	dryRun bool,
@@ -121,6 +172,7 @@ func createReconcilerConfig(
 	datastoreConfig *datastore.DataStoreConfig,
 	tokenConfig storeconfig.TokenConfig,
 	pipeline interface{},
+	devicePublisher devicequarantine.Publisher,
 ) reconciler.ReconcilerConfig {
 	// Convert store config types to the types expected by reconciler
 	clientTokenConfig := client.TokenConfig{
@@ -136,6 +188,7 @@ func createReconcilerConfig(
 		DataStoreConfig:       datastoreConfig,
 		TokenConfig:           clientTokenConfig,
 		DatabasePipeline:      pipeline,
+		DevicePublisher:       devicePublisher,
 	}
 }
 
@@ -85,6 +85,60 @@ var (
 		[]string{"node"},
 	)
 
+	EventsDeferredForMaintenanceWindow = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "fault_quarantine_events_deferred_for_maintenance_window_total",
+			Help: "Total number of events deferred until a maintenance window opens.",
+		},
+		[]string{"schedule"},
+	)
+
+	EventsDeferredByWorkloadRules = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "fault_quarantine_events_deferred_by_workload_rules_total",
+			Help: "Total number of events held back by Workload rules until the pods on the node change.",
+		},
+		[]string{"ruleset"},
+	)
+
+	// Device Quarantine Metrics
+	TotalDevicesQuarantined = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "fault_quarantine_devices_quarantined_total",
+			Help: "Total number of devices quarantined without cordoning their node.",
+		},
+		[]string{"node"},
+	)
+	TotalDevicesUnquarantined = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "fault_quarantine_devices_unquarantined_total",
+			Help: "Total number of quarantined devices released after recovery.",
+		},
+		[]string{"node"},
+	)
+	CurrentQuarantinedDevices = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "fault_quarantine_current_quarantined_devices",
+			Help: "Devices which are currently quarantined on a node.",
+		},
+		[]string{"node"},
+	)
+
+	// Probation Metrics
+	ProbationsStarted = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "fault_quarantine_probations_started_total",
+			Help: "Total number of recovered nodes kept cordoned for probation.",
+		},
+	)
+	ProbationOutcomes = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "fault_quarantine_probation_outcomes_total",
+			Help: "Total number of ended probations by outcome (passed, fault_recurred, validation_failed).",
+		},
+		[]string{"outcome"},
+	)
+
 	// Taint and Cordon Metrics
 	TaintsApplied = promauto.NewCounterVec(
 		prometheus.CounterOpts{
@@ -0,0 +1,292 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reconciler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/nvidia/nvsentinel/commons/pkg/tracing"
+	"github.com/nvidia/nvsentinel/data-models/pkg/model"
+	"github.com/nvidia/nvsentinel/data-models/pkg/protos"
+	"github.com/nvidia/nvsentinel/fault-quarantine/pkg/common"
+	"github.com/nvidia/nvsentinel/fault-quarantine/pkg/devicequarantine"
+	"github.com/nvidia/nvsentinel/fault-quarantine/pkg/healthEventsAnnotation"
+	"github.com/nvidia/nvsentinel/fault-quarantine/pkg/metrics"
+)
+
+// deviceQuarantineMatch collects the device-scoped rule sets that matched an event
+type deviceQuarantineMatch struct {
+	mu       sync.Mutex
+	ruleSets []string
+}
+
+func (m *deviceQuarantineMatch) add(ruleSet string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.ruleSets = append(m.ruleSets, ruleSet)
+}
+
+func (m *deviceQuarantineMatch) empty() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return len(m.ruleSets) == 0
+}
+
+// deviceUUIDs returns the GPU UUIDs impacted by the event
+func deviceUUIDs(event *protos.HealthEvent) []string {
+	var uuids []string
+
+	for _, entity := range event.EntitiesImpacted {
+		if entity != nil && entity.EntityType == devicequarantine.EntityType && entity.EntityValue != "" {
+			uuids = append(uuids, entity.EntityValue)
+		}
+	}
+
+	return uuids
+}
+
+// trackedDeviceUUIDs returns the sorted UUIDs of the devices tracked in the annotation map
+func trackedDeviceUUIDs(devices *healthEventsAnnotation.HealthEventsAnnotationMap) []string {
+	uuids := make([]string, 0, len(devices.Events))
+
+	for key := range devices.Events {
+		if key.EntityType == devicequarantine.EntityType && !slices.Contains(uuids, key.EntityValue) {
+			uuids = append(uuids, key.EntityValue)
+		}
+	}
+
+	slices.Sort(uuids)
+
+	return uuids
+}
+
+func parseDeviceHealthEvents(node *corev1.Node) (*healthEventsAnnotation.HealthEventsAnnotationMap, error) {
+	devices := healthEventsAnnotation.NewHealthEventsAnnotationMap()
+
+	if existing := node.Annotations[common.QuarantineDeviceHealthEventsAnnotationKey]; existing != "" {
+		if err := json.Unmarshal([]byte(existing), devices); err != nil {
+			return nil, fmt.Errorf("failed to parse device health events annotation: %w", err)
+		}
+	}
+
+	return devices, nil
+}
+
+// storeDeviceHealthEvents writes the tracked device events and the quarantined device list to the
+// node, removing both annotations once no device is tracked
+func storeDeviceHealthEvents(node *corev1.Node, devices *healthEventsAnnotation.HealthEventsAnnotationMap) error {
+	if devices.IsEmpty() {
+		delete(node.Annotations, common.QuarantineDeviceHealthEventsAnnotationKey)
+		delete(node.Annotations, common.QuarantinedDevicesAnnotationKey)
+
+		return nil
+	}
+
+	devicesBytes, err := json.Marshal(devices)
+	if err != nil {
+		return fmt.Errorf("failed to marshal device health events: %w", err)
+	}
+
+	if node.Annotations == nil {
+		node.Annotations = make(map[string]string)
+	}
+
+	node.Annotations[common.QuarantineDeviceHealthEventsAnnotationKey] = string(devicesBytes)
+	node.Annotations[common.QuarantinedDevicesAnnotationKey] = strings.Join(trackedDeviceUUIDs(devices), ",")
+
+	return nil
+}
+
+// quarantineDevices isolates the GPUs impacted by the event while leaving the node schedulable.
+// The returned Quarantined status lets node-drainer evict only the pods using those GPUs and
+// fault-remediation reset them.
+func (r *Reconciler) quarantineDevices(
+	ctx context.Context,
+	event *model.HealthEventWithStatus,
+	match *deviceQuarantineMatch,
+) *model.Status {
+	ctx, span := tracing.StartSpan(ctx, "fault_quarantine.quarantine_devices")
+	defer span.End()
+
+	nodeName := event.HealthEvent.NodeName
+	uuids := deviceUUIDs(event.HealthEvent)
+
+	match.mu.Lock()
+	ruleSets := match.ruleSets
+	match.mu.Unlock()
+
+	if !r.config.DryRun {
+		if err := r.devicePublisher.Quarantine(ctx, nodeName, uuids); err != nil {
+			slog.ErrorContext(ctx, "Failed to quarantine devices", "node", nodeName, "uuids", uuids, "error", err)
+			metrics.ProcessingErrors.WithLabelValues("device_quarantine_error").Inc()
+			tracing.RecordError(span, err)
+			span.SetAttributes(
+				attribute.String("fault_quarantine.error.type", "device_quarantine_error"),
+				attribute.String("fault_quarantine.error.message", err.Error()),
+			)
+
+			return nil
+		}
+	}
+
+	added := false
+	quarantinedCount := 0
+
+	updateFn := func(node *corev1.Node) error {
+		devices, err := parseDeviceHealthEvents(node)
+		if err != nil {
+			return err
+		}
+
+		added = devices.AddOrUpdateEvent(event.HealthEvent)
+		if !added {
+			return nil
+		}
+
+		quarantinedCount = len(trackedDeviceUUIDs(devices))
+
+		return storeDeviceHealthEvents(node, devices)
+	}
+
+	if err := r.k8sClient.UpdateNode(ctx, nodeName, updateFn); err != nil {
+		slog.ErrorContext(ctx, "Failed to record quarantined devices on node", "node", nodeName, "error", err)
+		metrics.ProcessingErrors.WithLabelValues("device_quarantine_annotation_error").Inc()
+		tracing.RecordError(span, err)
+		span.SetAttributes(
+			attribute.String("fault_quarantine.error.type", "device_quarantine_annotation_error"),
+			attribute.String("fault_quarantine.error.message", err.Error()),
+		)
+
+		return nil
+	}
+
+	if !added {
+		span.SetAttributes(
+			attribute.String("fault_quarantine.event.processing_status", EventProcessingStatusSkipped),
+			attribute.String("fault_quarantine.skip.reason", "Devices already quarantined for this check"),
+		)
+
+		return nil
+	}
+
+	metrics.TotalDevicesQuarantined.WithLabelValues(nodeName).Add(float64(len(uuids)))
+	metrics.CurrentQuarantinedDevices.WithLabelValues(nodeName).Set(float64(quarantinedCount))
+
+	slog.InfoContext(ctx, "Quarantined devices without cordoning node",
+		"node", nodeName, "uuids", uuids, "ruleSets", ruleSets)
+	span.SetAttributes(
+		attribute.StringSlice("fault_quarantine.device_quarantine.uuids", uuids),
+		attribute.StringSlice("fault_quarantine.device_quarantine.rulesets", ruleSets),
+	)
+
+	status := model.Quarantined
+
+	return &status
+}
+
+// releaseDevices removes the devices cleared by a healthy event from the node's device quarantine.
+// UnQuarantined is returned once the last quarantined device on the node has recovered; a partial
+// recovery returns nil so that other devices stay isolated downstream.
+func (r *Reconciler) releaseDevices(ctx context.Context, event *protos.HealthEvent) *model.Status {
+	node, err := r.k8sClient.NodeInformer.GetNode(event.NodeName)
+	if err != nil || node.Annotations[common.QuarantineDeviceHealthEventsAnnotationKey] == "" {
+		return nil
+	}
+
+	ctx, span := tracing.StartSpan(ctx, "fault_quarantine.release_devices")
+	defer span.End()
+
+	var released []string
+
+	remaining := 0
+
+	updateFn := func(node *corev1.Node) error {
+		devices, err := parseDeviceHealthEvents(node)
+		if err != nil {
+			return err
+		}
+
+		before := trackedDeviceUUIDs(devices)
+
+		if devices.RemoveEvent(event) == 0 {
+			released = nil
+			return nil
+		}
+
+		after := trackedDeviceUUIDs(devices)
+		remaining = len(after)
+		released = slices.DeleteFunc(before, func(uuid string) bool { return slices.Contains(after, uuid) })
+
+		return storeDeviceHealthEvents(node, devices)
+	}
+
+	if err := r.k8sClient.UpdateNode(ctx, event.NodeName, updateFn); err != nil {
+		slog.ErrorContext(ctx, "Failed to release recovered devices", "node", event.NodeName, "error", err)
+		metrics.ProcessingErrors.WithLabelValues("device_release_annotation_error").Inc()
+		tracing.RecordError(span, err)
+		span.SetAttributes(
+			attribute.String("fault_quarantine.error.type", "device_release_annotation_error"),
+			attribute.String("fault_quarantine.error.message", err.Error()),
+		)
+
+		return nil
+	}
+
+	if len(released) == 0 {
+		return nil
+	}
+
+	if !r.config.DryRun {
+		if err := r.devicePublisher.Release(ctx, event.NodeName, released); err != nil {
+			slog.ErrorContext(ctx, "Failed to release devices", "node", event.NodeName, "uuids", released, "error", err)
+			metrics.ProcessingErrors.WithLabelValues("device_release_error").Inc()
+			tracing.RecordError(span, err)
+			span.SetAttributes(
+				attribute.String("fault_quarantine.error.type", "device_release_error"),
+				attribute.String("fault_quarantine.error.message", err.Error()),
+			)
+		}
+	}
+
+	metrics.TotalDevicesUnquarantined.WithLabelValues(event.NodeName).Add(float64(len(released)))
+	metrics.CurrentQuarantinedDevices.WithLabelValues(event.NodeName).Set(float64(remaining))
+
+	slog.InfoContext(ctx, "Released recovered devices", "node", event.NodeName,
+		"uuids", released, "remaining", remaining)
+	span.SetAttributes(attribute.StringSlice("fault_quarantine.device_quarantine.released_uuids", released))
+
+	if remaining > 0 {
+		span.SetAttributes(
+			attribute.String("fault_quarantine.event.processing_status", EventProcessingStatusPartialRecovery),
+		)
+
+		return nil
+	}
+
+	status := model.UnQuarantined
+
+	return &status
+}
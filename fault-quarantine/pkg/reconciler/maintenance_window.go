@@ -0,0 +1,434 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reconciler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/nvidia/nvsentinel/commons/pkg/schedule"
+	"github.com/nvidia/nvsentinel/commons/pkg/tracing"
+	"github.com/nvidia/nvsentinel/data-models/pkg/model"
+	"github.com/nvidia/nvsentinel/data-models/pkg/protos"
+	"github.com/nvidia/nvsentinel/fault-quarantine/pkg/common"
+	"github.com/nvidia/nvsentinel/fault-quarantine/pkg/healthEventsAnnotation"
+	"github.com/nvidia/nvsentinel/fault-quarantine/pkg/metrics"
+)
+
+// maintenanceDeferral collects the rule sets that matched an event but are gated by a maintenance
+// schedule that is currently closed, along with the earliest time one of those schedules opens.
+type maintenanceDeferral struct {
+	mu        sync.Mutex
+	ruleSets  []string
+	schedules []string
+	nextOpen  time.Time
+}
+
+func (d *maintenanceDeferral) add(ruleSet, scheduleName string, nextOpen time.Time, found bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.ruleSets = append(d.ruleSets, ruleSet)
+	d.schedules = append(d.schedules, scheduleName)
+
+	if found && (d.nextOpen.IsZero() || nextOpen.Before(d.nextOpen)) {
+		d.nextOpen = nextOpen
+	}
+}
+
+func (d *maintenanceDeferral) empty() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return len(d.ruleSets) == 0
+}
+
+// buildSchedules compiles the configured maintenance schedules and verifies that every enabled
+// rule set references a known schedule
+func (r *Reconciler) buildSchedules() (map[string]*schedule.Schedule, error) {
+	schedules, err := schedule.NewSet(r.config.TomlConfig.Schedules)
+	if err != nil {
+		return nil, fmt.Errorf("invalid maintenance schedules: %w", err)
+	}
+
+	for _, ruleSet := range r.config.TomlConfig.RuleSets {
+		if !ruleSet.Enabled || ruleSet.Schedule == "" {
+			continue
+		}
+
+		if _, ok := schedules[ruleSet.Schedule]; !ok {
+			return nil, fmt.Errorf("rule set %q references unknown schedule %q", ruleSet.Name, ruleSet.Schedule)
+		}
+	}
+
+	return schedules, nil
+}
+
+// deferForMaintenanceWindow reports whether a matched rule set must wait for its maintenance
+// window. Fatal events are never deferred.
+func (r *Reconciler) deferForMaintenanceWindow(
+	event *protos.HealthEvent,
+	ruleSetName string,
+	rulesetsConfig rulesetsConfig,
+	deferral *maintenanceDeferral,
+) bool {
+	sched := rulesetsConfig.ScheduleMap[ruleSetName]
+	if sched == nil || event.IsFatal {
+		return false
+	}
+
+	now := time.Now()
+
+	nextOpen, found := sched.NextOpen(now)
+	if found && !nextOpen.After(now) {
+		return false
+	}
+
+	deferral.add(ruleSetName, sched.Name(), nextOpen, found)
+
+	return true
+}
+
+// holdUntilMaintenanceWindow records the event in the node's pending annotation, publishes the next
+// window on the node and schedules the event to be reprocessed when that window opens
+func (r *Reconciler) holdUntilMaintenanceWindow(
+	ctx context.Context,
+	event *model.HealthEventWithStatus,
+	deferral *maintenanceDeferral,
+) *model.Status {
+	ctx, span := tracing.StartSpan(ctx, "fault_quarantine.hold_until_maintenance_window")
+	defer span.End()
+
+	nodeName := event.HealthEvent.NodeName
+
+	deferral.mu.Lock()
+	ruleSets := deferral.ruleSets
+	schedules := deferral.schedules
+	nextOpen := deferral.nextOpen
+	deferral.mu.Unlock()
+
+	updateFn := func(node *corev1.Node) error {
+		if err := addHeldEvent(node, common.QuarantinePendingHealthEventsAnnotationKey, event.HealthEvent); err != nil {
+			return err
+		}
+
+		node.Annotations[common.QuarantinePendingMaintenanceSchedulesAnnotationKey] = mergeScheduleNames(
+			node.Annotations[common.QuarantinePendingMaintenanceSchedulesAnnotationKey], schedules)
+
+		if !nextOpen.IsZero() {
+			node.Annotations[common.QuarantineNextMaintenanceWindowAnnotationKey] =
+				nextOpen.UTC().Format(time.RFC3339)
+		}
+
+		return nil
+	}
+
+	if err := r.k8sClient.UpdateNode(ctx, nodeName, updateFn); err != nil {
+		slog.ErrorContext(ctx, "Failed to record pending health event on node", "node", nodeName, "error", err)
+		metrics.ProcessingErrors.WithLabelValues("pending_health_event_annotation_error").Inc()
+		tracing.RecordError(span, err)
+		span.SetAttributes(
+			attribute.String("fault_quarantine.error.type", "pending_health_event_annotation_error"),
+			attribute.String("fault_quarantine.error.message", err.Error()),
+		)
+
+		return nil
+	}
+
+	for _, name := range schedules {
+		metrics.EventsDeferredForMaintenanceWindow.WithLabelValues(name).Inc()
+	}
+
+	if nextOpen.IsZero() {
+		slog.WarnContext(ctx, "No upcoming maintenance window found, event held until schedules change",
+			"node", nodeName, "ruleSets", ruleSets, "schedules", schedules)
+	} else {
+		slog.InfoContext(ctx, "Holding health event until maintenance window opens",
+			"node", nodeName, "ruleSets", ruleSets, "nextWindow", nextOpen)
+		r.scheduleMaintenanceWindowRelease(ctx, nodeName, nextOpen)
+	}
+
+	span.SetAttributes(
+		attribute.String("fault_quarantine.event.processing_status", string(model.PendingMaintenanceWindow)),
+		attribute.StringSlice("fault_quarantine.deferred_rulesets", ruleSets),
+	)
+
+	status := model.PendingMaintenanceWindow
+
+	return &status
+}
+
+// scheduleMaintenanceWindowRelease arranges for the node's pending events to be released at
+// releaseAt. An already scheduled release is kept if it fires earlier.
+func (r *Reconciler) scheduleMaintenanceWindowRelease(ctx context.Context, nodeName string, releaseAt time.Time) {
+	r.pendingMu.Lock()
+	defer r.pendingMu.Unlock()
+
+	if r.pendingReleases == nil {
+		r.pendingReleases = make(map[string]pendingRelease)
+	}
+
+	if existing, ok := r.pendingReleases[nodeName]; ok {
+		if !existing.at.After(releaseAt) {
+			return
+		}
+
+		existing.timer.Stop()
+	}
+
+	// The release outlives the event that scheduled it, so it only keeps the context's values
+	releaseCtx := context.WithoutCancel(ctx)
+
+	r.pendingReleases[nodeName] = pendingRelease{
+		at: releaseAt,
+		timer: time.AfterFunc(time.Until(releaseAt), func() {
+			r.releasePendingEvents(releaseCtx, nodeName)
+		}),
+	}
+}
+
+// releasePendingEvents clears the node's pending annotations and reprocesses every held event now
+// that a maintenance window has opened. Events whose rule sets are still outside their window are
+// held again. Events whose reprocessing failed are written back with the schedules that held them
+// and retried after heldEventRetryDelay.
+func (r *Reconciler) releasePendingEvents(ctx context.Context, nodeName string) {
+	ctx, span := tracing.StartSpan(ctx, "fault_quarantine.release_pending_events")
+	defer span.End()
+
+	r.pendingMu.Lock()
+	delete(r.pendingReleases, nodeName)
+	r.pendingMu.Unlock()
+
+	var (
+		pending   []*protos.HealthEvent
+		schedules string
+	)
+
+	updateFn := func(node *corev1.Node) error {
+		pending = nil
+		schedules = node.Annotations[common.QuarantinePendingMaintenanceSchedulesAnnotationKey]
+
+		existing := node.Annotations[common.QuarantinePendingHealthEventsAnnotationKey]
+		if existing != "" {
+			if err := json.Unmarshal([]byte(existing), &pending); err != nil {
+				return fmt.Errorf("failed to parse pending health events annotation: %w", err)
+			}
+		}
+
+		delete(node.Annotations, common.QuarantinePendingHealthEventsAnnotationKey)
+		delete(node.Annotations, common.QuarantineNextMaintenanceWindowAnnotationKey)
+		delete(node.Annotations, common.QuarantinePendingMaintenanceSchedulesAnnotationKey)
+
+		return nil
+	}
+
+	if err := r.k8sClient.UpdateNode(ctx, nodeName, updateFn); err != nil {
+		slog.ErrorContext(ctx, "Failed to clear pending health events from node", "node", nodeName, "error", err)
+		metrics.ProcessingErrors.WithLabelValues("release_pending_health_events_error").Inc()
+		tracing.RecordError(span, err)
+		span.SetAttributes(
+			attribute.String("fault_quarantine.error.type", "release_pending_health_events_error"),
+			attribute.String("fault_quarantine.error.message", err.Error()),
+		)
+
+		return
+	}
+
+	slog.InfoContext(ctx, "Maintenance window opened, reprocessing pending health events",
+		"node", nodeName, "count", len(pending))
+
+	failed := r.reprocessHeldEvents(ctx, nodeName, pending, "reprocess_pending_health_event_error")
+	if len(failed) == 0 {
+		return
+	}
+
+	retryAt := time.Now().Add(heldEventRetryDelay)
+
+	restoreFn := func(node *corev1.Node) error {
+		for _, healthEvent := range failed {
+			if err := addHeldEvent(node, common.QuarantinePendingHealthEventsAnnotationKey, healthEvent); err != nil {
+				return err
+			}
+		}
+
+		// Events held again while reprocessing already published their own schedules and window
+		if _, ok := node.Annotations[common.QuarantineNextMaintenanceWindowAnnotationKey]; !ok {
+			node.Annotations[common.QuarantineNextMaintenanceWindowAnnotationKey] = retryAt.UTC().Format(time.RFC3339)
+		}
+
+		if _, ok := node.Annotations[common.QuarantinePendingMaintenanceSchedulesAnnotationKey]; !ok && schedules != "" {
+			node.Annotations[common.QuarantinePendingMaintenanceSchedulesAnnotationKey] = schedules
+		}
+
+		return nil
+	}
+
+	if err := r.k8sClient.UpdateNode(ctx, nodeName, restoreFn); err != nil {
+		slog.ErrorContext(ctx, "Failed to restore pending health events on node, events are lost",
+			"node", nodeName, "count", len(failed), "error", err)
+		metrics.ProcessingErrors.WithLabelValues("restore_pending_health_events_error").Inc()
+		tracing.RecordError(span, err)
+
+		return
+	}
+
+	r.scheduleMaintenanceWindowRelease(ctx, nodeName, retryAt)
+}
+
+// dropPendingEvents removes held events that a healthy event has cleared, whether they were waiting
+// for a maintenance window or for the workloads on the node to change
+func (r *Reconciler) dropPendingEvents(ctx context.Context, event *protos.HealthEvent) {
+	r.dropHeldEvents(ctx, event, common.QuarantinePendingHealthEventsAnnotationKey,
+		common.QuarantineNextMaintenanceWindowAnnotationKey, common.QuarantinePendingMaintenanceSchedulesAnnotationKey)
+	r.dropHeldEvents(ctx, event, common.QuarantineWorkloadDeferredHealthEventsAnnotationKey)
+}
+
+// dropHeldEvents removes the event from the held health events stored under the node's
+// annotationKey. The extra keys are removed along with it once no held events remain.
+func (r *Reconciler) dropHeldEvents(
+	ctx context.Context,
+	event *protos.HealthEvent,
+	annotationKey string,
+	relatedKeys ...string,
+) {
+	node, err := r.k8sClient.NodeInformer.GetNode(event.NodeName)
+	if err != nil || node.Annotations[annotationKey] == "" {
+		return
+	}
+
+	updateFn := func(node *corev1.Node) error {
+		existing := node.Annotations[annotationKey]
+		if existing == "" {
+			return nil
+		}
+
+		held := healthEventsAnnotation.NewHealthEventsAnnotationMap()
+		if err := json.Unmarshal([]byte(existing), held); err != nil {
+			return fmt.Errorf("failed to parse held health events annotation %s: %w", annotationKey, err)
+		}
+
+		if held.RemoveEvent(event) == 0 {
+			return nil
+		}
+
+		if held.IsEmpty() {
+			delete(node.Annotations, annotationKey)
+
+			for _, key := range relatedKeys {
+				delete(node.Annotations, key)
+			}
+
+			return nil
+		}
+
+		heldBytes, err := json.Marshal(held)
+		if err != nil {
+			return fmt.Errorf("failed to marshal held health events: %w", err)
+		}
+
+		node.Annotations[annotationKey] = string(heldBytes)
+
+		return nil
+	}
+
+	if err := r.k8sClient.UpdateNode(ctx, event.NodeName, updateFn); err != nil {
+		slog.ErrorContext(ctx, "Failed to drop recovered held health events", "node", event.NodeName,
+			"annotation", annotationKey, "error", err)
+		metrics.ProcessingErrors.WithLabelValues("drop_pending_health_events_error").Inc()
+
+		return
+	}
+
+	slog.InfoContext(ctx, "Dropped held health events cleared by healthy event",
+		"node", event.NodeName, "annotation", annotationKey, "checkName", event.CheckName)
+}
+
+// resumePendingMaintenanceWindows reschedules releases for events that were held when the process
+// last stopped. Their release time is recomputed from the schedules that held them.
+func (r *Reconciler) resumePendingMaintenanceWindows(ctx context.Context, rulesetsConfig rulesetsConfig) {
+	nodes, err := r.k8sClient.NodeInformer.ListNodes()
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list nodes to resume pending maintenance windows", "error", err)
+		return
+	}
+
+	for _, node := range nodes {
+		if node.Annotations[common.QuarantinePendingHealthEventsAnnotationKey] == "" {
+			continue
+		}
+
+		scheduleNames := splitScheduleNames(node.Annotations[common.QuarantinePendingMaintenanceSchedulesAnnotationKey])
+		releaseAt := time.Now()
+
+		if nextOpen, ok := r.earliestMaintenanceWindow(releaseAt, scheduleNames, rulesetsConfig); ok {
+			releaseAt = nextOpen
+		}
+
+		slog.InfoContext(ctx, "Resuming pending maintenance window for node",
+			"node", node.Name, "schedules", scheduleNames, "releaseAt", releaseAt)
+		r.scheduleMaintenanceWindowRelease(ctx, node.Name, releaseAt)
+	}
+}
+
+// earliestMaintenanceWindow returns the earliest time one of the named schedules is open. Nodes held
+// before the schedules were recorded have no names, in which case every schedule is considered.
+func (r *Reconciler) earliestMaintenanceWindow(
+	now time.Time,
+	scheduleNames []string,
+	rulesetsConfig rulesetsConfig,
+) (time.Time, bool) {
+	var (
+		earliest time.Time
+		found    bool
+	)
+
+	for _, sched := range rulesetsConfig.ScheduleMap {
+		if len(scheduleNames) > 0 && !slices.Contains(scheduleNames, sched.Name()) {
+			continue
+		}
+
+		nextOpen, ok := sched.NextOpen(now)
+		if ok && (!found || nextOpen.Before(earliest)) {
+			earliest, found = nextOpen, true
+		}
+	}
+
+	return earliest, found
+}
+
+// mergeScheduleNames adds names to the comma separated schedule list, keeping it sorted and unique
+func mergeScheduleNames(existing string, names []string) string {
+	merged := append(splitScheduleNames(existing), names...)
+	slices.Sort(merged)
+
+	return strings.Join(slices.Compact(merged), ",")
+}
+
+func splitScheduleNames(value string) []string {
+	if value == "" {
+		return nil
+	}
+
+	return strings.Split(value, ",")
+}
@@ -0,0 +1,606 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reconciler
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"slices"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/nvidia/nvsentinel/commons/pkg/tracing"
+	"github.com/nvidia/nvsentinel/data-models/pkg/model"
+	"github.com/nvidia/nvsentinel/data-models/pkg/protos"
+	"github.com/nvidia/nvsentinel/fault-quarantine/pkg/common"
+	"github.com/nvidia/nvsentinel/fault-quarantine/pkg/config"
+	"github.com/nvidia/nvsentinel/fault-quarantine/pkg/metrics"
+)
+
+const (
+	ProbationOutcomePassed           = "passed"
+	ProbationOutcomeFaultRecurred    = "fault_recurred"
+	ProbationOutcomeValidationFailed = "validation_failed"
+
+	probationJobNamePrefix          = "nvsentinel-probation-"
+	probationJobNodeAnnotationKey   = "nvsentinel.nvidia.com/probation-node"
+	probationJobManagedByLabelKey   = "nvsentinel.nvidia.com/managed-by"
+	probationJobManagedByLabelValue = "fault-quarantine"
+	probationValidationPollInterval = 30 * time.Second
+	defaultProbationJobTimeout      = 30 * time.Minute
+)
+
+// probationSettings is the validated probation configuration
+type probationSettings struct {
+	minHealthyDuration time.Duration
+	validation         *probationValidation
+}
+
+type probationValidation struct {
+	config.ProbationValidation
+	resourceLimits corev1.ResourceList
+	timeout        time.Duration
+}
+
+func newProbationSettings(cfg config.Probation) (*probationSettings, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	settings := &probationSettings{}
+
+	if cfg.MinHealthyDuration != "" {
+		duration, err := time.ParseDuration(cfg.MinHealthyDuration)
+		if err != nil || duration < 0 {
+			return nil, fmt.Errorf("invalid probation minHealthyDuration %q", cfg.MinHealthyDuration)
+		}
+
+		settings.minHealthyDuration = duration
+	}
+
+	if !cfg.Validation.Enabled {
+		return settings, nil
+	}
+
+	validation := &probationValidation{
+		ProbationValidation: cfg.Validation,
+		resourceLimits:      corev1.ResourceList{},
+		timeout:             defaultProbationJobTimeout,
+	}
+
+	if validation.Image == "" {
+		return nil, fmt.Errorf("probation validation requires an image")
+	}
+
+	if validation.Namespace == "" {
+		validation.Namespace = os.Getenv("POD_NAMESPACE")
+	}
+
+	if validation.Timeout != "" {
+		timeout, err := time.ParseDuration(validation.Timeout)
+		if err != nil || timeout <= 0 {
+			return nil, fmt.Errorf("invalid probation validation timeout %q", validation.Timeout)
+		}
+
+		validation.timeout = timeout
+	}
+
+	for name, value := range validation.ResourceLimits {
+		quantity, err := resource.ParseQuantity(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid probation validation resource limit %s=%q: %w", name, value, err)
+		}
+
+		validation.resourceLimits[corev1.ResourceName(name)] = quantity
+	}
+
+	settings.validation = validation
+
+	return settings, nil
+}
+
+// buildProbation validates the probation configuration
+func (r *Reconciler) buildProbation() error {
+	settings, err := newProbationSettings(r.config.TomlConfig.Probation)
+	if err != nil {
+		return fmt.Errorf("invalid probation configuration: %w", err)
+	}
+
+	r.probation = settings
+
+	return nil
+}
+
+// isProbationEvent reports whether the healthy event is for the check whose recovery put the node on
+// probation. Such events, as well as the reprocessed original, re-evaluate whether probation is over.
+func (r *Reconciler) isProbationEvent(event *protos.HealthEvent, annotations map[string]string) bool {
+	if r.probation == nil || annotations[common.QuarantineProbationStartTimeAnnotationKey] == "" {
+		return false
+	}
+
+	return matchesProbationEvent(event, annotations)
+}
+
+// isFailedProbationEvent reports whether the healthy event is for the check whose probation failed
+// validation. Such events start a new probation, as no tracked check is left to recover.
+func (r *Reconciler) isFailedProbationEvent(event *protos.HealthEvent, annotations map[string]string) bool {
+	if r.probation == nil || annotations[common.QuarantineProbationFailedAnnotationKey] == "" {
+		return false
+	}
+
+	return matchesProbationEvent(event, annotations)
+}
+
+func matchesProbationEvent(event *protos.HealthEvent, annotations map[string]string) bool {
+	probationEvent, err := parseProbationEvent(annotations[common.QuarantineProbationHealthEventAnnotationKey])
+	if err != nil {
+		return false
+	}
+
+	return probationEvent.Agent == event.Agent &&
+		probationEvent.CheckName == event.CheckName &&
+		probationEvent.ComponentClass == event.ComponentClass
+}
+
+func parseProbationEvent(value string) (*protos.HealthEvent, error) {
+	event := &protos.HealthEvent{}
+	if err := json.Unmarshal([]byte(value), event); err != nil {
+		return nil, fmt.Errorf("failed to parse probation health event: %w", err)
+	}
+
+	return event, nil
+}
+
+// startProbation keeps the recovered node cordoned and records the healthy event so that the
+// uncordon can be completed, and its status recorded, once probation is over
+func (r *Reconciler) startProbation(ctx context.Context, event *protos.HealthEvent) {
+	ctx, span := tracing.StartSpan(ctx, "fault_quarantine.start_probation")
+	defer span.End()
+
+	eventBytes, err := json.Marshal(event)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to marshal probation health event", "node", event.NodeName, "error", err)
+		metrics.ProcessingErrors.WithLabelValues("start_probation_error").Inc()
+		tracing.RecordError(span, err)
+
+		return
+	}
+
+	updateFn := func(node *corev1.Node) error {
+		if node.Annotations == nil {
+			node.Annotations = make(map[string]string)
+		}
+
+		node.Annotations[common.QuarantineProbationStartTimeAnnotationKey] = time.Now().UTC().Format(time.RFC3339)
+		node.Annotations[common.QuarantineProbationHealthEventAnnotationKey] = string(eventBytes)
+		delete(node.Annotations, common.QuarantineProbationFailedAnnotationKey)
+
+		return nil
+	}
+
+	if err := r.k8sClient.UpdateNode(ctx, event.NodeName, updateFn); err != nil {
+		slog.ErrorContext(ctx, "Failed to start probation for node", "node", event.NodeName, "error", err)
+		metrics.ProcessingErrors.WithLabelValues("start_probation_error").Inc()
+		tracing.RecordError(span, err)
+		span.SetAttributes(
+			attribute.String("fault_quarantine.error.type", "start_probation_error"),
+			attribute.String("fault_quarantine.error.message", err.Error()),
+		)
+
+		return
+	}
+
+	// A job left over from an earlier failed probation must not decide this one
+	r.deleteValidationJob(ctx, event.NodeName)
+
+	metrics.ProbationsStarted.Inc()
+	slog.InfoContext(ctx, "All health checks recovered, keeping node cordoned for probation",
+		"node", event.NodeName, "minHealthyDuration", r.probation.minHealthyDuration,
+		"validation", r.probation.validation != nil)
+	span.SetAttributes(attribute.String("fault_quarantine.event.processing_status", "probation_started"))
+
+	r.scheduleProbationCheck(ctx, event.NodeName, r.probation.minHealthyDuration)
+}
+
+// completeProbation uncordons the node once it has been healthy for the minimum duration and the
+// validation job, if configured, has passed. Until then nil is returned and a re-check is scheduled.
+func (r *Reconciler) completeProbation(
+	ctx context.Context,
+	event *protos.HealthEvent,
+	annotations map[string]string,
+) *model.Status {
+	ctx, span := tracing.StartSpan(ctx, "fault_quarantine.complete_probation")
+	defer span.End()
+
+	nodeName := event.NodeName
+
+	startedAt, err := time.Parse(time.RFC3339, annotations[common.QuarantineProbationStartTimeAnnotationKey])
+	if err != nil {
+		slog.WarnContext(ctx, "Invalid probation start time on node, restarting probation", "node", nodeName, "error", err)
+		r.startProbation(ctx, event)
+
+		return nil
+	}
+
+	if remaining := time.Until(startedAt.Add(r.probation.minHealthyDuration)); remaining > 0 {
+		r.scheduleProbationCheck(ctx, nodeName, remaining)
+		span.SetAttributes(attribute.String("fault_quarantine.event.processing_status", "probation_in_progress"))
+
+		return nil
+	}
+
+	if r.probation.validation != nil && !r.config.DryRun {
+		done, passed := r.checkValidationJob(ctx, nodeName)
+		if !done {
+			r.scheduleProbationCheck(ctx, nodeName, probationValidationPollInterval)
+			span.SetAttributes(attribute.String("fault_quarantine.event.processing_status", "probation_validating"))
+
+			return nil
+		}
+
+		if !passed {
+			r.failProbation(ctx, nodeName)
+			return nil
+		}
+
+		r.deleteValidationJob(ctx, nodeName)
+	}
+
+	r.stopProbationTimer(nodeName)
+
+	stayQuarantined, err := r.performUncordon(ctx, event, annotations)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to uncordon node after probation", "node", nodeName, "error", err)
+		metrics.ProcessingErrors.WithLabelValues("uncordon_error").Inc()
+		tracing.RecordError(span, err)
+		r.scheduleProbationCheck(ctx, nodeName, probationValidationPollInterval)
+
+		return nil
+	}
+
+	if stayQuarantined {
+		return nil
+	}
+
+	metrics.ProbationOutcomes.WithLabelValues(ProbationOutcomePassed).Inc()
+	slog.InfoContext(ctx, "Node passed probation and was uncordoned", "node", nodeName,
+		"probationStart", startedAt)
+
+	status := model.UnQuarantined
+
+	return &status
+}
+
+// cancelProbation ends the probation of a node on which a fault recurred. The node was never
+// uncordoned, so it simply stays quarantined for the new fault.
+func (r *Reconciler) cancelProbation(ctx context.Context, nodeName string) {
+	if r.probation == nil {
+		return
+	}
+
+	node, err := r.k8sClient.NodeInformer.GetNode(nodeName)
+	if err != nil || node.Annotations[common.QuarantineProbationStartTimeAnnotationKey] == "" {
+		return
+	}
+
+	if err := r.clearProbation(ctx, nodeName, ""); err != nil {
+		slog.ErrorContext(ctx, "Failed to cancel probation for node", "node", nodeName, "error", err)
+		metrics.ProcessingErrors.WithLabelValues("cancel_probation_error").Inc()
+
+		return
+	}
+
+	r.deleteValidationJob(ctx, nodeName)
+
+	metrics.ProbationOutcomes.WithLabelValues(ProbationOutcomeFaultRecurred).Inc()
+	slog.WarnContext(ctx, "Fault recurred during probation, node stays quarantined", "node", nodeName)
+}
+
+// failProbation keeps the node quarantined after its validation job failed. The job is kept for
+// inspection and the probation event stays recorded, so the next healthy event for the same check
+// starts a new probation; the node can also leave quarantine through a manual uncordon.
+func (r *Reconciler) failProbation(ctx context.Context, nodeName string) {
+	reason := fmt.Sprintf("validation job %s failed at %s", probationJobName(nodeName),
+		time.Now().UTC().Format(time.RFC3339))
+
+	if err := r.clearProbation(ctx, nodeName, reason); err != nil {
+		slog.ErrorContext(ctx, "Failed to record failed probation for node", "node", nodeName, "error", err)
+		metrics.ProcessingErrors.WithLabelValues("fail_probation_error").Inc()
+
+		return
+	}
+
+	metrics.ProbationOutcomes.WithLabelValues(ProbationOutcomeValidationFailed).Inc()
+	slog.WarnContext(ctx, "Probation validation failed, node stays quarantined", "node", nodeName,
+		"job", probationJobName(nodeName))
+}
+
+// clearProbation stops the node's probation. When failureReason is not empty it is recorded and the
+// probation event is kept so that a later healthy event for the check can retry the probation.
+func (r *Reconciler) clearProbation(ctx context.Context, nodeName, failureReason string) error {
+	r.stopProbationTimer(nodeName)
+
+	updateFn := func(node *corev1.Node) error {
+		delete(node.Annotations, common.QuarantineProbationStartTimeAnnotationKey)
+
+		if failureReason == "" {
+			delete(node.Annotations, common.QuarantineProbationHealthEventAnnotationKey)
+		} else {
+			if node.Annotations == nil {
+				node.Annotations = make(map[string]string)
+			}
+
+			node.Annotations[common.QuarantineProbationFailedAnnotationKey] = failureReason
+		}
+
+		return nil
+	}
+
+	return r.k8sClient.UpdateNode(ctx, nodeName, updateFn)
+}
+
+// scheduleProbationCheck re-evaluates the node's probation after the given delay, replacing any
+// check already scheduled for it
+func (r *Reconciler) scheduleProbationCheck(ctx context.Context, nodeName string, after time.Duration) {
+	r.probationMu.Lock()
+	defer r.probationMu.Unlock()
+
+	if r.probationTimers == nil {
+		r.probationTimers = make(map[string]*time.Timer)
+	}
+
+	if existing, ok := r.probationTimers[nodeName]; ok {
+		existing.Stop()
+	}
+
+	// The check outlives the event that scheduled it, so it only keeps the context's values
+	checkCtx := context.WithoutCancel(ctx)
+
+	r.probationTimers[nodeName] = time.AfterFunc(after, func() {
+		r.reprocessProbationEvent(checkCtx, nodeName)
+	})
+}
+
+func (r *Reconciler) stopProbationTimer(nodeName string) {
+	r.probationMu.Lock()
+	defer r.probationMu.Unlock()
+
+	if timer, ok := r.probationTimers[nodeName]; ok {
+		timer.Stop()
+		delete(r.probationTimers, nodeName)
+	}
+}
+
+// reprocessProbationEvent runs the healthy event that started the node's probation back through
+// event processing, so that its status is recorded on the original document once the node is
+// uncordoned
+func (r *Reconciler) reprocessProbationEvent(ctx context.Context, nodeName string) {
+	node, err := r.k8sClient.NodeInformer.GetNode(nodeName)
+	if err != nil || node.Annotations[common.QuarantineProbationStartTimeAnnotationKey] == "" {
+		return
+	}
+
+	healthEvent, err := parseProbationEvent(node.Annotations[common.QuarantineProbationHealthEventAnnotationKey])
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to read probation health event from node", "node", nodeName, "error", err)
+		metrics.ProcessingErrors.WithLabelValues("reprocess_probation_event_error").Inc()
+
+		return
+	}
+
+	if r.eventWatcher == nil {
+		slog.WarnContext(ctx, "Event watcher is not set, cannot complete probation", "node", nodeName)
+		return
+	}
+
+	event := &model.HealthEventWithStatus{
+		CreatedAt:         time.Now().UTC(),
+		HealthEvent:       healthEvent,
+		HealthEventStatus: &protos.HealthEventStatus{},
+	}
+
+	if err := r.eventWatcher.ReprocessEvent(ctx, event); err != nil {
+		slog.ErrorContext(ctx, "Failed to reprocess probation health event", "node", nodeName, "error", err)
+		metrics.ProcessingErrors.WithLabelValues("reprocess_probation_event_error").Inc()
+	}
+}
+
+// resumeProbations reschedules the probation checks of nodes that were on probation when the process
+// last stopped
+func (r *Reconciler) resumeProbations(ctx context.Context) {
+	if r.probation == nil {
+		return
+	}
+
+	nodes, err := r.k8sClient.NodeInformer.ListNodes()
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list nodes to resume probations", "error", err)
+		return
+	}
+
+	for _, node := range nodes {
+		value := node.Annotations[common.QuarantineProbationStartTimeAnnotationKey]
+		if value == "" {
+			continue
+		}
+
+		var after time.Duration
+
+		if startedAt, err := time.Parse(time.RFC3339, value); err == nil {
+			after = max(time.Until(startedAt.Add(r.probation.minHealthyDuration)), 0)
+		}
+
+		slog.InfoContext(ctx, "Resuming probation for node", "node", node.Name, "checkIn", after)
+		r.scheduleProbationCheck(ctx, node.Name, after)
+	}
+}
+
+// checkValidationJob creates the node's validation job if it does not exist yet and reports whether
+// it has finished and whether it succeeded
+func (r *Reconciler) checkValidationJob(ctx context.Context, nodeName string) (bool, bool) {
+	jobs := r.k8sClient.Clientset.BatchV1().Jobs(r.probation.validation.Namespace)
+
+	job, err := jobs.Get(ctx, probationJobName(nodeName), metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		if _, err := jobs.Create(ctx, r.newValidationJob(nodeName), metav1.CreateOptions{}); err != nil &&
+			!errors.IsAlreadyExists(err) {
+			slog.ErrorContext(ctx, "Failed to create probation validation job", "node", nodeName, "error", err)
+			metrics.ProcessingErrors.WithLabelValues("create_probation_job_error").Inc()
+
+			return false, false
+		}
+
+		slog.InfoContext(ctx, "Created probation validation job", "node", nodeName, "job", probationJobName(nodeName))
+
+		return false, false
+	}
+
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get probation validation job", "node", nodeName, "error", err)
+		metrics.ProcessingErrors.WithLabelValues("get_probation_job_error").Inc()
+
+		return false, false
+	}
+
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+
+		switch condition.Type {
+		case batchv1.JobComplete:
+			return true, true
+		case batchv1.JobFailed:
+			return true, false
+		}
+	}
+
+	return false, false
+}
+
+func (r *Reconciler) deleteValidationJob(ctx context.Context, nodeName string) {
+	if r.probation == nil || r.probation.validation == nil || r.config.DryRun {
+		return
+	}
+
+	err := r.k8sClient.Clientset.BatchV1().Jobs(r.probation.validation.Namespace).Delete(
+		ctx, probationJobName(nodeName), metav1.DeleteOptions{PropagationPolicy: ptr.To(metav1.DeletePropagationBackground)})
+	if err != nil && !errors.IsNotFound(err) {
+		slog.ErrorContext(ctx, "Failed to delete probation validation job", "node", nodeName, "error", err)
+		metrics.ProcessingErrors.WithLabelValues("delete_probation_job_error").Inc()
+	}
+}
+
+// newValidationJob builds a job bound to the node that tolerates every taint, so that it runs on
+// the cordoned and tainted node without being scheduled elsewhere
+func (r *Reconciler) newValidationJob(nodeName string) *batchv1.Job {
+	validation := r.probation.validation
+
+	env := make([]corev1.EnvVar, 0, len(validation.Env)+1)
+	env = append(env, corev1.EnvVar{Name: "NODE_NAME", Value: nodeName})
+
+	for name, value := range validation.Env {
+		env = append(env, corev1.EnvVar{Name: name, Value: value})
+	}
+
+	slices.SortFunc(env[1:], func(a, b corev1.EnvVar) int {
+		switch {
+		case a.Name < b.Name:
+			return -1
+		case a.Name > b.Name:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      probationJobName(nodeName),
+			Namespace: validation.Namespace,
+			Labels: map[string]string{
+				probationJobManagedByLabelKey: probationJobManagedByLabelValue,
+			},
+			Annotations: map[string]string{
+				probationJobNodeAnnotationKey: nodeName,
+			},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:          ptr.To[int32](0),
+			ActiveDeadlineSeconds: ptr.To(int64(validation.timeout.Seconds())),
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					NodeName:           nodeName,
+					RestartPolicy:      corev1.RestartPolicyNever,
+					ServiceAccountName: validation.ServiceAccountName,
+					Tolerations:        []corev1.Toleration{{Operator: corev1.TolerationOpExists}},
+					Containers: []corev1.Container{
+						{
+							Name:    "validation",
+							Image:   validation.Image,
+							Command: validation.Command,
+							Args:    validation.Args,
+							Env:     env,
+							Resources: corev1.ResourceRequirements{
+								Limits: validation.resourceLimits,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// probationJobName derives a stable job name for the node, hashing names that would not fit
+func probationJobName(nodeName string) string {
+	name := probationJobNamePrefix + nodeName
+	if len(name) <= 63 {
+		return name
+	}
+
+	sum := sha256.Sum256([]byte(nodeName))
+
+	return name[:52] + "-" + hex.EncodeToString(sum[:])[:10]
+}
+
+// probationAnnotationKeys returns the probation annotations present in the node's quarantine annotations
+func probationAnnotationKeys(annotations map[string]string) []string {
+	var keys []string
+
+	for _, key := range []string{
+		common.QuarantineProbationStartTimeAnnotationKey,
+		common.QuarantineProbationHealthEventAnnotationKey,
+		common.QuarantineProbationFailedAnnotationKey,
+	} {
+		if _, exists := annotations[key]; exists {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys
+}
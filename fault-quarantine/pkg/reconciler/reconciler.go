@@ -29,6 +29,7 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	corev1 "k8s.io/api/core/v1"
 
+	"github.com/nvidia/nvsentinel/commons/pkg/schedule"
 	"github.com/nvidia/nvsentinel/commons/pkg/statemanager"
 	"github.com/nvidia/nvsentinel/commons/pkg/tracing"
 	"github.com/nvidia/nvsentinel/data-models/pkg/model"
@@ -36,6 +37,7 @@ import (
 	"github.com/nvidia/nvsentinel/fault-quarantine/pkg/breaker"
 	"github.com/nvidia/nvsentinel/fault-quarantine/pkg/common"
 	"github.com/nvidia/nvsentinel/fault-quarantine/pkg/config"
+	"github.com/nvidia/nvsentinel/fault-quarantine/pkg/devicequarantine"
 	"github.com/nvidia/nvsentinel/fault-quarantine/pkg/evaluator"
 	"github.com/nvidia/nvsentinel/fault-quarantine/pkg/eventwatcher"
 	"github.com/nvidia/nvsentinel/fault-quarantine/pkg/healthEventsAnnotation"
@@ -59,12 +61,21 @@ type ReconcilerConfig struct {
 	DataStoreConfig       *datastore.DataStoreConfig
 	TokenConfig           client.TokenConfig
 	DatabasePipeline      interface{}
+	DevicePublisher       devicequarantine.Publisher
 }
 
 type rulesetsConfig struct {
 	TaintConfigMap     map[string]*config.Taint
 	CordonConfigMap    map[string]bool
 	RuleSetPriorityMap map[string]int
+	ScheduleMap        map[string]*schedule.Schedule // rule set name -> maintenance schedule
+	DeviceQuarantine   map[string]bool               // rule sets that isolate devices instead of the node
+}
+
+// pendingRelease is a scheduled release of events held until a maintenance window opens
+type pendingRelease struct {
+	at    time.Time
+	timer *time.Timer
 }
 
 // keyValTaint represents a taint key-value pair used for deduplication and priority tracking
@@ -79,8 +90,20 @@ type Reconciler struct {
 	lastProcessedObjectID atomic.Value
 	cb                    breaker.CircuitBreaker
 	eventWatcher          eventwatcher.EventWatcherInterface
+	devicePublisher       devicequarantine.Publisher
 	taintInitKeys         []keyValTaint // Pre-computed taint keys for map initialization
 	taintUpdateMu         sync.Mutex    // Protects taint priority updates
+	processMu             sync.Mutex    // Serializes change stream events and released pending events
+
+	pendingMu       sync.Mutex
+	pendingReleases map[string]pendingRelease // node name -> release of events held for a maintenance window
+
+	workloadMu            sync.Mutex
+	workloadReevaluations map[string]*time.Timer // node name -> re-evaluation of events held by Workload rules
+
+	probation       *probationSettings // nil when recovered nodes are uncordoned immediately
+	probationMu     sync.Mutex
+	probationTimers map[string]*time.Timer // node name -> next probation check
 
 	// Label keys
 	cordonedByLabelKey        string
@@ -106,9 +129,14 @@ func NewReconciler(
 	circuitBreaker breaker.CircuitBreaker,
 ) *Reconciler {
 	r := &Reconciler{
-		config:    cfg,
-		k8sClient: k8sClient,
-		cb:        circuitBreaker,
+		config:          cfg,
+		k8sClient:       k8sClient,
+		cb:              circuitBreaker,
+		devicePublisher: cfg.DevicePublisher,
+	}
+
+	if r.devicePublisher == nil {
+		r.devicePublisher = devicequarantine.Disabled()
 	}
 
 	return r
@@ -200,6 +228,10 @@ func (r *Reconciler) Start(ctx context.Context) error {
 
 	slog.InfoContext(ctx, "Node informer started and synced")
 
+	if err := r.startPodInformer(ctx); err != nil {
+		return err
+	}
+
 	// Check circuit breaker state AFTER informer is synced (IsTripped needs node counts from informer)
 	if err := r.checkCircuitBreakerAtStartup(ctx); err != nil {
 		return fmt.Errorf("failed to check circuit breaker at startup: %w", err)
@@ -210,9 +242,18 @@ func (r *Reconciler) Start(ctx context.Context) error {
 		return fmt.Errorf("failed to initialize rule set evaluators: %w", err)
 	}
 
+	schedules, err := r.buildSchedules()
+	if err != nil {
+		return err
+	}
+
+	if err := r.buildProbation(); err != nil {
+		return err
+	}
+
 	r.setupLabelKeys()
 
-	rulesetsConfig := r.buildRulesetsConfig()
+	rulesetsConfig := r.buildRulesetsConfig(schedules)
 
 	r.precomputeTaintInitKeys(ctx, ruleSetEvals, rulesetsConfig)
 
@@ -226,6 +267,13 @@ func (r *Reconciler) Start(ctx context.Context) error {
 
 	r.eventWatcher.SetFetchDocIDsFn(r.sourceDocIDsFromAnnotation)
 
+	r.resumePendingMaintenanceWindows(ctx, rulesetsConfig)
+	r.resumeProbations(ctx)
+
+	if err := r.watchWorkloadDeferrals(ctx); err != nil {
+		return err
+	}
+
 	if err := r.eventWatcher.Start(ctx); err != nil {
 		return fmt.Errorf("event watcher failed: %w", err)
 	}
@@ -273,9 +321,33 @@ func (r *Reconciler) SetupNodeInformerCallbacks() {
 	r.k8sClient.NodeInformer.SetOnManualUntaintCallback(r.handleManualUntaint)
 }
 
+// startPodInformer starts the pod informer used by Workload rules, if one was enabled
+func (r *Reconciler) startPodInformer(ctx context.Context) error {
+	if r.k8sClient.PodInformer == nil {
+		return nil
+	}
+
+	slog.InfoContext(ctx, "Starting pod informer")
+
+	go func() {
+		if err := r.k8sClient.PodInformer.Run(ctx.Done()); err != nil {
+			slog.ErrorContext(ctx, "Pod informer failed", "error", err)
+		}
+	}()
+
+	if !r.k8sClient.PodInformer.WaitForSync(ctx) {
+		return fmt.Errorf("failed to sync PodInformer cache")
+	}
+
+	slog.InfoContext(ctx, "Pod informer started and synced")
+
+	return nil
+}
+
 // initializeRuleSetEvaluators initializes all rule set evaluators from config
 func (r *Reconciler) initializeRuleSetEvaluators() ([]evaluator.RuleSetEvaluatorIface, error) {
-	ruleSetEvals, err := evaluator.InitializeRuleSetEvaluators(r.config.TomlConfig.RuleSets, r.k8sClient.NodeInformer)
+	ruleSetEvals, err := evaluator.InitializeRuleSetEvaluators(
+		r.config.TomlConfig.RuleSets, r.k8sClient.NodeInformer, r.k8sClient.PodInformer)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize all rule set evaluators: %w", err)
 	}
@@ -290,10 +362,12 @@ func (r *Reconciler) setupLabelKeys() {
 }
 
 // buildRulesetsConfig builds the rulesets configuration maps from TOML config
-func (r *Reconciler) buildRulesetsConfig() rulesetsConfig {
+func (r *Reconciler) buildRulesetsConfig(schedules map[string]*schedule.Schedule) rulesetsConfig {
 	taintConfigMap := make(map[string]*config.Taint)
 	cordonConfigMap := make(map[string]bool)
 	ruleSetPriorityMap := make(map[string]int)
+	scheduleMap := make(map[string]*schedule.Schedule)
+	deviceQuarantineMap := make(map[string]bool)
 
 	for _, ruleSet := range r.config.TomlConfig.RuleSets {
 		if ruleSet.Taint.Key != "" {
@@ -307,12 +381,22 @@ func (r *Reconciler) buildRulesetsConfig() rulesetsConfig {
 		if ruleSet.Priority > 0 {
 			ruleSetPriorityMap[ruleSet.Name] = ruleSet.Priority
 		}
+
+		if sched, ok := schedules[ruleSet.Schedule]; ok {
+			scheduleMap[ruleSet.Name] = sched
+		}
+
+		if ruleSet.DeviceQuarantine.Enabled {
+			deviceQuarantineMap[ruleSet.Name] = true
+		}
 	}
 
 	return rulesetsConfig{
 		TaintConfigMap:     taintConfigMap,
 		CordonConfigMap:    cordonConfigMap,
 		RuleSetPriorityMap: ruleSetPriorityMap,
+		ScheduleMap:        scheduleMap,
+		DeviceQuarantine:   deviceQuarantineMap,
 	}
 }
 
@@ -448,6 +532,9 @@ func (r *Reconciler) ProcessEvent(
 ) *model.Status {
 	span := tracing.SpanFromContext(ctx)
 
+	r.processMu.Lock()
+	defer r.processMu.Unlock()
+
 	if shouldHalt := r.checkCircuitBreakerAndHalt(ctx); shouldHalt {
 		span.SetAttributes(attribute.String("fault_quarantine.event.processing_status", EventProcessingStatusHalted))
 
@@ -525,13 +612,31 @@ func (r *Reconciler) handleEvent(
 
 	annotations, quarantineAnnotationExists := r.hasExistingQuarantine(ctx, event.HealthEvent.NodeName)
 
+	var deviceStatus *model.Status
+	if event.HealthEvent.IsHealthy {
+		deviceStatus = r.releaseDevices(ctx, event.HealthEvent)
+	}
+
 	if quarantineAnnotationExists {
-		return r.handleAlreadyQuarantinedNode(ctx, event.HealthEvent, ruleSetEvals)
+		status := r.handleAlreadyQuarantinedNode(ctx, event.HealthEvent, ruleSetEvals)
+
+		// The node level status takes precedence; without one, the device recovery is propagated
+		if status == nil {
+			return deviceStatus
+		}
+
+		return status
 	}
 
 	// For healthy events, if there's no existing quarantine annotation,
 	// skip processing as there's no transition from unhealthy to healthy
 	if event.HealthEvent.IsHealthy {
+		r.dropPendingEvents(ctx, event.HealthEvent)
+
+		if deviceStatus != nil {
+			return deviceStatus
+		}
+
 		slog.InfoContext(ctx, "Skipping healthy event for node as there's no existing quarantine annotation",
 			"node", event.HealthEvent.NodeName, "event", event.HealthEvent)
 		span.SetAttributes(
@@ -554,9 +659,15 @@ func (r *Reconciler) handleEvent(
 
 	var isCordoned atomic.Bool
 
+	var deferral maintenanceDeferral
+
+	var deviceMatch deviceQuarantineMatch
+
+	var workloads workloadDeferral
+
 	r.evaluateRulesets(
 		ctx, event, ruleSetEvals, rulesetsConfig,
-		taintAppliedMap, &labelsMap, &isCordoned, taintEffectPriorityMap,
+		taintAppliedMap, &labelsMap, &isCordoned, taintEffectPriorityMap, &deferral, &deviceMatch, &workloads,
 	)
 
 	taintsToBeApplied := r.collectTaintsToApply(taintAppliedMap)
@@ -565,6 +676,21 @@ func (r *Reconciler) handleEvent(
 
 	isNodeQuarantined := len(taintsToBeApplied) > 0 || isCordoned.Load()
 
+	// Device-scoped rule sets leave the node schedulable unless another rule set quarantines it
+	if !isNodeQuarantined && !deviceMatch.empty() {
+		return r.quarantineDevices(ctx, event, &deviceMatch)
+	}
+
+	// Rule sets gated by a closed maintenance window only matter if nothing else quarantines the node now
+	if !isNodeQuarantined && !deferral.empty() {
+		return r.holdUntilMaintenanceWindow(ctx, event, &deferral)
+	}
+
+	// Rule sets held back by Workload rules are evaluated again when the node's pods change
+	if !isNodeQuarantined && !workloads.empty() {
+		return r.holdForWorkloads(ctx, event, &workloads)
+	}
+
 	// In dry-run mode, always apply annotations for observability even if no actions would be taken
 	if !isNodeQuarantined && !r.config.DryRun {
 		span.SetAttributes(
@@ -634,7 +760,7 @@ func (r *Reconciler) handleAlreadyQuarantinedNode(
 	ctx, span := tracing.StartSpan(ctx, "fault_quarantine.handle_already_quarantined_node")
 	defer span.End()
 
-	healthEventsAnnotationMap, _, err := r.getHealthEventsFromAnnotation(ctx, event)
+	healthEventsAnnotationMap, annotations, err := r.getHealthEventsFromAnnotation(ctx, event)
 
 	// Only propagate events to ND/FR if they will modify node annotations
 	// Returning nil prevents unnecessary MongoDB writes and downstream processing
@@ -657,6 +783,13 @@ func (r *Reconciler) handleAlreadyQuarantinedNode(
 			attribute.String("fault_quarantine.error.message", err.Error()),
 		)
 
+		return nil
+	case event.IsHealthy && r.isProbationEvent(event, annotations):
+		return r.completeProbation(ctx, event, annotations)
+	case event.IsHealthy && healthEventsAnnotationMap.IsEmpty() && r.isFailedProbationEvent(event, annotations):
+		// Every tracked check already recovered, so the healthy event retries the failed probation
+		r.startProbation(ctx, event)
+
 		return nil
 	case event.IsHealthy:
 		_, hasExistingCheck := healthEventsAnnotationMap.GetEvent(event)
@@ -713,6 +846,9 @@ func (r *Reconciler) evaluateRulesets(
 	labelsMap *sync.Map,
 	isCordoned *atomic.Bool,
 	taintEffectPriorityMap map[keyValTaint]int,
+	deferral *maintenanceDeferral,
+	deviceMatch *deviceQuarantineMatch,
+	workloads *workloadDeferral,
 ) {
 	ctx, span := tracing.StartSpan(ctx, "fault_quarantine.evaluate_rulesets")
 	defer span.End()
@@ -742,9 +878,23 @@ func (r *Reconciler) evaluateRulesets(
 			ruleEvaluatedResult, err := eval.Evaluate(event.HealthEvent)
 
 			switch {
+			case ruleEvaluatedResult == common.RuleEvaluationSuccess &&
+				r.deferForMaintenanceWindow(event.HealthEvent, eval.GetName(), rulesetsConfig, deferral):
+				slog.InfoContext(ctx, "Ruleset matched outside its maintenance window, deferring",
+					"node", event.HealthEvent.NodeName, "ruleset", eval.GetName())
+				metrics.RulesetEvaluations.WithLabelValues(eval.GetName(), metrics.StatusPassed).Inc()
+			case ruleEvaluatedResult == common.RuleEvaluationSuccess &&
+				rulesetsConfig.DeviceQuarantine[eval.GetName()] && len(deviceUUIDs(event.HealthEvent)) > 0:
+				deviceMatch.add(eval.GetName())
+				metrics.RulesetEvaluations.WithLabelValues(eval.GetName(), metrics.StatusPassed).Inc()
 			case ruleEvaluatedResult == common.RuleEvaluationSuccess:
 				r.handleSuccessfulRuleEvaluation(
 					eval, rulesetsConfig, labelsMap, isCordoned, taintAppliedMap, taintEffectPriorityMap)
+			case ruleEvaluatedResult == common.RuleEvaluationDeferred:
+				slog.InfoContext(ctx, "Ruleset held back by Workload rules, deferring",
+					"node", event.HealthEvent.NodeName, "ruleset", eval.GetName())
+				workloads.add(eval.GetName())
+				metrics.RulesetEvaluations.WithLabelValues(eval.GetName(), metrics.StatusFailed).Inc()
 			case err != nil:
 				r.handleRuleEvaluationError(ctx, event.HealthEvent, eval.GetName(), err)
 			default:
@@ -1067,6 +1217,8 @@ func (r *Reconciler) handleUnhealthyEventOnQuarantinedNode(
 		return true
 	}
 
+	r.cancelProbation(ctx, event.NodeName)
+
 	added := healthEventsAnnotationMap.AddOrUpdateEvent(event)
 
 	if added {
@@ -1152,6 +1304,12 @@ func (r *Reconciler) handleQuarantinedNode(
 		return true
 	}
 
+	if updatedHealthEventsMap.IsEmpty() && r.probation != nil {
+		r.startProbation(ctx, event)
+
+		return true
+	}
+
 	if updatedHealthEventsMap.IsEmpty() {
 		slog.InfoContext(ctx, "All health checks recovered for node, proceeding with uncordon",
 			"node", event.NodeName)
@@ -1400,6 +1558,7 @@ func (r *Reconciler) performUncordon(
 	}
 
 	annotationsToBeRemoved = append(annotationsToBeRemoved, common.QuarantineHealthEventAnnotationKey)
+	annotationsToBeRemoved = append(annotationsToBeRemoved, probationAnnotationKeys(annotations)...)
 
 	if !r.config.CircuitBreakerEnabled {
 		slog.InfoContext(ctx, "Circuit breaker is disabled, proceeding with unquarantine action for node",
@@ -1526,6 +1685,9 @@ func (r *Reconciler) getNodeQuarantineAnnotations(ctx context.Context, nodeName
 		common.QuarantineHealthEventAppliedTaintsAnnotationKey,
 		common.QuarantineHealthEventIsCordonedAnnotationKey,
 		common.QuarantinedNodeUncordonedManuallyAnnotationKey,
+		common.QuarantineProbationStartTimeAnnotationKey,
+		common.QuarantineProbationHealthEventAnnotationKey,
+		common.QuarantineProbationFailedAnnotationKey,
 	}
 
 	if node.Annotations != nil {
@@ -1609,6 +1771,9 @@ func (r *Reconciler) handleManualUncordon(nodeName string) error {
 		annotationsToRemove = append(annotationsToRemove, common.QuarantineHealthEventIsCordonedAnnotationKey)
 	}
 
+	annotationsToRemove = append(annotationsToRemove, probationAnnotationKeys(annotations)...)
+	r.stopProbationTimer(nodeName)
+
 	slog.DebugContext(ctx, "Prepared annotations to remove", "node", nodeName, "count", len(annotationsToRemove))
 
 	newAnnotations := map[string]string{
@@ -1701,6 +1866,9 @@ func (r *Reconciler) handleManualUntaint(nodeName string) error {
 		annotationsToRemove = append(annotationsToRemove, common.QuarantineHealthEventIsCordonedAnnotationKey)
 	}
 
+	annotationsToRemove = append(annotationsToRemove, probationAnnotationKeys(annotations)...)
+	r.stopProbationTimer(nodeName)
+
 	slog.DebugContext(ctx, "Prepared annotations to remove", "node", nodeName, "count", len(annotationsToRemove))
 
 	newAnnotations := map[string]string{
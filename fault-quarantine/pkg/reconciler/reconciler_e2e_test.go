@@ -18,6 +18,7 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log"
@@ -38,6 +39,7 @@ import (
 	"k8s.io/client-go/kubernetes"
 	"sigs.k8s.io/controller-runtime/pkg/envtest"
 
+	"github.com/nvidia/nvsentinel/commons/pkg/schedule"
 	"github.com/nvidia/nvsentinel/commons/pkg/statemanager"
 	"github.com/nvidia/nvsentinel/data-models/pkg/model"
 	"github.com/nvidia/nvsentinel/data-models/pkg/protos"
@@ -233,6 +235,7 @@ type E2EReconcilerConfig struct {
 	TomlConfig           config.TomlConfig
 	CircuitBreakerConfig *breaker.CircuitBreakerConfig
 	DryRun               bool
+	WatchPods            bool
 }
 
 // setupE2EReconciler creates a test reconciler with mock watcher
@@ -262,7 +265,14 @@ func setupE2EReconcilerWithOptions(t *testing.T, ctx context.Context, cfg E2ERec
 		NodeInformer: nodeInformer,
 	}
 
-	ruleSetEvals, err := evaluator.InitializeRuleSetEvaluators(cfg.TomlConfig.RuleSets, fqClient.NodeInformer)
+	// Workload rules need the pod informer, mirroring initializer.InitializeAll
+	if cfg.WatchPods {
+		fqClient.PodInformer, err = informer.NewPodInformer(e2eTestClient, 0)
+		require.NoError(t, err)
+	}
+
+	ruleSetEvals, err := evaluator.InitializeRuleSetEvaluators(
+		cfg.TomlConfig.RuleSets, fqClient.NodeInformer, fqClient.PodInformer)
 	require.NoError(t, err)
 
 	var cb breaker.CircuitBreaker
@@ -321,6 +331,15 @@ func setupE2EReconcilerWithOptions(t *testing.T, ctx context.Context, cfg E2ERec
 
 	require.Eventually(t, nodeInformer.HasSynced, eventuallyTimeout, statusCheckPollInterval, "NodeInformer should sync")
 
+	if fqClient.PodInformer != nil {
+		go func() {
+			_ = fqClient.PodInformer.Run(stopCh)
+		}()
+
+		require.Eventually(t, fqClient.PodInformer.HasSynced, eventuallyTimeout, statusCheckPollInterval,
+			"PodInformer should sync")
+	}
+
 	// Build rulesets config (mimics reconciler.Start())
 	rulesetsConfig := rulesetsConfig{
 		TaintConfigMap:     make(map[string]*config.Taint),
@@ -340,6 +359,13 @@ func setupE2EReconcilerWithOptions(t *testing.T, ctx context.Context, cfg E2ERec
 		}
 	}
 
+	schedules, err := r.buildSchedules()
+	require.NoError(t, err)
+	require.NoError(t, r.buildProbation())
+	builtRulesetsConfig := r.buildRulesetsConfig(schedules)
+	rulesetsConfig.ScheduleMap = builtRulesetsConfig.ScheduleMap
+	rulesetsConfig.DeviceQuarantine = builtRulesetsConfig.DeviceQuarantine
+
 	r.precomputeTaintInitKeys(context.Background(), ruleSetEvals, rulesetsConfig)
 
 	r.initializeQuarantineMetrics(context.Background())
@@ -361,6 +387,15 @@ func setupE2EReconcilerWithOptions(t *testing.T, ctx context.Context, cfg E2ERec
 		return r.ProcessEvent(ctx, event, ruleSetEvals, rulesetsConfig)
 	}
 
+	// Probation checks and workload deferrals reprocess events through the event watcher
+	if r.probation != nil || fqClient.PodInformer != nil {
+		r.SetEventWatcher(&MockEventWatcher{ProcessEventCallbackFn: processEventFunc})
+	}
+
+	if fqClient.PodInformer != nil {
+		require.NoError(t, r.watchWorkloadDeferrals(ctx))
+	}
+
 	// Start event processing goroutine (mimics production event watcher)
 	go func() {
 		for event := range mockWatcher.Events() {
@@ -494,7 +529,7 @@ func runReconcilerAndQuarantineNode(
 
 		require.Eventually(t, nodeInformer.HasSynced, eventuallyTimeout, statusCheckPollInterval, "NodeInformer should sync")
 
-		ruleSetEvals, err := evaluator.InitializeRuleSetEvaluators(tomlConfig.RuleSets, fqClient.NodeInformer)
+		ruleSetEvals, err := evaluator.InitializeRuleSetEvaluators(tomlConfig.RuleSets, fqClient.NodeInformer, nil)
 		require.NoError(t, err)
 
 		reconcilerCfg := ReconcilerConfig{
@@ -623,6 +658,8 @@ type MockEventWatcher struct {
 	CancelLatestQuarantiningEventsFn func(ctx context.Context, nodeName string, reason string) error
 	ProcessEventCallbackFn           func(ctx context.Context, event *model.HealthEventWithStatus) *model.Status
 	StartFn                          func(ctx context.Context) error
+	// ReprocessEventFn overrides ReprocessEvent, e.g. to simulate datastore errors
+	ReprocessEventFn func(ctx context.Context, event *model.HealthEventWithStatus) error
 }
 
 func (m *MockEventWatcher) Start(ctx context.Context) error {
@@ -646,6 +683,17 @@ func (m *MockEventWatcher) CancelLatestQuarantiningEvents(ctx context.Context, n
 	return nil
 }
 
+func (m *MockEventWatcher) ReprocessEvent(ctx context.Context, event *model.HealthEventWithStatus) error {
+	if m.ReprocessEventFn != nil {
+		return m.ReprocessEventFn(ctx, event)
+	}
+
+	if m.ProcessEventCallbackFn != nil {
+		m.ProcessEventCallbackFn(ctx, event)
+	}
+	return nil
+}
+
 func TestE2E_BasicQuarantineAndUnquarantine(t *testing.T) {
 	ctx, cancel := context.WithTimeout(e2eTestContext, 20*time.Second)
 	defer cancel()
@@ -788,6 +836,575 @@ func TestE2E_BasicQuarantineAndUnquarantine(t *testing.T) {
 	assert.GreaterOrEqual(t, finalProcessed, beforeProcessed+2, "TotalEventsSuccessfullyProcessed should increment for both events")
 }
 
+func TestE2E_MaintenanceWindowDefersNonFatalEvents(t *testing.T) {
+	ctx, cancel := context.WithTimeout(e2eTestContext, 20*time.Second)
+	defer cancel()
+
+	nodeName := "e2e-window-" + generateShortTestID()
+	createE2ETestNode(ctx, t, nodeName, nil, nil, nil, false)
+	defer func() {
+		_ = e2eTestClient.CoreV1().Nodes().Delete(ctx, nodeName, metav1.DeleteOptions{})
+	}()
+
+	// A one hour window twelve hours from now is guaranteed to be closed while the test runs
+	windowHour := (time.Now().UTC().Hour() + 12) % 24
+
+	tomlConfig := config.TomlConfig{
+		LabelPrefix: "k8s.nvidia.com/",
+		Schedules: []schedule.Config{
+			{
+				Name:    "nightly",
+				Windows: []schedule.Window{{Cron: fmt.Sprintf("0 %d * * *", windowHour), Duration: "1h"}},
+			},
+		},
+		RuleSets: []config.RuleSet{
+			{
+				Enabled:  true,
+				Name:     "gpu-xid-errors",
+				Version:  "1",
+				Priority: 10,
+				Match: config.Match{
+					Any: []config.Rule{
+						{Kind: "HealthEvent", Expression: "event.checkName == 'GpuXidError'"},
+					},
+				},
+				Cordon:   config.Cordon{ShouldCordon: true},
+				Schedule: "nightly",
+			},
+		},
+	}
+
+	_, mockWatcher, getStatus, _ := setupE2EReconciler(t, ctx, tomlConfig, nil)
+
+	t.Log("Sending non-fatal event outside the maintenance window")
+	eventID1 := generateTestID()
+	mockWatcher.EventsChan <- &TestEvent{Data: createHealthEventBSON(
+		eventID1,
+		nodeName,
+		"GpuXidError",
+		false,
+		false,
+		[]*protos.Entity{{EntityType: "GPU", EntityValue: "0"}},
+		model.StatusInProgress,
+	)}
+
+	require.Eventually(t, func() bool {
+		status := getStatus(eventID1)
+		return status != nil && *status == model.PendingMaintenanceWindow
+	}, statusCheckTimeout, statusCheckPollInterval, "Status should be PendingMaintenanceWindow")
+
+	node, err := e2eTestClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.False(t, node.Spec.Unschedulable, "Node should not be cordoned outside the maintenance window")
+	assert.Empty(t, node.Annotations[common.QuarantineHealthEventAnnotationKey])
+	assert.Contains(t, node.Annotations[common.QuarantinePendingHealthEventsAnnotationKey], "GpuXidError")
+	assert.Equal(t, "nightly", node.Annotations[common.QuarantinePendingMaintenanceSchedulesAnnotationKey])
+
+	nextWindow, err := time.Parse(time.RFC3339, node.Annotations[common.QuarantineNextMaintenanceWindowAnnotationKey])
+	require.NoError(t, err, "Next maintenance window annotation should be RFC3339")
+	assert.Equal(t, windowHour, nextWindow.Hour())
+	assert.True(t, nextWindow.After(time.Now()))
+
+	t.Log("Sending fatal event, which bypasses the maintenance window")
+	eventID2 := generateTestID()
+	mockWatcher.EventsChan <- &TestEvent{Data: createHealthEventBSON(
+		eventID2,
+		nodeName,
+		"GpuXidError",
+		false,
+		true,
+		[]*protos.Entity{{EntityType: "GPU", EntityValue: "1"}},
+		model.StatusInProgress,
+	)}
+
+	require.Eventually(t, func() bool {
+		status := getStatus(eventID2)
+		return status != nil && *status == model.Quarantined
+	}, statusCheckTimeout, statusCheckPollInterval, "Fatal event should quarantine immediately")
+
+	node, err = e2eTestClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.True(t, node.Spec.Unschedulable, "Node should be cordoned by the fatal event")
+}
+
+func TestE2E_WorkloadRuleDefersUntilPodsChange(t *testing.T) {
+	ctx, cancel := context.WithTimeout(e2eTestContext, 30*time.Second)
+	defer cancel()
+
+	nodeName := "e2e-workload-" + generateShortTestID()
+	createE2ETestNode(ctx, t, nodeName, nil, nil, nil, false)
+	defer func() {
+		_ = e2eTestClient.CoreV1().Nodes().Delete(ctx, nodeName, metav1.DeleteOptions{})
+	}()
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "training-" + generateShortTestID(),
+			Namespace: "default",
+			Labels:    map[string]string{"checkpoint-in-progress": "true"},
+		},
+		Spec: corev1.PodSpec{
+			NodeName:   nodeName,
+			Containers: []corev1.Container{{Name: "trainer", Image: "trainer:latest"}},
+		},
+	}
+	_, err := e2eTestClient.CoreV1().Pods(pod.Namespace).Create(ctx, pod, metav1.CreateOptions{})
+	require.NoError(t, err)
+	defer func() {
+		_ = e2eTestClient.CoreV1().Pods(pod.Namespace).Delete(ctx, pod.Name, metav1.DeleteOptions{})
+	}()
+
+	tomlConfig := config.TomlConfig{
+		LabelPrefix: "k8s.nvidia.com/",
+		RuleSets: []config.RuleSet{
+			{
+				Enabled:  true,
+				Name:     "gpu-xid-errors",
+				Version:  "1",
+				Priority: 10,
+				Match: config.Match{
+					All: []config.Rule{
+						{Kind: "HealthEvent", Expression: "event.checkName == 'GpuXidError'"},
+						{Kind: "Workload", Expression: "!pods.exists(p, 'checkpoint-in-progress' in p.labels)"},
+					},
+				},
+				Cordon: config.Cordon{ShouldCordon: true},
+			},
+		},
+	}
+
+	_, mockWatcher, _, _ := setupE2EReconcilerWithOptions(t, ctx, E2EReconcilerConfig{
+		TomlConfig: tomlConfig,
+		WatchPods:  true,
+	})
+
+	t.Log("Sending event while a checkpoint is in progress")
+	mockWatcher.EventsChan <- &TestEvent{Data: createHealthEventBSON(
+		generateTestID(),
+		nodeName,
+		"GpuXidError",
+		false,
+		false,
+		[]*protos.Entity{{EntityType: "GPU", EntityValue: "0"}},
+		model.StatusInProgress,
+	)}
+
+	require.Eventually(t, func() bool {
+		node, err := e2eTestClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+		return err == nil &&
+			strings.Contains(node.Annotations[common.QuarantineWorkloadDeferredHealthEventsAnnotationKey], "GpuXidError")
+	}, statusCheckTimeout, statusCheckPollInterval, "Event should be held by the Workload rule")
+
+	node, err := e2eTestClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.False(t, node.Spec.Unschedulable, "Node should not be cordoned while the checkpoint is in progress")
+
+	t.Log("Finishing the checkpoint, which re-evaluates the held event")
+	current, err := e2eTestClient.CoreV1().Pods(pod.Namespace).Get(ctx, pod.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	delete(current.Labels, "checkpoint-in-progress")
+	_, err = e2eTestClient.CoreV1().Pods(pod.Namespace).Update(ctx, current, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		node, err := e2eTestClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+		return err == nil && node.Spec.Unschedulable
+	}, workloadReevaluationDelay+statusCheckTimeout, statusCheckPollInterval,
+		"Node should be cordoned once the checkpoint finishes")
+
+	node, err = e2eTestClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, node.Annotations[common.QuarantineWorkloadDeferredHealthEventsAnnotationKey])
+}
+
+// heldEventsAnnotations returns node annotations holding the event under annotationKey
+func heldEventsAnnotations(t *testing.T, annotationKey string, event *protos.HealthEvent) map[string]string {
+	t.Helper()
+
+	node := &corev1.Node{}
+	require.NoError(t, addHeldEvent(node, annotationKey, event))
+
+	return node.Annotations
+}
+
+func TestE2E_WorkloadDeferredEventsKeptWhenReprocessingFails(t *testing.T) {
+	ctx, cancel := context.WithTimeout(e2eTestContext, 20*time.Second)
+	defer cancel()
+
+	nodeName := "e2e-workload-retry-" + generateShortTestID()
+	heldEvent := &protos.HealthEvent{
+		NodeName:  nodeName,
+		Agent:     "gpu-health-monitor",
+		CheckName: "GpuXidError",
+		EntitiesImpacted: []*protos.Entity{
+			{EntityType: "GPU", EntityValue: "0"},
+		},
+	}
+	createE2ETestNode(ctx, t, nodeName,
+		heldEventsAnnotations(t, common.QuarantineWorkloadDeferredHealthEventsAnnotationKey, heldEvent),
+		nil, nil, false)
+	defer func() {
+		_ = e2eTestClient.CoreV1().Nodes().Delete(ctx, nodeName, metav1.DeleteOptions{})
+	}()
+
+	r, _, _, _ := setupE2EReconciler(t, ctx, config.TomlConfig{LabelPrefix: "k8s.nvidia.com/"}, nil)
+	t.Cleanup(func() {
+		r.workloadMu.Lock()
+		defer r.workloadMu.Unlock()
+
+		for _, timer := range r.workloadReevaluations {
+			timer.Stop()
+		}
+	})
+
+	var reprocessed []string
+
+	r.SetEventWatcher(&MockEventWatcher{
+		ReprocessEventFn: func(ctx context.Context, event *model.HealthEventWithStatus) error {
+			reprocessed = append(reprocessed, event.HealthEvent.CheckName)
+			return errors.New("datastore unavailable")
+		},
+	})
+
+	t.Log("Releasing the held event while reprocessing fails")
+	r.releaseWorkloadDeferredEvents(ctx, nodeName)
+
+	assert.Equal(t, []string{"GpuXidError"}, reprocessed)
+
+	node, err := e2eTestClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Contains(t, node.Annotations[common.QuarantineWorkloadDeferredHealthEventsAnnotationKey], "GpuXidError",
+		"Event should be written back when reprocessing fails")
+
+	r.workloadMu.Lock()
+	_, retryArmed := r.workloadReevaluations[nodeName]
+	r.workloadMu.Unlock()
+	assert.True(t, retryArmed, "Reprocessing should be retried")
+
+	t.Log("Releasing the held event again once reprocessing succeeds")
+	r.SetEventWatcher(&MockEventWatcher{})
+	r.releaseWorkloadDeferredEvents(ctx, nodeName)
+
+	node, err = e2eTestClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, node.Annotations[common.QuarantineWorkloadDeferredHealthEventsAnnotationKey])
+}
+
+func TestE2E_PendingEventsKeptWhenReprocessingFails(t *testing.T) {
+	ctx, cancel := context.WithTimeout(e2eTestContext, 20*time.Second)
+	defer cancel()
+
+	nodeName := "e2e-window-retry-" + generateShortTestID()
+	heldEvent := &protos.HealthEvent{
+		NodeName:  nodeName,
+		Agent:     "gpu-health-monitor",
+		CheckName: "GpuXidError",
+		EntitiesImpacted: []*protos.Entity{
+			{EntityType: "GPU", EntityValue: "0"},
+		},
+	}
+	annotations := heldEventsAnnotations(t, common.QuarantinePendingHealthEventsAnnotationKey, heldEvent)
+	annotations[common.QuarantinePendingMaintenanceSchedulesAnnotationKey] = "nightly"
+	annotations[common.QuarantineNextMaintenanceWindowAnnotationKey] = time.Now().UTC().Format(time.RFC3339)
+	createE2ETestNode(ctx, t, nodeName, annotations, nil, nil, false)
+	defer func() {
+		_ = e2eTestClient.CoreV1().Nodes().Delete(ctx, nodeName, metav1.DeleteOptions{})
+	}()
+
+	r, _, _, _ := setupE2EReconciler(t, ctx, config.TomlConfig{LabelPrefix: "k8s.nvidia.com/"}, nil)
+	t.Cleanup(func() {
+		r.pendingMu.Lock()
+		defer r.pendingMu.Unlock()
+
+		for _, release := range r.pendingReleases {
+			release.timer.Stop()
+		}
+	})
+
+	var reprocessed []string
+
+	r.SetEventWatcher(&MockEventWatcher{
+		ReprocessEventFn: func(ctx context.Context, event *model.HealthEventWithStatus) error {
+			reprocessed = append(reprocessed, event.HealthEvent.CheckName)
+			return errors.New("datastore unavailable")
+		},
+	})
+
+	t.Log("Releasing the pending event while reprocessing fails")
+	r.releasePendingEvents(ctx, nodeName)
+
+	assert.Equal(t, []string{"GpuXidError"}, reprocessed)
+
+	node, err := e2eTestClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Contains(t, node.Annotations[common.QuarantinePendingHealthEventsAnnotationKey], "GpuXidError",
+		"Event should be written back when reprocessing fails")
+	assert.Equal(t, "nightly", node.Annotations[common.QuarantinePendingMaintenanceSchedulesAnnotationKey])
+
+	retryAt, err := time.Parse(time.RFC3339, node.Annotations[common.QuarantineNextMaintenanceWindowAnnotationKey])
+	require.NoError(t, err, "Next maintenance window annotation should point at the retry")
+	assert.True(t, retryAt.After(time.Now()))
+
+	r.pendingMu.Lock()
+	_, retryArmed := r.pendingReleases[nodeName]
+	r.pendingMu.Unlock()
+	assert.True(t, retryArmed, "Reprocessing should be retried")
+
+	t.Log("Releasing the pending event again once reprocessing succeeds")
+	r.SetEventWatcher(&MockEventWatcher{})
+	r.releasePendingEvents(ctx, nodeName)
+
+	node, err = e2eTestClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, node.Annotations[common.QuarantinePendingHealthEventsAnnotationKey])
+	assert.Empty(t, node.Annotations[common.QuarantinePendingMaintenanceSchedulesAnnotationKey])
+	assert.Empty(t, node.Annotations[common.QuarantineNextMaintenanceWindowAnnotationKey])
+}
+
+func probationTestConfig(minHealthyDuration string) config.TomlConfig {
+	return config.TomlConfig{
+		LabelPrefix: "k8s.nvidia.com/",
+		RuleSets: []config.RuleSet{
+			{
+				Enabled:  true,
+				Name:     "gpu-xid-critical-errors",
+				Version:  "1",
+				Priority: 10,
+				Match: config.Match{
+					Any: []config.Rule{
+						{Kind: "HealthEvent", Expression: "event.checkName == 'GpuXidError' && event.isFatal == true"},
+					},
+				},
+				Taint:  config.Taint{Key: "nvidia.com/gpu-xid-error", Value: "true", Effect: "NoSchedule"},
+				Cordon: config.Cordon{ShouldCordon: true},
+			},
+		},
+		Probation: config.Probation{Enabled: true, MinHealthyDuration: minHealthyDuration},
+	}
+}
+
+func TestE2E_ProbationDelaysUncordon(t *testing.T) {
+	ctx, cancel := context.WithTimeout(e2eTestContext, 20*time.Second)
+	defer cancel()
+
+	nodeName := "e2e-probation-" + generateShortTestID()
+	createE2ETestNode(ctx, t, nodeName, nil, nil, nil, false)
+	defer func() {
+		_ = e2eTestClient.CoreV1().Nodes().Delete(ctx, nodeName, metav1.DeleteOptions{})
+	}()
+
+	_, mockWatcher, getStatus, _ := setupE2EReconciler(t, ctx, probationTestConfig("3s"), nil)
+
+	beforePassed := getCounterVecValue(t, metrics.ProbationOutcomes, ProbationOutcomePassed)
+
+	eventID1 := generateTestID()
+	mockWatcher.EventsChan <- &TestEvent{Data: createHealthEventBSON(
+		eventID1,
+		nodeName,
+		"GpuXidError",
+		false,
+		true,
+		[]*protos.Entity{{EntityType: "GPU", EntityValue: "0"}},
+		model.StatusInProgress,
+	)}
+
+	require.Eventually(t, func() bool {
+		status := getStatus(eventID1)
+		return status != nil && *status == model.Quarantined
+	}, statusCheckTimeout, statusCheckPollInterval, "Status should be Quarantined")
+
+	t.Log("Sending healthy event, which starts probation instead of uncordoning")
+	eventID2 := generateTestID()
+	mockWatcher.EventsChan <- &TestEvent{Data: createHealthEventBSON(
+		eventID2,
+		nodeName,
+		"GpuXidError",
+		true,
+		false,
+		[]*protos.Entity{{EntityType: "GPU", EntityValue: "0"}},
+		model.StatusInProgress,
+	)}
+
+	require.Eventually(t, func() bool {
+		node, err := e2eTestClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+		if err != nil {
+			return false
+		}
+		return node.Annotations[common.QuarantineProbationStartTimeAnnotationKey] != ""
+	}, eventuallyTimeout, eventuallyPollInterval, "Node should be on probation")
+
+	node, err := e2eTestClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.True(t, node.Spec.Unschedulable, "Node should stay cordoned during probation")
+	assert.Contains(t, node.Annotations[common.QuarantineProbationHealthEventAnnotationKey], "GpuXidError")
+	assert.Nil(t, getStatus(eventID2), "Healthy event should not be propagated during probation")
+
+	t.Log("Waiting for probation to end")
+	require.Eventually(t, func() bool {
+		node, err := e2eTestClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+		if err != nil {
+			return false
+		}
+		return !node.Spec.Unschedulable && node.Annotations[common.QuarantineHealthEventAnnotationKey] == ""
+	}, 10*time.Second, eventuallyPollInterval, "Node should be uncordoned after probation")
+
+	node, err = e2eTestClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, node.Annotations[common.QuarantineProbationStartTimeAnnotationKey])
+	assert.Empty(t, node.Annotations[common.QuarantineProbationHealthEventAnnotationKey])
+	for _, taint := range node.Spec.Taints {
+		assert.NotEqual(t, "nvidia.com/gpu-xid-error", taint.Key, "FQ taint should be removed")
+	}
+	verifyUnquarantineLabels(t, node)
+	assert.Equal(t, beforePassed+1, getCounterVecValue(t, metrics.ProbationOutcomes, ProbationOutcomePassed))
+}
+
+func TestE2E_FaultDuringProbationKeepsNodeQuarantined(t *testing.T) {
+	ctx, cancel := context.WithTimeout(e2eTestContext, 20*time.Second)
+	defer cancel()
+
+	nodeName := "e2e-probation-fault-" + generateShortTestID()
+	createE2ETestNode(ctx, t, nodeName, nil, nil, nil, false)
+	defer func() {
+		_ = e2eTestClient.CoreV1().Nodes().Delete(ctx, nodeName, metav1.DeleteOptions{})
+	}()
+
+	_, mockWatcher, getStatus, _ := setupE2EReconciler(t, ctx, probationTestConfig("1h"), nil)
+
+	beforeRecurred := getCounterVecValue(t, metrics.ProbationOutcomes, ProbationOutcomeFaultRecurred)
+
+	for _, isHealthy := range []bool{false, true} {
+		mockWatcher.EventsChan <- &TestEvent{Data: createHealthEventBSON(
+			generateTestID(),
+			nodeName,
+			"GpuXidError",
+			isHealthy,
+			!isHealthy,
+			[]*protos.Entity{{EntityType: "GPU", EntityValue: "0"}},
+			model.StatusInProgress,
+		)}
+	}
+
+	require.Eventually(t, func() bool {
+		node, err := e2eTestClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+		if err != nil {
+			return false
+		}
+		return node.Annotations[common.QuarantineProbationStartTimeAnnotationKey] != ""
+	}, eventuallyTimeout, eventuallyPollInterval, "Node should be on probation")
+
+	t.Log("Sending a recurring fault during probation")
+	eventID := generateTestID()
+	mockWatcher.EventsChan <- &TestEvent{Data: createHealthEventBSON(
+		eventID,
+		nodeName,
+		"GpuXidError",
+		false,
+		true,
+		[]*protos.Entity{{EntityType: "GPU", EntityValue: "1"}},
+		model.StatusInProgress,
+	)}
+
+	require.Eventually(t, func() bool {
+		status := getStatus(eventID)
+		return status != nil && *status == model.AlreadyQuarantined
+	}, statusCheckTimeout, statusCheckPollInterval, "Status should be AlreadyQuarantined")
+
+	node, err := e2eTestClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.True(t, node.Spec.Unschedulable, "Node should stay cordoned")
+	assert.Empty(t, node.Annotations[common.QuarantineProbationStartTimeAnnotationKey], "Probation should be cancelled")
+	verifyHealthEventInAnnotation(t, node, "GpuXidError", "gpu-health-monitor", "GPU", "GPU", "1")
+	assert.Equal(t, beforeRecurred+1, getCounterVecValue(t, metrics.ProbationOutcomes, ProbationOutcomeFaultRecurred))
+}
+
+func TestE2E_DeviceQuarantineLeavesNodeSchedulable(t *testing.T) {
+	ctx, cancel := context.WithTimeout(e2eTestContext, 20*time.Second)
+	defer cancel()
+
+	nodeName := "e2e-device-" + generateShortTestID()
+	createE2ETestNode(ctx, t, nodeName, nil, nil, nil, false)
+	defer func() {
+		_ = e2eTestClient.CoreV1().Nodes().Delete(ctx, nodeName, metav1.DeleteOptions{})
+	}()
+
+	tomlConfig := config.TomlConfig{
+		LabelPrefix: "k8s.nvidia.com/",
+		RuleSets: []config.RuleSet{
+			{
+				Enabled:  true,
+				Name:     "gpu-xid-errors",
+				Version:  "1",
+				Priority: 10,
+				Match: config.Match{
+					Any: []config.Rule{
+						{Kind: "HealthEvent", Expression: "event.checkName == 'GpuXidError'"},
+					},
+				},
+				Cordon:           config.Cordon{ShouldCordon: true},
+				DeviceQuarantine: config.DeviceQuarantine{Enabled: true},
+			},
+		},
+	}
+
+	_, mockWatcher, getStatus, _ := setupE2EReconciler(t, ctx, tomlConfig, nil)
+
+	sendGpuEvent := func(uuid string, isHealthy bool) string {
+		eventID := generateTestID()
+		mockWatcher.EventsChan <- &TestEvent{Data: createHealthEventBSON(
+			eventID,
+			nodeName,
+			"GpuXidError",
+			isHealthy,
+			true,
+			[]*protos.Entity{{EntityType: "GPU_UUID", EntityValue: uuid}},
+			model.StatusInProgress,
+		)}
+
+		return eventID
+	}
+
+	t.Log("Two GPUs fail")
+	eventID1 := sendGpuEvent("GPU-AAA", false)
+	require.Eventually(t, func() bool {
+		status := getStatus(eventID1)
+		return status != nil && *status == model.Quarantined
+	}, statusCheckTimeout, statusCheckPollInterval, "Status should be Quarantined")
+
+	eventID2 := sendGpuEvent("GPU-BBB", false)
+	require.Eventually(t, func() bool {
+		status := getStatus(eventID2)
+		return status != nil && *status == model.Quarantined
+	}, statusCheckTimeout, statusCheckPollInterval, "Status should be Quarantined")
+
+	node, err := e2eTestClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.False(t, node.Spec.Unschedulable, "Node should stay schedulable under device quarantine")
+	assert.Empty(t, node.Annotations[common.QuarantineHealthEventAnnotationKey])
+	assert.Equal(t, "GPU-AAA,GPU-BBB", node.Annotations[common.QuarantinedDevicesAnnotationKey])
+	assert.Equal(t, float64(2), getGaugeVecValue(t, metrics.CurrentQuarantinedDevices, nodeName))
+
+	t.Log("First GPU recovers - partial recovery")
+	eventID3 := sendGpuEvent("GPU-AAA", true)
+	require.Eventually(t, func() bool {
+		node, err := e2eTestClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+		return err == nil && node.Annotations[common.QuarantinedDevicesAnnotationKey] == "GPU-BBB"
+	}, eventuallyTimeout, eventuallyPollInterval, "Only the failed GPU should remain quarantined")
+
+	t.Log("Second GPU recovers")
+	eventID4 := sendGpuEvent("GPU-BBB", true)
+	require.Eventually(t, func() bool {
+		status := getStatus(eventID4)
+		return status != nil && *status == model.UnQuarantined
+	}, statusCheckTimeout, statusCheckPollInterval, "Status should be UnQuarantined")
+	assert.Nil(t, getStatus(eventID3), "Partial device recovery should not be propagated")
+
+	node, err = e2eTestClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, node.Annotations[common.QuarantinedDevicesAnnotationKey])
+	assert.Empty(t, node.Annotations[common.QuarantineDeviceHealthEventsAnnotationKey])
+}
+
 func TestE2E_EntityLevelTracking(t *testing.T) {
 	ctx, cancel := context.WithTimeout(e2eTestContext, 30*time.Second)
 	defer cancel()
@@ -4202,7 +4819,7 @@ func TestE2E_ConcurrentHealthyEvents_WithDelayedInformer(t *testing.T) {
 
 	require.Eventually(t, nodeInformer.HasSynced, 10*time.Second, 100*time.Millisecond, "NodeInformer should sync")
 
-	ruleSetEvals, err := evaluator.InitializeRuleSetEvaluators(tomlConfig.RuleSets, fqClient.NodeInformer)
+	ruleSetEvals, err := evaluator.InitializeRuleSetEvaluators(tomlConfig.RuleSets, fqClient.NodeInformer, nil)
 	require.NoError(t, err)
 
 	r := NewReconciler(ReconcilerConfig{TomlConfig: tomlConfig}, fqClient, nil)
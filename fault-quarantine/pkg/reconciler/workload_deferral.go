@@ -0,0 +1,329 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reconciler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/nvidia/nvsentinel/commons/pkg/tracing"
+	"github.com/nvidia/nvsentinel/data-models/pkg/model"
+	"github.com/nvidia/nvsentinel/data-models/pkg/protos"
+	"github.com/nvidia/nvsentinel/fault-quarantine/pkg/common"
+	"github.com/nvidia/nvsentinel/fault-quarantine/pkg/healthEventsAnnotation"
+	"github.com/nvidia/nvsentinel/fault-quarantine/pkg/metrics"
+)
+
+// workloadReevaluationDelay batches the pod changes on a node before its deferred events are
+// evaluated again, so a burst of pod updates only triggers one re-evaluation
+const workloadReevaluationDelay = 5 * time.Second
+
+// heldEventRetryDelay is how long held events whose reprocessing failed wait before they are
+// reprocessed again
+const heldEventRetryDelay = 30 * time.Second
+
+// workloadDeferral collects the rule sets whose Workload rules held an event back
+type workloadDeferral struct {
+	mu       sync.Mutex
+	ruleSets []string
+}
+
+func (d *workloadDeferral) add(ruleSet string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.ruleSets = append(d.ruleSets, ruleSet)
+}
+
+func (d *workloadDeferral) empty() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return len(d.ruleSets) == 0
+}
+
+// holdForWorkloads records the event in the node's workload deferred annotation. The event is
+// evaluated again once the pods on the node change.
+func (r *Reconciler) holdForWorkloads(
+	ctx context.Context,
+	event *model.HealthEventWithStatus,
+	deferral *workloadDeferral,
+) *model.Status {
+	ctx, span := tracing.StartSpan(ctx, "fault_quarantine.hold_for_workloads")
+	defer span.End()
+
+	nodeName := event.HealthEvent.NodeName
+
+	deferral.mu.Lock()
+	ruleSets := deferral.ruleSets
+	deferral.mu.Unlock()
+
+	updateFn := func(node *corev1.Node) error {
+		return addHeldEvent(node, common.QuarantineWorkloadDeferredHealthEventsAnnotationKey, event.HealthEvent)
+	}
+
+	if err := r.k8sClient.UpdateNode(ctx, nodeName, updateFn); err != nil {
+		slog.ErrorContext(ctx, "Failed to record workload deferred health event on node", "node", nodeName, "error", err)
+		metrics.ProcessingErrors.WithLabelValues("workload_deferred_health_event_annotation_error").Inc()
+		tracing.RecordError(span, err)
+		span.SetAttributes(
+			attribute.String("fault_quarantine.error.type", "workload_deferred_health_event_annotation_error"),
+			attribute.String("fault_quarantine.error.message", err.Error()),
+		)
+
+		return nil
+	}
+
+	for _, name := range ruleSets {
+		metrics.EventsDeferredByWorkloadRules.WithLabelValues(name).Inc()
+	}
+
+	slog.InfoContext(ctx, "Holding health event until the workloads on the node change",
+		"node", nodeName, "ruleSets", ruleSets)
+	span.SetAttributes(
+		attribute.String("fault_quarantine.event.processing_status", EventProcessingStatusSkipped),
+		attribute.String("fault_quarantine.skip.reason", "Deferred by Workload rules"),
+		attribute.StringSlice("fault_quarantine.deferred_rulesets", ruleSets),
+	)
+
+	return nil
+}
+
+// watchWorkloadDeferrals re-evaluates workload deferred events whenever a pod on their node is
+// added, updated or deleted. Nodes that were holding events when the process last stopped are
+// re-evaluated once at startup.
+func (r *Reconciler) watchWorkloadDeferrals(ctx context.Context) error {
+	if r.k8sClient.PodInformer == nil {
+		return nil
+	}
+
+	// Re-evaluation outlives the pod change that triggered it, so it only keeps the context's values
+	reevaluateCtx := context.WithoutCancel(ctx)
+
+	onPodChange := func(obj interface{}) {
+		if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+			obj = tombstone.Obj
+		}
+
+		pod, ok := obj.(*corev1.Pod)
+		if !ok || pod.Spec.NodeName == "" {
+			return
+		}
+
+		r.scheduleWorkloadReevaluation(reevaluateCtx, pod.Spec.NodeName)
+	}
+
+	_, err := r.k8sClient.PodInformer.GetInformer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: onPodChange,
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldPod, oldOK := oldObj.(*corev1.Pod)
+			newPod, newOK := newObj.(*corev1.Pod)
+
+			// Periodic resyncs deliver unchanged pods
+			if oldOK && newOK && oldPod.ResourceVersion == newPod.ResourceVersion {
+				return
+			}
+
+			onPodChange(newObj)
+		},
+		DeleteFunc: onPodChange,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to add workload deferral pod handler: %w", err)
+	}
+
+	nodes, err := r.k8sClient.NodeInformer.ListNodes()
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list nodes to resume workload deferred events", "error", err)
+		return nil
+	}
+
+	for _, node := range nodes {
+		r.scheduleWorkloadReevaluation(reevaluateCtx, node.Name)
+	}
+
+	return nil
+}
+
+// scheduleWorkloadReevaluation arranges for the node's workload deferred events to be evaluated
+// again after workloadReevaluationDelay. Nodes without deferred events are ignored.
+func (r *Reconciler) scheduleWorkloadReevaluation(ctx context.Context, nodeName string) {
+	node, err := r.k8sClient.NodeInformer.GetNode(nodeName)
+	if err != nil || node.Annotations[common.QuarantineWorkloadDeferredHealthEventsAnnotationKey] == "" {
+		return
+	}
+
+	r.armWorkloadReevaluation(ctx, nodeName, workloadReevaluationDelay)
+}
+
+// armWorkloadReevaluation releases the node's workload deferred events after delay, unless a
+// release is already armed for the node
+func (r *Reconciler) armWorkloadReevaluation(ctx context.Context, nodeName string, delay time.Duration) {
+	r.workloadMu.Lock()
+	defer r.workloadMu.Unlock()
+
+	if r.workloadReevaluations == nil {
+		r.workloadReevaluations = make(map[string]*time.Timer)
+	}
+
+	if _, ok := r.workloadReevaluations[nodeName]; ok {
+		return
+	}
+
+	r.workloadReevaluations[nodeName] = time.AfterFunc(delay, func() {
+		r.releaseWorkloadDeferredEvents(ctx, nodeName)
+	})
+}
+
+// releaseWorkloadDeferredEvents clears the node's workload deferred annotation and reprocesses every
+// held event. Events that Workload rules still hold back are recorded again, and events whose
+// reprocessing failed are written back and retried after heldEventRetryDelay.
+func (r *Reconciler) releaseWorkloadDeferredEvents(ctx context.Context, nodeName string) {
+	ctx, span := tracing.StartSpan(ctx, "fault_quarantine.release_workload_deferred_events")
+	defer span.End()
+
+	r.workloadMu.Lock()
+	delete(r.workloadReevaluations, nodeName)
+	r.workloadMu.Unlock()
+
+	var held []*protos.HealthEvent
+
+	updateFn := func(node *corev1.Node) error {
+		held = nil
+
+		existing := node.Annotations[common.QuarantineWorkloadDeferredHealthEventsAnnotationKey]
+		if existing == "" {
+			return nil
+		}
+
+		if err := json.Unmarshal([]byte(existing), &held); err != nil {
+			return fmt.Errorf("failed to parse workload deferred health events annotation: %w", err)
+		}
+
+		delete(node.Annotations, common.QuarantineWorkloadDeferredHealthEventsAnnotationKey)
+
+		return nil
+	}
+
+	if err := r.k8sClient.UpdateNode(ctx, nodeName, updateFn); err != nil {
+		slog.ErrorContext(ctx, "Failed to clear workload deferred health events from node",
+			"node", nodeName, "error", err)
+		metrics.ProcessingErrors.WithLabelValues("release_workload_deferred_health_events_error").Inc()
+		tracing.RecordError(span, err)
+		span.SetAttributes(
+			attribute.String("fault_quarantine.error.type", "release_workload_deferred_health_events_error"),
+			attribute.String("fault_quarantine.error.message", err.Error()),
+		)
+
+		return
+	}
+
+	if len(held) == 0 {
+		return
+	}
+
+	slog.InfoContext(ctx, "Workloads on node changed, reprocessing deferred health events",
+		"node", nodeName, "count", len(held))
+
+	failed := r.reprocessHeldEvents(ctx, nodeName, held, "reprocess_workload_deferred_health_event_error")
+	if len(failed) == 0 {
+		return
+	}
+
+	restoreFn := func(node *corev1.Node) error {
+		for _, healthEvent := range failed {
+			if err := addHeldEvent(node, common.QuarantineWorkloadDeferredHealthEventsAnnotationKey,
+				healthEvent); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	if err := r.k8sClient.UpdateNode(ctx, nodeName, restoreFn); err != nil {
+		slog.ErrorContext(ctx, "Failed to restore workload deferred health events on node, events are lost",
+			"node", nodeName, "count", len(failed), "error", err)
+		metrics.ProcessingErrors.WithLabelValues("restore_workload_deferred_health_events_error").Inc()
+		tracing.RecordError(span, err)
+
+		return
+	}
+
+	r.armWorkloadReevaluation(ctx, nodeName, heldEventRetryDelay)
+}
+
+// reprocessHeldEvents reprocesses the events held on the node and returns the events whose
+// reprocessing failed, counting them under errorType
+func (r *Reconciler) reprocessHeldEvents(
+	ctx context.Context,
+	nodeName string,
+	held []*protos.HealthEvent,
+	errorType string,
+) []*protos.HealthEvent {
+	var failed []*protos.HealthEvent
+
+	for _, healthEvent := range held {
+		event := &model.HealthEventWithStatus{
+			CreatedAt:         time.Now().UTC(),
+			HealthEvent:       healthEvent,
+			HealthEventStatus: &protos.HealthEventStatus{},
+		}
+
+		if err := r.eventWatcher.ReprocessEvent(ctx, event); err != nil {
+			slog.ErrorContext(ctx, "Failed to reprocess held health event, it will be retried",
+				"node", nodeName, "checkName", healthEvent.CheckName, "error", err)
+			metrics.ProcessingErrors.WithLabelValues(errorType).Inc()
+
+			failed = append(failed, healthEvent)
+		}
+	}
+
+	return failed
+}
+
+// addHeldEvent adds the event to the held health events stored under the node's annotationKey
+func addHeldEvent(node *corev1.Node, annotationKey string, event *protos.HealthEvent) error {
+	if node.Annotations == nil {
+		node.Annotations = make(map[string]string)
+	}
+
+	held := healthEventsAnnotation.NewHealthEventsAnnotationMap()
+
+	if existing := node.Annotations[annotationKey]; existing != "" {
+		if err := json.Unmarshal([]byte(existing), held); err != nil {
+			return fmt.Errorf("failed to parse held health events annotation %s: %w", annotationKey, err)
+		}
+	}
+
+	held.AddOrUpdateEvent(event)
+
+	heldBytes, err := json.Marshal(held)
+	if err != nil {
+		return fmt.Errorf("failed to marshal held health events: %w", err)
+	}
+
+	node.Annotations[annotationKey] = string(heldBytes)
+
+	return nil
+}
@@ -42,6 +42,13 @@ var conflictBackoff = wait.Backoff{
 	Jitter:   0.1,
 }
 
+// attemptsRetention is how long remediation attempts are kept for the daily attempt limit
+const attemptsRetention = 24 * time.Hour
+
+// verificationRetention is how long a verification is kept, so that the verification of an event that is
+// never processed again does not stay on the node forever
+const verificationRetention = 7 * 24 * time.Hour
+
 // NodeAnnotationManager manages node annotations for tracking remediation state.
 type NodeAnnotationManager struct {
 	client client.Client
@@ -142,25 +149,24 @@ func (m *NodeAnnotationManager) UpdateRemediationState(ctx context.Context, node
 	return nil
 }
 
-// ClearRemediationState removes the remediation state annotation from a node
+// ClearRemediationState removes the remediation state of all equivalence groups from a node. Escalations,
+// remediation attempts and verifications that have not expired are kept; the annotation is removed once none
+// remain.
 func (m *NodeAnnotationManager) ClearRemediationState(ctx context.Context, nodeName string) error {
 	err := retry.RetryOnConflict(conflictBackoff, func() error {
-		node := &corev1.Node{}
-
-		if err := m.client.Get(ctx, types.NamespacedName{
-			Name: nodeName,
-		}, node); err != nil {
+		state, node, err := m.GetRemediationState(ctx, nodeName)
+		if err != nil {
 			return err
 		}
 
-		if node.Annotations == nil {
+		if _, exists := node.Annotations[AnnotationKey]; !exists {
 			return nil
 		}
 
-		updatedNode := node.DeepCopy()
-		delete(updatedNode.Annotations, AnnotationKey)
+		state.EquivalenceGroups = make(map[string]EquivalenceGroupState)
+		pruneExpired(state, time.Now().UTC())
 
-		if err := m.client.Update(ctx, updatedNode); err != nil {
+		if err := m.writeState(ctx, node, state); err != nil {
 			return err
 		}
 
@@ -189,45 +195,239 @@ func (m *NodeAnnotationManager) RemoveGroupsFromState(ctx context.Context, nodeN
 			delete(state.EquivalenceGroups, group)
 		}
 
-		// If no groups remain, clear the entire annotation
-		if len(state.EquivalenceGroups) == 0 {
-			updatedNode := node.DeepCopy()
-			if updatedNode.Annotations != nil {
-				delete(updatedNode.Annotations, AnnotationKey)
-			}
-
-			if err = m.client.Update(ctx, updatedNode); err != nil {
-				return err
-			}
+		// If no state remains, clear the entire annotation
+		if err = m.writeState(ctx, node, state); err != nil {
+			return err
+		}
 
+		if isEmpty(state) {
 			slog.InfoContext(ctx, "Cleared remediation state annotation for node", "node", nodeName)
 
 			return nil
 		}
 
-		stateJSON, err := json.Marshal(state)
-		if err != nil {
+		slog.InfoContext(ctx, "Removed groups from remediation state for node", "node", nodeName, "groups", groups)
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to remove groups from remediation state for node %s: %w", nodeName, err)
+	}
+
+	return nil
+}
+
+// SetNextMaintenanceWindow records when the maintenance window gating a held remediation next opens.
+// A zero nextWindow removes the annotation.
+func (m *NodeAnnotationManager) SetNextMaintenanceWindow(ctx context.Context, nodeName string,
+	nextWindow time.Time) error {
+	value := ""
+	if !nextWindow.IsZero() {
+		value = nextWindow.UTC().Format(time.RFC3339)
+	}
+
+	err := retry.RetryOnConflict(conflictBackoff, func() error {
+		node := &corev1.Node{}
+
+		if err := m.client.Get(ctx, types.NamespacedName{
+			Name: nodeName,
+		}, node); err != nil {
 			return err
 		}
 
+		if node.Annotations[NextMaintenanceWindowAnnotationKey] == value {
+			return nil
+		}
+
 		updatedNode := node.DeepCopy()
-		if updatedNode.Annotations == nil {
-			updatedNode.Annotations = map[string]string{}
+
+		if value == "" {
+			delete(updatedNode.Annotations, NextMaintenanceWindowAnnotationKey)
+		} else {
+			if updatedNode.Annotations == nil {
+				updatedNode.Annotations = map[string]string{}
+			}
+
+			updatedNode.Annotations[NextMaintenanceWindowAnnotationKey] = value
 		}
 
-		updatedNode.Annotations[AnnotationKey] = string(stateJSON)
+		return m.client.Update(ctx, updatedNode)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set next maintenance window for node %s: %w", nodeName, err)
+	}
 
-		if err = m.client.Update(ctx, updatedNode); err != nil {
+	return nil
+}
+
+// RecordEscalation records the step taken on the escalation ladder of the fault of an equivalence group and
+// counts it as a remediation attempt of the node. Expired escalations and attempts are pruned.
+func (m *NodeAnnotationManager) RecordEscalation(ctx context.Context, nodeName string, group string,
+	escalation EscalationState) error {
+	err := retry.RetryOnConflict(conflictBackoff, func() error {
+		state, node, err := m.GetRemediationState(ctx, nodeName)
+		if err != nil {
 			return err
 		}
 
-		slog.InfoContext(ctx, "Removed groups from remediation state for node", "node", nodeName, "groups", groups)
+		pruneExpired(state, time.Now().UTC())
 
-		return nil
+		if state.Escalations == nil {
+			state.Escalations = make(map[string]EscalationState)
+		}
+
+		state.Escalations[group] = escalation
+		state.Attempts = append(state.Attempts, escalation.CreatedAt)
+
+		return m.writeState(ctx, node, state)
 	})
 	if err != nil {
-		return fmt.Errorf("failed to remove groups from remediation state for node %s: %w", nodeName, err)
+		return fmt.Errorf("failed to record escalation for node %s: %w", nodeName, err)
+	}
+
+	slog.InfoContext(ctx, "Recorded remediation escalation for node",
+		"node", nodeName,
+		"group", group,
+		"step", escalation.Step,
+		"action", escalation.ActionName,
+		"crName", escalation.MaintenanceCR)
+
+	return nil
+}
+
+// SetVerification records the verification of the remediation of a health event
+func (m *NodeAnnotationManager) SetVerification(ctx context.Context, nodeName string, eventID string,
+	verification VerificationState) error {
+	err := retry.RetryOnConflict(conflictBackoff, func() error {
+		state, node, err := m.GetRemediationState(ctx, nodeName)
+		if err != nil {
+			return err
+		}
+
+		pruneExpired(state, time.Now().UTC())
+
+		if state.Verifications == nil {
+			state.Verifications = make(map[string]VerificationState)
+		}
+
+		state.Verifications[eventID] = verification
+
+		return m.writeState(ctx, node, state)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set verification for node %s: %w", nodeName, err)
 	}
 
 	return nil
 }
+
+// CompleteVerification removes the verification of the remediation of a health event. When the
+// verification failed, the escalation step of its maintenance CR is marked so that the fault escalates.
+func (m *NodeAnnotationManager) CompleteVerification(ctx context.Context, nodeName string, eventID string,
+	failed bool) error {
+	err := retry.RetryOnConflict(conflictBackoff, func() error {
+		state, node, err := m.GetRemediationState(ctx, nodeName)
+		if err != nil {
+			return err
+		}
+
+		verification, exists := state.Verifications[eventID]
+		if !exists {
+			return nil
+		}
+
+		delete(state.Verifications, eventID)
+
+		if escalation, ok := state.Escalations[verification.Group]; ok && failed &&
+			escalation.MaintenanceCR == verification.MaintenanceCR {
+			escalation.VerificationFailed = true
+			state.Escalations[verification.Group] = escalation
+		}
+
+		return m.writeState(ctx, node, state)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to complete verification for node %s: %w", nodeName, err)
+	}
+
+	slog.InfoContext(ctx, "Completed remediation verification for node",
+		"node", nodeName,
+		"eventID", eventID,
+		"failed", failed)
+
+	return nil
+}
+
+// writeState writes the remediation state to the node annotation, or removes the annotation when the state
+// is empty
+func (m *NodeAnnotationManager) writeState(ctx context.Context, node *corev1.Node,
+	state *RemediationStateAnnotation) error {
+	updatedNode := node.DeepCopy()
+
+	if isEmpty(state) {
+		if updatedNode.Annotations == nil {
+			return nil
+		}
+
+		delete(updatedNode.Annotations, AnnotationKey)
+
+		return m.client.Update(ctx, updatedNode)
+	}
+
+	stateJSON, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	if updatedNode.Annotations == nil {
+		updatedNode.Annotations = map[string]string{}
+	}
+
+	updatedNode.Annotations[AnnotationKey] = string(stateJSON)
+
+	return m.client.Update(ctx, updatedNode)
+}
+
+func isEmpty(state *RemediationStateAnnotation) bool {
+	return len(state.EquivalenceGroups) == 0 && len(state.Escalations) == 0 && len(state.Attempts) == 0 &&
+		len(state.Verifications) == 0
+}
+
+// pruneExpired removes the escalations past their expiry, the attempts older than attemptsRetention and the
+// verifications older than verificationRetention
+func pruneExpired(state *RemediationStateAnnotation, now time.Time) {
+	for eventID, verification := range state.Verifications {
+		if now.Sub(verification.StartedAt) >= verificationRetention {
+			delete(state.Verifications, eventID)
+		}
+	}
+
+	for group, escalation := range state.Escalations {
+		if !now.Before(escalation.ExpiresAt) {
+			delete(state.Escalations, group)
+		}
+	}
+
+	attempts := state.Attempts[:0]
+
+	for _, attempt := range state.Attempts {
+		if now.Sub(attempt) < attemptsRetention {
+			attempts = append(attempts, attempt)
+		}
+	}
+
+	state.Attempts = attempts
+}
+
+// AttemptsSince returns how many remediation attempts of the node were made at or after since
+func (s *RemediationStateAnnotation) AttemptsSince(since time.Time) int {
+	count := 0
+
+	for _, attempt := range s.Attempts {
+		if !attempt.Before(since) {
+			count++
+		}
+	}
+
+	return count
+}
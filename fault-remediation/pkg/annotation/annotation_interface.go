@@ -24,6 +24,10 @@ import (
 const (
 	// AnnotationKey is the key for the node annotation that tracks remediation state
 	AnnotationKey = "latestFaultRemediationState"
+
+	// NextMaintenanceWindowAnnotationKey is the key for the node annotation that records when the
+	// maintenance window gating a held remediation next opens
+	NextMaintenanceWindowAnnotationKey = "nextRemediationMaintenanceWindow"
 )
 
 // NodeAnnotationManagerInterface defines the interface for managing node annotations
@@ -32,11 +36,28 @@ type NodeAnnotationManagerInterface interface {
 	UpdateRemediationState(ctx context.Context, nodeName string, group string, crName string, actionName string) error
 	ClearRemediationState(ctx context.Context, nodeName string) error
 	RemoveGroupsFromState(ctx context.Context, nodeName string, groups []string) error
+	SetNextMaintenanceWindow(ctx context.Context, nodeName string, nextWindow time.Time) error
+	RecordEscalation(ctx context.Context, nodeName string, group string, escalation EscalationState) error
+	SetVerification(ctx context.Context, nodeName string, eventID string, verification VerificationState) error
+	CompleteVerification(ctx context.Context, nodeName string, eventID string, failed bool) error
 }
 
 // RemediationStateAnnotation represents the structure of the node annotation
 type RemediationStateAnnotation struct {
 	EquivalenceGroups map[string]EquivalenceGroupState `json:"equivalenceGroups"`
+
+	// Escalations tracks the escalation ladder of each fault, keyed by the equivalence group of the
+	// action the fault recommends. Escalations outlive the remediation state of the node until they
+	// expire, so that a fault recurring after the node was released escalates.
+	Escalations map[string]EscalationState `json:"escalations,omitempty"`
+
+	// Attempts holds when maintenance CRs were created along escalation ladders within the last
+	// attemptsRetention, for the daily attempt limit of the node
+	Attempts []time.Time `json:"attempts,omitempty"`
+
+	// Verifications tracks the remediations being verified, keyed by the ID of the health event they
+	// remediate. Like escalations, they outlive the remediation state of the node.
+	Verifications map[string]VerificationState `json:"verifications,omitempty"`
 }
 
 // EquivalenceGroupState represents the state of a single equivalence group
@@ -48,3 +69,43 @@ type EquivalenceGroupState struct {
 	// Required to look up the corresponding MaintenanceResource from the TomlConfig
 	ActionName string `json:"actionName"`
 }
+
+// EscalationState represents the last step taken on the escalation ladder of a fault
+type EscalationState struct {
+	// Step is the index of ActionName in the ladder, 0 being the action recommended by the fault
+	Step          int       `json:"step"`
+	ActionName    string    `json:"actionName"`
+	MaintenanceCR string    `json:"maintenanceCR"`
+	CreatedAt     time.Time `json:"createdAt"`
+
+	// ExpiresAt is when a recurrence of the fault no longer escalates and the ladder starts over
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// EventID is the health event remediated by MaintenanceCR. The event stays pending until the CR
+	// finishes, so that a failed CR escalates it to the next step without waiting for another event.
+	EventID string `json:"eventID,omitempty"`
+
+	// VerificationFailed is set when the verification of the remediation by MaintenanceCR failed, so that
+	// the next event of the fault escalates even though the CR succeeded
+	VerificationFailed bool `json:"verificationFailed,omitempty"`
+}
+
+// VerificationState represents the verification of the remediation of a health event
+type VerificationState struct {
+	// Group is the equivalence group the escalation ladder of the fault is tracked under
+	Group         string `json:"group"`
+	ActionName    string `json:"actionName"`
+	MaintenanceCR string `json:"maintenanceCR"`
+
+	// CheckName and ErrorCodes identify the fault whose recurrence fails the verification
+	CheckName  string   `json:"checkName"`
+	ErrorCodes []string `json:"errorCodes,omitempty"`
+
+	// GPUCount is the number of GPUs the node reported before the remediation, 0 when unknown
+	GPUCount int `json:"gpuCount,omitempty"`
+
+	// StartedAt is when the maintenance CR was created
+	StartedAt time.Time `json:"startedAt"`
+	// SucceededAt is when the maintenance CR was first seen succeeded, zero until then
+	SucceededAt time.Time `json:"succeededAt,omitempty"`
+}
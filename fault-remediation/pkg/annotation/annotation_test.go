@@ -327,3 +327,171 @@ func TestConcurrentUpdateAndRemoveGroupsFromState(t *testing.T) {
 		_ = annotationManager.RemoveGroupsFromState(context.TODO(), nodeName, []string{"existing-group-1", "existing-group-2", "new-group"})
 	}
 }
+
+func TestRecordEscalation(t *testing.T) {
+	nodeName := "node"
+	now := time.Now().UTC()
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: nodeName,
+			Annotations: map[string]string{
+				AnnotationKey: fmt.Sprintf(`{
+				  "equivalenceGroups": {},
+				  "escalations": {
+					"stale": {"step": 1, "actionName": "RESTART_BM", "expiresAt": %q}
+				  },
+				  "attempts": [%q, %q]
+				}`, now.Add(-time.Minute).Format(time.RFC3339), now.Add(-25*time.Hour).Format(time.RFC3339),
+					now.Add(-time.Hour).Format(time.RFC3339)),
+			},
+		},
+	}
+	client := fake.NewClientBuilder().WithObjects(node).Build()
+	annotationManager := NodeAnnotationManager{
+		client: client,
+	}
+
+	err := annotationManager.RecordEscalation(context.TODO(), nodeName, "reset", EscalationState{
+		Step:          1,
+		ActionName:    "RESTART_BM",
+		MaintenanceCR: "maintenance-node-1",
+		CreatedAt:     now,
+		ExpiresAt:     now.Add(24 * time.Hour),
+	})
+	require.NoError(t, err)
+
+	state, _, err := annotationManager.GetRemediationState(context.TODO(), nodeName)
+	require.NoError(t, err)
+
+	assert.NotContains(t, state.Escalations, "stale")
+	require.Contains(t, state.Escalations, "reset")
+	assert.Equal(t, 1, state.Escalations["reset"].Step)
+	assert.Equal(t, "maintenance-node-1", state.Escalations["reset"].MaintenanceCR)
+	assert.Len(t, state.Attempts, 2, "the attempt older than a day should be pruned")
+	assert.Equal(t, 2, state.AttemptsSince(now.Add(-24*time.Hour)))
+	assert.Equal(t, 1, state.AttemptsSince(now))
+}
+
+func TestClearRemediationStateKeepsEscalations(t *testing.T) {
+	nodeName := "node"
+	now := time.Now().UTC()
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        nodeName,
+			Annotations: map[string]string{},
+		},
+	}
+	client := fake.NewClientBuilder().WithObjects(node).Build()
+	annotationManager := NodeAnnotationManager{
+		client: client,
+	}
+
+	err := annotationManager.UpdateRemediationState(context.TODO(), nodeName, "reset", "maintenance-node-1", "COMPONENT_RESET")
+	require.NoError(t, err)
+
+	err = annotationManager.RecordEscalation(context.TODO(), nodeName, "reset", EscalationState{
+		ActionName:    "COMPONENT_RESET",
+		MaintenanceCR: "maintenance-node-1",
+		CreatedAt:     now,
+		ExpiresAt:     now.Add(time.Hour),
+	})
+	require.NoError(t, err)
+
+	err = annotationManager.ClearRemediationState(context.TODO(), nodeName)
+	require.NoError(t, err)
+
+	state, _, err := annotationManager.GetRemediationState(context.TODO(), nodeName)
+	require.NoError(t, err)
+
+	assert.Empty(t, state.EquivalenceGroups)
+	assert.Contains(t, state.Escalations, "reset")
+	assert.Len(t, state.Attempts, 1)
+}
+
+func TestClearRemediationStateRemovesExpiredEscalations(t *testing.T) {
+	nodeName := "node"
+	now := time.Now().UTC()
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: nodeName,
+			Annotations: map[string]string{
+				AnnotationKey: fmt.Sprintf(`{
+				  "equivalenceGroups": {"reset": {"maintenanceCR": "maintenance-node-1"}},
+				  "escalations": {"reset": {"expiresAt": %q}},
+				  "attempts": [%q]
+				}`, now.Add(-time.Minute).Format(time.RFC3339), now.Add(-48*time.Hour).Format(time.RFC3339)),
+			},
+		},
+	}
+	client := fake.NewClientBuilder().WithObjects(node).Build()
+	annotationManager := NodeAnnotationManager{
+		client: client,
+	}
+
+	err := annotationManager.ClearRemediationState(context.TODO(), nodeName)
+	require.NoError(t, err)
+
+	err = client.Get(context.TODO(), types.NamespacedName{Name: nodeName}, node)
+	require.NoError(t, err)
+
+	assert.NotContains(t, node.Annotations, AnnotationKey)
+}
+
+func TestVerification(t *testing.T) {
+	nodeName := "node"
+	now := time.Now().UTC()
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: nodeName,
+			Annotations: map[string]string{
+				AnnotationKey: fmt.Sprintf(`{
+				  "equivalenceGroups": {},
+				  "verifications": {"stale": {"maintenanceCR": "maintenance-node-0", "startedAt": %q}}
+				}`, now.Add(-8*24*time.Hour).Format(time.RFC3339)),
+			},
+		},
+	}
+	client := fake.NewClientBuilder().WithObjects(node).Build()
+	annotationManager := NodeAnnotationManager{
+		client: client,
+	}
+
+	err := annotationManager.RecordEscalation(context.TODO(), nodeName, "reset", EscalationState{
+		ActionName:    "COMPONENT_RESET",
+		MaintenanceCR: "maintenance-node-1",
+		CreatedAt:     now,
+		ExpiresAt:     now.Add(time.Hour),
+	})
+	require.NoError(t, err)
+
+	for eventID, crName := range map[string]string{"event-1": "maintenance-node-1", "event-2": "maintenance-node-2"} {
+		err = annotationManager.SetVerification(context.TODO(), nodeName, eventID, VerificationState{
+			Group:         "reset",
+			ActionName:    "COMPONENT_RESET",
+			MaintenanceCR: crName,
+			CheckName:     "GpuXidError",
+			StartedAt:     now,
+		})
+		require.NoError(t, err)
+	}
+
+	state, _, err := annotationManager.GetRemediationState(context.TODO(), nodeName)
+	require.NoError(t, err)
+	assert.NotContains(t, state.Verifications, "stale", "the verification older than the retention should be pruned")
+	assert.Len(t, state.Verifications, 2)
+
+	// A failed verification of another CR than the one of the escalation step does not escalate
+	require.NoError(t, annotationManager.CompleteVerification(context.TODO(), nodeName, "event-2", true))
+
+	state, _, err = annotationManager.GetRemediationState(context.TODO(), nodeName)
+	require.NoError(t, err)
+	assert.NotContains(t, state.Verifications, "event-2")
+	assert.False(t, state.Escalations["reset"].VerificationFailed)
+
+	require.NoError(t, annotationManager.CompleteVerification(context.TODO(), nodeName, "event-1", true))
+
+	state, _, err = annotationManager.GetRemediationState(context.TODO(), nodeName)
+	require.NoError(t, err)
+	assert.Empty(t, state.Verifications)
+	assert.True(t, state.Escalations["reset"].VerificationFailed)
+}
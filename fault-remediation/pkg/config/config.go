@@ -21,8 +21,11 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"sort"
+	"time"
 
+	"github.com/nvidia/nvsentinel/commons/pkg/schedule"
 	"github.com/nvidia/nvsentinel/data-models/pkg/model"
 	"github.com/nvidia/nvsentinel/data-models/pkg/protos"
 )
@@ -58,6 +61,10 @@ type MaintenanceResource struct {
 	// EquivalenceGroups like reset-GPU-123 as being a member in restart because a
 	// node reboot has the same effect as a GPU reset.
 	SupersedingEquivalenceGroups []string `toml:"supersedingEquivalenceGroups"`
+	// Schedule optionally names a maintenance schedule from TomlConfig.Schedules. Non-fatal events
+	// recommending this action are held until the schedule's next window opens; fatal events
+	// are remediated immediately.
+	Schedule string `toml:"schedule"`
 }
 
 // Template holds configuration for template files mount
@@ -72,6 +79,220 @@ type UpdateRetry struct {
 	RetryDelaySeconds int `toml:"retryDelaySeconds"`
 }
 
+// Defaults of the remediation escalation
+const (
+	defaultRecurrenceWindowMinutes       = 24 * 60
+	defaultEscalationPollIntervalSeconds = 60
+)
+
+// EscalationConfig holds configuration for escalating remediation actions that did not fix a fault
+type EscalationConfig struct {
+	Enabled bool `toml:"enabled"`
+
+	// Chains maps a recommended action to the actions tried next, in order, when the maintenance CR
+	// of the previous action fails or the fault recurs within the recurrence window.
+	// Example: COMPONENT_RESET = ["RESTART_BM", "REPLACE_VM", "CONTACT_SUPPORT"]
+	// Every step but the last must be a remediation action. The last step may be a built-in action
+	// without a maintenance resource, such as CONTACT_SUPPORT, which ends the chain for an operator.
+	Chains map[string][]string `toml:"chains"`
+
+	// RecurrenceWindowMinutes is how long after a maintenance CR is created a recurrence of the fault
+	// escalates to the next action. Defaults to 24 hours.
+	RecurrenceWindowMinutes int `toml:"recurrenceWindowMinutes"`
+
+	// MaxAttemptsPerDay limits the maintenance CRs created along escalation chains for a node within
+	// 24 hours. 0 means no limit.
+	MaxAttemptsPerDay int `toml:"maxAttemptsPerDay"`
+
+	// PollIntervalSeconds is how often the maintenance CR of a step is checked, so that the next step is
+	// created as soon as it fails. Defaults to 60 seconds.
+	PollIntervalSeconds int `toml:"pollIntervalSeconds"`
+}
+
+// RecurrenceWindow returns the configured recurrence window or its default
+func (e EscalationConfig) RecurrenceWindow() time.Duration {
+	if e.RecurrenceWindowMinutes == 0 {
+		return defaultRecurrenceWindowMinutes * time.Minute
+	}
+
+	return time.Duration(e.RecurrenceWindowMinutes) * time.Minute
+}
+
+// PollInterval returns the configured poll interval or its default
+func (e EscalationConfig) PollInterval() time.Duration {
+	return durationOrDefault(e.PollIntervalSeconds, defaultEscalationPollIntervalSeconds, time.Second)
+}
+
+// Ladder returns the actions tried for a fault recommending actionName, starting with actionName
+// itself, or nil when escalation is disabled or no chain is configured for the action
+func (e EscalationConfig) Ladder(actionName string) []string {
+	chain, ok := e.Chains[actionName]
+	if !e.Enabled || !ok || len(chain) == 0 {
+		return nil
+	}
+
+	return append([]string{actionName}, chain...)
+}
+
+// defaultRateLimitRequeueIntervalSeconds is how often remediations held by rate limits or the emergency
+// stop are retried when no interval is configured
+const defaultRateLimitRequeueIntervalSeconds = 60
+
+// RemediationLimit limits the remediations of an action or of the nodes of a group.
+// A zero value means unlimited.
+type RemediationLimit struct {
+	// MaxConcurrent limits the maintenance CRs in progress at the same time
+	MaxConcurrent int `toml:"maxConcurrent"`
+	// MaxPerHour limits the maintenance CRs created within any hour
+	MaxPerHour int `toml:"maxPerHour"`
+}
+
+// ActionRateLimit limits the remediations of a remediation action, e.g. concurrent reboots
+type ActionRateLimit struct {
+	Action string `toml:"action"`
+	RemediationLimit
+}
+
+// NodeGroupRateLimit limits the remediations among nodes sharing a value of LabelKey,
+// e.g. nodes of the same rack or node pool
+type NodeGroupRateLimit struct {
+	Name     string `toml:"name"`
+	LabelKey string `toml:"labelKey"`
+	RemediationLimit
+}
+
+// RateLimitConfig limits how many maintenance CRs are created. Remediations over the limits are queued
+// and performed in arrival order as the limits allow.
+type RateLimitConfig struct {
+	// Limits cluster-wide
+	RemediationLimit
+
+	Actions    []ActionRateLimit    `toml:"actions"`
+	NodeGroups []NodeGroupRateLimit `toml:"nodeGroups"`
+
+	// RequeueIntervalSeconds is how often queued remediations are retried. Defaults to 60 seconds.
+	RequeueIntervalSeconds int `toml:"requeueIntervalSeconds"`
+}
+
+// Enabled reports whether any limit is configured
+func (r RateLimitConfig) Enabled() bool {
+	return r.MaxConcurrent > 0 || r.MaxPerHour > 0 || len(r.Actions) > 0 || len(r.NodeGroups) > 0
+}
+
+// RequeueInterval returns the configured requeue interval or its default
+func (r RateLimitConfig) RequeueInterval() time.Duration {
+	if r.RequeueIntervalSeconds == 0 {
+		return defaultRateLimitRequeueIntervalSeconds * time.Second
+	}
+
+	return time.Duration(r.RequeueIntervalSeconds) * time.Second
+}
+
+// EmergencyStopConfig configures the ConfigMap that pauses the creation of maintenance CRs cluster-wide
+type EmergencyStopConfig struct {
+	Enabled bool `toml:"enabled"`
+	// ConfigMapName is the ConfigMap, in the namespace of fault-remediation, whose "emergencyStop" key
+	// pauses remediation when set to "true"
+	ConfigMapName string `toml:"configMapName"`
+}
+
+// Defaults of the remediation verification
+const (
+	defaultVerificationPollIntervalSeconds = 60
+	defaultVerificationQuietPeriodMinutes  = 10
+	defaultVerificationMetadataTimeout     = 30
+	defaultValidationJobTimeoutMinutes     = 30
+	defaultValidationJobTemplateFileName   = "validation-job.yaml"
+)
+
+// defaultFreshMetadataActions are the actions whose verification waits for fresh GPU metadata when none are
+// configured: reboots restart metadata-collector on the node
+var defaultFreshMetadataActions = []string{
+	protos.RecommendedAction_RESTART_BM.String(),
+	protos.RecommendedAction_RESTART_VM.String(),
+}
+
+// VerificationConfig configures the verification of remediations. A verified remediation is only marked
+// remediated once its maintenance CR succeeded, the node published fresh GPU metadata and the fault did
+// not recur for a quiet period.
+type VerificationConfig struct {
+	Enabled bool `toml:"enabled"`
+
+	// FreshMetadataActions are the actions whose verification waits for metadata-collector to publish GPU
+	// metadata collected after the CR was created, and fails when the node reports fewer GPUs than before
+	// the remediation. Only actions that restart metadata-collector on the node, such as reboots, fit.
+	// Defaults to RESTART_BM and RESTART_VM when unset; an empty list disables the check.
+	FreshMetadataActions []string `toml:"freshMetadataActions"`
+	// MetadataTimeoutMinutes is how long to wait for fresh GPU metadata. Defaults to 30 minutes.
+	MetadataTimeoutMinutes int `toml:"metadataTimeoutMinutes"`
+
+	// QuietPeriodMinutes is how long the fault must not recur after the CR succeeded. Defaults to 10 minutes.
+	QuietPeriodMinutes int `toml:"quietPeriodMinutes"`
+
+	// PollIntervalSeconds is how often a verification in progress is checked. Defaults to 60 seconds.
+	PollIntervalSeconds int `toml:"pollIntervalSeconds"`
+
+	// ValidationJob optionally runs a Job on the node once the quiet period passed
+	ValidationJob ValidationJobConfig `toml:"validationJob"`
+}
+
+// ValidationJobConfig configures the Job run on a remediated node to validate it
+type ValidationJobConfig struct {
+	Enabled bool `toml:"enabled"`
+	// TemplateFileName is the Job manifest, relative to the template mount path.
+	// Defaults to validation-job.yaml.
+	TemplateFileName string `toml:"templateFileName"`
+	// TimeoutMinutes is how long the Job may run before the verification fails. Defaults to 30 minutes.
+	TimeoutMinutes int `toml:"timeoutMinutes"`
+}
+
+// RequiresFreshMetadata reports whether the verification of a remediation by the action waits for fresh GPU
+// metadata
+func (v VerificationConfig) RequiresFreshMetadata(actionName string) bool {
+	if v.FreshMetadataActions == nil {
+		return slices.Contains(defaultFreshMetadataActions, actionName)
+	}
+
+	return slices.Contains(v.FreshMetadataActions, actionName)
+}
+
+// PollInterval returns the configured poll interval or its default
+func (v VerificationConfig) PollInterval() time.Duration {
+	return durationOrDefault(v.PollIntervalSeconds, defaultVerificationPollIntervalSeconds, time.Second)
+}
+
+// QuietPeriod returns the configured quiet period or its default
+func (v VerificationConfig) QuietPeriod() time.Duration {
+	return durationOrDefault(v.QuietPeriodMinutes, defaultVerificationQuietPeriodMinutes, time.Minute)
+}
+
+// MetadataTimeout returns the configured metadata timeout or its default
+func (v VerificationConfig) MetadataTimeout() time.Duration {
+	return durationOrDefault(v.MetadataTimeoutMinutes, defaultVerificationMetadataTimeout, time.Minute)
+}
+
+// Timeout returns the configured validation Job timeout or its default
+func (j ValidationJobConfig) Timeout() time.Duration {
+	return durationOrDefault(j.TimeoutMinutes, defaultValidationJobTimeoutMinutes, time.Minute)
+}
+
+// Template returns the configured validation Job manifest file name or its default
+func (j ValidationJobConfig) Template() string {
+	if j.TemplateFileName == "" {
+		return defaultValidationJobTemplateFileName
+	}
+
+	return j.TemplateFileName
+}
+
+func durationOrDefault(value, defaultValue int, unit time.Duration) time.Duration {
+	if value == 0 {
+		value = defaultValue
+	}
+
+	return time.Duration(value) * unit
+}
+
 // TomlConfig holds the complete TOML configuration for fault remediation
 type TomlConfig struct {
 	// Template mount configuration
@@ -85,6 +306,21 @@ type TomlConfig struct {
 
 	// Common configuration
 	UpdateRetry UpdateRetry `toml:"updateRetry"`
+
+	// Schedules defines named maintenance windows that remediation actions can reference
+	Schedules []schedule.Config `toml:"schedules"`
+
+	// Escalation configures escalation chains of remediation actions
+	Escalation EscalationConfig `toml:"escalation"`
+
+	// RateLimit limits how many maintenance CRs are created, per action, per node group and cluster-wide
+	RateLimit RateLimitConfig `toml:"rateLimit"`
+
+	// EmergencyStop configures the switch pausing the creation of maintenance CRs
+	EmergencyStop EmergencyStopConfig `toml:"emergencyStop"`
+
+	// Verification configures the verification of remediations before they are marked remediated
+	Verification VerificationConfig `toml:"verification"`
 }
 
 // Validate checks the configuration for consistency and completeness.
@@ -95,7 +331,7 @@ type TomlConfig struct {
 //  1. Per-action validation: individual constraints that do not depend on other
 //     actions (EquivalenceGroup, template, scope, ImpactedEntityScope validity).
 //  2. Cross-action validation: constraints that require inspecting other
-//     actions (SupersedingEquivalenceGroups references).
+//     actions (SupersedingEquivalenceGroups, Schedule references and escalation chains).
 //
 // Running per-action checks first ensures that individually-invalid actions
 // surface their own error before any cross-reference error they may also
@@ -105,6 +341,11 @@ func (c *TomlConfig) Validate() error {
 		return err
 	}
 
+	schedules, err := schedule.NewSet(c.Schedules)
+	if err != nil {
+		return fmt.Errorf("invalid maintenance schedules: %w", err)
+	}
+
 	actionNames := sortedActionNames(c.RemediationActions)
 
 	for _, actionName := range actionNames {
@@ -117,9 +358,32 @@ func (c *TomlConfig) Validate() error {
 		if err := c.validateSupersedingGroups(actionName, c.RemediationActions[actionName]); err != nil {
 			return err
 		}
+
+		if name := c.RemediationActions[actionName].Schedule; name != "" {
+			if _, ok := schedules[name]; !ok {
+				return fmt.Errorf("action '%s' references unknown schedule '%s'", actionName, name)
+			}
+		}
 	}
 
-	return nil
+	if err := c.validateEscalation(); err != nil {
+		return err
+	}
+
+	if err := c.validateRateLimit(); err != nil {
+		return err
+	}
+
+	if c.EmergencyStop.Enabled && c.EmergencyStop.ConfigMapName == "" {
+		return fmt.Errorf("emergencyStop configMapName must be non-empty when the emergency stop is enabled")
+	}
+
+	return c.validateVerification()
+}
+
+// BuildSchedules compiles the configured maintenance schedules keyed by name
+func (c *TomlConfig) BuildSchedules() (map[string]*schedule.Schedule, error) {
+	return schedule.NewSet(c.Schedules)
 }
 
 func sortedActionNames(actions map[string]MaintenanceResource) []string {
@@ -133,6 +397,175 @@ func sortedActionNames(actions map[string]MaintenanceResource) []string {
 	return names
 }
 
+/*
+Escalation validation. When escalation is enabled:
+- RecurrenceWindowMinutes, MaxAttemptsPerDay and PollIntervalSeconds must not be negative.
+- Every chain must start from a remediation action and be non-empty.
+- Every step but the last must be a remediation action. The last step may instead be a built-in
+action without a maintenance resource (e.g. CONTACT_SUPPORT), which ends the chain.
+- A chain cannot contain its own action or the same action twice.
+*/
+func (c *TomlConfig) validateEscalation() error {
+	if !c.Escalation.Enabled {
+		return nil
+	}
+
+	if c.Escalation.RecurrenceWindowMinutes < 0 {
+		return fmt.Errorf("escalation recurrenceWindowMinutes must not be negative")
+	}
+
+	if c.Escalation.MaxAttemptsPerDay < 0 {
+		return fmt.Errorf("escalation maxAttemptsPerDay must not be negative")
+	}
+
+	if c.Escalation.PollIntervalSeconds < 0 {
+		return fmt.Errorf("escalation pollIntervalSeconds must not be negative")
+	}
+
+	chainActions := make([]string, 0, len(c.Escalation.Chains))
+	for actionName := range c.Escalation.Chains {
+		chainActions = append(chainActions, actionName)
+	}
+
+	sort.Strings(chainActions)
+
+	for _, actionName := range chainActions {
+		if err := c.validateEscalationChain(actionName, c.Escalation.Chains[actionName]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *TomlConfig) validateEscalationChain(actionName string, chain []string) error {
+	if _, ok := c.RemediationActions[actionName]; !ok {
+		return fmt.Errorf("escalation chain of '%s': action is not a remediation action", actionName)
+	}
+
+	if len(chain) == 0 {
+		return fmt.Errorf("escalation chain of '%s' must not be empty", actionName)
+	}
+
+	for i, step := range chain {
+		if step == actionName || slices.Contains(chain[:i], step) {
+			return fmt.Errorf("escalation chain of '%s' contains '%s' more than once", actionName, step)
+		}
+
+		if _, ok := c.RemediationActions[step]; ok {
+			continue
+		}
+
+		if i != len(chain)-1 || !isTerminalBuiltinAction(step) {
+			return fmt.Errorf("escalation chain of '%s': step '%s' is not a remediation action; only the last "+
+				"step may be a built-in action without a maintenance resource", actionName, step)
+		}
+	}
+
+	return nil
+}
+
+func isTerminalBuiltinAction(actionName string) bool {
+	value, ok := protos.RecommendedAction_value[actionName]
+	if !ok {
+		return false
+	}
+
+	action := protos.RecommendedAction(value)
+
+	return action != protos.RecommendedAction_NONE && action != protos.RecommendedAction_CUSTOM &&
+		action != protos.RecommendedAction_UNKNOWN
+}
+
+func (c *TomlConfig) validateRateLimit() error {
+	if c.RateLimit.RequeueIntervalSeconds < 0 {
+		return fmt.Errorf("rateLimit requeueIntervalSeconds must not be negative")
+	}
+
+	if err := c.RateLimit.validate("rateLimit"); err != nil {
+		return err
+	}
+
+	actions := make(map[string]struct{}, len(c.RateLimit.Actions))
+
+	for i, limit := range c.RateLimit.Actions {
+		field := fmt.Sprintf("rateLimit.actions[%d]", i)
+
+		if _, ok := c.RemediationActions[limit.Action]; !ok {
+			return fmt.Errorf("%s: action '%s' is not a remediation action", field, limit.Action)
+		}
+
+		if _, exists := actions[limit.Action]; exists {
+			return fmt.Errorf("%s: duplicate action '%s'", field, limit.Action)
+		}
+
+		actions[limit.Action] = struct{}{}
+
+		if err := limit.validate(field); err != nil {
+			return err
+		}
+	}
+
+	groups := make(map[string]struct{}, len(c.RateLimit.NodeGroups))
+
+	for i, group := range c.RateLimit.NodeGroups {
+		field := fmt.Sprintf("rateLimit.nodeGroups[%d]", i)
+
+		if group.Name == "" || group.LabelKey == "" {
+			return fmt.Errorf("%s: name and labelKey are required", field)
+		}
+
+		if _, exists := groups[group.Name]; exists {
+			return fmt.Errorf("%s: duplicate group name '%s'", field, group.Name)
+		}
+
+		groups[group.Name] = struct{}{}
+
+		if err := group.validate(field); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *TomlConfig) validateVerification() error {
+	v := c.Verification
+	if !v.Enabled {
+		return nil
+	}
+
+	if v.MetadataTimeoutMinutes < 0 || v.QuietPeriodMinutes < 0 || v.PollIntervalSeconds < 0 ||
+		v.ValidationJob.TimeoutMinutes < 0 {
+		return fmt.Errorf("verification durations must not be negative")
+	}
+
+	for _, actionName := range v.FreshMetadataActions {
+		if _, ok := c.RemediationActions[actionName]; !ok {
+			return fmt.Errorf("verification freshMetadataActions: %s is not a remediation action", actionName)
+		}
+	}
+
+	if !v.ValidationJob.Enabled {
+		return nil
+	}
+
+	manifestPath := filepath.Join(c.Template.MountPath, v.ValidationJob.Template())
+	if _, err := os.Stat(manifestPath); err != nil {
+		return fmt.Errorf("verification validationJob manifest %s: %w", manifestPath, err)
+	}
+
+	return nil
+}
+
+func (l RemediationLimit) validate(field string) error {
+	if l.MaxConcurrent < 0 || l.MaxPerHour < 0 {
+		return fmt.Errorf("%s: maxConcurrent and maxPerHour must not be negative", field)
+	}
+
+	return nil
+}
+
 func (c *TomlConfig) validateTemplate() error {
 	if c.Template.MountPath == "" {
 		return fmt.Errorf("template mountPath must be non-empty")
@@ -19,6 +19,9 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/nvidia/nvsentinel/commons/pkg/schedule"
 )
 
 func TestTomlConfig_Validate(t *testing.T) {
@@ -75,6 +78,56 @@ func TestTomlConfig_Validate(t *testing.T) {
 			},
 			expectError: false,
 		},
+		{
+			name: "action referencing a defined schedule",
+			config: TomlConfig{
+				Template: Template{MountPath: tempDir},
+				RemediationActions: map[string]MaintenanceResource{
+					"ACTION_A": {
+						TemplateFileName: "template-a.yaml",
+						Scope:            "Cluster",
+						EquivalenceGroup: "restart",
+						Schedule:         "weekday-nights",
+					},
+				},
+				Schedules: []schedule.Config{
+					{
+						Name:     "weekday-nights",
+						TimeZone: "America/Los_Angeles",
+						Windows:  []schedule.Window{{Cron: "0 2 * * MON-FRI", Duration: "4h"}},
+					},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "action referencing an unknown schedule",
+			config: TomlConfig{
+				Template: Template{MountPath: tempDir},
+				RemediationActions: map[string]MaintenanceResource{
+					"ACTION_A": {
+						TemplateFileName: "template-a.yaml",
+						Scope:            "Cluster",
+						EquivalenceGroup: "restart",
+						Schedule:         "missing",
+					},
+				},
+			},
+			expectError: true,
+			errorSubstr: "references unknown schedule 'missing'",
+		},
+		{
+			name: "invalid schedule definition",
+			config: TomlConfig{
+				Template:           Template{MountPath: tempDir},
+				RemediationActions: map[string]MaintenanceResource{},
+				Schedules: []schedule.Config{
+					{Name: "bad", Windows: []schedule.Window{{Cron: "0 25 * * *", Duration: "1h"}}},
+				},
+			},
+			expectError: true,
+			errorSubstr: "invalid maintenance schedules",
+		},
 		{
 			name: "missing template file reference",
 			config: TomlConfig{
@@ -320,3 +373,420 @@ func TestTomlConfig_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestTomlConfig_ValidateEscalation(t *testing.T) {
+	tempDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(tempDir, "template.yaml"), []byte("apiVersion: v1"), 0644); err != nil {
+		t.Fatalf("Failed to create template file: %v", err)
+	}
+
+	actions := map[string]MaintenanceResource{
+		"COMPONENT_RESET": {TemplateFileName: "template.yaml", Scope: "Cluster", EquivalenceGroup: "reset"},
+		"RESTART_BM":      {TemplateFileName: "template.yaml", Scope: "Cluster", EquivalenceGroup: "restart"},
+		"REPLACE_VM":      {TemplateFileName: "template.yaml", Scope: "Cluster", EquivalenceGroup: "replace"},
+	}
+
+	tests := []struct {
+		name        string
+		escalation  EscalationConfig
+		expectError bool
+		errorSubstr string
+	}{
+		{
+			name: "disabled escalation is not validated",
+			escalation: EscalationConfig{
+				Chains: map[string][]string{"UNKNOWN_ACTION": {}},
+			},
+		},
+		{
+			name: "valid chain ending with a built-in action without maintenance resource",
+			escalation: EscalationConfig{
+				Enabled: true,
+				Chains: map[string][]string{
+					"COMPONENT_RESET": {"RESTART_BM", "REPLACE_VM", "CONTACT_SUPPORT"},
+				},
+				RecurrenceWindowMinutes: 60,
+				MaxAttemptsPerDay:       3,
+			},
+		},
+		{
+			name: "chain of an action that is not a remediation action",
+			escalation: EscalationConfig{
+				Enabled: true,
+				Chains:  map[string][]string{"CONTACT_SUPPORT": {"RESTART_BM"}},
+			},
+			expectError: true,
+			errorSubstr: "action is not a remediation action",
+		},
+		{
+			name: "empty chain",
+			escalation: EscalationConfig{
+				Enabled: true,
+				Chains:  map[string][]string{"COMPONENT_RESET": {}},
+			},
+			expectError: true,
+			errorSubstr: "must not be empty",
+		},
+		{
+			name: "chain containing its own action",
+			escalation: EscalationConfig{
+				Enabled: true,
+				Chains:  map[string][]string{"COMPONENT_RESET": {"RESTART_BM", "COMPONENT_RESET"}},
+			},
+			expectError: true,
+			errorSubstr: "contains 'COMPONENT_RESET' more than once",
+		},
+		{
+			name: "chain containing an action twice",
+			escalation: EscalationConfig{
+				Enabled: true,
+				Chains:  map[string][]string{"COMPONENT_RESET": {"RESTART_BM", "RESTART_BM"}},
+			},
+			expectError: true,
+			errorSubstr: "contains 'RESTART_BM' more than once",
+		},
+		{
+			name: "unconfigured action before the last step",
+			escalation: EscalationConfig{
+				Enabled: true,
+				Chains:  map[string][]string{"COMPONENT_RESET": {"CONTACT_SUPPORT", "RESTART_BM"}},
+			},
+			expectError: true,
+			errorSubstr: "step 'CONTACT_SUPPORT' is not a remediation action",
+		},
+		{
+			name: "unconfigured custom action as the last step",
+			escalation: EscalationConfig{
+				Enabled: true,
+				Chains:  map[string][]string{"COMPONENT_RESET": {"RESTART_BM", "REPLACE_DISK"}},
+			},
+			expectError: true,
+			errorSubstr: "step 'REPLACE_DISK' is not a remediation action",
+		},
+		{
+			name: "negative recurrence window",
+			escalation: EscalationConfig{
+				Enabled:                 true,
+				RecurrenceWindowMinutes: -1,
+			},
+			expectError: true,
+			errorSubstr: "recurrenceWindowMinutes must not be negative",
+		},
+		{
+			name: "negative attempt limit",
+			escalation: EscalationConfig{
+				Enabled:           true,
+				MaxAttemptsPerDay: -1,
+			},
+			expectError: true,
+			errorSubstr: "maxAttemptsPerDay must not be negative",
+		},
+		{
+			name: "negative poll interval",
+			escalation: EscalationConfig{
+				Enabled:             true,
+				PollIntervalSeconds: -1,
+			},
+			expectError: true,
+			errorSubstr: "pollIntervalSeconds must not be negative",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := TomlConfig{
+				Template:           Template{MountPath: tempDir},
+				RemediationActions: actions,
+				Escalation:         tt.escalation,
+			}
+
+			err := config.Validate()
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected validation error but got none")
+					return
+				}
+
+				if !strings.Contains(err.Error(), tt.errorSubstr) {
+					t.Errorf("Expected error to contain '%s' but got: %v", tt.errorSubstr, err)
+				}
+			} else if err != nil {
+				t.Errorf("Expected no validation error but got: %v", err)
+			}
+		})
+	}
+}
+
+func TestEscalationConfig_Ladder(t *testing.T) {
+	escalation := EscalationConfig{
+		Enabled: true,
+		Chains:  map[string][]string{"COMPONENT_RESET": {"RESTART_BM", "CONTACT_SUPPORT"}},
+	}
+
+	ladder := escalation.Ladder("COMPONENT_RESET")
+	expected := []string{"COMPONENT_RESET", "RESTART_BM", "CONTACT_SUPPORT"}
+
+	if strings.Join(ladder, ",") != strings.Join(expected, ",") {
+		t.Errorf("Expected ladder %v but got %v", expected, ladder)
+	}
+
+	if ladder := escalation.Ladder("RESTART_BM"); ladder != nil {
+		t.Errorf("Expected no ladder for an action without chain but got %v", ladder)
+	}
+
+	escalation.Enabled = false
+	if ladder := escalation.Ladder("COMPONENT_RESET"); ladder != nil {
+		t.Errorf("Expected no ladder when escalation is disabled but got %v", ladder)
+	}
+
+	if window := escalation.RecurrenceWindow(); window != 24*time.Hour {
+		t.Errorf("Expected default recurrence window of 24h but got %v", window)
+	}
+}
+
+func TestTomlConfig_ValidateRateLimit(t *testing.T) {
+	tempDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(tempDir, "template.yaml"), []byte("apiVersion: v1"), 0644); err != nil {
+		t.Fatalf("Failed to create template file: %v", err)
+	}
+
+	actions := map[string]MaintenanceResource{
+		"RESTART_BM": {TemplateFileName: "template.yaml", Scope: "Cluster", EquivalenceGroup: "restart"},
+		"REPLACE_VM": {TemplateFileName: "template.yaml", Scope: "Cluster", EquivalenceGroup: "replace"},
+	}
+
+	tests := []struct {
+		name          string
+		rateLimit     RateLimitConfig
+		emergencyStop EmergencyStopConfig
+		expectError   bool
+		errorSubstr   string
+	}{
+		{
+			name: "valid limits",
+			rateLimit: RateLimitConfig{
+				RemediationLimit: RemediationLimit{MaxConcurrent: 10},
+				Actions: []ActionRateLimit{
+					{Action: "RESTART_BM", RemediationLimit: RemediationLimit{MaxConcurrent: 2}},
+					{Action: "REPLACE_VM", RemediationLimit: RemediationLimit{MaxPerHour: 5}},
+				},
+				NodeGroups: []NodeGroupRateLimit{
+					{Name: "rack", LabelKey: "topology.kubernetes.io/rack", RemediationLimit: RemediationLimit{MaxConcurrent: 1}},
+				},
+			},
+			emergencyStop: EmergencyStopConfig{Enabled: true, ConfigMapName: "fault-remediation-emergency-stop"},
+		},
+		{
+			name:        "negative requeue interval",
+			rateLimit:   RateLimitConfig{RequeueIntervalSeconds: -1},
+			expectError: true,
+			errorSubstr: "requeueIntervalSeconds must not be negative",
+		},
+		{
+			name:        "negative cluster-wide limit",
+			rateLimit:   RateLimitConfig{RemediationLimit: RemediationLimit{MaxPerHour: -1}},
+			expectError: true,
+			errorSubstr: "rateLimit: maxConcurrent and maxPerHour must not be negative",
+		},
+		{
+			name: "limit of an action that is not a remediation action",
+			rateLimit: RateLimitConfig{
+				Actions: []ActionRateLimit{{Action: "CONTACT_SUPPORT", RemediationLimit: RemediationLimit{MaxConcurrent: 1}}},
+			},
+			expectError: true,
+			errorSubstr: "action 'CONTACT_SUPPORT' is not a remediation action",
+		},
+		{
+			name: "duplicate action limit",
+			rateLimit: RateLimitConfig{
+				Actions: []ActionRateLimit{
+					{Action: "RESTART_BM", RemediationLimit: RemediationLimit{MaxConcurrent: 1}},
+					{Action: "RESTART_BM", RemediationLimit: RemediationLimit{MaxPerHour: 1}},
+				},
+			},
+			expectError: true,
+			errorSubstr: "duplicate action 'RESTART_BM'",
+		},
+		{
+			name: "negative action limit",
+			rateLimit: RateLimitConfig{
+				Actions: []ActionRateLimit{{Action: "RESTART_BM", RemediationLimit: RemediationLimit{MaxConcurrent: -1}}},
+			},
+			expectError: true,
+			errorSubstr: "rateLimit.actions[0]: maxConcurrent and maxPerHour must not be negative",
+		},
+		{
+			name: "node group without label key",
+			rateLimit: RateLimitConfig{
+				NodeGroups: []NodeGroupRateLimit{{Name: "rack", RemediationLimit: RemediationLimit{MaxConcurrent: 1}}},
+			},
+			expectError: true,
+			errorSubstr: "name and labelKey are required",
+		},
+		{
+			name: "duplicate node group",
+			rateLimit: RateLimitConfig{
+				NodeGroups: []NodeGroupRateLimit{
+					{Name: "rack", LabelKey: "rack", RemediationLimit: RemediationLimit{MaxConcurrent: 1}},
+					{Name: "rack", LabelKey: "zone", RemediationLimit: RemediationLimit{MaxConcurrent: 1}},
+				},
+			},
+			expectError: true,
+			errorSubstr: "duplicate group name 'rack'",
+		},
+		{
+			name:          "emergency stop without config map name",
+			emergencyStop: EmergencyStopConfig{Enabled: true},
+			expectError:   true,
+			errorSubstr:   "emergencyStop configMapName must be non-empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := TomlConfig{
+				Template:           Template{MountPath: tempDir},
+				RemediationActions: actions,
+				RateLimit:          tt.rateLimit,
+				EmergencyStop:      tt.emergencyStop,
+			}
+
+			err := config.Validate()
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected validation error but got none")
+					return
+				}
+
+				if !strings.Contains(err.Error(), tt.errorSubstr) {
+					t.Errorf("Expected error to contain '%s' but got: %v", tt.errorSubstr, err)
+				}
+			} else if err != nil {
+				t.Errorf("Expected no validation error but got: %v", err)
+			}
+		})
+	}
+}
+
+func TestRateLimitConfig_Enabled(t *testing.T) {
+	var rateLimit RateLimitConfig
+	if rateLimit.Enabled() {
+		t.Errorf("Expected rate limits without limits to be disabled")
+	}
+
+	if interval := rateLimit.RequeueInterval(); interval != time.Minute {
+		t.Errorf("Expected default requeue interval of 1m but got %v", interval)
+	}
+
+	rateLimit.NodeGroups = []NodeGroupRateLimit{{Name: "rack", LabelKey: "rack", RemediationLimit: RemediationLimit{MaxPerHour: 1}}}
+	if !rateLimit.Enabled() {
+		t.Errorf("Expected rate limits with a group limit to be enabled")
+	}
+}
+
+func TestTomlConfig_ValidateVerification(t *testing.T) {
+	tempDir := t.TempDir()
+	for _, name := range []string{"template.yaml", "validation-job.yaml"} {
+		if err := os.WriteFile(filepath.Join(tempDir, name), []byte("apiVersion: v1"), 0644); err != nil {
+			t.Fatalf("Failed to create template file: %v", err)
+		}
+	}
+
+	actions := map[string]MaintenanceResource{
+		"RESTART_BM": {TemplateFileName: "template.yaml", Scope: "Cluster", EquivalenceGroup: "restart"},
+	}
+
+	tests := []struct {
+		name         string
+		verification VerificationConfig
+		expectError  bool
+		errorSubstr  string
+	}{
+		{
+			name:         "disabled",
+			verification: VerificationConfig{QuietPeriodMinutes: -1, ValidationJob: ValidationJobConfig{Enabled: true, TemplateFileName: "missing.yaml"}},
+		},
+		{
+			name: "valid with validation job",
+			verification: VerificationConfig{
+				Enabled:              true,
+				FreshMetadataActions: []string{"RESTART_BM"},
+				QuietPeriodMinutes:   5,
+				ValidationJob:        ValidationJobConfig{Enabled: true},
+			},
+		},
+		{
+			name:         "fresh metadata action without maintenance resource",
+			verification: VerificationConfig{Enabled: true, FreshMetadataActions: []string{"COMPONENT_RESET"}},
+			expectError:  true,
+			errorSubstr:  "COMPONENT_RESET is not a remediation action",
+		},
+		{
+			name:         "negative quiet period",
+			verification: VerificationConfig{Enabled: true, QuietPeriodMinutes: -1},
+			expectError:  true,
+			errorSubstr:  "verification durations must not be negative",
+		},
+		{
+			name: "missing validation job manifest",
+			verification: VerificationConfig{
+				Enabled:       true,
+				ValidationJob: ValidationJobConfig{Enabled: true, TemplateFileName: "missing.yaml"},
+			},
+			expectError: true,
+			errorSubstr: "verification validationJob manifest",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := TomlConfig{
+				Template:           Template{MountPath: tempDir},
+				RemediationActions: actions,
+				Verification:       tt.verification,
+			}
+
+			err := config.Validate()
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected validation error but got none")
+					return
+				}
+
+				if !strings.Contains(err.Error(), tt.errorSubstr) {
+					t.Errorf("Expected error to contain '%s' but got: %v", tt.errorSubstr, err)
+				}
+			} else if err != nil {
+				t.Errorf("Expected no validation error but got: %v", err)
+			}
+		})
+	}
+}
+
+func TestVerificationConfig_Defaults(t *testing.T) {
+	var verification VerificationConfig
+
+	if interval := verification.PollInterval(); interval != time.Minute {
+		t.Errorf("Expected default poll interval of 1m but got %v", interval)
+	}
+
+	if quiet := verification.QuietPeriod(); quiet != 10*time.Minute {
+		t.Errorf("Expected default quiet period of 10m but got %v", quiet)
+	}
+
+	if template := verification.ValidationJob.Template(); template != "validation-job.yaml" {
+		t.Errorf("Expected default validation job manifest validation-job.yaml but got %s", template)
+	}
+
+	if !verification.RequiresFreshMetadata("RESTART_BM") || verification.RequiresFreshMetadata("COMPONENT_RESET") {
+		t.Errorf("Expected only reboots to require fresh metadata by default")
+	}
+
+	verification.FreshMetadataActions = []string{}
+	if verification.RequiresFreshMetadata("RESTART_BM") {
+		t.Errorf("Expected an empty freshMetadataActions to disable the fresh metadata check")
+	}
+}
@@ -45,15 +45,48 @@ func NewCRStatusChecker(
 
 // ShouldSkipCRCreation returns true if the CR exists and is not in a terminal state otherwise returns false.
 func (c *CRStatusChecker) ShouldSkipCRCreation(ctx context.Context, actionName string, crName string) bool {
+	obj, resource, ok := c.getCR(ctx, actionName, crName)
+	if !ok {
+		return false
+	}
+
+	return c.checkCondition(obj, resource)
+}
+
+// GetCRStatus returns the outcome of the CR from its complete condition: True means succeeded, False failed.
+// A CR that cannot be read, or any CR in dry-run mode, is reported as not found.
+func (c *CRStatusChecker) GetCRStatus(ctx context.Context, actionName string, crName string) CRStatus {
+	obj, resource, ok := c.getCR(ctx, actionName, crName)
+	if !ok {
+		return CRStatusNotFound
+	}
+
+	conditions, found, err := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	if err != nil || !found {
+		return CRStatusInProgress
+	}
+
+	switch c.findConditionStatus(conditions, resource.CompleteConditionType) {
+	case "True":
+		return CRStatusSucceeded
+	case "False":
+		return CRStatusFailed
+	default:
+		return CRStatusInProgress
+	}
+}
+
+func (c *CRStatusChecker) getCR(ctx context.Context, actionName string,
+	crName string) (*unstructured.Unstructured, config.MaintenanceResource, bool) {
 	resource, exists := c.remediationActions[actionName]
 	if !exists {
 		slog.ErrorContext(ctx, "No remediation configuration found for action", "action", actionName)
-		return false
+		return nil, resource, false
 	}
 
 	if c.dryRun {
 		slog.InfoContext(ctx, "DRY-RUN: CR doesn't exist (dry-run mode)", "crName", crName, "action", actionName)
-		return false
+		return nil, resource, false
 	}
 
 	gvk := schema.GroupVersionKind{
@@ -69,10 +102,10 @@ func (c *CRStatusChecker) ShouldSkipCRCreation(ctx context.Context, actionName s
 
 	if err := c.client.Get(ctx, key, obj); err != nil {
 		slog.WarnContext(ctx, "Failed to get CR, allowing create", "crName", crName, "gvk", gvk.String(), "error", err)
-		return false
+		return nil, resource, false
 	}
 
-	return c.checkCondition(obj, resource)
+	return obj, resource, true
 }
 
 func (c *CRStatusChecker) checkCondition(obj *unstructured.Unstructured, resource config.MaintenanceResource) bool {
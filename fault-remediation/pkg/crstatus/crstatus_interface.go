@@ -20,6 +20,17 @@ import (
 	"context"
 )
 
+// CRStatus is the outcome of a maintenance CR
+type CRStatus string
+
+const (
+	CRStatusInProgress CRStatus = "InProgress"
+	CRStatusSucceeded  CRStatus = "Succeeded"
+	CRStatusFailed     CRStatus = "Failed"
+	CRStatusNotFound   CRStatus = "NotFound"
+)
+
 type CRStatusCheckerInterface interface {
 	ShouldSkipCRCreation(context.Context, string, string) bool
+	GetCRStatus(ctx context.Context, actionName string, crName string) CRStatus
 }
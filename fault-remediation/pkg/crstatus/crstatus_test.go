@@ -15,10 +15,12 @@
 package crstatus
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/nvidia/nvsentinel/fault-remediation/pkg/config"
 )
@@ -120,3 +122,66 @@ func TestCheckCondition(t *testing.T) {
 		})
 	}
 }
+
+func TestGetCRStatus(t *testing.T) {
+	testResource := config.MaintenanceResource{
+		ApiGroup:              "janitor.dgxc.nvidia.com",
+		Version:               "v1alpha1",
+		Kind:                  "RebootNode",
+		CompleteConditionType: "Completed",
+	}
+	cfg := map[string]config.MaintenanceResource{
+		"RESTART_BM": testResource,
+	}
+
+	newCR := func(name string, conditionStatus string) *unstructured.Unstructured {
+		cr := &unstructured.Unstructured{Object: map[string]any{}}
+		cr.SetAPIVersion(testResource.ApiGroup + "/" + testResource.Version)
+		cr.SetKind(testResource.Kind)
+		cr.SetName(name)
+
+		if conditionStatus != "" {
+			cr.Object["status"] = map[string]any{
+				"conditions": []any{
+					map[string]any{"type": "Completed", "status": conditionStatus},
+				},
+			}
+		}
+
+		return cr
+	}
+
+	k8sClient := fake.NewClientBuilder().WithObjects(
+		newCR("in-progress", ""),
+		newCR("succeeded", "True"),
+		newCR("failed", "False"),
+		newCR("unknown", "Unknown"),
+	).Build()
+
+	checker := NewCRStatusChecker(k8sClient, cfg, false)
+
+	tests := []struct {
+		name       string
+		actionName string
+		crName     string
+		expected   CRStatus
+	}{
+		{name: "no conditions is in progress", actionName: "RESTART_BM", crName: "in-progress", expected: CRStatusInProgress},
+		{name: "condition true is succeeded", actionName: "RESTART_BM", crName: "succeeded", expected: CRStatusSucceeded},
+		{name: "condition false is failed", actionName: "RESTART_BM", crName: "failed", expected: CRStatusFailed},
+		{name: "condition unknown is in progress", actionName: "RESTART_BM", crName: "unknown", expected: CRStatusInProgress},
+		{name: "missing CR is not found", actionName: "RESTART_BM", crName: "missing", expected: CRStatusNotFound},
+		{name: "unknown action is not found", actionName: "REPLACE_VM", crName: "succeeded", expected: CRStatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, checker.GetCRStatus(context.Background(), tt.actionName, tt.crName))
+		})
+	}
+
+	t.Run("dry run is not found", func(t *testing.T) {
+		dryRunChecker := NewCRStatusChecker(k8sClient, cfg, true)
+		assert.Equal(t, CRStatusNotFound, dryRunChecker.GetCRStatus(context.Background(), "RESTART_BM", "succeeded"))
+	})
+}
@@ -19,14 +19,17 @@ import (
 	"fmt"
 
 	"log/slog"
+	"os"
 	"time"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 
 	"github.com/nvidia/nvsentinel/commons/pkg/configmanager"
 	"github.com/nvidia/nvsentinel/commons/pkg/statemanager"
 	"github.com/nvidia/nvsentinel/fault-remediation/pkg/config"
+	"github.com/nvidia/nvsentinel/fault-remediation/pkg/ratelimit"
 	"github.com/nvidia/nvsentinel/fault-remediation/pkg/reconciler"
 	"github.com/nvidia/nvsentinel/fault-remediation/pkg/remediation"
 	"github.com/nvidia/nvsentinel/store-client/pkg/client"
@@ -90,6 +93,16 @@ func InitializeAll(
 		TokenCollection: tokenConfig.TokenCollection,
 	}
 
+	schedules, err := tomlConfig.BuildSchedules()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build maintenance schedules: %w", err)
+	}
+
+	rateLimiter, emergencyStop, err := initRateLimits(ctx, params.Config, tomlConfig, remediationClient)
+	if err != nil {
+		return nil, err
+	}
+
 	reconcilerCfg := reconciler.ReconcilerConfig{
 		DataStoreConfig:    *datastoreConfig,
 		TokenConfig:        clientTokenConfig,
@@ -99,6 +112,9 @@ func InitializeAll(
 		EnableLogCollector: params.EnableLogCollector,
 		UpdateMaxRetries:   tomlConfig.UpdateRetry.MaxRetries,
 		UpdateRetryDelay:   time.Duration(tomlConfig.UpdateRetry.RetryDelaySeconds) * time.Second,
+		Schedules:          schedules,
+		RateLimiter:        rateLimiter,
+		EmergencyStop:      emergencyStop,
 	}
 
 	slog.Info("Initialization completed successfully")
@@ -148,6 +164,63 @@ func initRemediationAndStateManager(
 	return remediationClient, stateManager, nil
 }
 
+// initRateLimits builds the remediation rate limiter, seeded with the maintenance CRs recorded on the nodes,
+// and the emergency stop switch. Either is nil when not configured.
+func initRateLimits(
+	ctx context.Context,
+	restConfig *rest.Config,
+	tomlConfig *config.TomlConfig,
+	remediationClient *remediation.FaultRemediationClient,
+) (*ratelimit.Limiter, *ratelimit.EmergencyStop, error) {
+	if !tomlConfig.RateLimit.Enabled() && !tomlConfig.EmergencyStop.Enabled {
+		return nil, nil, nil
+	}
+
+	kubeClient, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error init kube client for remediation rate limits: %w", err)
+	}
+
+	var emergencyStop *ratelimit.EmergencyStop
+
+	if tomlConfig.EmergencyStop.Enabled {
+		namespace := os.Getenv("POD_NAMESPACE")
+		emergencyStop = ratelimit.NewEmergencyStop(kubeClient, tomlConfig.EmergencyStop.ConfigMapName, namespace)
+
+		if err := emergencyStop.Ensure(ctx); err != nil {
+			return nil, nil, fmt.Errorf("failed to ensure emergency stop config map: %w", err)
+		}
+
+		slog.Info("Emergency stop enabled",
+			"configMap", tomlConfig.EmergencyStop.ConfigMapName,
+			"namespace", namespace)
+	}
+
+	var statusFunc ratelimit.StatusFunc
+	if statusChecker := remediationClient.GetStatusChecker(); statusChecker != nil {
+		statusFunc = statusChecker.GetCRStatus
+	}
+
+	rateLimiter := ratelimit.NewLimiter(tomlConfig.RateLimit, statusFunc)
+	if rateLimiter == nil {
+		return nil, emergencyStop, nil
+	}
+
+	nodes, err := kubeClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list nodes for remediation rate limits: %w", err)
+	}
+
+	records := ratelimit.RecordsFromNodes(nodes.Items)
+	for _, record := range records {
+		rateLimiter.Record(record)
+	}
+
+	slog.Info("Remediation rate limits enabled", "existingRemediations", len(records))
+
+	return rateLimiter, emergencyStop, nil
+}
+
 func initDatastoreAndWatcher(
 	ctx context.Context,
 	pipeline datastore.Pipeline,
@@ -57,6 +57,73 @@ var (
 		},
 		[]string{"action", "node_name"},
 	)
+	ActiveRemediations = promauto.With(crmetrics.Registry).NewGauge(
+		prometheus.GaugeOpts{
+			Name: "fault_remediation_active_remediations",
+			Help: "Number of maintenance CRs in progress counted against the remediation rate limits.",
+		},
+	)
+	WaitingRemediations = promauto.With(crmetrics.Registry).NewGauge(
+		prometheus.GaugeOpts{
+			Name: "fault_remediation_waiting_remediations",
+			Help: "Number of remediations waiting for the remediation rate limits to allow them.",
+		},
+	)
+	RemediationWaitDuration = promauto.With(crmetrics.Registry).NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "fault_remediation_remediation_wait_duration_seconds",
+			Help:    "Time remediations waited for the remediation rate limits before their maintenance CR was created.",
+			Buckets: prometheus.ExponentialBuckets(1, 2, 15),
+		},
+	)
+	EventsHeldForRateLimit = promauto.With(crmetrics.Registry).NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "fault_remediation_events_held_for_rate_limit_total",
+			Help: "Total number of times events were requeued because of the remediation rate limits or the emergency stop.",
+		},
+		[]string{"reason"},
+	)
+	EmergencyStopActive = promauto.With(crmetrics.Registry).NewGauge(
+		prometheus.GaugeOpts{
+			Name: "fault_remediation_emergency_stop_active",
+			Help: "Whether the emergency stop pausing the creation of maintenance CRs is active (1) or not (0).",
+		},
+	)
+	RemediationEscalations = promauto.With(crmetrics.Registry).NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "fault_remediation_escalations_total",
+			Help: "Total number of remediations escalated to the next action of their escalation chain.",
+		},
+		[]string{"action", "trigger"},
+	)
+	EscalationsStopped = promauto.With(crmetrics.Registry).NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "fault_remediation_escalations_stopped_total",
+			Help: "Total number of remediations not performed because their escalation chain stopped.",
+		},
+		[]string{"reason", "node_name"},
+	)
+	RemediationVerifications = promauto.With(crmetrics.Registry).NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "fault_remediation_verifications_total",
+			Help: "Total number of remediation verifications completed, by result and failure reason.",
+		},
+		[]string{"result", "reason"},
+	)
+	RemediationVerificationDuration = promauto.With(crmetrics.Registry).NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "fault_remediation_verification_duration_seconds",
+			Help:    "Time from maintenance CR creation to the end of the verification of the remediation.",
+			Buckets: prometheus.ExponentialBuckets(60, 2, 10),
+		},
+	)
+	EventsHeldForMaintenanceWindow = promauto.With(crmetrics.Registry).NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "fault_remediation_events_held_for_maintenance_window_total",
+			Help: "Total number of times a remediation was held until a maintenance window opens.",
+		},
+		[]string{"schedule"},
+	)
 
 	// Performance Metrics
 	EventHandlingDuration = promauto.With(crmetrics.Registry).NewHistogram(
@@ -0,0 +1,108 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/nvidia/nvsentinel/fault-remediation/pkg/metrics"
+)
+
+// EmergencyStopKey is the ConfigMap key that pauses the creation of maintenance CRs when set to "true"
+const EmergencyStopKey = "emergencyStop"
+
+// EmergencyStop reads the emergency stop switch from a ConfigMap
+type EmergencyStop struct {
+	clientset kubernetes.Interface
+	name      string
+	namespace string
+}
+
+func NewEmergencyStop(clientset kubernetes.Interface, name, namespace string) *EmergencyStop {
+	return &EmergencyStop{
+		clientset: clientset,
+		name:      name,
+		namespace: namespace,
+	}
+}
+
+// Ensure creates the ConfigMap with the emergency stop off if it does not exist, so that operators only
+// have to flip its value
+func (e *EmergencyStop) Ensure(ctx context.Context) error {
+	cmClient := e.clientset.CoreV1().ConfigMaps(e.namespace)
+
+	_, err := cmClient.Get(ctx, e.name, metav1.GetOptions{})
+	if err == nil {
+		return nil
+	}
+
+	if !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to get config map %s in namespace %s: %w", e.name, e.namespace, err)
+	}
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: e.name, Namespace: e.namespace},
+		Data:       map[string]string{EmergencyStopKey: "false"},
+	}
+
+	if _, err := cmClient.Create(ctx, cm, metav1.CreateOptions{}); err != nil && !errors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create config map %s in namespace %s: %w", e.name, e.namespace, err)
+	}
+
+	slog.InfoContext(ctx, "Created emergency stop config map", "name", e.name, "namespace", e.namespace)
+
+	return nil
+}
+
+// IsStopped reports whether the emergency stop is active. A missing ConfigMap or key means it is not; any
+// other error is returned so that the caller can fail closed.
+func (e *EmergencyStop) IsStopped(ctx context.Context) (bool, error) {
+	cm, err := e.clientset.CoreV1().ConfigMaps(e.namespace).Get(ctx, e.name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		metrics.EmergencyStopActive.Set(0)
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("failed to get config map %s in namespace %s: %w", e.name, e.namespace, err)
+	}
+
+	value := cm.Data[EmergencyStopKey]
+
+	stopped, err := strconv.ParseBool(value)
+	if err != nil && value != "" {
+		// Err on the side of pausing remediation when the switch was set to a value we do not understand
+		slog.WarnContext(ctx, "Invalid emergency stop value, treating it as active",
+			"name", e.name, "namespace", e.namespace, "value", value)
+
+		stopped = true
+	}
+
+	if stopped {
+		metrics.EmergencyStopActive.Set(1)
+	} else {
+		metrics.EmergencyStopActive.Set(0)
+	}
+
+	return stopped, nil
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestEmergencyStop(t *testing.T) {
+	ctx := context.Background()
+	clientset := fake.NewSimpleClientset()
+	emergencyStop := NewEmergencyStop(clientset, "emergency-stop", "nvsentinel")
+
+	stopped, err := emergencyStop.IsStopped(ctx)
+	require.NoError(t, err)
+	assert.False(t, stopped, "a missing config map must not stop remediation")
+
+	require.NoError(t, emergencyStop.Ensure(ctx))
+
+	cm, err := clientset.CoreV1().ConfigMaps("nvsentinel").Get(ctx, "emergency-stop", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "false", cm.Data[EmergencyStopKey])
+
+	tests := []struct {
+		value    string
+		expected bool
+	}{
+		{value: "false", expected: false},
+		{value: "", expected: false},
+		{value: "true", expected: true},
+		{value: "yes", expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			cm.Data[EmergencyStopKey] = tt.value
+			_, err := clientset.CoreV1().ConfigMaps("nvsentinel").Update(ctx, cm, metav1.UpdateOptions{})
+			require.NoError(t, err)
+
+			stopped, err := emergencyStop.IsStopped(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, stopped)
+		})
+	}
+
+	// Ensure keeps the value set by the operator
+	cm.Data[EmergencyStopKey] = "true"
+	_, err = clientset.CoreV1().ConfigMaps("nvsentinel").Update(ctx, cm, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	require.NoError(t, emergencyStop.Ensure(ctx))
+
+	stopped, err = emergencyStop.IsStopped(ctx)
+	require.NoError(t, err)
+	assert.True(t, stopped)
+}
@@ -0,0 +1,395 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ratelimit limits how many maintenance CRs fault-remediation creates, and pauses their creation
+// cluster-wide on an emergency stop.
+//
+// A remediation counts against the concurrency limits from the creation of its maintenance CR until the CR
+// reaches a terminal condition, and against the hourly limits for an hour after its creation. Limits apply
+// cluster-wide, per remediation action and per node group, the nodes sharing a label value. Remediations
+// over the limits wait in arrival order; a waiting remediation is admitted as soon as all of its limits have
+// room, after the remediations that arrived before it. A remediation waiting only on a full action or group
+// does not block remediations of other actions or groups.
+package ratelimit
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/nvidia/nvsentinel/fault-remediation/pkg/annotation"
+	"github.com/nvidia/nvsentinel/fault-remediation/pkg/config"
+	"github.com/nvidia/nvsentinel/fault-remediation/pkg/crstatus"
+	"github.com/nvidia/nvsentinel/fault-remediation/pkg/metrics"
+)
+
+const (
+	// hourlyWindow is the window of the hourly limits
+	hourlyWindow = time.Hour
+
+	// notFoundGrace is how long a CR that cannot be read yet, e.g. because it was just created, is still
+	// considered in progress
+	notFoundGrace = time.Minute
+)
+
+// StatusFunc returns the status of the maintenance CR of a remediation
+type StatusFunc func(ctx context.Context, actionName string, crName string) crstatus.CRStatus
+
+// limitKey identifies a limit: cluster-wide, of an action, or of the nodes of a group sharing a label value
+type limitKey struct {
+	kind  string
+	name  string
+	value string
+}
+
+const (
+	kindCluster = "cluster"
+	kindAction  = "action"
+	kindGroup   = "group"
+)
+
+// Request is a remediation asking to create a maintenance CR
+type Request struct {
+	NodeName   string
+	ActionName string
+	NodeLabels map[string]string
+}
+
+// Record is a maintenance CR created for a remediation
+type Record struct {
+	NodeName   string
+	ActionName string
+	CRName     string
+	NodeLabels map[string]string
+	CreatedAt  time.Time
+}
+
+type remediation struct {
+	actionName string
+	crName     string
+	keys       []limitKey
+	createdAt  time.Time
+	inProgress bool
+}
+
+type waiter struct {
+	nodeName   string
+	actionName string
+	keys       []limitKey
+	since      time.Time
+	lastSeen   time.Time
+}
+
+// usage counts the remediations against each limit
+type usage struct {
+	concurrent map[limitKey]int
+	hourly     map[limitKey]int
+}
+
+type Limiter struct {
+	mu sync.Mutex
+
+	limits     map[limitKey]config.RemediationLimit
+	nodeGroups []config.NodeGroupRateLimit
+	crStatus   StatusFunc
+
+	// waitingTimeout drops waiting remediations that stopped asking for a slot, e.g. because their event was
+	// cancelled, so they do not hold back the remediations behind them
+	waitingTimeout time.Duration
+
+	remediations []*remediation
+	waiting      []*waiter
+}
+
+// NewLimiter returns a limiter for the configuration, or nil when remediations are not limited. crStatus
+// tells whether the maintenance CR of a remediation is still in progress.
+func NewLimiter(cfg config.RateLimitConfig, crStatus StatusFunc) *Limiter {
+	if !cfg.Enabled() {
+		return nil
+	}
+
+	limits := map[limitKey]config.RemediationLimit{
+		{kind: kindCluster}: cfg.RemediationLimit,
+	}
+
+	for _, action := range cfg.Actions {
+		limits[limitKey{kind: kindAction, name: action.Action}] = action.RemediationLimit
+	}
+
+	for _, group := range cfg.NodeGroups {
+		limits[limitKey{kind: kindGroup, name: group.Name}] = group.RemediationLimit
+	}
+
+	return &Limiter{
+		limits:         limits,
+		nodeGroups:     cfg.NodeGroups,
+		crStatus:       crStatus,
+		waitingTimeout: 5 * cfg.RequeueInterval(),
+	}
+}
+
+// Acquire reports whether the remediation may create its maintenance CR now. A remediation that is not
+// admitted joins the waiting list, and its 1-based position in that list is returned. The caller records
+// the CR it creates after being admitted with Record.
+func (l *Limiter) Acquire(ctx context.Context, request Request) (bool, int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.refresh(ctx, now)
+
+	candidate, position := l.findWaiting(request.NodeName, request.ActionName)
+	if candidate == nil {
+		candidate = &waiter{
+			nodeName:   request.NodeName,
+			actionName: request.ActionName,
+			keys:       l.keys(request.ActionName, request.NodeLabels),
+			since:      now,
+		}
+		l.waiting = append(l.waiting, candidate)
+		position = len(l.waiting) - 1
+	}
+
+	candidate.lastSeen = now
+
+	if l.admissible(position, now) {
+		l.waiting = append(l.waiting[:position], l.waiting[position+1:]...)
+		metrics.RemediationWaitDuration.Observe(now.Sub(candidate.since).Seconds())
+		l.updateMetrics()
+
+		return true, 0
+	}
+
+	l.updateMetrics()
+
+	return false, position + 1
+}
+
+// Record counts the maintenance CR created for a remediation against the limits
+func (l *Limiter) Record(record Record) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	createdAt := record.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+
+	l.remediations = append(l.remediations, &remediation{
+		actionName: record.ActionName,
+		crName:     record.CRName,
+		keys:       l.keys(record.ActionName, record.NodeLabels),
+		createdAt:  createdAt,
+		inProgress: true,
+	})
+
+	l.updateMetrics()
+}
+
+// Release removes the remediations of the node from the waiting list
+func (l *Limiter) Release(nodeName string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	waiting := l.waiting[:0]
+
+	for _, w := range l.waiting {
+		if w.nodeName != nodeName {
+			waiting = append(waiting, w)
+		}
+	}
+
+	l.waiting = waiting
+	l.updateMetrics()
+}
+
+func (l *Limiter) findWaiting(nodeName string, actionName string) (*waiter, int) {
+	for i, w := range l.waiting {
+		if w.nodeName == nodeName && w.actionName == actionName {
+			return w, i
+		}
+	}
+
+	return nil, -1
+}
+
+func (l *Limiter) keys(actionName string, nodeLabels map[string]string) []limitKey {
+	keys := []limitKey{{kind: kindCluster}}
+
+	if _, ok := l.limits[limitKey{kind: kindAction, name: actionName}]; ok {
+		keys = append(keys, limitKey{kind: kindAction, name: actionName})
+	}
+
+	for _, group := range l.nodeGroups {
+		if value, ok := nodeLabels[group.LabelKey]; ok {
+			keys = append(keys, limitKey{kind: kindGroup, name: group.Name, value: value})
+		}
+	}
+
+	return keys
+}
+
+// refresh marks the remediations whose CR reached a terminal condition, forgets the remediations that no
+// longer count against any limit, and drops the waiting remediations that stopped asking for a slot
+func (l *Limiter) refresh(ctx context.Context, now time.Time) {
+	remediations := l.remediations[:0]
+
+	for _, r := range l.remediations {
+		if r.inProgress && l.finished(ctx, r, now) {
+			r.inProgress = false
+		}
+
+		if r.inProgress || now.Sub(r.createdAt) < hourlyWindow {
+			remediations = append(remediations, r)
+		}
+	}
+
+	l.remediations = remediations
+
+	waiting := l.waiting[:0]
+
+	for _, w := range l.waiting {
+		if now.Sub(w.lastSeen) < l.waitingTimeout {
+			waiting = append(waiting, w)
+		}
+	}
+
+	l.waiting = waiting
+}
+
+// finished reports whether the CR of the remediation reached a terminal condition or was deleted
+func (l *Limiter) finished(ctx context.Context, r *remediation, now time.Time) bool {
+	if l.crStatus == nil {
+		return true
+	}
+
+	switch l.crStatus(ctx, r.actionName, r.crName) {
+	case crstatus.CRStatusSucceeded, crstatus.CRStatusFailed:
+		return true
+	case crstatus.CRStatusNotFound:
+		return now.Sub(r.createdAt) >= notFoundGrace
+	case crstatus.CRStatusInProgress:
+		return false
+	}
+
+	return false
+}
+
+func (l *Limiter) currentUsage(now time.Time) usage {
+	u := usage{concurrent: make(map[limitKey]int), hourly: make(map[limitKey]int)}
+
+	for _, r := range l.remediations {
+		for _, key := range r.keys {
+			if r.inProgress {
+				u.concurrent[key]++
+			}
+
+			if now.Sub(r.createdAt) < hourlyWindow {
+				u.hourly[key]++
+			}
+		}
+	}
+
+	return u
+}
+
+// admissible reports whether the waiting remediation at position fits once every admissible remediation
+// ahead of it has been given a slot
+func (l *Limiter) admissible(position int, now time.Time) bool {
+	u := l.currentUsage(now)
+
+	for i := 0; i <= position; i++ {
+		w := l.waiting[i]
+		if !l.fits(w.keys, u) {
+			continue
+		}
+
+		if i == position {
+			return true
+		}
+
+		for _, key := range w.keys {
+			u.concurrent[key]++
+			u.hourly[key]++
+		}
+	}
+
+	return false
+}
+
+func (l *Limiter) fits(keys []limitKey, u usage) bool {
+	for _, key := range keys {
+		limit := l.limits[limitKey{kind: key.kind, name: key.name}]
+
+		if limit.MaxConcurrent > 0 && u.concurrent[key] >= limit.MaxConcurrent {
+			return false
+		}
+
+		if limit.MaxPerHour > 0 && u.hourly[key] >= limit.MaxPerHour {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (l *Limiter) updateMetrics() {
+	active := 0
+
+	for _, r := range l.remediations {
+		if r.inProgress {
+			active++
+		}
+	}
+
+	metrics.ActiveRemediations.Set(float64(active))
+	metrics.WaitingRemediations.Set(float64(len(l.waiting)))
+}
+
+// RecordsFromNodes returns the maintenance CRs tracked in the remediation state annotation of the nodes,
+// so that remediations created before a restart count against the limits
+func RecordsFromNodes(nodes []corev1.Node) []Record {
+	var records []Record
+
+	for _, node := range nodes {
+		value, ok := node.Annotations[annotation.AnnotationKey]
+		if !ok {
+			continue
+		}
+
+		var state annotation.RemediationStateAnnotation
+		if err := json.Unmarshal([]byte(value), &state); err != nil {
+			continue
+		}
+
+		for _, group := range state.EquivalenceGroups {
+			if group.MaintenanceCR == "" {
+				continue
+			}
+
+			records = append(records, Record{
+				NodeName:   node.Name,
+				ActionName: group.ActionName,
+				CRName:     group.MaintenanceCR,
+				NodeLabels: node.Labels,
+				CreatedAt:  group.CreatedAt,
+			})
+		}
+	}
+
+	return records
+}
@@ -0,0 +1,239 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/nvidia/nvsentinel/fault-remediation/pkg/annotation"
+	"github.com/nvidia/nvsentinel/fault-remediation/pkg/config"
+	"github.com/nvidia/nvsentinel/fault-remediation/pkg/crstatus"
+)
+
+// fakeStatuses returns the status of CRs by name, in progress by default
+type fakeStatuses map[string]crstatus.CRStatus
+
+func (f fakeStatuses) status(_ context.Context, _ string, crName string) crstatus.CRStatus {
+	if status, ok := f[crName]; ok {
+		return status
+	}
+
+	return crstatus.CRStatusInProgress
+}
+
+func acquireAndRecord(t *testing.T, limiter *Limiter, nodeName, actionName, crName string, labels map[string]string) {
+	t.Helper()
+
+	admitted, _ := limiter.Acquire(context.Background(), Request{NodeName: nodeName, ActionName: actionName,
+		NodeLabels: labels})
+	require.True(t, admitted, "expected %s to be admitted", nodeName)
+
+	limiter.Record(Record{NodeName: nodeName, ActionName: actionName, CRName: crName, NodeLabels: labels})
+}
+
+func TestNewLimiterDisabled(t *testing.T) {
+	assert.Nil(t, NewLimiter(config.RateLimitConfig{}, nil))
+}
+
+func TestLimiterConcurrency(t *testing.T) {
+	ctx := context.Background()
+	statuses := fakeStatuses{}
+	limiter := NewLimiter(config.RateLimitConfig{
+		RemediationLimit: config.RemediationLimit{MaxConcurrent: 2},
+	}, statuses.status)
+
+	acquireAndRecord(t, limiter, "node-1", "RESTART_BM", "cr-1", nil)
+	acquireAndRecord(t, limiter, "node-2", "RESTART_BM", "cr-2", nil)
+
+	admitted, position := limiter.Acquire(ctx, Request{NodeName: "node-3", ActionName: "RESTART_BM"})
+	assert.False(t, admitted)
+	assert.Equal(t, 1, position)
+
+	admitted, position = limiter.Acquire(ctx, Request{NodeName: "node-4", ActionName: "RESTART_BM"})
+	assert.False(t, admitted)
+	assert.Equal(t, 2, position)
+
+	// A finished CR frees its slot for the first waiting remediation only
+	statuses["cr-1"] = crstatus.CRStatusSucceeded
+
+	admitted, position = limiter.Acquire(ctx, Request{NodeName: "node-4", ActionName: "RESTART_BM"})
+	assert.False(t, admitted)
+	assert.Equal(t, 2, position)
+
+	admitted, _ = limiter.Acquire(ctx, Request{NodeName: "node-3", ActionName: "RESTART_BM"})
+	assert.True(t, admitted)
+}
+
+func TestLimiterHourlyLimit(t *testing.T) {
+	ctx := context.Background()
+	statuses := fakeStatuses{"old": crstatus.CRStatusSucceeded, "recent": crstatus.CRStatusSucceeded}
+	limiter := NewLimiter(config.RateLimitConfig{
+		Actions: []config.ActionRateLimit{
+			{Action: "REPLACE_VM", RemediationLimit: config.RemediationLimit{MaxPerHour: 1}},
+		},
+	}, statuses.status)
+
+	limiter.Record(Record{NodeName: "node-1", ActionName: "REPLACE_VM", CRName: "old",
+		CreatedAt: time.Now().Add(-2 * time.Hour)})
+	limiter.Record(Record{NodeName: "node-2", ActionName: "REPLACE_VM", CRName: "recent",
+		CreatedAt: time.Now().Add(-30 * time.Minute)})
+
+	// The finished remediation created within the hour still counts against the hourly limit
+	admitted, _ := limiter.Acquire(ctx, Request{NodeName: "node-3", ActionName: "REPLACE_VM"})
+	assert.False(t, admitted)
+
+	// Other actions are not limited
+	admitted, _ = limiter.Acquire(ctx, Request{NodeName: "node-4", ActionName: "RESTART_BM"})
+	assert.True(t, admitted)
+
+	limiter.mu.Lock()
+	limiter.remediations[len(limiter.remediations)-1].createdAt = time.Now().Add(-time.Hour)
+	limiter.mu.Unlock()
+
+	admitted, _ = limiter.Acquire(ctx, Request{NodeName: "node-3", ActionName: "REPLACE_VM"})
+	assert.True(t, admitted)
+}
+
+func TestLimiterNodeGroups(t *testing.T) {
+	ctx := context.Background()
+	limiter := NewLimiter(config.RateLimitConfig{
+		NodeGroups: []config.NodeGroupRateLimit{
+			{Name: "rack", LabelKey: "rack", RemediationLimit: config.RemediationLimit{MaxConcurrent: 1}},
+		},
+	}, fakeStatuses{}.status)
+
+	rackA := map[string]string{"rack": "a"}
+	rackB := map[string]string{"rack": "b"}
+
+	acquireAndRecord(t, limiter, "node-a1", "RESTART_BM", "cr-a1", rackA)
+
+	// A remediation waiting on a full group does not block the other groups
+	admitted, position := limiter.Acquire(ctx, Request{NodeName: "node-a2", ActionName: "RESTART_BM",
+		NodeLabels: rackA})
+	assert.False(t, admitted)
+	assert.Equal(t, 1, position)
+
+	admitted, _ = limiter.Acquire(ctx, Request{NodeName: "node-b1", ActionName: "RESTART_BM", NodeLabels: rackB})
+	assert.True(t, admitted)
+
+	// Nodes without the group label are only limited by the other limits
+	admitted, _ = limiter.Acquire(ctx, Request{NodeName: "node-x", ActionName: "RESTART_BM"})
+	assert.True(t, admitted)
+}
+
+func TestLimiterNotFoundGrace(t *testing.T) {
+	ctx := context.Background()
+	statuses := fakeStatuses{"cr-1": crstatus.CRStatusNotFound}
+	limiter := NewLimiter(config.RateLimitConfig{
+		RemediationLimit: config.RemediationLimit{MaxConcurrent: 1},
+	}, statuses.status)
+
+	acquireAndRecord(t, limiter, "node-1", "RESTART_BM", "cr-1", nil)
+
+	// A CR that is not in the cache yet still holds its slot
+	admitted, _ := limiter.Acquire(ctx, Request{NodeName: "node-2", ActionName: "RESTART_BM"})
+	assert.False(t, admitted)
+
+	limiter.mu.Lock()
+	limiter.remediations[0].createdAt = time.Now().Add(-2 * notFoundGrace)
+	limiter.mu.Unlock()
+
+	admitted, _ = limiter.Acquire(ctx, Request{NodeName: "node-2", ActionName: "RESTART_BM"})
+	assert.True(t, admitted)
+}
+
+func TestLimiterRelease(t *testing.T) {
+	ctx := context.Background()
+	limiter := NewLimiter(config.RateLimitConfig{
+		RemediationLimit: config.RemediationLimit{MaxConcurrent: 1},
+	}, fakeStatuses{}.status)
+
+	acquireAndRecord(t, limiter, "node-1", "RESTART_BM", "cr-1", nil)
+
+	admitted, position := limiter.Acquire(ctx, Request{NodeName: "node-2", ActionName: "RESTART_BM"})
+	assert.False(t, admitted)
+	assert.Equal(t, 1, position)
+
+	admitted, position = limiter.Acquire(ctx, Request{NodeName: "node-3", ActionName: "RESTART_BM"})
+	assert.False(t, admitted)
+	assert.Equal(t, 2, position)
+
+	limiter.Release("node-2")
+
+	admitted, position = limiter.Acquire(ctx, Request{NodeName: "node-3", ActionName: "RESTART_BM"})
+	assert.False(t, admitted)
+	assert.Equal(t, 1, position)
+}
+
+func TestLimiterDropsStaleWaiters(t *testing.T) {
+	ctx := context.Background()
+	statuses := fakeStatuses{}
+	limiter := NewLimiter(config.RateLimitConfig{
+		RemediationLimit: config.RemediationLimit{MaxConcurrent: 1},
+	}, statuses.status)
+
+	acquireAndRecord(t, limiter, "node-1", "RESTART_BM", "cr-1", nil)
+
+	admitted, _ := limiter.Acquire(ctx, Request{NodeName: "node-2", ActionName: "RESTART_BM"})
+	assert.False(t, admitted)
+
+	limiter.mu.Lock()
+	limiter.waiting[0].lastSeen = time.Now().Add(-2 * limiter.waitingTimeout)
+	limiter.mu.Unlock()
+
+	statuses["cr-1"] = crstatus.CRStatusFailed
+
+	admitted, _ = limiter.Acquire(ctx, Request{NodeName: "node-3", ActionName: "RESTART_BM"})
+	assert.True(t, admitted)
+}
+
+func TestRecordsFromNodes(t *testing.T) {
+	createdAt := time.Now().Add(-10 * time.Minute).UTC().Truncate(time.Second)
+
+	nodes := []corev1.Node{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "node-1",
+				Labels: map[string]string{"rack": "a"},
+				Annotations: map[string]string{
+					annotation.AnnotationKey: `{"equivalenceGroups":{"restart":{"maintenanceCR":"cr-1",` +
+						`"createdAt":"` + createdAt.Format(time.RFC3339) + `","actionName":"RESTART_BM"}}}`,
+				},
+			},
+		},
+		{ObjectMeta: metav1.ObjectMeta{Name: "node-2"}},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "node-3",
+				Annotations: map[string]string{annotation.AnnotationKey: "invalid"},
+			},
+		},
+	}
+
+	records := RecordsFromNodes(nodes)
+	require.Len(t, records, 1)
+	assert.Equal(t, "node-1", records[0].NodeName)
+	assert.Equal(t, "RESTART_BM", records[0].ActionName)
+	assert.Equal(t, "cr-1", records[0].CRName)
+	assert.Equal(t, "a", records[0].NodeLabels["rack"])
+	assert.True(t, createdAt.Equal(records[0].CreatedAt))
+}
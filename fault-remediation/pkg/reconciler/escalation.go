@@ -0,0 +1,433 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reconciler
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/protobuf/proto"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/nvidia/nvsentinel/commons/pkg/statemanager"
+	"github.com/nvidia/nvsentinel/commons/pkg/tracing"
+	"github.com/nvidia/nvsentinel/data-models/pkg/model"
+	"github.com/nvidia/nvsentinel/data-models/pkg/protos"
+	"github.com/nvidia/nvsentinel/fault-remediation/pkg/annotation"
+	"github.com/nvidia/nvsentinel/fault-remediation/pkg/common"
+	"github.com/nvidia/nvsentinel/fault-remediation/pkg/crstatus"
+	"github.com/nvidia/nvsentinel/fault-remediation/pkg/events"
+	"github.com/nvidia/nvsentinel/fault-remediation/pkg/metrics"
+	"github.com/nvidia/nvsentinel/store-client/pkg/datastore"
+)
+
+// attemptLimitPeriod is the period the escalation attempt limit of a node applies to
+const attemptLimitPeriod = 24 * time.Hour
+
+type escalationOutcome string
+
+const (
+	// escalationProceed remediates the event with the action of the step
+	escalationProceed escalationOutcome = "proceed"
+	// escalationInProgress skips the event while the CR of the previous step is in progress
+	escalationInProgress escalationOutcome = "in_progress"
+	// escalationStopped fails the remediation because the ladder cannot go any further
+	escalationStopped escalationOutcome = "stopped"
+)
+
+// Reasons an escalation ladder stops
+const (
+	escalationStopExhausted      = "exhausted"
+	escalationStopNoResource     = "no_maintenance_resource"
+	escalationStopAttemptLimit   = "attempt_limit"
+	escalationStopGroupConfigErr = "group_config_error"
+)
+
+// escalationStep is the step of the escalation ladder of a fault taken for an event
+type escalationStep struct {
+	// group is the equivalence group of the recommended action, which the ladder is tracked under
+	group      string
+	index      int
+	actionName string
+	outcome    escalationOutcome
+
+	// trigger is why the ladder climbed to this step: "cr_failed", "recurrence" or "verification_failed",
+	// empty for the first step
+	trigger string
+	// stopReason is why the ladder stopped, for escalationStopped
+	stopReason string
+	// maintenanceCR is the CR of the previous step, for escalationInProgress
+	maintenanceCR string
+}
+
+// remediationPlan is the event and group config a maintenance CR is created for, and the escalation step it
+// takes, if any
+type remediationPlan struct {
+	event       *events.HealthEventDoc
+	groupConfig *common.EquivalenceGroupConfig
+	escalation  *escalationStep
+
+	// nodeLabels are the labels of the node the node groups of the rate limits are matched against
+	nodeLabels map[string]string
+}
+
+// planRemediation applies the escalation ladder of the recommended action to the event. It returns
+// (plan, zero, nil, false) to remediate the event with the plan, or (nil, result, err, true) when the event
+// was handled: skipped while the CR of the previous step is in progress, or failed because the ladder stopped.
+func (r *FaultRemediationReconciler) planRemediation(
+	ctx context.Context,
+	healthEventWithStatus *events.HealthEventDoc,
+	groupConfig *common.EquivalenceGroupConfig,
+	eventWithToken datastore.EventWithToken,
+	watcherInstance datastore.ChangeStreamWatcher,
+	healthEventStore datastore.HealthEventStore,
+) (*remediationPlan, ctrl.Result, error, bool) {
+	span := tracing.SpanFromContext(ctx)
+	nodeName := healthEventWithStatus.HealthEvent.NodeName
+	plan := &remediationPlan{event: healthEventWithStatus, groupConfig: groupConfig}
+
+	step, err := r.resolveEscalation(ctx, healthEventWithStatus.HealthEvent, groupConfig)
+	if err != nil {
+		metrics.ProcessingErrors.WithLabelValues("escalation_error", nodeName).Inc()
+		slog.ErrorContext(ctx, "Error resolving remediation escalation", "node", nodeName, "error", err)
+		tracing.RecordError(span, err)
+
+		return nil, ctrl.Result{}, fmt.Errorf("error resolving remediation escalation: %w", err), true
+	}
+
+	if step == nil {
+		return plan, ctrl.Result{}, nil, false
+	}
+
+	switch step.outcome {
+	case escalationInProgress:
+		res, err := r.handleExistingCRSkip(ctx, eventWithToken, watcherInstance, nodeName, step.maintenanceCR)
+		return nil, res, err, true
+	case escalationStopped:
+		res, err := r.handleStoppedEscalation(ctx, step, eventWithToken, watcherInstance, healthEventStore, nodeName)
+		return nil, res, err, true
+	case escalationProceed:
+	}
+
+	span.SetAttributes(
+		attribute.Int("fault_remediation.escalation.step", step.index),
+		attribute.String("fault_remediation.escalation.action", step.actionName),
+	)
+
+	plan.escalation = step
+
+	if step.index == 0 {
+		return plan, ctrl.Result{}, nil, false
+	}
+
+	plan.event, plan.groupConfig, err = r.escalateEvent(healthEventWithStatus, step)
+	if err != nil {
+		slog.ErrorContext(ctx, "Error building escalated remediation", "node", nodeName, "error", err)
+		res, err := r.handleStoppedEscalation(ctx, step.stop(escalationStopGroupConfigErr), eventWithToken,
+			watcherInstance, healthEventStore, nodeName)
+
+		return nil, res, err, true
+	}
+
+	slog.InfoContext(ctx, "Escalating remediation",
+		"node", nodeName,
+		"group", step.group,
+		"step", step.index,
+		"action", step.actionName,
+		"trigger", step.trigger)
+
+	shouldCreateCR, existingCR, err := r.checkExistingCRStatus(ctx, plan.event.HealthEvent, plan.groupConfig)
+	if err != nil {
+		metrics.ProcessingErrors.WithLabelValues("cr_status_check_error", nodeName).Inc()
+		tracing.RecordError(span, err)
+
+		return nil, ctrl.Result{}, fmt.Errorf("error checking existing CR status: %w", err), true
+	}
+
+	if !shouldCreateCR {
+		res, err := r.handleExistingCRSkip(ctx, eventWithToken, watcherInstance, nodeName, existingCR)
+		return nil, res, err, true
+	}
+
+	return plan, ctrl.Result{}, nil, false
+}
+
+// resolveEscalation picks the step of the escalation ladder to take for the event. The ladder climbs one
+// step when the CR of the previous step failed or its remediation failed verification, or when the fault
+// recurs before the previous step expires.
+// It returns nil when the recommended action has no escalation chain.
+func (r *FaultRemediationReconciler) resolveEscalation(ctx context.Context, healthEvent *protos.HealthEvent,
+	groupConfig *common.EquivalenceGroupConfig) (*escalationStep, error) {
+	cfg := r.Config.RemediationClient.GetConfig()
+
+	ladder := cfg.Escalation.Ladder(model.GetEffectiveActionName(healthEvent))
+	if ladder == nil || groupConfig == nil {
+		return nil, nil
+	}
+
+	nodeName := healthEvent.NodeName
+
+	state, _, err := r.annotationManager.GetRemediationState(ctx, nodeName)
+	if err != nil {
+		return nil, fmt.Errorf("error getting remediation state: %w", err)
+	}
+
+	now := time.Now().UTC()
+	step := &escalationStep{group: groupConfig.EffectiveEquivalenceGroup, outcome: escalationProceed}
+
+	previous, ok := state.Escalations[step.group]
+	if ok && now.Before(previous.ExpiresAt) && previous.VerificationFailed {
+		step.index = previous.Step + 1
+		step.trigger = "verification_failed"
+	} else if ok && now.Before(previous.ExpiresAt) {
+		switch r.escalationCRStatus(ctx, previous) {
+		case crstatus.CRStatusInProgress:
+			step.outcome = escalationInProgress
+			step.maintenanceCR = previous.MaintenanceCR
+
+			return step, nil
+		case crstatus.CRStatusFailed:
+			step.index = previous.Step + 1
+			step.trigger = "cr_failed"
+		case crstatus.CRStatusSucceeded, crstatus.CRStatusNotFound:
+			step.index = previous.Step + 1
+			step.trigger = "recurrence"
+		}
+	}
+
+	if step.index >= len(ladder) {
+		return step.stop(escalationStopExhausted), nil
+	}
+
+	step.actionName = ladder[step.index]
+
+	if _, ok := cfg.RemediationActions[step.actionName]; !ok {
+		return step.stop(escalationStopNoResource), nil
+	}
+
+	limit := cfg.Escalation.MaxAttemptsPerDay
+	if limit > 0 && state.AttemptsSince(now.Add(-attemptLimitPeriod)) >= limit {
+		return step.stop(escalationStopAttemptLimit), nil
+	}
+
+	return step, nil
+}
+
+func (s *escalationStep) stop(reason string) *escalationStep {
+	s.outcome = escalationStopped
+	s.stopReason = reason
+
+	return s
+}
+
+func (r *FaultRemediationReconciler) escalationCRStatus(ctx context.Context,
+	previous annotation.EscalationState) crstatus.CRStatus {
+	statusChecker := r.Config.RemediationClient.GetStatusChecker()
+	if statusChecker == nil {
+		return crstatus.CRStatusNotFound
+	}
+
+	return statusChecker.GetCRStatus(ctx, previous.ActionName, previous.MaintenanceCR)
+}
+
+// escalateEvent returns a copy of the event recommending the action of the step, and its group config
+func (r *FaultRemediationReconciler) escalateEvent(healthEventWithStatus *events.HealthEventDoc,
+	step *escalationStep) (*events.HealthEventDoc, *common.EquivalenceGroupConfig, error) {
+	healthEvent, ok := proto.Clone(healthEventWithStatus.HealthEvent).(*protos.HealthEvent)
+	if !ok {
+		return nil, nil, fmt.Errorf("failed to clone health event")
+	}
+
+	value, builtin := protos.RecommendedAction_value[step.actionName]
+	if builtin && protos.RecommendedAction(value) != protos.RecommendedAction_CUSTOM {
+		healthEvent.RecommendedAction = protos.RecommendedAction(value)
+		healthEvent.CustomRecommendedAction = ""
+	} else {
+		healthEvent.RecommendedAction = protos.RecommendedAction_CUSTOM
+		healthEvent.CustomRecommendedAction = step.actionName
+	}
+
+	escalated := *healthEventWithStatus
+	escalated.HealthEvent = healthEvent
+
+	escalatedGroupConfig, err := common.GetGroupConfigForEvent(
+		r.Config.RemediationClient.GetConfig().RemediationActions, healthEvent)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if escalatedGroupConfig == nil {
+		return nil, nil, fmt.Errorf("no remediation configuration found for action %s", step.actionName)
+	}
+
+	return &escalated, escalatedGroupConfig, nil
+}
+
+// recordEscalation records the step taken once its maintenance CR was created for the event. It returns
+// whether the step was recorded.
+func (r *FaultRemediationReconciler) recordEscalation(ctx context.Context, nodeName string,
+	step *escalationStep, crName string, eventID string) bool {
+	now := time.Now().UTC()
+	window := r.Config.RemediationClient.GetConfig().Escalation.RecurrenceWindow()
+
+	if step.index > 0 {
+		metrics.RemediationEscalations.WithLabelValues(step.actionName, step.trigger).Inc()
+	}
+
+	if err := r.annotationManager.RecordEscalation(ctx, nodeName, step.group, annotation.EscalationState{
+		Step:          step.index,
+		ActionName:    step.actionName,
+		MaintenanceCR: crName,
+		CreatedAt:     now,
+		ExpiresAt:     now.Add(window),
+		EventID:       eventID,
+	}); err != nil {
+		metrics.ProcessingErrors.WithLabelValues("record_escalation_error", nodeName).Inc()
+		slog.ErrorContext(ctx, "Failed to record remediation escalation", "node", nodeName, "error", err)
+
+		return false
+	}
+
+	return true
+}
+
+// continueEscalation returns (result, err, true) while the maintenance CR of the escalation step taken for
+// the event is in progress: the event is requeued until the CR finishes, then marked remediated and
+// processed. When the CR or the verification of its remediation failed, it returns (zero, nil, false) so
+// that the event is remediated with the next step of the ladder. It also returns (zero, nil, false) when no
+// escalation step is pending for the event.
+func (r *FaultRemediationReconciler) continueEscalation(
+	ctx context.Context,
+	healthEventWithStatus *events.HealthEventDoc,
+	eventWithToken datastore.EventWithToken,
+	watcherInstance datastore.ChangeStreamWatcher,
+	healthEventStore datastore.HealthEventStore,
+) (ctrl.Result, error, bool) {
+	if r.Config.RemediationClient.GetStatusChecker() == nil {
+		return ctrl.Result{}, nil, false
+	}
+
+	nodeName := healthEventWithStatus.HealthEvent.NodeName
+
+	state, _, err := r.annotationManager.GetRemediationState(ctx, nodeName)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to get remediation escalation", "node", nodeName, "error", err)
+		return ctrl.Result{}, nil, false
+	}
+
+	previous, ok := pendingEscalation(state, healthEventWithStatus.ID)
+	if !ok || previous.VerificationFailed {
+		return ctrl.Result{}, nil, false
+	}
+
+	span := tracing.SpanFromContext(ctx)
+	poll := ctrl.Result{RequeueAfter: r.Config.RemediationClient.GetConfig().Escalation.PollInterval()}
+
+	switch r.escalationCRStatus(ctx, previous) {
+	case crstatus.CRStatusInProgress:
+		span.SetAttributes(attribute.String("fault_remediation.status", "pending_escalation_step"))
+		return poll, nil, true
+	case crstatus.CRStatusFailed:
+		slog.InfoContext(ctx, "Maintenance CR of escalation step failed, escalating",
+			"node", nodeName,
+			"step", previous.Step,
+			"action", previous.ActionName,
+			"maintenanceCR", previous.MaintenanceCR)
+
+		return ctrl.Result{}, nil, false
+	case crstatus.CRStatusNotFound:
+		if time.Since(previous.CreatedAt) < crNotFoundGrace {
+			return poll, nil, true
+		}
+	case crstatus.CRStatusSucceeded:
+	}
+
+	if err := r.updateNodeRemediatedStatus(ctx, healthEventStore, eventWithToken, true); err != nil {
+		metrics.ProcessingErrors.WithLabelValues("update_status_error", nodeName).Inc()
+		slog.ErrorContext(ctx, "Error updating remediation status for node", "error", err)
+		tracing.RecordError(span, err)
+
+		return ctrl.Result{}, err, true
+	}
+
+	res, err := r.markProcessedOrError(ctx, watcherInstance, eventWithToken, nodeName)
+
+	return res, err, true
+}
+
+// pendingEscalation returns the escalation step taken for the event, if any
+func pendingEscalation(state *annotation.RemediationStateAnnotation, eventID string) (annotation.EscalationState,
+	bool) {
+	for _, escalation := range state.Escalations {
+		if escalation.EventID != "" && escalation.EventID == eventID {
+			return escalation, true
+		}
+	}
+
+	return annotation.EscalationState{}, false
+}
+
+// handleStoppedEscalation fails the remediation of an event whose escalation ladder stopped: the node is
+// labeled remediation-failed and left for an operator
+func (r *FaultRemediationReconciler) handleStoppedEscalation(
+	ctx context.Context,
+	step *escalationStep,
+	eventWithToken datastore.EventWithToken,
+	watcherInstance datastore.ChangeStreamWatcher,
+	healthEventStore datastore.HealthEventStore,
+	nodeName string,
+) (ctrl.Result, error) {
+	span := tracing.SpanFromContext(ctx)
+
+	slog.WarnContext(ctx, "Escalation chain stopped, remediation left to an operator",
+		"node", nodeName,
+		"group", step.group,
+		"step", step.index,
+		"action", step.actionName,
+		"reason", step.stopReason)
+
+	span.SetAttributes(
+		attribute.String("fault_remediation.skip_reason", "escalation_stopped"),
+		attribute.String("fault_remediation.escalation.stop_reason", step.stopReason),
+	)
+
+	metrics.EscalationsStopped.WithLabelValues(step.stopReason, nodeName).Inc()
+
+	if _, err := r.Config.StateManager.UpdateNVSentinelStateNodeLabel(ctx, nodeName,
+		statemanager.RemediationFailedLabelValue, false); err != nil {
+		slog.ErrorContext(ctx, "Error updating node label",
+			"label", statemanager.RemediationFailedLabelValue,
+			"error", err)
+		metrics.ProcessingErrors.WithLabelValues("label_update_error", nodeName).Inc()
+		tracing.RecordError(span, err)
+		span.SetAttributes(
+			attribute.String("fault_remediation.error.type", "label_update_error"),
+			attribute.String("fault_remediation.error.message", err.Error()),
+		)
+	}
+
+	if err := r.updateNodeRemediatedStatus(ctx, healthEventStore, eventWithToken, false); err != nil {
+		metrics.ProcessingErrors.WithLabelValues("update_status_error", nodeName).Inc()
+		slog.ErrorContext(ctx, "Error updating remediation status for node", "error", err)
+		tracing.RecordError(span, err)
+
+		return ctrl.Result{}, err
+	}
+
+	return r.markProcessedOrError(ctx, watcherInstance, eventWithToken, nodeName)
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reconciler
+
+import (
+	"context"
+	"log/slog"
+
+	"go.opentelemetry.io/otel/attribute"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/nvidia/nvsentinel/commons/pkg/statemanager"
+	"github.com/nvidia/nvsentinel/commons/pkg/tracing"
+	"github.com/nvidia/nvsentinel/data-models/pkg/model"
+	"github.com/nvidia/nvsentinel/fault-remediation/pkg/metrics"
+	"github.com/nvidia/nvsentinel/fault-remediation/pkg/ratelimit"
+)
+
+const (
+	holdReasonEmergencyStop = "emergency_stop"
+	holdReasonRateLimit     = "rate_limit"
+)
+
+// holdForRateLimit returns (result, true) when the maintenance CR of the plan may not be created now: the
+// emergency stop is active, or the remediation rate limits are reached. The node is labeled
+// remediation-waiting and the event is requeued without being marked processed, so it is remediated once
+// the remediation is allowed, including after a restart.
+func (r *FaultRemediationReconciler) holdForRateLimit(
+	ctx context.Context,
+	plan *remediationPlan,
+	nodeName string,
+) (ctrl.Result, bool) {
+	if r.Config.EmergencyStop == nil && r.Config.RateLimiter == nil {
+		return ctrl.Result{}, false
+	}
+
+	_, node, err := r.annotationManager.GetRemediationState(ctx, nodeName)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to get node for remediation rate limits", "node", nodeName, "error", err)
+	} else if node != nil {
+		plan.nodeLabels = node.Labels
+	}
+
+	if r.emergencyStopped(ctx, nodeName) {
+		return r.holdRemediation(ctx, plan, nodeName, holdReasonEmergencyStop, 0), true
+	}
+
+	if r.Config.RateLimiter == nil {
+		return ctrl.Result{}, false
+	}
+
+	admitted, position := r.Config.RateLimiter.Acquire(ctx, ratelimit.Request{
+		NodeName:   nodeName,
+		ActionName: model.GetEffectiveActionName(plan.event.HealthEvent),
+		NodeLabels: plan.nodeLabels,
+	})
+	if admitted {
+		return ctrl.Result{}, false
+	}
+
+	return r.holdRemediation(ctx, plan, nodeName, holdReasonRateLimit, position), true
+}
+
+// emergencyStopped reports whether the emergency stop is active. Remediation is paused when the switch
+// cannot be read.
+func (r *FaultRemediationReconciler) emergencyStopped(ctx context.Context, nodeName string) bool {
+	if r.Config.EmergencyStop == nil {
+		return false
+	}
+
+	stopped, err := r.Config.EmergencyStop.IsStopped(ctx)
+	if err != nil {
+		metrics.ProcessingErrors.WithLabelValues("emergency_stop_check_error", nodeName).Inc()
+		slog.ErrorContext(ctx, "Failed to read emergency stop, pausing remediation", "node", nodeName, "error", err)
+
+		return true
+	}
+
+	return stopped
+}
+
+func (r *FaultRemediationReconciler) holdRemediation(
+	ctx context.Context,
+	plan *remediationPlan,
+	nodeName string,
+	reason string,
+	position int,
+) ctrl.Result {
+	span := tracing.SpanFromContext(ctx)
+	actionName := model.GetEffectiveActionName(plan.event.HealthEvent)
+
+	r.markRemediationWaiting(ctx, nodeName, plan.nodeLabels)
+
+	if reason == holdReasonEmergencyStop {
+		slog.WarnContext(ctx, "Emergency stop is active, holding remediation",
+			"node", nodeName,
+			"action", actionName)
+	} else {
+		slog.InfoContext(ctx, "Remediation rate limit reached, remediation is waiting",
+			"node", nodeName,
+			"action", actionName,
+			"position", position)
+	}
+
+	span.SetAttributes(
+		attribute.String("fault_remediation.status", "pending_rate_limit"),
+		attribute.String("fault_remediation.rate_limit.reason", reason),
+	)
+
+	metrics.EventsHeldForRateLimit.WithLabelValues(reason).Inc()
+
+	return ctrl.Result{RequeueAfter: r.Config.RemediationClient.GetConfig().RateLimit.RequeueInterval()}
+}
+
+// markRemediationWaiting labels the node remediation-waiting unless nodeLabels show it already is
+func (r *FaultRemediationReconciler) markRemediationWaiting(
+	ctx context.Context,
+	nodeName string,
+	nodeLabels map[string]string,
+) {
+	if nodeLabels[statemanager.NVSentinelStateLabelKey] == string(statemanager.RemediationWaitingLabelValue) {
+		return
+	}
+
+	if _, err := r.Config.StateManager.UpdateNVSentinelStateNodeLabel(ctx, nodeName,
+		statemanager.RemediationWaitingLabelValue, false); err != nil {
+		slog.ErrorContext(ctx, "Failed to update node label to remediation-waiting",
+			"node", nodeName,
+			"error", err)
+		metrics.ProcessingErrors.WithLabelValues("label_update_error", nodeName).Inc()
+	}
+}
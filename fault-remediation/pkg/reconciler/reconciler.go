@@ -34,6 +34,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"github.com/nvidia/nvsentinel/commons/pkg/eventutil"
+	"github.com/nvidia/nvsentinel/commons/pkg/schedule"
 	"github.com/nvidia/nvsentinel/commons/pkg/statemanager"
 	"github.com/nvidia/nvsentinel/commons/pkg/tracing"
 	"github.com/nvidia/nvsentinel/data-models/pkg/model"
@@ -42,6 +43,7 @@ import (
 	"github.com/nvidia/nvsentinel/fault-remediation/pkg/common"
 	"github.com/nvidia/nvsentinel/fault-remediation/pkg/events"
 	"github.com/nvidia/nvsentinel/fault-remediation/pkg/metrics"
+	"github.com/nvidia/nvsentinel/fault-remediation/pkg/ratelimit"
 	"github.com/nvidia/nvsentinel/fault-remediation/pkg/remediation"
 	nvstoreclient "github.com/nvidia/nvsentinel/store-client/pkg/client"
 	"github.com/nvidia/nvsentinel/store-client/pkg/datastore"
@@ -61,6 +63,12 @@ type ReconcilerConfig struct {
 	EnableLogCollector bool
 	UpdateMaxRetries   int
 	UpdateRetryDelay   time.Duration
+	// Schedules holds the compiled maintenance schedules referenced by remediation actions
+	Schedules map[string]*schedule.Schedule
+	// RateLimiter limits the maintenance CRs created; nil when remediations are not limited
+	RateLimiter *ratelimit.Limiter
+	// EmergencyStop pauses the creation of maintenance CRs; nil when the emergency stop is disabled
+	EmergencyStop *ratelimit.EmergencyStop
 }
 
 // FaultRemediationReconciler reconciles health events from a datastore change stream
@@ -381,6 +389,10 @@ func (r *FaultRemediationReconciler) handleCancellationEvent(
 		"node", nodeName,
 		"status", status)
 
+	if r.Config.RateLimiter != nil {
+		r.Config.RateLimiter.Release(nodeName)
+	}
+
 	if err := r.annotationManager.ClearRemediationState(ctx, nodeName); err != nil {
 		slog.ErrorContext(ctx, "Failed to clear remediation state for node",
 			"node", nodeName,
@@ -433,6 +445,22 @@ func (r *FaultRemediationReconciler) handleRemediationEvent(
 		return res, err
 	}
 
+	res, err, done = r.continueVerification(ctx, healthEventWithStatus, eventWithToken, watcherInstance,
+		healthEventStore)
+	if done {
+		return res, err
+	}
+
+	res, err, done = r.continueEscalation(ctx, healthEventWithStatus, eventWithToken, watcherInstance,
+		healthEventStore)
+	if done {
+		return res, err
+	}
+
+	if res, held := r.holdUntilMaintenanceWindow(ctx, healthEvent, nodeName); held {
+		return res, nil
+	}
+
 	shouldCreateCR, existingCR, err := r.checkExistingCRStatus(ctx, healthEvent, groupConfig)
 	if err != nil {
 		metrics.ProcessingErrors.WithLabelValues("cr_status_check_error", nodeName).Inc()
@@ -451,8 +479,18 @@ func (r *FaultRemediationReconciler) handleRemediationEvent(
 		return r.handleExistingCRSkip(ctx, eventWithToken, watcherInstance, nodeName, existingCR)
 	}
 
-	result, err := r.runLogCollectorAndRemediate(ctx, healthEvent, healthEventWithStatus, eventWithToken,
-		watcherInstance, healthEventStore, groupConfig, nodeName)
+	plan, res, err, done := r.planRemediation(ctx, healthEventWithStatus, groupConfig, eventWithToken,
+		watcherInstance, healthEventStore)
+	if done {
+		return res, err
+	}
+
+	if res, held := r.holdForRateLimit(ctx, plan, nodeName); held {
+		return res, nil
+	}
+
+	result, err := r.runLogCollectorAndRemediate(ctx, healthEvent, plan, eventWithToken,
+		watcherInstance, healthEventStore, nodeName)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
@@ -466,6 +504,74 @@ func (r *FaultRemediationReconciler) handleRemediationEvent(
 	return r.markProcessedOrError(ctx, watcherInstance, eventWithToken, nodeName)
 }
 
+// holdUntilMaintenanceWindow returns (result, true) when the event's action is gated by a maintenance schedule
+// that is currently closed. The node is labeled remediation-waiting and the event is requeued for when the
+// window opens without being marked processed, so cold start picks it up again after a restart. Fatal events
+// are never held.
+func (r *FaultRemediationReconciler) holdUntilMaintenanceWindow(
+	ctx context.Context,
+	healthEvent *protos.HealthEvent,
+	nodeName string,
+) (ctrl.Result, bool) {
+	actionName := model.GetEffectiveActionName(healthEvent)
+	scheduleName := r.Config.RemediationClient.GetConfig().RemediationActions[actionName].Schedule
+
+	sched := r.Config.Schedules[scheduleName]
+	if sched == nil || healthEvent.IsFatal {
+		return ctrl.Result{}, false
+	}
+
+	span := tracing.SpanFromContext(ctx)
+	now := time.Now()
+
+	nextOpen, found := sched.NextOpen(now)
+	if found && !nextOpen.After(now) {
+		if err := r.annotationManager.SetNextMaintenanceWindow(ctx, nodeName, time.Time{}); err != nil {
+			slog.WarnContext(ctx, "Failed to clear next maintenance window annotation", "node", nodeName, "error", err)
+		}
+
+		return ctrl.Result{}, false
+	}
+
+	// Without an upcoming window the event is rechecked periodically in case the schedule changes
+	requeueAfter := time.Hour
+
+	if found {
+		requeueAfter = time.Until(nextOpen)
+
+		if err := r.annotationManager.SetNextMaintenanceWindow(ctx, nodeName, nextOpen); err != nil {
+			metrics.ProcessingErrors.WithLabelValues("set_maintenance_window_annotation_error", nodeName).Inc()
+			slog.ErrorContext(ctx, "Failed to set next maintenance window annotation", "node", nodeName, "error", err)
+		}
+	}
+
+	var nodeLabels map[string]string
+
+	_, node, err := r.annotationManager.GetRemediationState(ctx, nodeName)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to get node for maintenance window hold", "node", nodeName, "error", err)
+	} else if node != nil {
+		nodeLabels = node.Labels
+	}
+
+	r.markRemediationWaiting(ctx, nodeName, nodeLabels)
+
+	slog.InfoContext(ctx, "Holding remediation until maintenance window opens",
+		"node", nodeName,
+		"action", actionName,
+		"schedule", scheduleName,
+		"requeueAfter", requeueAfter)
+
+	span.SetAttributes(
+		attribute.String("fault_remediation.status", "pending_maintenance_window"),
+		attribute.String("fault_remediation.maintenance_window.schedule", scheduleName),
+	)
+
+	metrics.EventsHeldForMaintenanceWindow.WithLabelValues(scheduleName).Inc()
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, true
+}
+
 // trySkipEvent returns (result, err, true) when the event should be skipped; otherwise (zero, nil, false).
 func (r *FaultRemediationReconciler) trySkipEvent(
 	ctx context.Context,
@@ -516,20 +622,20 @@ func (r *FaultRemediationReconciler) handleExistingCRSkip(
 }
 
 // runLogCollectorAndRemediate runs the log collector, then performs remediation and updates status.
-// Returns a non-zero ctrl.Result if the log collector requested a requeue; otherwise Result{}, and any error.
+// Returns a non-zero ctrl.Result if the log collector requested a requeue or the remediation is being
+// verified; otherwise Result{}, and any error.
 func (r *FaultRemediationReconciler) runLogCollectorAndRemediate(
 	ctx context.Context,
 	healthEvent *protos.HealthEvent,
-	healthEventWithStatus *events.HealthEventDoc,
+	plan *remediationPlan,
 	eventWithToken datastore.EventWithToken,
 	_ datastore.ChangeStreamWatcher,
 	healthEventStore datastore.HealthEventStore,
-	groupConfig *common.EquivalenceGroupConfig,
 	nodeName string,
 ) (ctrl.Result, error) {
 	span := tracing.SpanFromContext(ctx)
 
-	result, err := r.runLogCollector(ctx, healthEvent, healthEventWithStatus.ID)
+	result, err := r.runLogCollector(ctx, healthEvent, plan.event.ID)
 	if err != nil {
 		return ctrl.Result{}, fmt.Errorf("error running log collector: %w", err)
 	}
@@ -538,7 +644,33 @@ func (r *FaultRemediationReconciler) runLogCollectorAndRemediate(
 		return result, nil
 	}
 
-	_, performRemediationErr := r.performRemediation(ctx, healthEventWithStatus, groupConfig)
+	crName, performRemediationErr := r.performRemediation(ctx, plan.event, plan.groupConfig)
+
+	escalationRecorded := performRemediationErr == nil && plan.escalation != nil &&
+		r.recordEscalation(ctx, nodeName, plan.escalation, crName, plan.event.ID)
+
+	if performRemediationErr == nil && r.Config.RateLimiter != nil {
+		r.Config.RateLimiter.Record(ratelimit.Record{
+			NodeName:   nodeName,
+			ActionName: model.GetEffectiveActionName(plan.event.HealthEvent),
+			CRName:     crName,
+			NodeLabels: plan.nodeLabels,
+		})
+	}
+
+	if performRemediationErr == nil && r.Config.RemediationClient.GetConfig().Verification.Enabled &&
+		r.startVerification(ctx, plan, crName, nodeName) {
+		metrics.EventsProcessed.WithLabelValues(metrics.CRStatusCreated, nodeName).Inc()
+
+		return ctrl.Result{RequeueAfter: r.Config.RemediationClient.GetConfig().Verification.PollInterval()}, nil
+	}
+
+	// The event of an escalation step stays pending until its CR finishes, so that a failed CR escalates
+	if escalationRecorded && r.Config.RemediationClient.GetStatusChecker() != nil {
+		metrics.EventsProcessed.WithLabelValues(metrics.CRStatusCreated, nodeName).Inc()
+
+		return ctrl.Result{RequeueAfter: r.Config.RemediationClient.GetConfig().Escalation.PollInterval()}, nil
+	}
 
 	nodeRemediatedStatus := performRemediationErr == nil
 	if performRemediationErr != nil {
@@ -23,9 +23,13 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
 	ctrl "sigs.k8s.io/controller-runtime"
 
+	"github.com/nvidia/nvsentinel/commons/pkg/schedule"
 	"github.com/nvidia/nvsentinel/commons/pkg/statemanager"
 	"github.com/nvidia/nvsentinel/data-models/pkg/model"
 	"github.com/nvidia/nvsentinel/data-models/pkg/protos"
@@ -34,6 +38,7 @@ import (
 	"github.com/nvidia/nvsentinel/fault-remediation/pkg/config"
 	"github.com/nvidia/nvsentinel/fault-remediation/pkg/crstatus"
 	"github.com/nvidia/nvsentinel/fault-remediation/pkg/events"
+	"github.com/nvidia/nvsentinel/fault-remediation/pkg/ratelimit"
 	"github.com/nvidia/nvsentinel/store-client/pkg/client"
 	"github.com/nvidia/nvsentinel/store-client/pkg/datastore"
 )
@@ -43,8 +48,10 @@ type MockK8sClient struct {
 	createMaintenanceResourceFn func(ctx context.Context, healthEventData *events.HealthEventData,
 		groupConfig *common.EquivalenceGroupConfig) (string, error)
 	runLogCollectorJobFn      func(ctx context.Context, nodeName string) (ctrl.Result, error)
+	runValidationJobFn        func(ctx context.Context, nodeName string) (ctrl.Result, bool, error)
 	annotationManagerOverride annotation.NodeAnnotationManagerInterface
 	mockStatusChecker         *mockStatusChecker
+	configOverride            *config.TomlConfig
 }
 
 func (m *MockK8sClient) CreateMaintenanceResource(ctx context.Context, healthEventData *events.HealthEventData, groupConfig *common.EquivalenceGroupConfig) (string, error) {
@@ -55,6 +62,10 @@ func (m *MockK8sClient) RunLogCollectorJob(ctx context.Context, nodeName string,
 	return m.runLogCollectorJobFn(ctx, nodeName)
 }
 
+func (m *MockK8sClient) RunValidationJob(ctx context.Context, nodeName string, eventId string) (ctrl.Result, bool, error) {
+	return m.runValidationJobFn(ctx, nodeName)
+}
+
 func (m *MockK8sClient) GetAnnotationManager() annotation.NodeAnnotationManagerInterface {
 	return m.annotationManagerOverride
 }
@@ -66,6 +77,7 @@ func (m *MockK8sClient) GetStatusChecker() crstatus.CRStatusCheckerInterface {
 type mockStatusChecker struct {
 	shouldSkip []bool
 	callCount  int
+	crStatus   crstatus.CRStatus
 }
 
 func (statusChecker *mockStatusChecker) ShouldSkipCRCreation(context.Context, string, string) bool {
@@ -76,7 +88,15 @@ func (statusChecker *mockStatusChecker) ShouldSkipCRCreation(context.Context, st
 	return shouldSkip
 }
 
+func (statusChecker *mockStatusChecker) GetCRStatus(context.Context, string, string) crstatus.CRStatus {
+	return statusChecker.crStatus
+}
+
 func (m *MockK8sClient) GetConfig() *config.TomlConfig {
+	if m.configOverride != nil {
+		return m.configOverride
+	}
+
 	return &config.TomlConfig{
 		RemediationActions: map[string]config.MaintenanceResource{
 			protos.RecommendedAction_RESTART_BM.String(): {
@@ -124,18 +144,32 @@ func (w *MockCRStatusCheckerWrapper) IsSuccessful(ctx context.Context, crName st
 }
 
 type MockNodeAnnotationManager struct {
-	existingCRs map[string]string
+	existingCRs   map[string]string
+	escalations   map[string]annotation.EscalationState
+	attempts      []time.Time
+	verifications map[string]annotation.VerificationState
+	node          *corev1.Node
+	// failedVerifications records the events whose verification completed, and whether it failed
+	failedVerifications map[string]bool
+	// nextMaintenanceWindows records the last next maintenance window set per node
+	nextMaintenanceWindows map[string]time.Time
 }
 
 func (m *MockNodeAnnotationManager) GetRemediationState(ctx context.Context, nodeName string) (*annotation.RemediationStateAnnotation, *corev1.Node, error) {
 	if m.existingCRs == nil {
 		return &annotation.RemediationStateAnnotation{
 			EquivalenceGroups: make(map[string]annotation.EquivalenceGroupState),
-		}, nil, nil
+			Escalations:       m.escalations,
+			Attempts:          m.attempts,
+			Verifications:     m.verifications,
+		}, m.node, nil
 	}
 
 	annotationState := &annotation.RemediationStateAnnotation{
 		EquivalenceGroups: make(map[string]annotation.EquivalenceGroupState),
+		Escalations:       m.escalations,
+		Attempts:          m.attempts,
+		Verifications:     m.verifications,
 	}
 	for groupName, crName := range m.existingCRs {
 		annotationState.EquivalenceGroups[groupName] = annotation.EquivalenceGroupState{
@@ -143,7 +177,7 @@ func (m *MockNodeAnnotationManager) GetRemediationState(ctx context.Context, nod
 			CreatedAt:     time.Now(),
 		}
 	}
-	return annotationState, nil, nil
+	return annotationState, m.node, nil
 }
 
 func (m *MockNodeAnnotationManager) UpdateRemediationState(ctx context.Context, nodeName string,
@@ -159,6 +193,44 @@ func (m *MockNodeAnnotationManager) RemoveGroupsFromState(ctx context.Context, n
 	return nil
 }
 
+func (m *MockNodeAnnotationManager) RecordEscalation(ctx context.Context, nodeName string, group string,
+	escalation annotation.EscalationState) error {
+	return nil
+}
+
+func (m *MockNodeAnnotationManager) SetVerification(ctx context.Context, nodeName string, eventID string,
+	verification annotation.VerificationState) error {
+	if m.verifications == nil {
+		m.verifications = make(map[string]annotation.VerificationState)
+	}
+
+	m.verifications[eventID] = verification
+
+	return nil
+}
+
+func (m *MockNodeAnnotationManager) CompleteVerification(ctx context.Context, nodeName string, eventID string,
+	failed bool) error {
+	if m.failedVerifications == nil {
+		m.failedVerifications = make(map[string]bool)
+	}
+
+	delete(m.verifications, eventID)
+	m.failedVerifications[eventID] = failed
+
+	return nil
+}
+
+func (m *MockNodeAnnotationManager) SetNextMaintenanceWindow(ctx context.Context, nodeName string, nextWindow time.Time) error {
+	if m.nextMaintenanceWindows == nil {
+		m.nextMaintenanceWindows = make(map[string]time.Time)
+	}
+
+	m.nextMaintenanceWindows[nodeName] = nextWindow
+
+	return nil
+}
+
 func (m *MockDatabaseClient) UpdateDocument(ctx context.Context, filter interface{}, update interface{}) (*client.UpdateResult, error) {
 	if m.updateDocumentFn != nil {
 		return m.updateDocumentFn(ctx, filter, update)
@@ -1025,9 +1097,764 @@ func TestCRBasedDeduplication(t *testing.T) {
 	}
 }
 
+func TestResolveEscalation(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	escalationConfig := &config.TomlConfig{
+		RemediationActions: map[string]config.MaintenanceResource{
+			protos.RecommendedAction_COMPONENT_RESET.String(): {EquivalenceGroup: "reset"},
+			protos.RecommendedAction_RESTART_BM.String():      {EquivalenceGroup: "restart"},
+		},
+		Escalation: config.EscalationConfig{
+			Enabled: true,
+			Chains: map[string][]string{
+				protos.RecommendedAction_COMPONENT_RESET.String(): {
+					protos.RecommendedAction_RESTART_BM.String(),
+					protos.RecommendedAction_CONTACT_SUPPORT.String(),
+				},
+			},
+			MaxAttemptsPerDay: 3,
+		},
+	}
+
+	previous := func(step int, action string) map[string]annotation.EscalationState {
+		return map[string]annotation.EscalationState{
+			"reset": {
+				Step:          step,
+				ActionName:    action,
+				MaintenanceCR: "maintenance-test-node-1",
+				CreatedAt:     now.Add(-time.Hour),
+				ExpiresAt:     now.Add(time.Hour),
+			},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		action         protos.RecommendedAction
+		escalations    map[string]annotation.EscalationState
+		attempts       []time.Time
+		crStatus       crstatus.CRStatus
+		expectNil      bool
+		expectOutcome  escalationOutcome
+		expectIndex    int
+		expectAction   string
+		expectTrigger  string
+		expectStopCode string
+	}{
+		{
+			name:      "NoChain_NoEscalation",
+			action:    protos.RecommendedAction_RESTART_BM,
+			expectNil: true,
+		},
+		{
+			name:          "NoPreviousStep_FirstStep",
+			action:        protos.RecommendedAction_COMPONENT_RESET,
+			expectOutcome: escalationProceed,
+			expectIndex:   0,
+			expectAction:  protos.RecommendedAction_COMPONENT_RESET.String(),
+		},
+		{
+			name:          "PreviousStepInProgress_Skip",
+			action:        protos.RecommendedAction_COMPONENT_RESET,
+			escalations:   previous(0, protos.RecommendedAction_COMPONENT_RESET.String()),
+			crStatus:      crstatus.CRStatusInProgress,
+			expectOutcome: escalationInProgress,
+		},
+		{
+			name:          "PreviousStepFailed_Escalate",
+			action:        protos.RecommendedAction_COMPONENT_RESET,
+			escalations:   previous(0, protos.RecommendedAction_COMPONENT_RESET.String()),
+			crStatus:      crstatus.CRStatusFailed,
+			expectOutcome: escalationProceed,
+			expectIndex:   1,
+			expectAction:  protos.RecommendedAction_RESTART_BM.String(),
+			expectTrigger: "cr_failed",
+		},
+		{
+			name:          "RecurrenceWithinWindow_Escalate",
+			action:        protos.RecommendedAction_COMPONENT_RESET,
+			escalations:   previous(0, protos.RecommendedAction_COMPONENT_RESET.String()),
+			crStatus:      crstatus.CRStatusSucceeded,
+			expectOutcome: escalationProceed,
+			expectIndex:   1,
+			expectAction:  protos.RecommendedAction_RESTART_BM.String(),
+			expectTrigger: "recurrence",
+		},
+		{
+			name:   "PreviousStepFailedVerification_Escalate",
+			action: protos.RecommendedAction_COMPONENT_RESET,
+			escalations: map[string]annotation.EscalationState{
+				"reset": {
+					Step:               0,
+					ActionName:         protos.RecommendedAction_COMPONENT_RESET.String(),
+					MaintenanceCR:      "maintenance-test-node-1",
+					CreatedAt:          now.Add(-time.Hour),
+					ExpiresAt:          now.Add(time.Hour),
+					VerificationFailed: true,
+				},
+			},
+			crStatus:      crstatus.CRStatusInProgress,
+			expectOutcome: escalationProceed,
+			expectIndex:   1,
+			expectAction:  protos.RecommendedAction_RESTART_BM.String(),
+			expectTrigger: "verification_failed",
+		},
+		{
+			name:   "PreviousStepExpired_StartOver",
+			action: protos.RecommendedAction_COMPONENT_RESET,
+			escalations: map[string]annotation.EscalationState{
+				"reset": {
+					Step:       1,
+					ActionName: protos.RecommendedAction_RESTART_BM.String(),
+					CreatedAt:  now.Add(-48 * time.Hour),
+					ExpiresAt:  now.Add(-24 * time.Hour),
+				},
+			},
+			crStatus:      crstatus.CRStatusSucceeded,
+			expectOutcome: escalationProceed,
+			expectIndex:   0,
+			expectAction:  protos.RecommendedAction_COMPONENT_RESET.String(),
+		},
+		{
+			name:           "UnconfiguredTerminalStep_Stop",
+			action:         protos.RecommendedAction_COMPONENT_RESET,
+			escalations:    previous(1, protos.RecommendedAction_RESTART_BM.String()),
+			crStatus:       crstatus.CRStatusFailed,
+			expectOutcome:  escalationStopped,
+			expectStopCode: escalationStopNoResource,
+		},
+		{
+			name:           "LadderExhausted_Stop",
+			action:         protos.RecommendedAction_COMPONENT_RESET,
+			escalations:    previous(2, protos.RecommendedAction_CONTACT_SUPPORT.String()),
+			crStatus:       crstatus.CRStatusNotFound,
+			expectOutcome:  escalationStopped,
+			expectStopCode: escalationStopExhausted,
+		},
+		{
+			name:           "AttemptLimitReached_Stop",
+			action:         protos.RecommendedAction_COMPONENT_RESET,
+			attempts:       []time.Time{now.Add(-3 * time.Hour), now.Add(-2 * time.Hour), now.Add(-time.Hour)},
+			expectOutcome:  escalationStopped,
+			expectStopCode: escalationStopAttemptLimit,
+		},
+		{
+			name:          "AttemptsOutsideLimitPeriod_Proceed",
+			action:        protos.RecommendedAction_COMPONENT_RESET,
+			attempts:      []time.Time{now.Add(-30 * time.Hour), now.Add(-2 * time.Hour), now.Add(-time.Hour)},
+			expectOutcome: escalationProceed,
+			expectIndex:   0,
+			expectAction:  protos.RecommendedAction_COMPONENT_RESET.String(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockK8sClient := &MockK8sClient{
+				annotationManagerOverride: &MockNodeAnnotationManager{
+					escalations: tt.escalations,
+					attempts:    tt.attempts,
+				},
+				mockStatusChecker: &mockStatusChecker{shouldSkip: []bool{false}, crStatus: tt.crStatus},
+				configOverride:    escalationConfig,
+			}
+
+			r := NewFaultRemediationReconciler(nil, nil, nil, ReconcilerConfig{RemediationClient: mockK8sClient}, false)
+
+			healthEvent := &protos.HealthEvent{NodeName: "test-node", RecommendedAction: tt.action}
+			groupConfig, err := common.GetGroupConfigForEvent(escalationConfig.RemediationActions, healthEvent)
+			require.NoError(t, err)
+
+			step, err := r.resolveEscalation(ctx, healthEvent, groupConfig)
+			require.NoError(t, err)
+
+			if tt.expectNil {
+				assert.Nil(t, step)
+				return
+			}
+
+			require.NotNil(t, step)
+			assert.Equal(t, tt.expectOutcome, step.outcome)
+			assert.Equal(t, tt.expectStopCode, step.stopReason)
+
+			if tt.expectOutcome == escalationProceed {
+				assert.Equal(t, "reset", step.group)
+				assert.Equal(t, tt.expectIndex, step.index)
+				assert.Equal(t, tt.expectAction, step.actionName)
+				assert.Equal(t, tt.expectTrigger, step.trigger)
+			}
+		})
+	}
+}
+
+func TestEscalateEvent(t *testing.T) {
+	mockK8sClient := &MockK8sClient{}
+	r := NewFaultRemediationReconciler(nil, nil, nil, ReconcilerConfig{RemediationClient: mockK8sClient}, false)
+
+	healthEventDoc := &events.HealthEventDoc{
+		ID: "event-1",
+		HealthEventWithStatus: model.HealthEventWithStatus{
+			HealthEvent: &protos.HealthEvent{
+				NodeName:          "test-node",
+				RecommendedAction: protos.RecommendedAction_COMPONENT_RESET,
+			},
+		},
+	}
+
+	escalated, groupConfig, err := r.escalateEvent(healthEventDoc, &escalationStep{
+		index:      1,
+		actionName: protos.RecommendedAction_RESTART_BM.String(),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, groupConfig)
+
+	assert.Equal(t, "event-1", escalated.ID)
+	assert.Equal(t, protos.RecommendedAction_RESTART_BM, escalated.HealthEvent.RecommendedAction)
+	assert.Equal(t, protos.RecommendedAction_COMPONENT_RESET, healthEventDoc.HealthEvent.RecommendedAction,
+		"the original event must not be modified")
+	assert.Equal(t, "restart", groupConfig.EffectiveEquivalenceGroup)
+}
+
+func TestContinueVerification(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	metadataNode := func(collectedAt time.Time, gpuCount int) *corev1.Node {
+		return &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+			Name: "node1",
+			Annotations: map[string]string{
+				model.GPUMetadataAnnotationName: fmt.Sprintf(`{"collectedAt":"%s","gpuCount":%d}`,
+					collectedAt.Format(time.RFC3339), gpuCount),
+			},
+		}}
+	}
+
+	succeeded := annotation.VerificationState{
+		Group:         "restart",
+		ActionName:    "RESTART_BM",
+		MaintenanceCR: "cr-1",
+		CheckName:     "GpuXidError",
+		GPUCount:      8,
+		StartedAt:     now.Add(-time.Hour),
+		SucceededAt:   now.Add(-15 * time.Minute),
+	}
+	inProgress := succeeded
+	inProgress.SucceededAt = time.Time{}
+
+	tests := []struct {
+		name         string
+		verification *annotation.VerificationState
+		crStatus     crstatus.CRStatus
+		node         *corev1.Node
+		recurrences  []datastore.HealthEventWithStatus
+		expectDone   bool
+		expectResult string
+	}{
+		{
+			name:       "no verification in progress",
+			node:       metadataNode(now, 8),
+			expectDone: false,
+		},
+		{
+			name:         "maintenance CR in progress",
+			verification: &inProgress,
+			crStatus:     crstatus.CRStatusInProgress,
+			node:         metadataNode(now, 8),
+			expectDone:   true,
+		},
+		{
+			name:         "maintenance CR failed",
+			verification: &inProgress,
+			crStatus:     crstatus.CRStatusFailed,
+			node:         metadataNode(now, 8),
+			expectDone:   true,
+			expectResult: verificationResultFailed,
+		},
+		{
+			name:         "waiting for fresh metadata",
+			verification: &succeeded,
+			crStatus:     crstatus.CRStatusSucceeded,
+			node:         metadataNode(now.Add(-2*time.Hour), 8),
+			expectDone:   true,
+		},
+		{
+			name:         "GPU missing after remediation",
+			verification: &succeeded,
+			crStatus:     crstatus.CRStatusSucceeded,
+			node:         metadataNode(now, 7),
+			expectDone:   true,
+			expectResult: verificationResultFailed,
+		},
+		{
+			name:         "fault recurred",
+			verification: &succeeded,
+			crStatus:     crstatus.CRStatusSucceeded,
+			node:         metadataNode(now, 8),
+			recurrences:  []datastore.HealthEventWithStatus{{CreatedAt: now}},
+			expectDone:   true,
+			expectResult: verificationResultFailed,
+		},
+		{
+			name:         "verified",
+			verification: &succeeded,
+			crStatus:     crstatus.CRStatusSucceeded,
+			node:         metadataNode(now, 8),
+			expectDone:   true,
+			expectResult: verificationResultSucceeded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			annotationManager := &MockNodeAnnotationManager{node: tt.node}
+			if tt.verification != nil {
+				annotationManager.verifications = map[string]annotation.VerificationState{
+					"event-1": *tt.verification,
+				}
+			}
+
+			cfg := ReconcilerConfig{
+				RemediationClient: &MockK8sClient{
+					annotationManagerOverride: annotationManager,
+					mockStatusChecker:         &mockStatusChecker{crStatus: tt.crStatus},
+					configOverride: &config.TomlConfig{
+						Verification: config.VerificationConfig{Enabled: true},
+					},
+				},
+				StateManager: &statemanager.MockStateManager{
+					UpdateNVSentinelStateNodeLabelFn: func(context.Context, string,
+						statemanager.NVSentinelStateLabelValue, bool) (bool, error) {
+						return true, nil
+					},
+				},
+			}
+			r := NewFaultRemediationReconciler(nil, nil, nil, cfg, false)
+
+			var remediated *bool
+
+			healthStore := &MockHealthEventStore{
+				UpdateHealthEventStatusFn: func(_ context.Context, _ string, status datastore.HealthEventStatus) error {
+					remediated = status.FaultRemediated
+					return nil
+				},
+				FindHealthEventsByQueryFn: func(context.Context,
+					datastore.QueryBuilder) ([]datastore.HealthEventWithStatus, error) {
+					return tt.recurrences, nil
+				},
+			}
+
+			doc := &events.HealthEventDoc{
+				ID: "event-1",
+				HealthEventWithStatus: model.HealthEventWithStatus{
+					HealthEvent: &protos.HealthEvent{NodeName: "node1", CheckName: "GpuXidError"},
+				},
+			}
+			eventToken := datastore.EventWithToken{
+				Event: map[string]interface{}{"fullDocument": map[string]interface{}{"_id": "event-1"}},
+			}
+
+			result, err, done := r.continueVerification(ctx, doc, eventToken, nil, healthStore)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectDone, done)
+
+			if tt.expectResult == "" {
+				assert.Nil(t, remediated, "expected the event not to be marked yet")
+				assert.Empty(t, annotationManager.failedVerifications)
+
+				if done {
+					assert.NotZero(t, result.RequeueAfter, "expected the verification to be requeued")
+				}
+
+				return
+			}
+
+			failed := tt.expectResult == verificationResultFailed
+			require.NotNil(t, remediated)
+			assert.Equal(t, !failed, *remediated)
+			assert.Equal(t, failed, annotationManager.failedVerifications["event-1"])
+			assert.True(t, result.IsZero())
+		})
+	}
+}
+
+func TestContinueVerification_FailedVerificationEscalates(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	annotationManager := &MockNodeAnnotationManager{
+		escalations: map[string]annotation.EscalationState{
+			"reset": {
+				ActionName:    protos.RecommendedAction_COMPONENT_RESET.String(),
+				MaintenanceCR: "cr-1",
+				CreatedAt:     now.Add(-time.Hour),
+				ExpiresAt:     now.Add(time.Hour),
+				EventID:       "event-1",
+			},
+		},
+		verifications: map[string]annotation.VerificationState{
+			"event-1": {
+				Group:         "reset",
+				ActionName:    protos.RecommendedAction_COMPONENT_RESET.String(),
+				MaintenanceCR: "cr-1",
+				StartedAt:     now.Add(-time.Hour),
+			},
+		},
+	}
+
+	cfg := ReconcilerConfig{
+		RemediationClient: &MockK8sClient{
+			annotationManagerOverride: annotationManager,
+			mockStatusChecker:         &mockStatusChecker{crStatus: crstatus.CRStatusFailed},
+			configOverride: &config.TomlConfig{
+				Escalation: config.EscalationConfig{
+					Enabled: true,
+					Chains: map[string][]string{
+						protos.RecommendedAction_COMPONENT_RESET.String(): {
+							protos.RecommendedAction_RESTART_BM.String(),
+						},
+					},
+				},
+				Verification: config.VerificationConfig{Enabled: true},
+			},
+		},
+	}
+	r := NewFaultRemediationReconciler(nil, nil, nil, cfg, false)
+
+	healthStore := &MockHealthEventStore{
+		UpdateHealthEventStatusFn: func(context.Context, string, datastore.HealthEventStatus) error {
+			t.Fatal("expected the event not to be marked when it escalates")
+			return nil
+		},
+	}
+
+	doc := &events.HealthEventDoc{
+		ID: "event-1",
+		HealthEventWithStatus: model.HealthEventWithStatus{
+			HealthEvent: &protos.HealthEvent{
+				NodeName:          "node1",
+				RecommendedAction: protos.RecommendedAction_COMPONENT_RESET,
+			},
+		},
+	}
+
+	result, err, done := r.continueVerification(ctx, doc, datastore.EventWithToken{}, nil, healthStore)
+	require.NoError(t, err)
+	assert.False(t, done, "expected the event to go on to the next step of its ladder")
+	assert.True(t, result.IsZero())
+	assert.True(t, annotationManager.failedVerifications["event-1"])
+}
+
+func TestContinueEscalation(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	escalationOf := func(eventID string) map[string]annotation.EscalationState {
+		return map[string]annotation.EscalationState{
+			"reset": {
+				Step:          0,
+				ActionName:    protos.RecommendedAction_COMPONENT_RESET.String(),
+				MaintenanceCR: "cr-1",
+				CreatedAt:     now.Add(-10 * time.Minute),
+				ExpiresAt:     now.Add(time.Hour),
+				EventID:       eventID,
+			},
+		}
+	}
+	pending := escalationOf("event-1")
+
+	tests := []struct {
+		name            string
+		escalations     map[string]annotation.EscalationState
+		crStatus        crstatus.CRStatus
+		expectDone      bool
+		expectRequeue   bool
+		expectRemediate bool
+	}{
+		{
+			name:     "no escalation step pending for the event",
+			crStatus: crstatus.CRStatusInProgress,
+		},
+		{
+			name:        "escalation step pending for another event",
+			escalations: escalationOf("event-2"),
+			crStatus:    crstatus.CRStatusInProgress,
+		},
+		{
+			name:          "maintenance CR in progress",
+			escalations:   pending,
+			crStatus:      crstatus.CRStatusInProgress,
+			expectDone:    true,
+			expectRequeue: true,
+		},
+		{
+			name:        "maintenance CR failed escalates",
+			escalations: pending,
+			crStatus:    crstatus.CRStatusFailed,
+		},
+		{
+			name:            "maintenance CR succeeded",
+			escalations:     pending,
+			crStatus:        crstatus.CRStatusSucceeded,
+			expectDone:      true,
+			expectRemediate: true,
+		},
+		{
+			name: "verification failed escalates",
+			escalations: map[string]annotation.EscalationState{
+				"reset": {
+					Step:               0,
+					ActionName:         protos.RecommendedAction_COMPONENT_RESET.String(),
+					MaintenanceCR:      "cr-1",
+					CreatedAt:          now.Add(-10 * time.Minute),
+					ExpiresAt:          now.Add(time.Hour),
+					EventID:            "event-1",
+					VerificationFailed: true,
+				},
+			},
+			crStatus: crstatus.CRStatusSucceeded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := ReconcilerConfig{
+				RemediationClient: &MockK8sClient{
+					annotationManagerOverride: &MockNodeAnnotationManager{escalations: tt.escalations},
+					mockStatusChecker:         &mockStatusChecker{crStatus: tt.crStatus},
+					configOverride: &config.TomlConfig{
+						Escalation: config.EscalationConfig{Enabled: true},
+					},
+				},
+			}
+			r := NewFaultRemediationReconciler(nil, nil, nil, cfg, false)
+
+			var remediated *bool
+
+			healthStore := &MockHealthEventStore{
+				UpdateHealthEventStatusFn: func(_ context.Context, _ string, status datastore.HealthEventStatus) error {
+					remediated = status.FaultRemediated
+					return nil
+				},
+			}
+
+			doc := &events.HealthEventDoc{
+				ID: "event-1",
+				HealthEventWithStatus: model.HealthEventWithStatus{
+					HealthEvent: &protos.HealthEvent{
+						NodeName:          "node1",
+						RecommendedAction: protos.RecommendedAction_COMPONENT_RESET,
+					},
+				},
+			}
+			eventToken := datastore.EventWithToken{
+				Event: map[string]interface{}{"fullDocument": map[string]interface{}{"_id": "event-1"}},
+			}
+
+			result, err, done := r.continueEscalation(ctx, doc, eventToken, nil, healthStore)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectDone, done)
+			assert.Equal(t, tt.expectRequeue, result.RequeueAfter > 0)
+
+			if !tt.expectRemediate {
+				assert.Nil(t, remediated, "expected the event not to be marked yet")
+				return
+			}
+
+			require.NotNil(t, remediated)
+			assert.True(t, *remediated)
+		})
+	}
+}
+
 // TestLogCollectorOnlyCalledWhenShouldCreateCR verifies that log collector is only called
 // when shouldCreateCR is true (Issue #441 - prevent duplicate log-collector jobs)
 // This tests the logic that log collector runs AFTER checkExistingCRStatus, not before
+func TestHoldForRateLimit(t *testing.T) {
+	ctx := context.Background()
+	labels := []statemanager.NVSentinelStateLabelValue{}
+	stateManager := &statemanager.MockStateManager{
+		UpdateNVSentinelStateNodeLabelFn: func(ctx context.Context, nodeName string,
+			newStateLabelValue statemanager.NVSentinelStateLabelValue, removeStateLabel bool) (bool, error) {
+			labels = append(labels, newStateLabelValue)
+			return true, nil
+		},
+	}
+	limiter := ratelimit.NewLimiter(config.RateLimitConfig{
+		RemediationLimit: config.RemediationLimit{MaxConcurrent: 1},
+	}, func(context.Context, string, string) crstatus.CRStatus {
+		return crstatus.CRStatusInProgress
+	})
+	clientset := fake.NewSimpleClientset()
+	emergencyStop := ratelimit.NewEmergencyStop(clientset, "emergency-stop", "nvsentinel")
+	require.NoError(t, emergencyStop.Ensure(ctx))
+
+	cfg := ReconcilerConfig{
+		RemediationClient: &MockK8sClient{annotationManagerOverride: &MockNodeAnnotationManager{}},
+		StateManager:      stateManager,
+		RateLimiter:       limiter,
+		EmergencyStop:     emergencyStop,
+	}
+	r := NewFaultRemediationReconciler(nil, nil, nil, cfg, false)
+
+	plan := func(nodeName string) *remediationPlan {
+		return &remediationPlan{event: &events.HealthEventDoc{
+			HealthEventWithStatus: model.HealthEventWithStatus{
+				HealthEvent: &protos.HealthEvent{
+					NodeName:          nodeName,
+					RecommendedAction: protos.RecommendedAction_RESTART_BM,
+				},
+			},
+		}}
+	}
+
+	_, held := r.holdForRateLimit(ctx, plan("node1"), "node1")
+	assert.False(t, held)
+	limiter.Record(ratelimit.Record{NodeName: "node1", ActionName: "RESTART_BM", CRName: "cr-1"})
+
+	result, held := r.holdForRateLimit(ctx, plan("node2"), "node2")
+	assert.True(t, held, "expected the remediation over the concurrency limit to be held")
+	assert.Equal(t, time.Minute, result.RequeueAfter)
+	assert.Equal(t, []statemanager.NVSentinelStateLabelValue{statemanager.RemediationWaitingLabelValue}, labels)
+
+	cm, err := clientset.CoreV1().ConfigMaps("nvsentinel").Get(ctx, "emergency-stop", metav1.GetOptions{})
+	require.NoError(t, err)
+	cm.Data[ratelimit.EmergencyStopKey] = "true"
+	_, err = clientset.CoreV1().ConfigMaps("nvsentinel").Update(ctx, cm, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	r.Config.RateLimiter = nil
+	result, held = r.holdForRateLimit(ctx, plan("node3"), "node3")
+	assert.True(t, held, "expected the remediation to be held by the emergency stop")
+	assert.Equal(t, time.Minute, result.RequeueAfter)
+}
+
+func TestHoldUntilMaintenanceWindow(t *testing.T) {
+	// A one hour window twelve hours from now is guaranteed to be closed while the test runs
+	closedHour := (time.Now().UTC().Hour() + 12) % 24
+	schedules, err := schedule.NewSet([]schedule.Config{
+		{Name: "closed", Windows: []schedule.Window{{Cron: fmt.Sprintf("0 %d * * *", closedHour), Duration: "1h"}}},
+		{Name: "open", Windows: []schedule.Window{{Cron: "0 * * * *", Duration: "1h"}}},
+		{Name: "never", Windows: []schedule.Window{{Cron: "0 0 30 2 *", Duration: "1h"}}},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name             string
+		schedule         string
+		isFatal          bool
+		nodeState        string
+		expectHeld       bool
+		expectRequeue    time.Duration
+		expectLabels     []statemanager.NVSentinelStateLabelValue
+		expectNextWindow bool
+	}{
+		{
+			name:             "held outside the maintenance window",
+			schedule:         "closed",
+			expectHeld:       true,
+			expectLabels:     []statemanager.NVSentinelStateLabelValue{statemanager.RemediationWaitingLabelValue},
+			expectNextWindow: true,
+		},
+		{
+			name:             "already waiting node is not relabeled",
+			schedule:         "closed",
+			nodeState:        string(statemanager.RemediationWaitingLabelValue),
+			expectHeld:       true,
+			expectNextWindow: true,
+		},
+		{
+			name:     "remediated inside the maintenance window",
+			schedule: "open",
+		},
+		{
+			name:     "fatal event bypasses the maintenance window",
+			schedule: "closed",
+			isFatal:  true,
+		},
+		{
+			name:          "rechecked hourly without an upcoming window",
+			schedule:      "never",
+			expectHeld:    true,
+			expectRequeue: time.Hour,
+			expectLabels:  []statemanager.NVSentinelStateLabelValue{statemanager.RemediationWaitingLabelValue},
+		},
+		{
+			name:     "action without a schedule is not held",
+			schedule: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			var labels []statemanager.NVSentinelStateLabelValue
+			stateManager := &statemanager.MockStateManager{
+				UpdateNVSentinelStateNodeLabelFn: func(ctx context.Context, nodeName string,
+					newStateLabelValue statemanager.NVSentinelStateLabelValue, removeStateLabel bool) (bool, error) {
+					labels = append(labels, newStateLabelValue)
+					return true, nil
+				},
+			}
+
+			node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node1", Labels: map[string]string{}}}
+			if tt.nodeState != "" {
+				node.Labels[statemanager.NVSentinelStateLabelKey] = tt.nodeState
+			}
+
+			annotationManager := &MockNodeAnnotationManager{node: node}
+			cfg := ReconcilerConfig{
+				RemediationClient: &MockK8sClient{
+					annotationManagerOverride: annotationManager,
+					configOverride: &config.TomlConfig{
+						RemediationActions: map[string]config.MaintenanceResource{
+							protos.RecommendedAction_RESTART_BM.String(): {
+								EquivalenceGroup: "restart",
+								Schedule:         tt.schedule,
+							},
+						},
+					},
+				},
+				StateManager: stateManager,
+				Schedules:    schedules,
+			}
+			r := NewFaultRemediationReconciler(nil, nil, nil, cfg, false)
+
+			healthEvent := &protos.HealthEvent{
+				NodeName:          "node1",
+				IsFatal:           tt.isFatal,
+				RecommendedAction: protos.RecommendedAction_RESTART_BM,
+			}
+
+			result, held := r.holdUntilMaintenanceWindow(ctx, healthEvent, "node1")
+
+			assert.Equal(t, tt.expectHeld, held)
+			assert.Equal(t, tt.expectLabels, labels)
+
+			switch {
+			case !tt.expectHeld:
+				assert.Zero(t, result.RequeueAfter)
+			case tt.expectRequeue != 0:
+				assert.Equal(t, tt.expectRequeue, result.RequeueAfter)
+			default:
+				assert.Positive(t, result.RequeueAfter)
+				assert.LessOrEqual(t, result.RequeueAfter, 24*time.Hour)
+			}
+
+			nextWindow, set := annotationManager.nextMaintenanceWindows["node1"]
+			if tt.expectNextWindow {
+				require.True(t, set, "expected the next maintenance window annotation to be set")
+				assert.Equal(t, closedHour, nextWindow.UTC().Hour())
+				assert.True(t, nextWindow.After(time.Now()))
+			} else {
+				assert.True(t, nextWindow.IsZero(), "expected no upcoming maintenance window on the node")
+			}
+		})
+	}
+}
+
 func TestLogCollectorOnlyCalledWhenShouldCreateCR(t *testing.T) {
 	ctx := context.Background()
 
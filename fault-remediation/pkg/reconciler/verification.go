@@ -0,0 +1,450 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reconciler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/nvidia/nvsentinel/commons/pkg/eventutil"
+	"github.com/nvidia/nvsentinel/commons/pkg/statemanager"
+	"github.com/nvidia/nvsentinel/commons/pkg/tracing"
+	"github.com/nvidia/nvsentinel/data-models/pkg/model"
+	"github.com/nvidia/nvsentinel/fault-remediation/pkg/annotation"
+	"github.com/nvidia/nvsentinel/fault-remediation/pkg/crstatus"
+	"github.com/nvidia/nvsentinel/fault-remediation/pkg/events"
+	"github.com/nvidia/nvsentinel/fault-remediation/pkg/metrics"
+	"github.com/nvidia/nvsentinel/store-client/pkg/datastore"
+	"github.com/nvidia/nvsentinel/store-client/pkg/query"
+)
+
+// crNotFoundGrace is how long a maintenance CR that cannot be read yet, e.g. because it was just created,
+// is considered in progress
+const crNotFoundGrace = time.Minute
+
+// Reasons a verification fails
+const (
+	verificationFailCRFailed        = "cr_failed"
+	verificationFailCRNotFound      = "cr_not_found"
+	verificationFailMetadataTimeout = "metadata_timeout"
+	verificationFailGPUMissing      = "gpu_missing"
+	verificationFailRecurrence      = "recurrence"
+	verificationFailValidation      = "validation_failed"
+)
+
+const (
+	verificationResultSucceeded = "succeeded"
+	verificationResultFailed    = "failed"
+)
+
+// startVerification records the verification of the remediation the maintenance CR was created for, instead
+// of marking the event remediated. It returns false when the verification could not be recorded, in which
+// case the event is marked remediated without verification.
+func (r *FaultRemediationReconciler) startVerification(
+	ctx context.Context,
+	plan *remediationPlan,
+	crName string,
+	nodeName string,
+) bool {
+	healthEvent := plan.event.HealthEvent
+
+	group := plan.groupConfig.EffectiveEquivalenceGroup
+	if plan.escalation != nil {
+		group = plan.escalation.group
+	}
+
+	verification := annotation.VerificationState{
+		Group:         group,
+		ActionName:    model.GetEffectiveActionName(healthEvent),
+		MaintenanceCR: crName,
+		CheckName:     healthEvent.CheckName,
+		ErrorCodes:    healthEvent.ErrorCode,
+		StartedAt:     time.Now().UTC(),
+	}
+
+	_, node, err := r.annotationManager.GetRemediationState(ctx, nodeName)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to get node for remediation verification", "node", nodeName, "error", err)
+	} else if metadata := gpuMetadata(node); metadata != nil {
+		verification.GPUCount = metadata.GPUCount
+	}
+
+	if err := r.annotationManager.SetVerification(ctx, nodeName, plan.event.ID, verification); err != nil {
+		metrics.ProcessingErrors.WithLabelValues("set_verification_error", nodeName).Inc()
+		slog.ErrorContext(ctx, "Failed to start remediation verification, marking event remediated",
+			"node", nodeName,
+			"error", err)
+
+		return false
+	}
+
+	slog.InfoContext(ctx, "Verifying remediation before marking event remediated",
+		"node", nodeName,
+		"maintenanceCR", crName,
+		"checkName", verification.CheckName)
+
+	tracing.SpanFromContext(ctx).SetAttributes(
+		attribute.String("fault_remediation.status", "pending_verification"),
+	)
+
+	return true
+}
+
+// continueVerification returns (result, err, true) when the remediation of the event is being verified: the
+// event is requeued until the verification passes or fails, then marked remediated or failed and processed.
+// It returns (zero, nil, false) when the event has no verification in progress, or when the verification
+// failed and the event escalates to the next step of its ladder.
+func (r *FaultRemediationReconciler) continueVerification(
+	ctx context.Context,
+	healthEventWithStatus *events.HealthEventDoc,
+	eventWithToken datastore.EventWithToken,
+	watcherInstance datastore.ChangeStreamWatcher,
+	healthEventStore datastore.HealthEventStore,
+) (ctrl.Result, error, bool) {
+	nodeName := healthEventWithStatus.HealthEvent.NodeName
+
+	state, node, err := r.annotationManager.GetRemediationState(ctx, nodeName)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to get remediation verification", "node", nodeName, "error", err)
+		return ctrl.Result{}, nil, false
+	}
+
+	verification, ok := state.Verifications[healthEventWithStatus.ID]
+	if !ok {
+		return ctrl.Result{}, nil, false
+	}
+
+	ctx, span := tracing.StartSpan(ctx, "fault_remediation.verify_remediation")
+	defer span.End()
+
+	failReason, result, err := r.verifyRemediation(ctx, healthEventWithStatus, &verification, node,
+		healthEventStore)
+	if err != nil {
+		metrics.ProcessingErrors.WithLabelValues("verification_error", nodeName).Inc()
+		slog.ErrorContext(ctx, "Error verifying remediation", "node", nodeName, "error", err)
+		tracing.RecordError(span, err)
+
+		return ctrl.Result{}, fmt.Errorf("error verifying remediation: %w", err), true
+	}
+
+	if !result.IsZero() {
+		span.SetAttributes(attribute.String("fault_remediation.status", "pending_verification"))
+		return result, nil, true
+	}
+
+	if failReason != "" && r.escalateFailedVerification(ctx, healthEventWithStatus, verification, state,
+		failReason) {
+		return ctrl.Result{}, nil, false
+	}
+
+	res, err := r.completeVerification(ctx, healthEventWithStatus, verification, node, failReason,
+		eventWithToken, watcherInstance, healthEventStore)
+
+	return res, err, true
+}
+
+// escalateFailedVerification completes the failed verification of a remediation taken along the escalation
+// ladder of the fault and returns true, so that the event is remediated with the next step right away. It
+// returns false when no ladder applies, in which case the event is failed.
+func (r *FaultRemediationReconciler) escalateFailedVerification(
+	ctx context.Context,
+	healthEventWithStatus *events.HealthEventDoc,
+	verification annotation.VerificationState,
+	state *annotation.RemediationStateAnnotation,
+	failReason string,
+) bool {
+	nodeName := healthEventWithStatus.HealthEvent.NodeName
+	actionName := model.GetEffectiveActionName(healthEventWithStatus.HealthEvent)
+
+	if r.Config.RemediationClient.GetConfig().Escalation.Ladder(actionName) == nil {
+		return false
+	}
+
+	escalation, ok := state.Escalations[verification.Group]
+	if !ok || escalation.MaintenanceCR != verification.MaintenanceCR {
+		return false
+	}
+
+	if err := r.annotationManager.CompleteVerification(ctx, nodeName, healthEventWithStatus.ID, true); err != nil {
+		metrics.ProcessingErrors.WithLabelValues("complete_verification_error", nodeName).Inc()
+		slog.ErrorContext(ctx, "Failed to complete remediation verification", "node", nodeName, "error", err)
+
+		return false
+	}
+
+	slog.WarnContext(ctx, "Remediation verification failed, escalating",
+		"node", nodeName,
+		"maintenanceCR", verification.MaintenanceCR,
+		"reason", failReason)
+
+	tracing.SpanFromContext(ctx).SetAttributes(
+		attribute.String("fault_remediation.verification.result", verificationResultFailed),
+		attribute.String("fault_remediation.verification.reason", failReason),
+	)
+
+	metrics.RemediationVerifications.WithLabelValues(verificationResultFailed, failReason).Inc()
+	metrics.RemediationVerificationDuration.Observe(time.Since(verification.StartedAt).Seconds())
+
+	return true
+}
+
+// verifyRemediation checks the verification of a remediation. It returns a non-zero result while the
+// verification is in progress, then the reason the verification failed, empty when it passed.
+func (r *FaultRemediationReconciler) verifyRemediation(
+	ctx context.Context,
+	healthEventWithStatus *events.HealthEventDoc,
+	verification *annotation.VerificationState,
+	node *corev1.Node,
+	healthEventStore datastore.HealthEventStore,
+) (string, ctrl.Result, error) {
+	cfg := r.Config.RemediationClient.GetConfig().Verification
+	nodeName := healthEventWithStatus.HealthEvent.NodeName
+	poll := ctrl.Result{RequeueAfter: cfg.PollInterval()}
+	now := time.Now().UTC()
+
+	if verification.SucceededAt.IsZero() {
+		failReason, done := r.verificationCRStatus(ctx, verification, now)
+		if !done {
+			return "", poll, nil
+		}
+
+		if failReason != "" {
+			return failReason, ctrl.Result{}, nil
+		}
+
+		verification.SucceededAt = now
+
+		if err := r.annotationManager.SetVerification(ctx, nodeName, healthEventWithStatus.ID,
+			*verification); err != nil {
+			return "", ctrl.Result{}, err
+		}
+	}
+
+	if cfg.RequiresFreshMetadata(verification.ActionName) {
+		// GPU metadata collected after the CR was created describes the node as remediated
+		metadata := gpuMetadata(node)
+		if metadata == nil || !metadata.CollectedAt.After(verification.StartedAt) {
+			if now.Sub(verification.SucceededAt) >= cfg.MetadataTimeout() {
+				return verificationFailMetadataTimeout, ctrl.Result{}, nil
+			}
+
+			return "", poll, nil
+		}
+
+		if metadata.GPUCount < verification.GPUCount {
+			slog.WarnContext(ctx, "Node reports fewer GPUs after remediation",
+				"node", nodeName,
+				"before", verification.GPUCount,
+				"after", metadata.GPUCount)
+
+			return verificationFailGPUMissing, ctrl.Result{}, nil
+		}
+	}
+
+	recurred, err := r.faultRecurred(ctx, healthEventStore, nodeName, verification)
+	if err != nil {
+		return "", ctrl.Result{}, err
+	}
+
+	if recurred {
+		return verificationFailRecurrence, ctrl.Result{}, nil
+	}
+
+	if remaining := verification.SucceededAt.Add(cfg.QuietPeriod()).Sub(now); remaining > 0 {
+		return "", ctrl.Result{RequeueAfter: min(remaining, poll.RequeueAfter)}, nil
+	}
+
+	if !cfg.ValidationJob.Enabled {
+		return "", ctrl.Result{}, nil
+	}
+
+	result, passed, err := r.Config.RemediationClient.RunValidationJob(ctx, nodeName, healthEventWithStatus.ID)
+	if err != nil {
+		return "", ctrl.Result{}, fmt.Errorf("error running validation job: %w", err)
+	}
+
+	if !result.IsZero() {
+		return "", result, nil
+	}
+
+	if !passed {
+		return verificationFailValidation, ctrl.Result{}, nil
+	}
+
+	return "", ctrl.Result{}, nil
+}
+
+// verificationCRStatus returns whether the maintenance CR of the verification finished, and the reason the
+// verification fails when it did not succeed
+func (r *FaultRemediationReconciler) verificationCRStatus(
+	ctx context.Context,
+	verification *annotation.VerificationState,
+	now time.Time,
+) (string, bool) {
+	statusChecker := r.Config.RemediationClient.GetStatusChecker()
+	if statusChecker == nil {
+		return "", true
+	}
+
+	switch statusChecker.GetCRStatus(ctx, verification.ActionName, verification.MaintenanceCR) {
+	case crstatus.CRStatusSucceeded:
+		return "", true
+	case crstatus.CRStatusFailed:
+		return verificationFailCRFailed, true
+	case crstatus.CRStatusNotFound:
+		if now.Sub(verification.StartedAt) < crNotFoundGrace {
+			return "", false
+		}
+
+		return verificationFailCRNotFound, true
+	case crstatus.CRStatusInProgress:
+		return "", false
+	}
+
+	return "", false
+}
+
+// faultRecurred reports whether the node reported the fault of the verification again after the CR succeeded
+func (r *FaultRemediationReconciler) faultRecurred(
+	ctx context.Context,
+	healthEventStore datastore.HealthEventStore,
+	nodeName string,
+	verification *annotation.VerificationState,
+) (bool, error) {
+	q := query.New().Build(query.And(
+		query.Eq("healthevent.nodename", nodeName),
+		query.Eq("healthevent.checkname", verification.CheckName),
+		query.Eq("healthevent.ishealthy", false),
+		query.Gt("createdAt", verification.SucceededAt),
+	))
+
+	recurrences, err := healthEventStore.FindHealthEventsByQuery(ctx, q)
+	if err != nil {
+		return false, fmt.Errorf("error querying fault recurrences: %w", err)
+	}
+
+	for _, recurrence := range recurrences {
+		if len(verification.ErrorCodes) == 0 || len(recurrence.RawEvent) == 0 {
+			return true, nil
+		}
+
+		event, err := eventutil.ParseHealthEventFromEvent(recurrence.RawEvent)
+		if err != nil {
+			// A recurrence of the check whose error codes cannot be read counts as a recurrence of the fault
+			return true, nil
+		}
+
+		for _, errorCode := range event.HealthEvent.ErrorCode {
+			if slices.Contains(verification.ErrorCodes, errorCode) {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// completeVerification marks the event remediated when the verification passed, or failed otherwise, and
+// marks it processed. A failed verification labels the node remediation-failed and escalates the fault.
+func (r *FaultRemediationReconciler) completeVerification(
+	ctx context.Context,
+	healthEventWithStatus *events.HealthEventDoc,
+	verification annotation.VerificationState,
+	node *corev1.Node,
+	failReason string,
+	eventWithToken datastore.EventWithToken,
+	watcherInstance datastore.ChangeStreamWatcher,
+	healthEventStore datastore.HealthEventStore,
+) (ctrl.Result, error) {
+	span := tracing.SpanFromContext(ctx)
+	nodeName := healthEventWithStatus.HealthEvent.NodeName
+	failed := failReason != ""
+
+	result := verificationResultSucceeded
+	if failed {
+		result = verificationResultFailed
+
+		slog.WarnContext(ctx, "Remediation verification failed",
+			"node", nodeName,
+			"maintenanceCR", verification.MaintenanceCR,
+			"reason", failReason)
+
+		// A node that was uncordoned in the meantime has no NVSentinel state left to fail
+		if node == nil || node.Labels[statemanager.NVSentinelStateLabelKey] != "" {
+			if _, err := r.Config.StateManager.UpdateNVSentinelStateNodeLabel(ctx, nodeName,
+				statemanager.RemediationFailedLabelValue, false); err != nil {
+				slog.ErrorContext(ctx, "Error updating node label",
+					"label", statemanager.RemediationFailedLabelValue,
+					"error", err)
+				metrics.ProcessingErrors.WithLabelValues("label_update_error", nodeName).Inc()
+			}
+		}
+	} else {
+		slog.InfoContext(ctx, "Remediation verified",
+			"node", nodeName,
+			"maintenanceCR", verification.MaintenanceCR)
+	}
+
+	span.SetAttributes(
+		attribute.String("fault_remediation.verification.result", result),
+		attribute.String("fault_remediation.verification.reason", failReason),
+	)
+
+	if err := r.updateNodeRemediatedStatus(ctx, healthEventStore, eventWithToken, !failed); err != nil {
+		metrics.ProcessingErrors.WithLabelValues("update_status_error", nodeName).Inc()
+		slog.ErrorContext(ctx, "Error updating remediation status for node", "error", err)
+		tracing.RecordError(span, err)
+
+		return ctrl.Result{}, err
+	}
+
+	metrics.RemediationVerifications.WithLabelValues(result, failReason).Inc()
+	metrics.RemediationVerificationDuration.Observe(time.Since(verification.StartedAt).Seconds())
+
+	if err := r.annotationManager.CompleteVerification(ctx, nodeName, healthEventWithStatus.ID,
+		failed); err != nil {
+		metrics.ProcessingErrors.WithLabelValues("complete_verification_error", nodeName).Inc()
+		slog.ErrorContext(ctx, "Failed to complete remediation verification", "node", nodeName, "error", err)
+	}
+
+	return r.markProcessedOrError(ctx, watcherInstance, eventWithToken, nodeName)
+}
+
+// gpuMetadata returns the GPU metadata metadata-collector published on the node, or nil
+func gpuMetadata(node *corev1.Node) *model.GPUMetadataAnnotation {
+	if node == nil {
+		return nil
+	}
+
+	value, ok := node.Annotations[model.GPUMetadataAnnotationName]
+	if !ok {
+		return nil
+	}
+
+	var metadata model.GPUMetadataAnnotation
+	if err := json.Unmarshal([]byte(value), &metadata); err != nil {
+		return nil
+	}
+
+	return &metadata
+}
@@ -31,6 +31,7 @@ type FaultRemediationClientInterface interface {
 	CreateMaintenanceResource(ctx context.Context, healthEventData *events.HealthEventData,
 		groupConfig *common.EquivalenceGroupConfig) (string, error)
 	RunLogCollectorJob(ctx context.Context, nodeName string, eventId string) (ctrl.Result, error)
+	RunValidationJob(ctx context.Context, nodeName string, eventId string) (ctrl.Result, bool, error)
 	GetAnnotationManager() annotation.NodeAnnotationManagerInterface
 	GetStatusChecker() crstatus.CRStatusCheckerInterface
 	GetConfig() *config.TomlConfig
@@ -575,3 +575,107 @@ func TestRemediationClientGetters(t *testing.T) {
 	assert.NotNil(t, c.GetStatusChecker())
 	assert.Equal(t, "templates", c.GetConfig().Template.MountPath)
 }
+
+func TestRunValidationJob(t *testing.T) {
+	eventId := "12345"
+	jobNamespace := "test"
+	nodeName := "test-node-1"
+	labels := map[string]string{
+		validationJobNodeLabel:  nodeName,
+		validationJobEventLabel: eventId,
+	}
+
+	existingJob := func(created time.Time, conditionType batchv1.JobConditionType) []client.Object {
+		job := &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "validation",
+				Namespace:         jobNamespace,
+				Labels:            labels,
+				CreationTimestamp: metav1.NewTime(created),
+			},
+		}
+		if conditionType != "" {
+			job.Status.Conditions = []batchv1.JobCondition{{Type: conditionType, Status: corev1.ConditionTrue}}
+		}
+
+		return []client.Object{job}
+	}
+
+	tests := []struct {
+		name            string
+		dryRun          bool
+		existingObjects []client.Object
+		expectRequeue   bool
+		expectPassed    bool
+	}{
+		{
+			name:         "Skip validation with dry run",
+			dryRun:       true,
+			expectPassed: true,
+		},
+		{
+			name:          "Job created",
+			expectRequeue: true,
+		},
+		{
+			name:            "Job running",
+			existingObjects: existingJob(time.Now(), ""),
+			expectRequeue:   true,
+		},
+		{
+			name:            "Job completed",
+			existingObjects: existingJob(time.Now(), batchv1.JobComplete),
+			expectPassed:    true,
+		},
+		{
+			name:            "Job failed",
+			existingObjects: existingJob(time.Now(), batchv1.JobFailed),
+		},
+		{
+			name:            "Job timed out",
+			existingObjects: existingJob(time.Now().Add(-time.Hour), ""),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().
+				WithObjects(tt.existingObjects...).
+				WithStatusSubresource(tt.existingObjects...).
+				Build()
+
+			remediationClient := &FaultRemediationClient{
+				client:            fakeClient,
+				dryRunMode:        []string{},
+				templateMountPath: "templates",
+				remediationConfig: config.TomlConfig{
+					Verification: config.VerificationConfig{
+						Enabled:       true,
+						ValidationJob: config.ValidationJobConfig{Enabled: true, TimeoutMinutes: 30},
+					},
+				},
+			}
+			if tt.dryRun {
+				remediationClient.dryRunMode = []string{metav1.DryRunAll}
+			}
+
+			result, passed, err := remediationClient.RunValidationJob(context.Background(), nodeName, eventId)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectRequeue, !result.IsZero())
+			assert.Equal(t, tt.expectPassed, passed)
+
+			if tt.dryRun {
+				return
+			}
+
+			jobs := &batchv1.JobList{}
+			require.NoError(t, fakeClient.List(context.TODO(), jobs, client.MatchingLabels(labels),
+				client.InNamespace(jobNamespace)))
+			require.Len(t, jobs.Items, 1)
+
+			if len(tt.existingObjects) == 0 {
+				assert.Equal(t, nodeName, jobs.Items[0].Spec.Template.Spec.NodeName)
+			}
+		})
+	}
+}
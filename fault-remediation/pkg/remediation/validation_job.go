@@ -0,0 +1,150 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remediation
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// The labels of validation Jobs differ from those of log collector Jobs, which are looked up by node and
+	// event as well
+	validationJobNodeLabel  = "dgxc.nvidia.com/validation-node-name"
+	validationJobEventLabel = "dgxc.nvidia.com/validation-event-id"
+
+	validationJobGenerateName = "node-validation-"
+)
+
+// RunValidationJob runs the configured validation Job on a remediated node. It returns a non-zero result
+// while the Job runs, then whether the Job completed successfully within its timeout.
+func (c *FaultRemediationClient) RunValidationJob(
+	ctx context.Context,
+	nodeName string,
+	eventUID string,
+) (ctrl.Result, bool, error) {
+	if len(c.dryRunMode) > 0 {
+		slog.InfoContext(ctx, "DRY-RUN: Skipping validation job for node", "node", nodeName)
+		return ctrl.Result{}, true, nil
+	}
+
+	jobConfig := c.remediationConfig.Verification.ValidationJob
+
+	job, err := c.readValidationJobManifest(jobConfig.Template())
+	if err != nil {
+		return ctrl.Result{}, false, err
+	}
+
+	labels := map[string]string{
+		validationJobNodeLabel:  nodeName,
+		validationJobEventLabel: eventUID,
+	}
+
+	existingJobs := &batchv1.JobList{}
+	if err := c.client.List(ctx, existingJobs, client.MatchingLabels(labels),
+		client.InNamespace(job.GetNamespace())); err != nil {
+		return ctrl.Result{}, false, fmt.Errorf("failed to list validation jobs: %w", err)
+	}
+
+	if len(existingJobs.Items) == 0 {
+		if err := c.createValidationJob(ctx, job, nodeName, labels); err != nil {
+			return ctrl.Result{}, false, err
+		}
+
+		return ctrl.Result{RequeueAfter: controllerRequeueDuration}, false, nil
+	}
+
+	existing := existingJobs.Items[0]
+
+	for _, condition := range existing.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+
+		if condition.Type == batchv1.JobComplete {
+			slog.InfoContext(ctx, "Validation job completed successfully", "node", nodeName, "job", existing.Name)
+			return ctrl.Result{}, true, nil
+		}
+
+		if condition.Type == batchv1.JobFailed {
+			slog.WarnContext(ctx, "Validation job failed", "node", nodeName, "job", existing.Name,
+				"reason", condition.Reason)
+
+			return ctrl.Result{}, false, nil
+		}
+	}
+
+	if timeout := jobConfig.Timeout(); time.Since(existing.CreationTimestamp.Time) > timeout {
+		slog.WarnContext(ctx, "Validation job past timeout", "node", nodeName, "job", existing.Name,
+			"timeout", timeout)
+
+		return ctrl.Result{}, false, nil
+	}
+
+	return ctrl.Result{RequeueAfter: controllerRequeueDuration}, false, nil
+}
+
+func (c *FaultRemediationClient) readValidationJobManifest(fileName string) (*batchv1.Job, error) {
+	content, err := os.ReadFile(filepath.Join(c.templateMountPath, fileName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read validation job manifest: %w", err)
+	}
+
+	job := &batchv1.Job{}
+	if err := yaml.Unmarshal(content, job); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal validation job manifest: %w", err)
+	}
+
+	return job, nil
+}
+
+func (c *FaultRemediationClient) createValidationJob(
+	ctx context.Context,
+	job *batchv1.Job,
+	nodeName string,
+	labels map[string]string,
+) error {
+	job.Spec.Template.Spec.NodeName = nodeName
+
+	if job.Name == "" && job.GenerateName == "" {
+		job.GenerateName = validationJobGenerateName
+	}
+
+	if job.Labels == nil {
+		job.Labels = map[string]string{}
+	}
+
+	for k, v := range labels {
+		job.Labels[k] = v
+	}
+
+	if err := c.client.Create(ctx, job); err != nil {
+		return fmt.Errorf("failed to create validation job: %w", err)
+	}
+
+	slog.InfoContext(ctx, "Created validation job", "node", nodeName, "job", job.Name)
+
+	return nil
+}
@@ -0,0 +1,129 @@
+#!/bin/bash
+# Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# Reloads the NVIDIA kernel modules and runs a post-reload health check.
+# Used by the janitor GPUServicesRestart controller while the GPU services
+# of the node are torn down.
+#
+# USAGE:
+#   Mode 1: Host driver (DRIVER_ROOT=/):
+#     ./driver_reload.sh
+#
+#   Mode 2: HostPath driver mount (NVIDIA_VISIBLE_DEVICES=void):
+#     Requires HostPath volumes:
+#       - From /run/nvidia/driver to /run/nvidia/driver
+#       - From /sys to /run/nvidia/driver/sys
+#     Example:
+#       DRIVER_ROOT=/run/nvidia/driver ./driver_reload.sh
+#
+#   NVIDIA_KERNEL_MODULES overrides the modules to unload, in unload order.
+#   They are loaded back in reverse order; modules which were not loaded
+#   before are not loaded back. If the workflow fails partway, the modules
+#   it unloaded are loaded back before it exits.
+#
+# NOTES:
+#   - Requires root
+#   - Fails if a module is still in use, for example by a process holding
+#     the GPUs which was not torn down
+
+set -eou pipefail
+
+DRIVER_ROOT="${DRIVER_ROOT:-/}"
+MODULES="${NVIDIA_KERNEL_MODULES:-nvidia_peermem nvidia_drm nvidia_modeset nvidia_uvm nvidia}"
+START_TIME=$(date +%s.%N)
+
+log() {
+  printf "(%s) %s\n" "$(date '+%Y-%m-%d %H:%M:%S')" "$*"
+}
+
+driver_root_exec() {
+  chroot "$DRIVER_ROOT" "$@"
+}
+
+module_loaded() {
+  grep -q "^$1 " /proc/modules
+}
+
+# Loads back the unloaded modules which are not loaded when the workflow fails,
+# so that a failed rmmod does not leave the node without its driver
+restore_modules() {
+  local status=$?
+
+  if [ "$status" -eq 0 ]; then
+    return
+  fi
+
+  for MODULE in $UNLOADED; do
+    if module_loaded "$MODULE"; then
+      continue
+    fi
+
+    log "WARN: Loading module $MODULE back after failure..."
+    driver_root_exec modprobe "$MODULE" || log "ERROR: Failed to load module $MODULE back."
+  done
+}
+
+log "INFO: Using DRIVER_ROOT=$DRIVER_ROOT"
+log "INFO: Starting NVIDIA driver reload workflow..."
+
+#--------------
+# UNLOAD MODULES
+#--------------
+
+UNLOADED=""
+trap restore_modules EXIT
+
+for MODULE in $MODULES; do
+  if ! module_loaded "$MODULE"; then
+    log "INFO: Module $MODULE is not loaded, skipping."
+    continue
+  fi
+
+  log "INFO: Unloading module $MODULE..."
+
+  if ! driver_root_exec rmmod "$MODULE"; then
+    log "ERROR: Failed to unload module $MODULE. Holders:"
+    find "/sys/module/$MODULE/holders" -mindepth 1 -printf '  %f\n' 2>/dev/null || true
+    exit 1
+  fi
+
+  UNLOADED="$MODULE $UNLOADED"
+done
+
+#------------
+# LOAD MODULES
+#------------
+
+for MODULE in $UNLOADED; do
+  log "INFO: Loading module $MODULE..."
+  driver_root_exec modprobe "$MODULE"
+done
+
+#-------------------------
+# POST-RELOAD HEALTH CHECK
+#-------------------------
+
+log "INFO: Running post-reload health check..."
+
+if ! driver_root_exec nvidia-smi -L; then
+  log "ERROR: Post-reload health check failed."
+  exit 1
+fi
+
+END_TIME=$(date +%s.%N)
+DURATION_RAW=$(awk "BEGIN {print ${END_TIME} - ${START_TIME}}")
+DURATION=$(printf "%.3f" "$DURATION_RAW")
+
+log "SUCCESS: NVIDIA driver reload workflow completed in ${DURATION}s."
@@ -28,15 +28,21 @@ import (
 	"time"
 
 	"golang.org/x/sync/errgroup"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
 
 	"github.com/nvidia/nvsentinel/commons/pkg/logger"
 	srv "github.com/nvidia/nvsentinel/commons/pkg/server"
 	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/config"
 	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/csp"
 	awsclient "github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/csp/aws"
+	azureclient "github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/csp/azure"
 	gcpclient "github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/csp/gcp"
+	ociclient "github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/csp/oci"
 	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/datastore"
 	eventpkg "github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/event"
+	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/expedite"
 	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/metrics"
 	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/model"
 )
@@ -104,6 +110,73 @@ func startActiveMonitorAndLog(
 	}()
 }
 
+// startExpediteEngine starts the engine which asks the CSP to start maintenance early once the affected node is
+// drained, when enabled in the configuration and supported by the active monitor.
+func startExpediteEngine(
+	ctx context.Context,
+	wg *sync.WaitGroup,
+	cfg *config.Config,
+	kubeconfigPath string,
+	store datastore.Store,
+	activeMonitor csp.Monitor,
+) {
+	if !cfg.ExpediteMaintenanceAfterDrain || activeMonitor == nil {
+		return
+	}
+
+	expediter, ok := activeMonitor.(csp.Expediter)
+	if !ok {
+		slog.Warn("Expediting maintenance after drain is not supported by the active monitor",
+			"name", activeMonitor.GetName())
+
+		return
+	}
+
+	k8sClient, err := newKubernetesClient(kubeconfigPath)
+	if err != nil {
+		metrics.CSPMonitorErrors.WithLabelValues(string(activeMonitor.GetName()), "expedite_init_error").Inc()
+		slog.Error("Failed to initialize maintenance expedite engine. Maintenance will not be expedited.",
+			"error", err)
+
+		return
+	}
+
+	engine := expedite.NewEngine(cfg, store, activeMonitor.GetName(), expediter, k8sClient)
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		engine.Start(ctx)
+	}()
+}
+
+// newKubernetesClient builds a clientset from the kubeconfig path, or from the in-cluster config when it is empty.
+func newKubernetesClient(kubeconfigPath string) (kubernetes.Interface, error) {
+	var (
+		restConfig *rest.Config
+		err        error
+	)
+
+	if kubeconfigPath != "" {
+		restConfig, err = clientcmd.BuildConfigFromFlags("", kubeconfigPath)
+	} else {
+		restConfig, err = rest.InClusterConfig()
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to obtain Kubernetes config (kubeconfig: '%s'): %w", kubeconfigPath, err)
+	}
+
+	k8sClient, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Kubernetes clientset: %w", err)
+	}
+
+	return k8sClient, nil
+}
+
 func run() error {
 	configPath := flag.String("config", defaultConfigPath, "Path to the TOML configuration file.")
 	metricsPort := flag.String("metrics-port", defaultMetricsPort, "Port to expose Prometheus metrics on.")
@@ -189,6 +262,7 @@ func run() error {
 		var wg sync.WaitGroup
 
 		startActiveMonitorAndLog(gCtx, &wg, activeMonitor, eventChan)
+		startExpediteEngine(gCtx, &wg, cfg, effectiveKubeconfigPath, store, activeMonitor)
 
 		wg.Add(1)
 
@@ -217,7 +291,7 @@ func run() error {
 	return nil
 }
 
-// initActiveMonitor instantiates the appropriate CSP monitor (GCP/AWS) based on
+// initActiveMonitor instantiates the appropriate CSP monitor (GCP/AWS/Azure/OCI) based on
 // the supplied configuration. It returns nil when no CSP is enabled.
 func initActiveMonitor(
 	ctx context.Context,
@@ -259,7 +333,41 @@ func initActiveMonitor(
 		return awsMonitor
 	}
 
-	slog.Info("No CSP is explicitly enabled in the configuration (GCP or AWS).")
+	if cfg.Azure.Enabled {
+		slog.Info("Azure configuration is enabled.")
+
+		azureMonitor, err := azureclient.NewClient(ctx, cfg.Azure, cfg.ClusterName, kubeconfigPath, store)
+		if err != nil {
+			metrics.CSPMonitorErrors.WithLabelValues(string(model.CSPAzure), "init_error").Inc()
+			slog.Error("Failed to initialize Azure monitor. Azure will not be monitored.", "error", err)
+
+			return nil
+		}
+
+		slog.Info("Azure monitor initialized")
+
+		return azureMonitor
+	}
+
+	if cfg.OCI.Enabled {
+		slog.Info("OCI configuration is enabled.")
+
+		ociMonitor, err := ociclient.NewClient(ctx, cfg.OCI, cfg.ClusterName, kubeconfigPath, store)
+		if err != nil {
+			metrics.CSPMonitorErrors.WithLabelValues(string(model.CSPOCI), "init_error").Inc()
+			slog.Error("Failed to initialize OCI monitor. OCI will not be monitored.", "error", err)
+
+			return nil
+		}
+
+		slog.Info("OCI monitor initialized",
+			"compartment", cfg.OCI.CompartmentID,
+			"region", cfg.OCI.Region)
+
+		return ociMonitor
+	}
+
+	slog.Info("No CSP is explicitly enabled in the configuration (GCP, AWS, Azure or OCI).")
 
 	return nil
 }
@@ -37,13 +37,16 @@ const (
 )
 
 type Config struct {
-	MaintenanceEventPollIntervalSeconds       int       `toml:"maintenanceEventPollIntervalSeconds"`
-	TriggerQuarantineWorkflowTimeLimitMinutes int       `toml:"triggerQuarantineWorkflowTimeLimitMinutes"`
-	PostMaintenanceHealthyDelayMinutes        int       `toml:"postMaintenanceHealthyDelayMinutes"`
-	NodeReadinessTimeoutMinutes               int       `toml:"nodeReadinessTimeoutMinutes"`
-	ClusterName                               string    `toml:"clusterName"`
-	GCP                                       GCPConfig `toml:"gcp"`
-	AWS                                       AWSConfig `toml:"aws"`
+	MaintenanceEventPollIntervalSeconds       int         `toml:"maintenanceEventPollIntervalSeconds"`
+	TriggerQuarantineWorkflowTimeLimitMinutes int         `toml:"triggerQuarantineWorkflowTimeLimitMinutes"`
+	PostMaintenanceHealthyDelayMinutes        int         `toml:"postMaintenanceHealthyDelayMinutes"`
+	NodeReadinessTimeoutMinutes               int         `toml:"nodeReadinessTimeoutMinutes"`
+	ClusterName                               string      `toml:"clusterName"`
+	ExpediteMaintenanceAfterDrain             bool        `toml:"expediteMaintenanceAfterDrain"`
+	GCP                                       GCPConfig   `toml:"gcp"`
+	AWS                                       AWSConfig   `toml:"aws"`
+	Azure                                     AzureConfig `toml:"azure"`
+	OCI                                       OCIConfig   `toml:"oci"`
 }
 
 // GCPConfig holds GCP specific configuration.
@@ -64,6 +67,24 @@ type AWSConfig struct {
 	EndpointOverride       string `toml:"endpointOverride"`
 }
 
+// AzureConfig holds Azure specific configuration.
+type AzureConfig struct {
+	Enabled                bool   `toml:"enabled"`
+	PollingIntervalSeconds int    `toml:"pollingIntervalSeconds"`
+	EndpointOverride       string `toml:"endpointOverride"`
+	// Deprecated: AcknowledgeAfterDrain enables ExpediteMaintenanceAfterDrain, use that setting instead.
+	AcknowledgeAfterDrain bool `toml:"acknowledgeAfterDrain"`
+}
+
+// OCIConfig holds OCI specific configuration.
+type OCIConfig struct {
+	Enabled                bool   `toml:"enabled"`
+	CompartmentID          string `toml:"compartmentId"`
+	Region                 string `toml:"region"`
+	PollingIntervalSeconds int    `toml:"pollingIntervalSeconds"`
+	EndpointOverride       string `toml:"endpointOverride"`
+}
+
 // LoadConfig reads the configuration from a TOML file.
 func LoadConfig(filePath string) (*Config, error) {
 	var cfg Config
@@ -128,6 +149,13 @@ func applyDefaults(cfg *Config) {
 
 		cfg.NodeReadinessTimeoutMinutes = DefaultNodeReadinessTimeoutMinutes
 	}
+
+	if cfg.Azure.AcknowledgeAfterDrain {
+		slog.Warn("Deprecated configuration set, use expediteMaintenanceAfterDrain instead",
+			"setting", "azure.acknowledgeAfterDrain")
+
+		cfg.ExpediteMaintenanceAfterDrain = true
+	}
 }
 
 // validateGeneralConfig checks and enforces settings for logging and global timeouts.
@@ -176,7 +204,7 @@ func validateGeneralConfig(cfg *Config) error {
 	return nil
 }
 
-// validateCSPConfig checks GCP/AWS polling intervals and ensures only one CSP is enabled.
+// validateCSPConfig checks CSP polling intervals and ensures only one CSP is enabled.
 func validateCSPConfig(cfg *Config) error {
 	// Validate GCP polling interval
 	if cfg.GCP.Enabled && cfg.GCP.APIPollingIntervalSeconds < minCSPSpecificPollingIntervalSeconds {
@@ -196,9 +224,42 @@ func validateCSPConfig(cfg *Config) error {
 		)
 	}
 
+	// Validate Azure polling interval
+	if cfg.Azure.Enabled && cfg.Azure.PollingIntervalSeconds < minCSPSpecificPollingIntervalSeconds {
+		return fmt.Errorf(
+			"azure.pollingIntervalSeconds must be at least %d seconds (got %d)",
+			minCSPSpecificPollingIntervalSeconds,
+			cfg.Azure.PollingIntervalSeconds,
+		)
+	}
+
+	// Validate OCI polling interval and compartment
+	if cfg.OCI.Enabled {
+		if cfg.OCI.PollingIntervalSeconds < minCSPSpecificPollingIntervalSeconds {
+			return fmt.Errorf(
+				"oci.pollingIntervalSeconds must be at least %d seconds (got %d)",
+				minCSPSpecificPollingIntervalSeconds,
+				cfg.OCI.PollingIntervalSeconds,
+			)
+		}
+
+		if cfg.OCI.CompartmentID == "" {
+			return fmt.Errorf("oci.compartmentId must be set when OCI is enabled")
+		}
+	}
+
 	// Ensure only one CSP is enabled
-	if cfg.GCP.Enabled && cfg.AWS.Enabled {
-		return fmt.Errorf("multiple CSPs enabled: only one of GCP or AWS can be enabled at a time in the configuration")
+	enabled := 0
+
+	for _, cspEnabled := range []bool{cfg.GCP.Enabled, cfg.AWS.Enabled, cfg.Azure.Enabled, cfg.OCI.Enabled} {
+		if cspEnabled {
+			enabled++
+		}
+	}
+
+	if enabled > 1 {
+		return fmt.Errorf(
+			"multiple CSPs enabled: only one of GCP, AWS, Azure or OCI can be enabled at a time in the configuration")
 	}
 
 	return nil
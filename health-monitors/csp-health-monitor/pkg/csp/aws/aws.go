@@ -24,6 +24,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsConfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/health"
 	"github.com/aws/aws-sdk-go-v2/service/health/types"
 	"github.com/hashicorp/go-multierror"
@@ -104,6 +105,7 @@ type healthClientInterface interface {
 type AWSClient struct {
 	config         config.AWSConfig
 	awsClient      healthClientInterface
+	ec2Client      ec2ClientInterface
 	k8sClient      kubernetes.Interface
 	normalizer     eventpkg.Normalizer
 	clusterName    string
@@ -129,6 +131,9 @@ func NewClient(
 
 	var healthAPIClient healthClientInterface
 
+	// The EC2 client is only used to expedite maintenance, which the endpoint override does not serve
+	var ec2APIClient ec2ClientInterface
+
 	if cfg.EndpointOverride != "" {
 		slog.Info("AWS Client: Using endpoint override", "endpoint", cfg.EndpointOverride)
 
@@ -137,6 +142,7 @@ func NewClient(
 		})
 	} else {
 		healthAPIClient = health.NewFromConfig(awsSDKConfig)
+		ec2APIClient = ec2.NewFromConfig(awsSDKConfig)
 	}
 
 	slog.Info("Successfully initialized AWS Health client", "region", cfg.Region)
@@ -188,6 +194,7 @@ func NewClient(
 	return &AWSClient{
 		config:         cfg,
 		awsClient:      healthAPIClient,
+		ec2Client:      ec2APIClient,
 		k8sClient:      k8sClient,
 		normalizer:     normalizer,
 		clusterName:    clusterName,
@@ -22,6 +22,8 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/aws/aws-sdk-go-v2/service/health"
 	"github.com/aws/aws-sdk-go-v2/service/health/types"
 	"github.com/stretchr/testify/assert"
@@ -35,6 +37,7 @@ import (
 
 	pb "github.com/nvidia/nvsentinel/data-models/pkg/protos"
 	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/config"
+	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/csp"
 	eventpkg "github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/event"
 	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/model"
 )
@@ -959,3 +962,92 @@ func TestIgnoredEventTypes(t *testing.T) {
 	default:
 	}
 }
+
+type MockEC2Client struct {
+	mock.Mock
+}
+
+func (m *MockEC2Client) DescribeInstanceStatus(
+	ctx context.Context,
+	params *ec2.DescribeInstanceStatusInput,
+	optFns ...func(*ec2.Options),
+) (*ec2.DescribeInstanceStatusOutput, error) {
+	args := m.Called(ctx, params)
+	return args.Get(0).(*ec2.DescribeInstanceStatusOutput), args.Error(1)
+}
+
+func (m *MockEC2Client) ModifyInstanceEventStartTime(
+	ctx context.Context,
+	params *ec2.ModifyInstanceEventStartTimeInput,
+	optFns ...func(*ec2.Options),
+) (*ec2.ModifyInstanceEventStartTimeOutput, error) {
+	args := m.Called(ctx, params)
+	return args.Get(0).(*ec2.ModifyInstanceEventStartTimeOutput), args.Error(1)
+}
+
+func TestExpediteMaintenance(t *testing.T) {
+	notBefore := time.Now().Add(6 * time.Hour)
+	deadline := time.Now().Add(72 * time.Hour)
+	event := model.MaintenanceEvent{EventID: "test-event", ResourceID: testInstanceID, NodeName: testNodeName}
+
+	t.Run("reschedules the scheduled event", func(t *testing.T) {
+		mockEC2 := new(MockEC2Client)
+		mockEC2.On("DescribeInstanceStatus", mock.Anything, mock.Anything).Return(&ec2.DescribeInstanceStatusOutput{
+			InstanceStatuses: []ec2types.InstanceStatus{{
+				InstanceId: aws.String(testInstanceID),
+				Events: []ec2types.InstanceStatusEvent{
+					{
+						InstanceEventId:   aws.String("instance-event-old"),
+						Description:       aws.String("[Completed] The instance is running on degraded hardware"),
+						NotBefore:         aws.Time(notBefore),
+						NotBeforeDeadline: aws.Time(deadline),
+					},
+					{
+						InstanceEventId:   aws.String("instance-event-1"),
+						Code:              ec2types.EventCodeSystemReboot,
+						Description:       aws.String("Scheduled system reboot"),
+						NotBefore:         aws.Time(notBefore),
+						NotBeforeDeadline: aws.Time(deadline),
+					},
+				},
+			}},
+		}, nil)
+		mockEC2.On("ModifyInstanceEventStartTime", mock.Anything, mock.MatchedBy(
+			func(input *ec2.ModifyInstanceEventStartTimeInput) bool {
+				return aws.ToString(input.InstanceId) == testInstanceID &&
+					aws.ToString(input.InstanceEventId) == "instance-event-1" &&
+					input.NotBefore.Before(notBefore)
+			})).Return(&ec2.ModifyInstanceEventStartTimeOutput{}, nil)
+
+		client := &AWSClient{ec2Client: mockEC2}
+
+		require.NoError(t, client.ExpediteMaintenance(context.Background(), event))
+		mockEC2.AssertExpectations(t)
+	})
+
+	t.Run("event without deadline cannot be rescheduled", func(t *testing.T) {
+		mockEC2 := new(MockEC2Client)
+		mockEC2.On("DescribeInstanceStatus", mock.Anything, mock.Anything).Return(&ec2.DescribeInstanceStatusOutput{
+			InstanceStatuses: []ec2types.InstanceStatus{{
+				InstanceId: aws.String(testInstanceID),
+				Events: []ec2types.InstanceStatusEvent{{
+					InstanceEventId: aws.String("instance-event-1"),
+					Code:            ec2types.EventCodeInstanceRetirement,
+					NotBefore:       aws.Time(notBefore),
+				}},
+			}},
+		}, nil)
+
+		client := &AWSClient{ec2Client: mockEC2}
+
+		err := client.ExpediteMaintenance(context.Background(), event)
+		require.ErrorIs(t, err, csp.ErrExpediteNotSupported)
+		mockEC2.AssertNotCalled(t, "ModifyInstanceEventStartTime", mock.Anything, mock.Anything)
+	})
+
+	t.Run("endpoint override", func(t *testing.T) {
+		client := &AWSClient{}
+
+		require.ErrorIs(t, client.ExpediteMaintenance(context.Background(), event), csp.ErrExpediteNotSupported)
+	})
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/csp"
+	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/metrics"
+	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/model"
+)
+
+// expediteLeadTime is how far in the future an expedited event is rescheduled, to leave EC2 time to apply the change.
+const expediteLeadTime = 10 * time.Minute
+
+type ec2ClientInterface interface {
+	DescribeInstanceStatus(
+		ctx context.Context,
+		params *ec2.DescribeInstanceStatusInput,
+		optFns ...func(*ec2.Options),
+	) (*ec2.DescribeInstanceStatusOutput, error)
+	ModifyInstanceEventStartTime(
+		ctx context.Context,
+		params *ec2.ModifyInstanceEventStartTimeInput,
+		optFns ...func(*ec2.Options),
+	) (*ec2.ModifyInstanceEventStartTimeOutput, error)
+}
+
+// ExpediteMaintenance reschedules the scheduled EC2 event of the instance of the event to start shortly. Only events
+// with a NotBeforeDeadline can be rescheduled.
+func (c *AWSClient) ExpediteMaintenance(ctx context.Context, event model.MaintenanceEvent) error {
+	if c.ec2Client == nil {
+		return fmt.Errorf("%w: EC2 API is not available with an endpoint override", csp.ErrExpediteNotSupported)
+	}
+
+	instanceID := event.ResourceID
+
+	statusOutput, err := c.ec2Client.DescribeInstanceStatus(ctx, &ec2.DescribeInstanceStatusInput{
+		InstanceIds:         []string{instanceID},
+		IncludeAllInstances: aws.Bool(true),
+	})
+	if err != nil {
+		metrics.CSPAPIErrors.WithLabelValues(string(model.CSPAWS), "describe_instance_status").Inc()
+		return fmt.Errorf("describe status of instance %s: %w", instanceID, err)
+	}
+
+	scheduledEvent, found := reschedulableEvent(statusOutput.InstanceStatuses)
+	if !found {
+		return fmt.Errorf("%w: instance %s has no scheduled event which can be rescheduled",
+			csp.ErrExpediteNotSupported, instanceID)
+	}
+
+	notBefore := time.Now().UTC().Add(expediteLeadTime)
+	if scheduledEvent.NotBefore != nil && !notBefore.Before(*scheduledEvent.NotBefore) {
+		slog.Info("EC2 event already starts within the expedite lead time",
+			"instanceID", instanceID,
+			"instanceEventID", aws.ToString(scheduledEvent.InstanceEventId))
+
+		return nil
+	}
+
+	slog.Info("Rescheduling EC2 event to start now",
+		"eventID", event.EventID,
+		"instanceID", instanceID,
+		"instanceEventID", aws.ToString(scheduledEvent.InstanceEventId),
+		"notBefore", notBefore.Format(time.RFC3339))
+
+	_, err = c.ec2Client.ModifyInstanceEventStartTime(ctx, &ec2.ModifyInstanceEventStartTimeInput{
+		InstanceId:      aws.String(instanceID),
+		InstanceEventId: scheduledEvent.InstanceEventId,
+		NotBefore:       aws.Time(notBefore),
+	})
+	if err != nil {
+		metrics.CSPAPIErrors.WithLabelValues(string(model.CSPAWS), "modify_instance_event_start_time").Inc()
+		return fmt.Errorf("reschedule event %s of instance %s: %w",
+			aws.ToString(scheduledEvent.InstanceEventId), instanceID, err)
+	}
+
+	return nil
+}
+
+// reschedulableEvent returns the first scheduled event which can be rescheduled. EC2 keeps completed and canceled
+// events for a while, prefixing their description.
+func reschedulableEvent(statuses []ec2types.InstanceStatus) (ec2types.InstanceStatusEvent, bool) {
+	for _, status := range statuses {
+		for _, event := range status.Events {
+			description := aws.ToString(event.Description)
+			if strings.HasPrefix(description, "[Completed]") || strings.HasPrefix(description, "[Canceled]") {
+				continue
+			}
+
+			if event.InstanceEventId != nil && event.NotBeforeDeadline != nil {
+				return event, true
+			}
+		}
+	}
+
+	return ec2types.InstanceStatusEvent{}, false
+}
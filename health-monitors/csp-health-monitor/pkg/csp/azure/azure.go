@@ -0,0 +1,484 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/config"
+	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/csp"
+	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/datastore"
+	eventpkg "github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/event"
+	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/expedite"
+	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/metrics"
+	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/model"
+)
+
+const (
+	// defaultIMDSEndpoint is the Azure Instance Metadata Service, which serves the Scheduled Events of all VMs in the
+	// availability set or scale set placement group of the VM running the monitor
+	defaultIMDSEndpoint       = "http://169.254.169.254"
+	scheduledEventsPath       = "/metadata/scheduledevents"
+	scheduledEventsAPIVersion = "2020-07-01"
+	imdsRequestTimeout        = 10 * time.Second
+
+	// eventSourcePlatform marks events initiated by Azure, as opposed to events initiated by users through the API
+	eventSourcePlatform = "Platform"
+)
+
+// SupportedEventTypes are the Scheduled Events types which interrupt the VM. Freeze events pause the VM for a few
+// seconds and are not worth a quarantine.
+var SupportedEventTypes = map[string]struct{}{
+	"Reboot":    {},
+	"Redeploy":  {},
+	"Preempt":   {},
+	"Terminate": {},
+}
+
+func isSupportedEvent(event eventpkg.AzureScheduledEvent) bool {
+	if _, ok := SupportedEventTypes[event.EventType]; !ok {
+		return false
+	}
+
+	return event.EventSource == "" || event.EventSource == eventSourcePlatform
+}
+
+type AzureClient struct {
+	config       config.AzureConfig
+	httpClient   *http.Client
+	endpoint     string
+	k8sClient    kubernetes.Interface
+	normalizer   eventpkg.Normalizer
+	clusterName  string
+	store        datastore.Store
+	nodeInformer *NodeInformer
+}
+
+func NewClient(
+	ctx context.Context,
+	cfg config.AzureConfig,
+	clusterName string,
+	kubeconfigPath string,
+	store datastore.Store,
+) (*AzureClient, error) {
+	endpoint := defaultIMDSEndpoint
+	if cfg.EndpointOverride != "" {
+		slog.Info("Azure Client: Using endpoint override", "endpoint", cfg.EndpointOverride)
+
+		endpoint = strings.TrimSuffix(cfg.EndpointOverride, "/")
+	}
+
+	var k8sRestConfig *rest.Config
+
+	var err error
+
+	if kubeconfigPath != "" {
+		slog.Info("Azure Client: Using kubeconfig from path", "path", kubeconfigPath)
+		k8sRestConfig, err = clientcmd.BuildConfigFromFlags("", kubeconfigPath)
+	} else {
+		slog.Info("Azure Client: KubeconfigPath not specified, attempting in-cluster config")
+
+		k8sRestConfig, err = rest.InClusterConfig()
+	}
+
+	if err != nil {
+		metrics.CSPMonitorErrors.WithLabelValues(string(model.CSPAzure), "k8s_config_error").Inc()
+
+		return nil, fmt.Errorf(
+			"azure client failed to initialize K8s config (kubeconfig: '%s'): %w",
+			kubeconfigPath, err,
+		)
+	}
+
+	k8sClient, err := kubernetes.NewForConfig(k8sRestConfig)
+	if err != nil {
+		metrics.CSPMonitorErrors.WithLabelValues(string(model.CSPAzure), "k8s_clientset_error").Inc()
+		return nil, fmt.Errorf("azure client failed to create K8s clientset: %w", err)
+	}
+
+	slog.Info("Azure Client: Kubernetes clientset initialized successfully.")
+
+	nodeInformer, err := NewNodeInformer(k8sClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create node informer: %w", err)
+	}
+
+	nodeInformer.Start(ctx)
+
+	slog.Info("Azure Client: Node informer started successfully")
+
+	normalizer, err := eventpkg.GetNormalizer(model.CSPAzure)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Azure normalizer: %w", err)
+	}
+
+	return &AzureClient{
+		config: cfg,
+		// IMDS must not be reached through a proxy
+		httpClient:   &http.Client{Timeout: imdsRequestTimeout, Transport: &http.Transport{Proxy: nil}},
+		endpoint:     endpoint,
+		k8sClient:    k8sClient,
+		normalizer:   normalizer,
+		clusterName:  clusterName,
+		store:        store,
+		nodeInformer: nodeInformer,
+	}, nil
+}
+
+func (c *AzureClient) GetName() model.CSP {
+	return model.CSPAzure
+}
+
+// StartMonitoring polls the Azure Scheduled Events endpoint periodically.
+func (c *AzureClient) StartMonitoring(ctx context.Context, eventChan chan<- model.MaintenanceEvent) error {
+	slog.Info("Starting Azure Scheduled Events polling", "intervalSeconds", c.config.PollingIntervalSeconds)
+
+	ticker := time.NewTicker(time.Duration(c.config.PollingIntervalSeconds) * time.Second)
+	defer ticker.Stop()
+
+	if err := c.pollScheduledEvents(ctx, eventChan); err != nil {
+		slog.Error("Initial error polling Azure Scheduled Events", "error", err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.Info("Context cancelled, Azure monitoring stopped")
+			return ctx.Err()
+		case <-ticker.C:
+			if err := c.pollScheduledEvents(ctx, eventChan); err != nil {
+				metrics.CSPMonitorErrors.WithLabelValues(string(model.CSPAzure), "poll_events_error").Inc()
+				slog.Error("Error polling Azure Scheduled Events", "error", err)
+			}
+		}
+	}
+}
+
+// pollScheduledEvents fetches the Scheduled Events document and dispatches the events of cluster nodes which are
+// new or changed their status. Events which were active and are no longer listed have completed.
+func (c *AzureClient) pollScheduledEvents(ctx context.Context, eventChan chan<- model.MaintenanceEvent) error {
+	pollStart := time.Now()
+
+	defer func() {
+		metrics.CSPPollingDuration.WithLabelValues(string(model.CSPAzure)).Observe(time.Since(pollStart).Seconds())
+	}()
+
+	doc, err := c.getScheduledEvents(ctx)
+	if err != nil {
+		return err
+	}
+
+	activeEvents, err := c.store.FindActiveEventsByStatuses(ctx, model.CSPAzure, []string{
+		eventpkg.AzureEventStatusScheduled,
+		eventpkg.AzureEventStatusStarted,
+	})
+	if err != nil {
+		return fmt.Errorf("failed DB query for active events: %w", err)
+	}
+
+	knownEvents := make(map[string]model.MaintenanceEvent, len(activeEvents))
+	for _, activeEvent := range activeEvents {
+		knownEvents[activeEvent.EventID] = activeEvent
+	}
+
+	listedEvents := c.dispatchListedEvents(ctx, doc.Events, knownEvents, c.nodeInformer.GetResourceNodes(), eventChan)
+
+	for eventID, known := range knownEvents {
+		if _, listed := listedEvents[eventID]; listed {
+			continue
+		}
+
+		if err := c.dispatch(ctx, completedEvent(known), known.NodeName, known.ResourceID, eventChan); err != nil {
+			slog.Error("Error dispatching completed Azure event", "eventID", eventID, "error", err)
+		}
+	}
+
+	return nil
+}
+
+// dispatchListedEvents dispatches the events of cluster nodes which are new or changed their status, and returns the
+// IDs of all listed events of cluster nodes.
+func (c *AzureClient) dispatchListedEvents(
+	ctx context.Context,
+	events []eventpkg.AzureScheduledEvent,
+	knownEvents map[string]model.MaintenanceEvent,
+	resourceNodes map[string]string,
+	eventChan chan<- model.MaintenanceEvent,
+) map[string]struct{} {
+	listedEvents := make(map[string]struct{})
+
+	for _, event := range events {
+		metrics.CSPEventsReceived.WithLabelValues(string(model.CSPAzure)).Inc()
+
+		if !isSupportedEvent(event) {
+			metrics.CSPEventsByTypeUnsupported.WithLabelValues(string(model.CSPAzure), event.EventType).Inc()
+			slog.Debug("Ignoring unsupported event",
+				"eventID", event.EventID,
+				"eventType", event.EventType,
+				"eventSource", event.EventSource)
+
+			continue
+		}
+
+		for _, resource := range event.Resources {
+			nodeName, ok := lookupNode(resourceNodes, resource)
+			if !ok {
+				slog.Debug("Resource not found in node map", "resource", resource, "eventID", event.EventID)
+				continue
+			}
+
+			eventID := eventpkg.AzureEventID(event.EventID, resource)
+			listedEvents[eventID] = struct{}{}
+
+			if known, found := knownEvents[eventID]; found && string(known.CSPStatus) == event.EventStatus {
+				continue
+			}
+
+			if err := c.dispatch(ctx, event, nodeName, resource, eventChan); err != nil {
+				slog.Error("Error dispatching Azure event", "eventID", eventID, "error", err)
+			}
+		}
+	}
+
+	return listedEvents
+}
+
+// completedEvent rebuilds the scheduled event of a maintenance event which Azure no longer lists.
+func completedEvent(known model.MaintenanceEvent) eventpkg.AzureScheduledEvent {
+	return eventpkg.AzureScheduledEvent{
+		EventID:      known.Metadata["eventId"],
+		EventType:    known.Metadata["eventType"],
+		ResourceType: known.ResourceType,
+		Resources:    []string{known.ResourceID},
+		EventStatus:  eventpkg.AzureEventStatusCompleted,
+		Description:  known.Metadata["description"],
+		EventSource:  known.Metadata["eventSource"],
+	}
+}
+
+// lookupNode returns the node of a resource. Scale set VMs may be listed with a leading underscore.
+func lookupNode(resourceNodes map[string]string, resource string) (string, bool) {
+	if nodeName, ok := resourceNodes[resource]; ok {
+		return nodeName, true
+	}
+
+	nodeName, ok := resourceNodes[strings.TrimPrefix(resource, "_")]
+
+	return nodeName, ok
+}
+
+func (c *AzureClient) dispatch(
+	ctx context.Context,
+	event eventpkg.AzureScheduledEvent,
+	nodeName string,
+	resource string,
+	eventChan chan<- model.MaintenanceEvent,
+) error {
+	normalizedEvent, err := c.normalizer.Normalize(event, eventpkg.AzureEventMetadata{
+		NodeName:     nodeName,
+		ResourceName: resource,
+		ClusterName:  c.clusterName,
+	})
+	if err != nil {
+		metrics.MainNormalizationErrors.WithLabelValues(string(model.CSPAzure)).Inc()
+		return fmt.Errorf("error normalizing Azure event %s: %w", event.EventID, err)
+	}
+
+	metrics.MainEventsToNormalize.WithLabelValues(string(model.CSPAzure)).Inc()
+
+	select {
+	case eventChan <- *normalizedEvent:
+		slog.Info("Dispatched maintenance event",
+			"node", nodeName,
+			"resource", resource,
+			"eventID", normalizedEvent.EventID,
+			"status", normalizedEvent.CSPStatus)
+	case <-ctx.Done():
+		return fmt.Errorf("context cancelled while sending event for node %s (resource %s, event %s)",
+			nodeName, resource, event.EventID)
+	}
+
+	return nil
+}
+
+// ExpediteMaintenance acknowledges the scheduled event of a drained node, so that Azure starts the maintenance
+// without waiting for NotBefore. A scheduled event may affect several VMs, so it is only acknowledged once every
+// resource it affects is a drained cluster node; events affecting VMs outside the cluster are left to their schedule.
+func (c *AzureClient) ExpediteMaintenance(ctx context.Context, event model.MaintenanceEvent) error {
+	eventID := event.Metadata["eventId"]
+	if eventID == "" {
+		return fmt.Errorf("%w: event %s has no Scheduled Events ID", csp.ErrExpediteNotSupported, event.EventID)
+	}
+
+	doc, err := c.getScheduledEvents(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, scheduledEvent := range doc.Events {
+		if scheduledEvent.EventID != eventID {
+			continue
+		}
+
+		// Started events need no acknowledgement, and vanished events are completed by the next poll
+		if scheduledEvent.EventStatus != eventpkg.AzureEventStatusScheduled {
+			return nil
+		}
+
+		if !c.allResourcesDrained(ctx, scheduledEvent, c.nodeInformer.GetResourceNodes()) {
+			return fmt.Errorf("%w: not every resource of scheduled event %s is a drained node",
+				csp.ErrExpediteDeferred, eventID)
+		}
+
+		return c.startEvents(ctx, []string{eventID})
+	}
+
+	return nil
+}
+
+func (c *AzureClient) allResourcesDrained(
+	ctx context.Context,
+	event eventpkg.AzureScheduledEvent,
+	resourceNodes map[string]string,
+) bool {
+	if len(event.Resources) == 0 {
+		return false
+	}
+
+	for _, resource := range event.Resources {
+		nodeName, ok := lookupNode(resourceNodes, resource)
+		if !ok {
+			return false
+		}
+
+		node, err := c.k8sClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+		if err != nil {
+			slog.Warn("Failed to get node to check drain state", "node", nodeName, "error", err)
+			return false
+		}
+
+		if !expedite.IsNodeDrained(node) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (c *AzureClient) scheduledEventsURL() string {
+	return c.endpoint + scheduledEventsPath + "?api-version=" + scheduledEventsAPIVersion
+}
+
+// getScheduledEvents fetches the Scheduled Events document from IMDS.
+func (c *AzureClient) getScheduledEvents(ctx context.Context) (*eventpkg.AzureScheduledEventsDocument, error) {
+	start := time.Now()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.scheduledEventsURL(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Scheduled Events request: %w", err)
+	}
+
+	req.Header.Set("Metadata", "true")
+
+	resp, err := c.httpClient.Do(req)
+
+	metrics.CSPAPIDuration.WithLabelValues(string(model.CSPAzure), "get_scheduled_events").
+		Observe(time.Since(start).Seconds())
+
+	if err != nil {
+		metrics.CSPAPIErrors.WithLabelValues(string(model.CSPAzure), "get_scheduled_events").Inc()
+		return nil, fmt.Errorf("error fetching Scheduled Events: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+
+		metrics.CSPAPIErrors.WithLabelValues(string(model.CSPAzure), "get_scheduled_events").Inc()
+
+		return nil, fmt.Errorf("unexpected status %d fetching Scheduled Events: %s", resp.StatusCode, string(body))
+	}
+
+	var doc eventpkg.AzureScheduledEventsDocument
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		metrics.CSPAPIErrors.WithLabelValues(string(model.CSPAzure), "decode_scheduled_events").Inc()
+		return nil, fmt.Errorf("failed to decode Scheduled Events: %w", err)
+	}
+
+	slog.Debug("Fetched Azure Scheduled Events",
+		"documentIncarnation", doc.DocumentIncarnation,
+		"count", len(doc.Events))
+
+	return &doc, nil
+}
+
+type startRequest struct {
+	EventID string `json:"EventId"`
+}
+
+type startRequests struct {
+	StartRequests []startRequest `json:"StartRequests"`
+}
+
+// startEvents acknowledges scheduled events, which makes Azure start them.
+func (c *AzureClient) startEvents(ctx context.Context, eventIDs []string) error {
+	body := startRequests{StartRequests: make([]startRequest, 0, len(eventIDs))}
+	for _, eventID := range eventIDs {
+		body.StartRequests = append(body.StartRequests, startRequest{EventID: eventID})
+	}
+
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to marshal start requests: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.scheduledEventsURL(), bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to create start request: %w", err)
+	}
+
+	req.Header.Set("Metadata", "true")
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		metrics.CSPAPIErrors.WithLabelValues(string(model.CSPAzure), "start_scheduled_events").Inc()
+		return fmt.Errorf("error acknowledging Scheduled Events: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+
+		metrics.CSPAPIErrors.WithLabelValues(string(model.CSPAzure), "start_scheduled_events").Inc()
+
+		return fmt.Errorf("unexpected status %d acknowledging Scheduled Events: %s", resp.StatusCode, string(respBody))
+	}
+
+	return nil
+}
@@ -0,0 +1,304 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/nvidia/nvsentinel/commons/pkg/statemanager"
+	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/config"
+	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/csp"
+	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/datastore"
+	eventpkg "github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/event"
+	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/model"
+)
+
+const (
+	testNodeName     = "aks-gpu-12345678-vmss000000"
+	testResourceName = "aks-gpu-12345678-vmss_0"
+	testProviderID   = "azure:///subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/" +
+		"virtualMachineScaleSets/aks-gpu-12345678-vmss/virtualMachines/0"
+	testEventID = "602d9444-d2cd-49c7-8624-8643e7171297"
+)
+
+// fakeStore returns the configured active events; the other datastore methods are not used by the monitor.
+type fakeStore struct {
+	datastore.Store
+	activeEvents []model.MaintenanceEvent
+}
+
+func (s *fakeStore) FindActiveEventsByStatuses(
+	ctx context.Context,
+	csp model.CSP,
+	statuses []string,
+) ([]model.MaintenanceEvent, error) {
+	return s.activeEvents, nil
+}
+
+// fakeIMDS serves a fixed Scheduled Events document and records start requests.
+type fakeIMDS struct {
+	mu            sync.Mutex
+	doc           eventpkg.AzureScheduledEventsDocument
+	startRequests []string
+}
+
+func (f *fakeIMDS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != scheduledEventsPath || r.Header.Get("Metadata") != "true" {
+		http.Error(w, "bad request", http.StatusBadRequest)
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if r.Method == http.MethodPost {
+		var body startRequests
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		for _, req := range body.StartRequests {
+			f.startRequests = append(f.startRequests, req.EventID)
+		}
+
+		return
+	}
+
+	_ = json.NewEncoder(w).Encode(f.doc)
+}
+
+func newTestScheduledEvent(eventType, status string) eventpkg.AzureScheduledEvent {
+	return eventpkg.AzureScheduledEvent{
+		EventID:           testEventID,
+		EventType:         eventType,
+		ResourceType:      "VirtualMachine",
+		Resources:         []string{testResourceName, "vm-outside-cluster"},
+		EventStatus:       status,
+		NotBefore:         time.Now().Add(10 * time.Minute).UTC().Format(time.RFC1123),
+		EventSource:       eventSourcePlatform,
+		DurationInSeconds: -1,
+	}
+}
+
+func createTestClient(
+	t *testing.T,
+	imds *fakeIMDS,
+	store datastore.Store,
+	nodeLabels map[string]string,
+) *AzureClient {
+	t.Helper()
+
+	server := httptest.NewServer(imds)
+	t.Cleanup(server.Close)
+
+	k8sClient := fake.NewSimpleClientset(&v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: testNodeName, Labels: nodeLabels},
+		Spec:       v1.NodeSpec{ProviderID: testProviderID},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	nodeInformer, err := NewNodeInformer(k8sClient)
+	require.NoError(t, err)
+	nodeInformer.Start(ctx)
+
+	require.Eventually(t, func() bool {
+		_, exists := nodeInformer.GetResourceNodes()[testResourceName]
+		return exists
+	}, 5*time.Second, 50*time.Millisecond, "Node should be tracked by informer")
+
+	return &AzureClient{
+		config:       config.AzureConfig{Enabled: true, PollingIntervalSeconds: 60},
+		httpClient:   server.Client(),
+		endpoint:     server.URL,
+		k8sClient:    k8sClient,
+		normalizer:   &eventpkg.AzureNormalizer{},
+		clusterName:  "test-cluster",
+		store:        store,
+		nodeInformer: nodeInformer,
+	}
+}
+
+func drainEvents(eventChan chan model.MaintenanceEvent) []model.MaintenanceEvent {
+	var events []model.MaintenanceEvent
+
+	for {
+		select {
+		case e := <-eventChan:
+			events = append(events, e)
+		default:
+			return events
+		}
+	}
+}
+
+func TestExtractResourceName(t *testing.T) {
+	tests := []struct {
+		providerID string
+		expected   string
+	}{
+		{providerID: testProviderID, expected: testResourceName},
+		{
+			providerID: "azure:///subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/virtualMachines/gpu-vm-1",
+			expected:   "gpu-vm-1",
+		},
+		{providerID: "aws:///us-east-1a/i-0123456789abcdef0", expected: ""},
+		{providerID: "azure:///subscriptions/sub", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.providerID, func(t *testing.T) {
+			node := &v1.Node{Spec: v1.NodeSpec{ProviderID: tt.providerID}}
+			assert.Equal(t, tt.expected, extractResourceName(node))
+		})
+	}
+}
+
+func TestPollScheduledEvents_DispatchesNewEvents(t *testing.T) {
+	imds := &fakeIMDS{doc: eventpkg.AzureScheduledEventsDocument{
+		DocumentIncarnation: 1,
+		Events: []eventpkg.AzureScheduledEvent{
+			newTestScheduledEvent("Reboot", eventpkg.AzureEventStatusScheduled),
+			func() eventpkg.AzureScheduledEvent {
+				e := newTestScheduledEvent("Freeze", eventpkg.AzureEventStatusScheduled)
+				e.EventID = "freeze-event"
+
+				return e
+			}(),
+		},
+	}}
+	client := createTestClient(t, imds, &fakeStore{}, nil)
+	eventChan := make(chan model.MaintenanceEvent, 10)
+
+	require.NoError(t, client.pollScheduledEvents(context.Background(), eventChan))
+
+	events := drainEvents(eventChan)
+	require.Len(t, events, 1, "only the Reboot event of the cluster node should be dispatched")
+	assert.Equal(t, eventpkg.AzureEventID(testEventID, testResourceName), events[0].EventID)
+	assert.Equal(t, testNodeName, events[0].NodeName)
+	assert.Equal(t, model.StatusDetected, events[0].Status)
+	assert.Empty(t, imds.startRequests, "polling should not acknowledge events")
+}
+
+func TestPollScheduledEvents_SkipsUnchangedAndCompletesVanishedEvents(t *testing.T) {
+	knownEvent := model.MaintenanceEvent{
+		EventID:      eventpkg.AzureEventID(testEventID, testResourceName),
+		CSP:          model.CSPAzure,
+		NodeName:     testNodeName,
+		ResourceID:   testResourceName,
+		ResourceType: "VirtualMachine",
+		CSPStatus:    model.ProviderStatus(eventpkg.AzureEventStatusScheduled),
+		Metadata:     map[string]string{"eventId": testEventID, "eventType": "Reboot"},
+	}
+	store := &fakeStore{activeEvents: []model.MaintenanceEvent{knownEvent}}
+	imds := &fakeIMDS{doc: eventpkg.AzureScheduledEventsDocument{
+		Events: []eventpkg.AzureScheduledEvent{newTestScheduledEvent("Reboot", eventpkg.AzureEventStatusScheduled)},
+	}}
+	client := createTestClient(t, imds, store, nil)
+	eventChan := make(chan model.MaintenanceEvent, 10)
+
+	require.NoError(t, client.pollScheduledEvents(context.Background(), eventChan))
+	assert.Empty(t, drainEvents(eventChan), "unchanged events should not be dispatched again")
+
+	imds.doc.Events = nil
+
+	require.NoError(t, client.pollScheduledEvents(context.Background(), eventChan))
+
+	events := drainEvents(eventChan)
+	require.Len(t, events, 1)
+	assert.Equal(t, knownEvent.EventID, events[0].EventID)
+	assert.Equal(t, model.StatusMaintenanceComplete, events[0].Status)
+}
+
+func TestExpediteMaintenance(t *testing.T) {
+	drainedLabels := map[string]string{
+		statemanager.NVSentinelStateLabelKey: string(statemanager.DrainSucceededLabelValue),
+	}
+
+	tests := []struct {
+		name          string
+		labels        map[string]string
+		resources     []string
+		status        string
+		expected      []string
+		expectedError error
+	}{
+		{
+			name:      "drained node",
+			labels:    drainedLabels,
+			resources: []string{testResourceName},
+			status:    eventpkg.AzureEventStatusScheduled,
+			expected:  []string{testEventID},
+		},
+		{
+			name:          "node not drained",
+			resources:     []string{testResourceName},
+			status:        eventpkg.AzureEventStatusScheduled,
+			expectedError: csp.ErrExpediteDeferred,
+		},
+		{
+			name:          "resource outside the cluster",
+			labels:        drainedLabels,
+			resources:     []string{testResourceName, "vm-outside-cluster"},
+			status:        eventpkg.AzureEventStatusScheduled,
+			expectedError: csp.ErrExpediteDeferred,
+		},
+		{
+			name:      "event already started",
+			labels:    drainedLabels,
+			resources: []string{testResourceName},
+			status:    eventpkg.AzureEventStatusStarted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheduledEvent := newTestScheduledEvent("Reboot", tt.status)
+			scheduledEvent.Resources = tt.resources
+
+			imds := &fakeIMDS{doc: eventpkg.AzureScheduledEventsDocument{
+				Events: []eventpkg.AzureScheduledEvent{scheduledEvent},
+			}}
+			client := createTestClient(t, imds, &fakeStore{}, tt.labels)
+
+			err := client.ExpediteMaintenance(context.Background(), model.MaintenanceEvent{
+				EventID:  eventpkg.AzureEventID(testEventID, testResourceName),
+				NodeName: testNodeName,
+				Metadata: map[string]string{"eventId": testEventID},
+			})
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.expected, imds.startRequests)
+		})
+	}
+}
@@ -0,0 +1,182 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+// NodeInformer watches Kubernetes nodes and maintains an up-to-date mapping
+// of Azure resource names, as listed in Scheduled Events, to node names
+type NodeInformer struct {
+	k8sClient              kubernetes.Interface
+	informer               cache.SharedIndexInformer
+	stopCh                 chan struct{}
+	resourceNameToNodeName map[string]string
+	mu                     sync.RWMutex
+	stopOnce               sync.Once
+}
+
+func NewNodeInformer(k8sClient kubernetes.Interface) (*NodeInformer, error) {
+	ni := &NodeInformer{
+		k8sClient:              k8sClient,
+		stopCh:                 make(chan struct{}),
+		resourceNameToNodeName: make(map[string]string),
+	}
+
+	factory := informers.NewSharedInformerFactory(k8sClient, 0)
+	informer := factory.Core().V1().Nodes().Informer()
+
+	_, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			node := obj.(*v1.Node)
+			ni.handleNodeAdd(node)
+		},
+		DeleteFunc: func(obj interface{}) {
+			node, ok := obj.(*v1.Node)
+			if !ok {
+				tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+				if !ok {
+					return
+				}
+
+				if node, ok = tombstone.Obj.(*v1.Node); !ok {
+					return
+				}
+			}
+
+			ni.handleNodeDelete(node)
+		},
+	})
+	if err != nil {
+		slog.Error("Failed to add event handlers to informer", "error", err)
+		return nil, fmt.Errorf("failed to add event handlers to informer: %w", err)
+	}
+
+	ni.informer = informer
+
+	return ni, nil
+}
+
+func (ni *NodeInformer) Start(ctx context.Context) {
+	slog.Info("Starting Azure node informer")
+
+	go ni.informer.Run(ni.stopCh)
+
+	if !cache.WaitForCacheSync(ni.stopCh, ni.informer.HasSynced) {
+		slog.Error("Failed to sync node informer cache")
+		ni.Stop()
+
+		return
+	}
+
+	ni.mu.RLock()
+	slog.Info("Azure node informer cache synced successfully", "nodesMap", ni.resourceNameToNodeName)
+	ni.mu.RUnlock()
+
+	go func() {
+		<-ctx.Done()
+		ni.Stop()
+	}()
+}
+
+func (ni *NodeInformer) Stop() {
+	ni.stopOnce.Do(func() {
+		slog.Info("Stopping Azure node informer")
+		close(ni.stopCh)
+	})
+}
+
+// GetResourceNodes returns a copy of the mapping of Azure resource names to node names.
+func (ni *NodeInformer) GetResourceNodes() map[string]string {
+	ni.mu.RLock()
+	defer ni.mu.RUnlock()
+
+	resourceNodesCopy := make(map[string]string, len(ni.resourceNameToNodeName))
+	for k, v := range ni.resourceNameToNodeName {
+		resourceNodesCopy[k] = v
+	}
+
+	return resourceNodesCopy
+}
+
+func (ni *NodeInformer) handleNodeAdd(node *v1.Node) {
+	resourceName := extractResourceName(node)
+	if resourceName == "" {
+		return
+	}
+
+	ni.mu.Lock()
+	ni.resourceNameToNodeName[resourceName] = node.Name
+	ni.mu.Unlock()
+
+	slog.Info("Node added to Azure resource map",
+		"node", node.Name,
+		"resourceName", resourceName)
+}
+
+func (ni *NodeInformer) handleNodeDelete(node *v1.Node) {
+	resourceName := extractResourceName(node)
+	if resourceName == "" {
+		return
+	}
+
+	ni.mu.Lock()
+	delete(ni.resourceNameToNodeName, resourceName)
+	ni.mu.Unlock()
+
+	slog.Info("Node removed from Azure resource map",
+		"node", node.Name,
+		"resourceName", resourceName)
+}
+
+// extractResourceName returns the name under which Scheduled Events list the virtual machine of the node: the VM
+// name for standalone and availability set VMs, and <scale set>_<instance ID> for scale set VMs.
+func extractResourceName(node *v1.Node) string {
+	// Parse Azure provider ID formats:
+	// azure:///subscriptions/<sub>/resourceGroups/<rg>/providers/Microsoft.Compute/virtualMachines/<vm>
+	// azure:///subscriptions/<sub>/resourceGroups/<rg>/providers/Microsoft.Compute/virtualMachineScaleSets/<vmss>/
+	//   virtualMachines/<id>
+	if !strings.HasPrefix(node.Spec.ProviderID, "azure://") {
+		return ""
+	}
+
+	parts := strings.Split(strings.TrimPrefix(node.Spec.ProviderID, "azure://"), "/")
+
+	for i := 0; i+1 < len(parts); i++ {
+		switch {
+		case strings.EqualFold(parts[i], "virtualMachineScaleSets") && i+3 < len(parts) &&
+			strings.EqualFold(parts[i+2], "virtualMachines"):
+			return parts[i+1] + "_" + parts[i+3]
+		case strings.EqualFold(parts[i], "virtualMachines"):
+			return parts[i+1]
+		}
+	}
+
+	slog.Debug("Unexpected Azure provider ID format",
+		"node", node.Name,
+		"providerID", node.Spec.ProviderID)
+
+	return ""
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	compute "cloud.google.com/go/compute/apiv1"
+	"cloud.google.com/go/compute/apiv1/computepb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/csp"
+	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/metrics"
+	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/model"
+)
+
+const gceProviderIDPrefix = "gce://"
+
+// gceInstance identifies a Compute Engine instance.
+type gceInstance struct {
+	project string
+	zone    string
+	name    string
+}
+
+// ExpediteMaintenance asks Compute Engine to perform the pending maintenance of the instance of the event now,
+// instead of at its scheduled start time.
+func (c *Client) ExpediteMaintenance(ctx context.Context, event model.MaintenanceEvent) error {
+	if c.config.EndpointOverride != "" {
+		// The endpoint override only applies to Cloud Logging, there is no Compute Engine API to call
+		return fmt.Errorf("%w: Compute Engine API is not available with an endpoint override",
+			csp.ErrExpediteNotSupported)
+	}
+
+	instance, err := c.instanceOfEvent(ctx, event)
+	if err != nil {
+		return err
+	}
+
+	instancesClient, err := compute.NewInstancesRESTClient(ctx)
+	if err != nil {
+		metrics.CSPAPIErrors.WithLabelValues(string(model.CSPGCP), "instances_client_init").Inc()
+		return fmt.Errorf("failed to create instances client: %w", err)
+	}
+
+	defer func() {
+		if cerr := instancesClient.Close(); cerr != nil {
+			slog.Error("Failed to close instances client", "error", cerr)
+		}
+	}()
+
+	slog.Info("Requesting GCP maintenance to start now",
+		"eventID", event.EventID,
+		"node", event.NodeName,
+		"project", instance.project,
+		"zone", instance.zone,
+		"instance", instance.name)
+
+	_, err = instancesClient.PerformMaintenance(ctx, &computepb.PerformMaintenanceInstanceRequest{
+		Project:  instance.project,
+		Zone:     instance.zone,
+		Instance: instance.name,
+	})
+	if err != nil {
+		metrics.CSPAPIErrors.WithLabelValues(string(model.CSPGCP), "perform_maintenance").Inc()
+		return fmt.Errorf("perform maintenance on instance %s: %w", instance.name, err)
+	}
+
+	return nil
+}
+
+// instanceOfEvent resolves the instance of an event from the provider ID of its node. GKE names nodes after their
+// instances, so the node name and the event metadata are used when the provider ID is not a GCE one.
+func (c *Client) instanceOfEvent(ctx context.Context, event model.MaintenanceEvent) (gceInstance, error) {
+	node, err := c.k8sClientset.CoreV1().Nodes().Get(ctx, event.NodeName, metav1.GetOptions{})
+	if err != nil {
+		return gceInstance{}, fmt.Errorf("failed to get node %s: %w", event.NodeName, err)
+	}
+
+	if instance, ok := parseGCEProviderID(node.Spec.ProviderID); ok {
+		return instance, nil
+	}
+
+	instance := gceInstance{
+		project: event.Metadata["gcp_project_id"],
+		zone:    event.Metadata["gcp_zone"],
+		name:    event.NodeName,
+	}
+	if instance.project == "" {
+		instance.project = c.config.TargetProjectID
+	}
+
+	if instance.zone == "" {
+		return gceInstance{}, fmt.Errorf("zone of node %s is unknown", event.NodeName)
+	}
+
+	return instance, nil
+}
+
+// parseGCEProviderID parses a provider ID of the form gce://<project>/<zone>/<instance>.
+func parseGCEProviderID(providerID string) (gceInstance, bool) {
+	if !strings.HasPrefix(providerID, gceProviderIDPrefix) {
+		return gceInstance{}, false
+	}
+
+	parts := strings.Split(strings.TrimPrefix(providerID, gceProviderIDPrefix), "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return gceInstance{}, false
+	}
+
+	return gceInstance{project: parts[0], zone: parts[1], name: parts[2]}, true
+}
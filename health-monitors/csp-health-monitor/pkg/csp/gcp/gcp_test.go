@@ -1027,3 +1027,40 @@ func TestGetName(t *testing.T) {
 		t.Fatalf("GetName() expected %s, got %s", model.CSPGCP, c.GetName())
 	}
 }
+
+func TestInstanceOfEvent(t *testing.T) {
+	withProviderID := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "gke-node-1"},
+		Spec:       corev1.NodeSpec{ProviderID: "gce://node-project/us-central1-a/gke-instance-1"},
+	}
+	withoutProviderID := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "gke-node-2"}}
+	c := &Client{
+		config:       config.GCPConfig{TargetProjectID: "config-project"},
+		k8sClientset: fake.NewSimpleClientset(withProviderID, withoutProviderID),
+	}
+
+	got, err := c.instanceOfEvent(context.Background(), model.MaintenanceEvent{NodeName: "gke-node-1"})
+	if err != nil {
+		t.Fatalf(UNEXPECTED_ERROR_MESSAGE, err)
+	}
+	want := gceInstance{project: "node-project", zone: "us-central1-a", name: "gke-instance-1"}
+	if got != want {
+		t.Fatalf("expected %+v, got %+v", want, got)
+	}
+
+	got, err = c.instanceOfEvent(context.Background(), model.MaintenanceEvent{
+		NodeName: "gke-node-2",
+		Metadata: map[string]string{"gcp_zone": "us-central1-b"},
+	})
+	if err != nil {
+		t.Fatalf(UNEXPECTED_ERROR_MESSAGE, err)
+	}
+	want = gceInstance{project: "config-project", zone: "us-central1-b", name: "gke-node-2"}
+	if got != want {
+		t.Fatalf("expected %+v, got %+v", want, got)
+	}
+
+	if _, err = c.instanceOfEvent(context.Background(), model.MaintenanceEvent{NodeName: "gke-node-2"}); err == nil {
+		t.Fatal("expected an error when the zone of the node is unknown")
+	}
+}
@@ -16,6 +16,7 @@ package csp
 
 import (
 	"context"
+	"errors"
 
 	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/model"
 )
@@ -29,3 +30,19 @@ type Monitor interface {
 	// GetName returns the name of the CSP (e.g., "gcp", "aws").
 	GetName() model.CSP
 }
+
+// ErrExpediteNotSupported is returned by an Expediter when the provider cannot start the maintenance of an event
+// early, e.g. because the event type has no early start API.
+var ErrExpediteNotSupported = errors.New("expediting maintenance is not supported for this event")
+
+// ErrExpediteDeferred is returned by an Expediter when the maintenance cannot be started yet, e.g. because it also
+// affects nodes which are not drained. The expedite is retried on the next poll.
+var ErrExpediteDeferred = errors.New("expediting maintenance is deferred")
+
+// Expediter is implemented by CSP clients which can ask the provider to start a scheduled maintenance before its
+// scheduled start time.
+type Expediter interface {
+	// ExpediteMaintenance asks the provider to start the maintenance of the event as soon as possible. It is only
+	// called once the node of the event has been drained.
+	ExpediteMaintenance(ctx context.Context, event model.MaintenanceEvent) error
+}
@@ -0,0 +1,388 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/common/auth"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/config"
+	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/datastore"
+	eventpkg "github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/event"
+	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/metrics"
+	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/model"
+)
+
+// activeLifecycleStates are the lifecycle states of maintenance events which have not finished yet.
+var activeLifecycleStates = []string{
+	eventpkg.OCIEventStateScheduled,
+	eventpkg.OCIEventStateStarted,
+	eventpkg.OCIEventStateProcessing,
+}
+
+func isActiveLifecycleState(state string) bool {
+	for _, activeState := range activeLifecycleStates {
+		if state == activeState {
+			return true
+		}
+	}
+
+	return false
+}
+
+type computeClientInterface interface {
+	ListInstanceMaintenanceEvents(
+		ctx context.Context,
+		request core.ListInstanceMaintenanceEventsRequest,
+	) (core.ListInstanceMaintenanceEventsResponse, error)
+}
+
+type OCIClient struct {
+	config        config.OCIConfig
+	computeClient computeClientInterface
+	normalizer    eventpkg.Normalizer
+	clusterName   string
+	store         datastore.Store
+	nodeInformer  *NodeInformer
+}
+
+// newComputeClient creates the OCI Compute client. Like janitor-provider, it uses the credentials file set by
+// OCI_CREDENTIALS_FILE and OCI_PROFILE if present, and OKE workload identity otherwise.
+func newComputeClient(cfg config.OCIConfig) (*core.ComputeClient, error) {
+	var (
+		cfgProvider common.ConfigurationProvider
+		err         error
+	)
+
+	if os.Getenv("OCI_CREDENTIALS_FILE") != "" {
+		cfgProvider = common.CustomProfileConfigProvider(os.Getenv("OCI_CREDENTIALS_FILE"), os.Getenv("OCI_PROFILE"))
+	} else {
+		cfgProvider, err = auth.OkeWorkloadIdentityConfigurationProvider()
+		if err != nil {
+			return nil, fmt.Errorf("failed to create OKE workload identity provider: %w", err)
+		}
+	}
+
+	computeClient, err := core.NewComputeClientWithConfigurationProvider(cfgProvider)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OCI Compute client: %w", err)
+	}
+
+	if cfg.Region != "" {
+		computeClient.SetRegion(cfg.Region)
+	}
+
+	if cfg.EndpointOverride != "" {
+		slog.Info("OCI Client: Using endpoint override", "endpoint", cfg.EndpointOverride)
+
+		computeClient.Host = cfg.EndpointOverride
+	}
+
+	return &computeClient, nil
+}
+
+func NewClient(
+	ctx context.Context,
+	cfg config.OCIConfig,
+	clusterName string,
+	kubeconfigPath string,
+	store datastore.Store,
+) (*OCIClient, error) {
+	computeClient, err := newComputeClient(cfg)
+	if err != nil {
+		metrics.CSPMonitorErrors.WithLabelValues(string(model.CSPOCI), "oci_sdk_config_error").Inc()
+		return nil, err
+	}
+
+	slog.Info("Successfully initialized OCI Compute client", "region", cfg.Region)
+
+	var k8sRestConfig *rest.Config
+
+	if kubeconfigPath != "" {
+		slog.Info("OCI Client: Using kubeconfig from path", "path", kubeconfigPath)
+		k8sRestConfig, err = clientcmd.BuildConfigFromFlags("", kubeconfigPath)
+	} else {
+		slog.Info("OCI Client: KubeconfigPath not specified, attempting in-cluster config")
+
+		k8sRestConfig, err = rest.InClusterConfig()
+	}
+
+	if err != nil {
+		metrics.CSPMonitorErrors.WithLabelValues(string(model.CSPOCI), "k8s_config_error").Inc()
+
+		return nil, fmt.Errorf(
+			"OCI client failed to initialize K8s config (kubeconfig: '%s'): %w",
+			kubeconfigPath, err,
+		)
+	}
+
+	k8sClient, err := kubernetes.NewForConfig(k8sRestConfig)
+	if err != nil {
+		metrics.CSPMonitorErrors.WithLabelValues(string(model.CSPOCI), "k8s_clientset_error").Inc()
+		return nil, fmt.Errorf("OCI client failed to create K8s clientset: %w", err)
+	}
+
+	slog.Info("OCI Client: Kubernetes clientset initialized successfully.")
+
+	nodeInformer, err := NewNodeInformer(k8sClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create node informer: %w", err)
+	}
+
+	nodeInformer.Start(ctx)
+
+	slog.Info("OCI Client: Node informer started successfully")
+
+	normalizer, err := eventpkg.GetNormalizer(model.CSPOCI)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get OCI normalizer: %w", err)
+	}
+
+	return &OCIClient{
+		config:        cfg,
+		computeClient: computeClient,
+		normalizer:    normalizer,
+		clusterName:   clusterName,
+		store:         store,
+		nodeInformer:  nodeInformer,
+	}, nil
+}
+
+func (c *OCIClient) GetName() model.CSP {
+	return model.CSPOCI
+}
+
+// StartMonitoring polls the OCI instance maintenance events of the compartment periodically.
+func (c *OCIClient) StartMonitoring(ctx context.Context, eventChan chan<- model.MaintenanceEvent) error {
+	slog.Info("Starting OCI instance maintenance event polling",
+		"intervalSeconds", c.config.PollingIntervalSeconds,
+		"compartmentID", c.config.CompartmentID)
+
+	ticker := time.NewTicker(time.Duration(c.config.PollingIntervalSeconds) * time.Second)
+	defer ticker.Stop()
+
+	if err := c.pollMaintenanceEvents(ctx, eventChan); err != nil {
+		slog.Error("Initial error polling OCI instance maintenance events", "error", err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.Info("Context cancelled, OCI monitoring stopped")
+			return ctx.Err()
+		case <-ticker.C:
+			if err := c.pollMaintenanceEvents(ctx, eventChan); err != nil {
+				metrics.CSPMonitorErrors.WithLabelValues(string(model.CSPOCI), "poll_events_error").Inc()
+				slog.Error("Error polling OCI instance maintenance events", "error", err)
+			}
+		}
+	}
+}
+
+// pollMaintenanceEvents lists the maintenance events of the compartment and dispatches the events of cluster nodes
+// which are new or changed their lifecycle state. Finished events which were never active are history and skipped.
+// Events which were active and are no longer listed have completed.
+func (c *OCIClient) pollMaintenanceEvents(ctx context.Context, eventChan chan<- model.MaintenanceEvent) error {
+	pollStart := time.Now()
+
+	defer func() {
+		metrics.CSPPollingDuration.WithLabelValues(string(model.CSPOCI)).Observe(time.Since(pollStart).Seconds())
+	}()
+
+	events, err := c.listMaintenanceEvents(ctx)
+	if err != nil {
+		return err
+	}
+
+	activeEvents, err := c.store.FindActiveEventsByStatuses(ctx, model.CSPOCI, activeLifecycleStates)
+	if err != nil {
+		return fmt.Errorf("failed DB query for active events: %w", err)
+	}
+
+	knownEvents := make(map[string]model.MaintenanceEvent, len(activeEvents))
+	for _, activeEvent := range activeEvents {
+		knownEvents[activeEvent.EventID] = activeEvent
+	}
+
+	listedEvents := c.dispatchListedEvents(ctx, events, knownEvents, c.nodeInformer.GetInstanceIDs(), eventChan)
+
+	for eventID, known := range knownEvents {
+		if _, listed := listedEvents[eventID]; listed {
+			continue
+		}
+
+		if err := c.dispatch(ctx, completedEvent(known), known.NodeName, eventChan); err != nil {
+			slog.Error("Error dispatching completed OCI event", "eventID", eventID, "error", err)
+		}
+	}
+
+	return nil
+}
+
+// dispatchListedEvents dispatches the events of cluster nodes which are new or changed their lifecycle state, and
+// returns the IDs of all listed events of cluster nodes.
+func (c *OCIClient) dispatchListedEvents(
+	ctx context.Context,
+	events []core.InstanceMaintenanceEventSummary,
+	knownEvents map[string]model.MaintenanceEvent,
+	instanceIDs map[string]string,
+	eventChan chan<- model.MaintenanceEvent,
+) map[string]struct{} {
+	listedEvents := make(map[string]struct{})
+
+	for _, event := range events {
+		metrics.CSPEventsReceived.WithLabelValues(string(model.CSPOCI)).Inc()
+
+		if event.Id == nil || event.InstanceId == nil {
+			slog.Debug("Skipping event with nil ID or instance ID")
+			continue
+		}
+
+		nodeName, ok := instanceIDs[*event.InstanceId]
+		if !ok {
+			slog.Debug("Instance ID not found in node map", "instanceID", *event.InstanceId, "eventID", *event.Id)
+			continue
+		}
+
+		listedEvents[*event.Id] = struct{}{}
+		lifecycleState := string(event.LifecycleState)
+
+		known, found := knownEvents[*event.Id]
+		if found && string(known.CSPStatus) == lifecycleState {
+			continue
+		}
+
+		if !found && !isActiveLifecycleState(lifecycleState) {
+			continue
+		}
+
+		if err := c.dispatch(ctx, event, nodeName, eventChan); err != nil {
+			slog.Error("Error dispatching OCI event", "eventID", *event.Id, "error", err)
+		}
+	}
+
+	return listedEvents
+}
+
+// completedEvent rebuilds the maintenance event summary of a maintenance event which OCI no longer lists. OCI does not
+// report whether the maintenance of a vanished event succeeded, so it is completed like a succeeded one.
+func completedEvent(known model.MaintenanceEvent) core.InstanceMaintenanceEventSummary {
+	return core.InstanceMaintenanceEventSummary{
+		Id:                  common.String(known.EventID),
+		InstanceId:          common.String(known.ResourceID),
+		LifecycleState:      core.InstanceMaintenanceEventLifecycleStateSucceeded,
+		MaintenanceCategory: core.InstanceMaintenanceEventMaintenanceCategoryEnum(known.Metadata["maintenanceCategory"]),
+		MaintenanceReason:   core.InstanceMaintenanceEventMaintenanceReasonEnum(known.Metadata["maintenanceReason"]),
+		InstanceAction:      core.InstanceMaintenanceEventInstanceActionEnum(known.Metadata["instanceAction"]),
+		Description:         optionalString(known.Metadata["description"]),
+		EstimatedDuration:   optionalString(known.Metadata["estimatedDuration"]),
+		TimeWindowStart:     sdkTime(known.ScheduledStartTime),
+		TimeStarted:         sdkTime(known.ActualStartTime),
+	}
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return common.String(s)
+}
+
+func sdkTime(t *time.Time) *common.SDKTime {
+	if t == nil {
+		return nil
+	}
+
+	return &common.SDKTime{Time: *t}
+}
+
+func (c *OCIClient) dispatch(
+	ctx context.Context,
+	event core.InstanceMaintenanceEventSummary,
+	nodeName string,
+	eventChan chan<- model.MaintenanceEvent,
+) error {
+	normalizedEvent, err := c.normalizer.Normalize(event, eventpkg.OCIEventMetadata{
+		NodeName:    nodeName,
+		ClusterName: c.clusterName,
+	})
+	if err != nil {
+		metrics.MainNormalizationErrors.WithLabelValues(string(model.CSPOCI)).Inc()
+		return fmt.Errorf("error normalizing OCI event %s: %w", *event.Id, err)
+	}
+
+	metrics.MainEventsToNormalize.WithLabelValues(string(model.CSPOCI)).Inc()
+
+	select {
+	case eventChan <- *normalizedEvent:
+		slog.Info("Dispatched maintenance event",
+			"node", nodeName,
+			"instanceID", normalizedEvent.ResourceID,
+			"eventID", normalizedEvent.EventID,
+			"status", normalizedEvent.CSPStatus)
+	case <-ctx.Done():
+		return fmt.Errorf("context cancelled while sending event for node %s (instance %s, event %s)",
+			nodeName, normalizedEvent.ResourceID, normalizedEvent.EventID)
+	}
+
+	return nil
+}
+
+// listMaintenanceEvents lists all instance maintenance events of the compartment.
+func (c *OCIClient) listMaintenanceEvents(ctx context.Context) ([]core.InstanceMaintenanceEventSummary, error) {
+	start := time.Now()
+
+	defer func() {
+		metrics.CSPAPIDuration.WithLabelValues(string(model.CSPOCI), "list_instance_maintenance_events").
+			Observe(time.Since(start).Seconds())
+	}()
+
+	var events []core.InstanceMaintenanceEventSummary
+
+	request := core.ListInstanceMaintenanceEventsRequest{
+		CompartmentId: common.String(c.config.CompartmentID),
+	}
+
+	for {
+		response, err := c.computeClient.ListInstanceMaintenanceEvents(ctx, request)
+		if err != nil {
+			metrics.CSPAPIErrors.WithLabelValues(string(model.CSPOCI), "ListInstanceMaintenanceEvents_api_error").Inc()
+			return nil, fmt.Errorf("error listing OCI instance maintenance events: %w", err)
+		}
+
+		events = append(events, response.Items...)
+
+		if response.OpcNextPage == nil {
+			break
+		}
+
+		request.Page = response.OpcNextPage
+	}
+
+	slog.Debug("Listed OCI instance maintenance events", "count", len(events))
+
+	return events, nil
+}
@@ -0,0 +1,266 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/config"
+	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/datastore"
+	eventpkg "github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/event"
+	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/model"
+)
+
+const (
+	testNodeName      = "oke-gpu-node-1"
+	testInstanceID    = "ocid1.instance.oc1.iad.node1"
+	testCompartmentID = "ocid1.compartment.oc1..test"
+)
+
+// fakeStore returns the configured active events; the other datastore methods are not used by the monitor.
+type fakeStore struct {
+	datastore.Store
+	activeEvents []model.MaintenanceEvent
+}
+
+func (s *fakeStore) FindActiveEventsByStatuses(
+	ctx context.Context,
+	csp model.CSP,
+	statuses []string,
+) ([]model.MaintenanceEvent, error) {
+	return s.activeEvents, nil
+}
+
+// fakeComputeClient serves the configured pages of maintenance events and records the requests.
+type fakeComputeClient struct {
+	pages    [][]core.InstanceMaintenanceEventSummary
+	err      error
+	requests []core.ListInstanceMaintenanceEventsRequest
+}
+
+func (f *fakeComputeClient) ListInstanceMaintenanceEvents(
+	ctx context.Context,
+	request core.ListInstanceMaintenanceEventsRequest,
+) (core.ListInstanceMaintenanceEventsResponse, error) {
+	f.requests = append(f.requests, request)
+
+	if f.err != nil {
+		return core.ListInstanceMaintenanceEventsResponse{}, f.err
+	}
+
+	page := 0
+	if request.Page != nil {
+		page, _ = strconv.Atoi(*request.Page)
+	}
+
+	if page >= len(f.pages) {
+		return core.ListInstanceMaintenanceEventsResponse{}, nil
+	}
+
+	response := core.ListInstanceMaintenanceEventsResponse{Items: f.pages[page]}
+	if page+1 < len(f.pages) {
+		response.OpcNextPage = common.String(strconv.Itoa(page + 1))
+	}
+
+	return response, nil
+}
+
+func newTestEvent(
+	eventID, instanceID string,
+	state core.InstanceMaintenanceEventLifecycleStateEnum,
+) core.InstanceMaintenanceEventSummary {
+	return core.InstanceMaintenanceEventSummary{
+		Id:                  common.String(eventID),
+		InstanceId:          common.String(instanceID),
+		LifecycleState:      state,
+		MaintenanceCategory: core.InstanceMaintenanceEventMaintenanceCategoryFlexible,
+		MaintenanceReason:   core.InstanceMaintenanceEventMaintenanceReasonHardwareReplacement,
+		InstanceAction:      core.InstanceMaintenanceEventInstanceActionRebootMigration,
+		TimeWindowStart:     &common.SDKTime{Time: time.Now().Add(time.Hour)},
+	}
+}
+
+func newKnownEvent(eventID, state string) model.MaintenanceEvent {
+	return model.MaintenanceEvent{
+		EventID:      eventID,
+		CSP:          model.CSPOCI,
+		NodeName:     testNodeName,
+		ResourceID:   testInstanceID,
+		ResourceType: "Instance",
+		CSPStatus:    model.ProviderStatus(state),
+		Metadata:     map[string]string{"instanceAction": "REBOOT_MIGRATION", "description": "hardware replacement"},
+	}
+}
+
+func createTestClient(t *testing.T, computeClient *fakeComputeClient, store datastore.Store) *OCIClient {
+	t.Helper()
+
+	k8sClient := fake.NewSimpleClientset(&v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: testNodeName},
+		Spec:       v1.NodeSpec{ProviderID: testInstanceID},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	nodeInformer, err := NewNodeInformer(k8sClient)
+	require.NoError(t, err)
+	nodeInformer.Start(ctx)
+
+	require.Eventually(t, func() bool {
+		_, exists := nodeInformer.GetNodeName(testInstanceID)
+		return exists
+	}, 5*time.Second, 50*time.Millisecond, "Node should be tracked by informer")
+
+	return &OCIClient{
+		config:        config.OCIConfig{CompartmentID: testCompartmentID, PollingIntervalSeconds: 60},
+		computeClient: computeClient,
+		normalizer:    &eventpkg.OCINormalizer{},
+		clusterName:   "test-cluster",
+		store:         store,
+		nodeInformer:  nodeInformer,
+	}
+}
+
+func drainEvents(eventChan chan model.MaintenanceEvent) []model.MaintenanceEvent {
+	var events []model.MaintenanceEvent
+
+	for {
+		select {
+		case e := <-eventChan:
+			events = append(events, e)
+		default:
+			return events
+		}
+	}
+}
+
+func eventIDs(events []model.MaintenanceEvent) []string {
+	ids := make([]string, 0, len(events))
+	for _, event := range events {
+		ids = append(ids, event.EventID)
+	}
+
+	return ids
+}
+
+func TestPollMaintenanceEvents_DispatchesActiveEventsOfAllPages(t *testing.T) {
+	computeClient := &fakeComputeClient{pages: [][]core.InstanceMaintenanceEventSummary{
+		{
+			newTestEvent("scheduled", testInstanceID, core.InstanceMaintenanceEventLifecycleStateScheduled),
+			newTestEvent("outside-cluster", "ocid1.instance.oc1.iad.other",
+				core.InstanceMaintenanceEventLifecycleStateScheduled),
+			{Id: common.String("no-instance"), LifecycleState: core.InstanceMaintenanceEventLifecycleStateScheduled},
+		},
+		{
+			newTestEvent("processing", testInstanceID, core.InstanceMaintenanceEventLifecycleStateProcessing),
+			newTestEvent("history-succeeded", testInstanceID, core.InstanceMaintenanceEventLifecycleStateSucceeded),
+			newTestEvent("history-canceled", testInstanceID, core.InstanceMaintenanceEventLifecycleStateCanceled),
+		},
+	}}
+	client := createTestClient(t, computeClient, &fakeStore{})
+	eventChan := make(chan model.MaintenanceEvent, 10)
+
+	require.NoError(t, client.pollMaintenanceEvents(context.Background(), eventChan))
+
+	require.Len(t, computeClient.requests, 2, "every page should be listed")
+	assert.Equal(t, testCompartmentID, *computeClient.requests[0].CompartmentId)
+	assert.Nil(t, computeClient.requests[0].Page)
+	assert.Equal(t, "1", *computeClient.requests[1].Page)
+
+	events := drainEvents(eventChan)
+	assert.Equal(t, []string{"scheduled", "processing"}, eventIDs(events),
+		"only the active events of cluster nodes should be dispatched")
+	assert.Equal(t, testNodeName, events[0].NodeName)
+	assert.Equal(t, model.StatusDetected, events[0].Status)
+	assert.Equal(t, model.StatusMaintenanceOngoing, events[1].Status)
+}
+
+func TestPollMaintenanceEvents_DispatchesLifecycleStateChanges(t *testing.T) {
+	store := &fakeStore{activeEvents: []model.MaintenanceEvent{
+		newKnownEvent("unchanged", eventpkg.OCIEventStateScheduled),
+		newKnownEvent("started", eventpkg.OCIEventStateScheduled),
+		newKnownEvent("succeeded", eventpkg.OCIEventStateProcessing),
+	}}
+	computeClient := &fakeComputeClient{pages: [][]core.InstanceMaintenanceEventSummary{{
+		newTestEvent("unchanged", testInstanceID, core.InstanceMaintenanceEventLifecycleStateScheduled),
+		newTestEvent("started", testInstanceID, core.InstanceMaintenanceEventLifecycleStateStarted),
+		newTestEvent("succeeded", testInstanceID, core.InstanceMaintenanceEventLifecycleStateSucceeded),
+	}}}
+	client := createTestClient(t, computeClient, store)
+	eventChan := make(chan model.MaintenanceEvent, 10)
+
+	require.NoError(t, client.pollMaintenanceEvents(context.Background(), eventChan))
+
+	events := drainEvents(eventChan)
+	assert.Equal(t, []string{"started", "succeeded"}, eventIDs(events),
+		"events should only be dispatched again when their lifecycle state changed")
+	assert.Equal(t, model.StatusMaintenanceOngoing, events[0].Status)
+	assert.Equal(t, model.StatusMaintenanceComplete, events[1].Status)
+}
+
+func TestPollMaintenanceEvents_CompletesVanishedEvents(t *testing.T) {
+	startTime := time.Now().Add(-time.Hour).UTC()
+	vanished := newKnownEvent("vanished", eventpkg.OCIEventStateProcessing)
+	vanished.ActualStartTime = &startTime
+
+	store := &fakeStore{activeEvents: []model.MaintenanceEvent{
+		newKnownEvent("listed", eventpkg.OCIEventStateScheduled),
+		vanished,
+	}}
+	computeClient := &fakeComputeClient{pages: [][]core.InstanceMaintenanceEventSummary{{
+		newTestEvent("listed", testInstanceID, core.InstanceMaintenanceEventLifecycleStateScheduled),
+	}}}
+	client := createTestClient(t, computeClient, store)
+	eventChan := make(chan model.MaintenanceEvent, 10)
+
+	require.NoError(t, client.pollMaintenanceEvents(context.Background(), eventChan))
+
+	events := drainEvents(eventChan)
+	require.Len(t, events, 1)
+	assert.Equal(t, "vanished", events[0].EventID)
+	assert.Equal(t, testNodeName, events[0].NodeName)
+	assert.Equal(t, testInstanceID, events[0].ResourceID)
+	assert.Equal(t, model.StatusMaintenanceComplete, events[0].Status)
+	assert.Equal(t, model.ProviderStatus(eventpkg.OCIEventStateSucceeded), events[0].CSPStatus)
+	assert.Equal(t, &startTime, events[0].ActualStartTime)
+	assert.NotNil(t, events[0].ActualEndTime)
+	assert.Equal(t, "REBOOT_MIGRATION", events[0].Metadata["instanceAction"])
+	assert.Equal(t, "hardware replacement", events[0].Metadata["description"])
+}
+
+func TestPollMaintenanceEvents_ListError(t *testing.T) {
+	store := &fakeStore{activeEvents: []model.MaintenanceEvent{
+		newKnownEvent("active", eventpkg.OCIEventStateScheduled),
+	}}
+	client := createTestClient(t, &fakeComputeClient{err: errors.New("service unavailable")}, store)
+	eventChan := make(chan model.MaintenanceEvent, 10)
+
+	err := client.pollMaintenanceEvents(context.Background(), eventChan)
+	assert.ErrorContains(t, err, "service unavailable")
+	assert.Empty(t, drainEvents(eventChan), "active events should not be completed when listing fails")
+}
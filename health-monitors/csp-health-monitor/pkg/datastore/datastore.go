@@ -49,6 +49,13 @@ type Store interface {
 	) (*model.MaintenanceEvent, bool, error)
 	FindLatestOngoingEventByNode(ctx context.Context, nodeName string) (*model.MaintenanceEvent, bool, error)
 	FindActiveEventsByStatuses(ctx context.Context, csp model.CSP, statuses []string) ([]model.MaintenanceEvent, error)
+	FindEventsToExpedite(ctx context.Context, csp model.CSP) ([]model.MaintenanceEvent, error)
+	UpdateEventExpediteStatus(
+		ctx context.Context,
+		eventID string,
+		status model.ExpediteStatus,
+		message string,
+	) error
 }
 
 // DatabaseStore implements the Store interface using store-client.
@@ -440,3 +447,84 @@ func (s *DatabaseStore) FindActiveEventsByStatuses(
 
 	return results, nil
 }
+
+// FindEventsToExpedite finds quarantined events of a CSP whose maintenance has not started yet and was not expedited.
+// Metrics handled by the caller (Expedite Engine).
+func (s *DatabaseStore) FindEventsToExpedite(ctx context.Context, csp model.CSP) ([]model.MaintenanceEvent, error) {
+	now := time.Now().UTC()
+
+	filter := client.NewFilterBuilder().
+		Eq("csp", csp).
+		Eq("status", model.StatusQuarantineTriggered).
+		Gt("scheduledStartTime", now).
+		Build()
+
+	slog.Debug("Querying for events to expedite",
+		"csp", csp,
+		"status", model.StatusQuarantineTriggered,
+		"currentTime", now.Format(time.RFC3339))
+
+	cursor, err := s.databaseClient.Find(ctx, filter, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query events to expedite: %w", err)
+	}
+
+	defer cursor.Close(ctx)
+
+	var events []model.MaintenanceEvent
+	if err := cursor.All(ctx, &events); err != nil {
+		return nil, fmt.Errorf("failed to decode maintenance events to expedite: %w", err)
+	}
+
+	// The expedite outcome is a document field, so events which were already expedited are skipped here
+	results := make([]model.MaintenanceEvent, 0, len(events))
+
+	for _, event := range events {
+		if event.ExpediteStatus == "" {
+			results = append(results, event)
+		}
+	}
+
+	slog.Debug("Found events potentially ready to expedite", "count", len(results))
+
+	return results, nil
+}
+
+// UpdateEventExpediteStatus records the outcome of asking the CSP to start the maintenance of an event early.
+// Metrics handled by the caller (Expedite Engine).
+func (s *DatabaseStore) UpdateEventExpediteStatus(
+	ctx context.Context,
+	eventID string,
+	status model.ExpediteStatus,
+	message string,
+) error {
+	if eventID == "" {
+		return fmt.Errorf("cannot update expedite status for empty eventID")
+	}
+
+	now := time.Now().UTC()
+	filter := client.BuildStatusFilter("eventId", eventID)
+	update := client.BuildSetUpdate(map[string]interface{}{
+		"expediteStatus":       status,
+		"expediteMessage":      message,
+		"expediteRequestedAt":  now,
+		"lastUpdatedTimestamp": now,
+	})
+
+	matched, _, err := client.RetryableUpdateWithResult(ctx, s.databaseClient, filter, update,
+		client.DefaultMaxRetries, client.DefaultRetryDelay)
+	if err != nil {
+		return fmt.Errorf("failed to update expedite status for event (EventID: %s): %w", eventID, err)
+	}
+
+	if matched == 0 {
+		slog.Warn("Attempted to update expedite status for non-existent event", "eventID", eventID)
+		return nil
+	}
+
+	slog.Debug("Successfully updated expedite status for event",
+		"expediteStatus", status,
+		"eventID", eventID)
+
+	return nil
+}
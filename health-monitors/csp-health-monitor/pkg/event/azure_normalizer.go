@@ -0,0 +1,155 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package event
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+
+	pb "github.com/nvidia/nvsentinel/data-models/pkg/protos"
+	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/model"
+)
+
+// Azure Scheduled Events statuses. Azure removes events from the document once the maintenance is over, so
+// AzureEventStatusCompleted is set by the monitor for events which are no longer listed.
+const (
+	AzureEventStatusScheduled = "Scheduled"
+	AzureEventStatusStarted   = "Started"
+	AzureEventStatusCompleted = "Completed"
+)
+
+// AzureScheduledEventsDocument is the response of the Azure Instance Metadata Service Scheduled Events endpoint.
+type AzureScheduledEventsDocument struct {
+	DocumentIncarnation int                   `json:"DocumentIncarnation"`
+	Events              []AzureScheduledEvent `json:"Events"`
+}
+
+// AzureScheduledEvent is a single event of the Scheduled Events document.
+type AzureScheduledEvent struct {
+	EventID           string   `json:"EventId"`
+	EventType         string   `json:"EventType"`
+	ResourceType      string   `json:"ResourceType"`
+	Resources         []string `json:"Resources"`
+	EventStatus       string   `json:"EventStatus"`
+	NotBefore         string   `json:"NotBefore"`
+	Description       string   `json:"Description"`
+	EventSource       string   `json:"EventSource"`
+	DurationInSeconds int      `json:"DurationInSeconds"`
+}
+
+// AzureEventMetadata carries the node of the affected resource of an Azure scheduled event.
+type AzureEventMetadata struct {
+	NodeName     string
+	ResourceName string
+	ClusterName  string
+}
+
+// AzureEventID returns the ID of the maintenance event of a resource affected by an Azure scheduled event. A
+// scheduled event can affect several resources, so the resource name is part of the ID.
+func AzureEventID(eventID, resourceName string) string {
+	return eventID + "/" + resourceName
+}
+
+// AzureNormalizer implements the Normalizer interface for Azure Scheduled Events.
+type AzureNormalizer struct{}
+
+// Ensure AzureNormalizer implements the Normalizer interface.
+var _ Normalizer = (*AzureNormalizer)(nil)
+
+// Normalize converts an Azure scheduled event into a standard MaintenanceEvent for one of its resources.
+// It expects rawEvent to be of type AzureScheduledEvent and additionalInfo to hold an AzureEventMetadata.
+func (n *AzureNormalizer) Normalize(
+	rawEvent interface{},
+	additionalInfo ...interface{},
+) (*model.MaintenanceEvent, error) {
+	event, ok := rawEvent.(AzureScheduledEvent)
+	if !ok {
+		return nil, fmt.Errorf("error normalizing Azure event: expected AzureScheduledEvent, got %T", rawEvent)
+	}
+
+	if len(additionalInfo) < 1 {
+		return nil, fmt.Errorf("missing additional metadata for Azure event")
+	}
+
+	meta, ok := additionalInfo[0].(AzureEventMetadata)
+	if !ok {
+		return nil, fmt.Errorf("invalid metadata type: expected AzureEventMetadata, got %T", additionalInfo[0])
+	}
+
+	if event.EventID == "" || meta.ResourceName == "" {
+		return nil, fmt.Errorf("azure event %q has missing required fields", event.EventID)
+	}
+
+	now := time.Now().UTC()
+
+	normalizedEvent := &model.MaintenanceEvent{
+		EventID:                AzureEventID(event.EventID, meta.ResourceName),
+		CSP:                    model.CSPAzure,
+		ClusterName:            meta.ClusterName,
+		ResourceType:           event.ResourceType,
+		ResourceID:             meta.ResourceName,
+		NodeName:               meta.NodeName,
+		MaintenanceType:        model.TypeScheduled,
+		CSPStatus:              model.ProviderStatus(event.EventStatus),
+		EventReceivedTimestamp: now,
+		LastUpdatedTimestamp:   now,
+		RecommendedAction:      pb.RecommendedAction_NONE.String(),
+		Metadata: map[string]string{
+			"eventId":     event.EventID,
+			"eventType":   event.EventType,
+			"eventSource": event.EventSource,
+			"description": event.Description,
+		},
+	}
+
+	switch event.EventStatus {
+	case AzureEventStatusScheduled:
+		normalizedEvent.Status = model.StatusDetected
+	case AzureEventStatusStarted:
+		normalizedEvent.Status = model.StatusMaintenanceOngoing
+		normalizedEvent.ActualStartTime = &now
+	case AzureEventStatusCompleted:
+		normalizedEvent.Status = model.StatusMaintenanceComplete
+		normalizedEvent.ActualEndTime = &now
+	default:
+		return nil, fmt.Errorf("unknown Azure event status %q for event %s", event.EventStatus, event.EventID)
+	}
+
+	// NotBefore is empty once the event has started
+	if event.NotBefore != "" {
+		notBefore, err := time.Parse(time.RFC1123, event.NotBefore)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse NotBefore %q of Azure event %s: %w",
+				event.NotBefore, event.EventID, err)
+		}
+
+		start := notBefore.UTC()
+		normalizedEvent.ScheduledStartTime = &start
+
+		// DurationInSeconds is -1 when Azure does not know the duration
+		if event.DurationInSeconds > 0 {
+			end := start.Add(time.Duration(event.DurationInSeconds) * time.Second)
+			normalizedEvent.ScheduledEndTime = &end
+		}
+	}
+
+	slog.Debug("Normalized Azure event",
+		"node", meta.NodeName,
+		"eventID", normalizedEvent.EventID,
+		"status", normalizedEvent.Status)
+
+	return normalizedEvent, nil
+}
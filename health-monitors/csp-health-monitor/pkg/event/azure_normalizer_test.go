@@ -0,0 +1,148 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package event
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/model"
+)
+
+// helper to create an Azure scheduled event with the supplied status
+func newTestAzureEvent(status string) AzureScheduledEvent {
+	return AzureScheduledEvent{
+		EventID:           "602d9444-d2cd-49c7-8624-8643e7171297",
+		EventType:         "Reboot",
+		ResourceType:      "VirtualMachine",
+		Resources:         []string{"aks-gpu-12345678-vmss_0"},
+		EventStatus:       status,
+		NotBefore:         "Mon, 19 Oct 2026 18:29:47 GMT",
+		Description:       "Virtual machine is going to be restarted as requested by authorized user.",
+		EventSource:       "Platform",
+		DurationInSeconds: 600,
+	}
+}
+
+func newTestAzureMetadata() AzureEventMetadata {
+	return AzureEventMetadata{
+		NodeName:     "aks-gpu-12345678-vmss000000",
+		ResourceName: "aks-gpu-12345678-vmss_0",
+		ClusterName:  "test-cluster",
+	}
+}
+
+func TestAzureNormalizer_ScheduledEvent(t *testing.T) {
+	n := &AzureNormalizer{}
+	testEvent := newTestAzureEvent(AzureEventStatusScheduled)
+	meta := newTestAzureMetadata()
+
+	normalized, err := n.Normalize(testEvent, meta)
+	require.NoError(t, err)
+
+	assert.Equal(t, AzureEventID(testEvent.EventID, meta.ResourceName), normalized.EventID)
+	assert.Equal(t, model.CSPAzure, normalized.CSP)
+	assert.Equal(t, meta.NodeName, normalized.NodeName)
+	assert.Equal(t, meta.ResourceName, normalized.ResourceID)
+	assert.Equal(t, meta.ClusterName, normalized.ClusterName)
+	assert.Equal(t, model.StatusDetected, normalized.Status)
+	assert.Equal(t, model.ProviderStatus(AzureEventStatusScheduled), normalized.CSPStatus)
+	assert.Equal(t, testEvent.EventID, normalized.Metadata["eventId"])
+
+	expectedStart := time.Date(2026, time.October, 19, 18, 29, 47, 0, time.UTC)
+
+	require.NotNil(t, normalized.ScheduledStartTime)
+	require.NotNil(t, normalized.ScheduledEndTime)
+	assert.Equal(t, expectedStart, *normalized.ScheduledStartTime)
+	assert.Equal(t, expectedStart.Add(10*time.Minute), *normalized.ScheduledEndTime)
+	assert.Nil(t, normalized.ActualStartTime)
+	assert.Nil(t, normalized.ActualEndTime)
+}
+
+func TestAzureNormalizer_StartedEvent(t *testing.T) {
+	n := &AzureNormalizer{}
+	testEvent := newTestAzureEvent(AzureEventStatusStarted)
+	testEvent.NotBefore = ""
+	testEvent.DurationInSeconds = -1
+
+	normalized, err := n.Normalize(testEvent, newTestAzureMetadata())
+	require.NoError(t, err)
+
+	assert.Equal(t, model.StatusMaintenanceOngoing, normalized.Status)
+	assert.NotNil(t, normalized.ActualStartTime)
+	assert.Nil(t, normalized.ScheduledStartTime)
+	assert.Nil(t, normalized.ScheduledEndTime)
+}
+
+func TestAzureNormalizer_CompletedEvent(t *testing.T) {
+	n := &AzureNormalizer{}
+	testEvent := newTestAzureEvent(AzureEventStatusCompleted)
+	testEvent.NotBefore = ""
+
+	normalized, err := n.Normalize(testEvent, newTestAzureMetadata())
+	require.NoError(t, err)
+
+	assert.Equal(t, model.StatusMaintenanceComplete, normalized.Status)
+	assert.NotNil(t, normalized.ActualEndTime)
+}
+
+func TestAzureNormalizer_Errors(t *testing.T) {
+	n := &AzureNormalizer{}
+
+	tests := []struct {
+		name  string
+		event interface{}
+		meta  []interface{}
+	}{
+		{
+			name:  "wrong event type",
+			event: "not-an-event",
+			meta:  []interface{}{newTestAzureMetadata()},
+		},
+		{
+			name:  "missing metadata",
+			event: newTestAzureEvent(AzureEventStatusScheduled),
+		},
+		{
+			name:  "wrong metadata type",
+			event: newTestAzureEvent(AzureEventStatusScheduled),
+			meta:  []interface{}{"not-metadata"},
+		},
+		{
+			name:  "unknown status",
+			event: newTestAzureEvent("Unknown"),
+			meta:  []interface{}{newTestAzureMetadata()},
+		},
+		{
+			name: "invalid NotBefore",
+			event: func() AzureScheduledEvent {
+				e := newTestAzureEvent(AzureEventStatusScheduled)
+				e.NotBefore = "tomorrow"
+
+				return e
+			}(),
+			meta: []interface{}{newTestAzureMetadata()},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := n.Normalize(tt.event, tt.meta...)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -37,6 +37,10 @@ func GetNormalizer(csp model.CSP) (Normalizer, error) {
 		return &GCPNormalizer{}, nil // GCPNormalizer is defined in gcp_normalizer.go
 	case model.CSPAWS:
 		return &AWSNormalizer{}, nil // AWSNormalizer is defined in aws_normalizer.go
+	case model.CSPAzure:
+		return &AzureNormalizer{}, nil // AzureNormalizer is defined in azure_normalizer.go
+	case model.CSPOCI:
+		return &OCINormalizer{}, nil // OCINormalizer is defined in oci_normalizer.go
 	default:
 		return nil, fmt.Errorf("no normalizer available for CSP: %s", csp)
 	}
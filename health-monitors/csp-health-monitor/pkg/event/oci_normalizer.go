@@ -0,0 +1,149 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package event
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+
+	pb "github.com/nvidia/nvsentinel/data-models/pkg/protos"
+	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/model"
+)
+
+// OCI instance maintenance event lifecycle states.
+const (
+	OCIEventStateScheduled  = "SCHEDULED"
+	OCIEventStateStarted    = "STARTED"
+	OCIEventStateProcessing = "PROCESSING"
+	OCIEventStateSucceeded  = "SUCCEEDED"
+	OCIEventStateFailed     = "FAILED"
+	OCIEventStateCanceled   = "CANCELED"
+)
+
+// OCIEventMetadata carries the node of the instance of an OCI instance maintenance event.
+type OCIEventMetadata struct {
+	NodeName    string
+	ClusterName string
+}
+
+// OCINormalizer implements the Normalizer interface for OCI instance maintenance events.
+type OCINormalizer struct{}
+
+// Ensure OCINormalizer implements the Normalizer interface.
+var _ Normalizer = (*OCINormalizer)(nil)
+
+// Normalize converts an OCI instance maintenance event into a standard MaintenanceEvent.
+// It expects rawEvent to be of type core.InstanceMaintenanceEventSummary and additionalInfo to hold an
+// OCIEventMetadata.
+func (n *OCINormalizer) Normalize(
+	rawEvent interface{},
+	additionalInfo ...interface{},
+) (*model.MaintenanceEvent, error) {
+	event, ok := rawEvent.(core.InstanceMaintenanceEventSummary)
+	if !ok {
+		return nil, fmt.Errorf(
+			"error normalizing OCI event: expected core.InstanceMaintenanceEventSummary, got %T", rawEvent)
+	}
+
+	if len(additionalInfo) < 1 {
+		return nil, fmt.Errorf("missing additional metadata for OCI event")
+	}
+
+	meta, ok := additionalInfo[0].(OCIEventMetadata)
+	if !ok {
+		return nil, fmt.Errorf("invalid metadata type: expected OCIEventMetadata, got %T", additionalInfo[0])
+	}
+
+	if event.Id == nil || event.InstanceId == nil {
+		return nil, fmt.Errorf("OCI event %s has missing required fields", stringValue(event.Id))
+	}
+
+	now := time.Now().UTC()
+	lifecycleState := string(event.LifecycleState)
+
+	normalizedEvent := &model.MaintenanceEvent{
+		EventID:                *event.Id,
+		CSP:                    model.CSPOCI,
+		ClusterName:            meta.ClusterName,
+		ResourceType:           "Instance",
+		ResourceID:             *event.InstanceId,
+		NodeName:               meta.NodeName,
+		MaintenanceType:        model.TypeScheduled,
+		CSPStatus:              model.ProviderStatus(lifecycleState),
+		ScheduledStartTime:     sdkTimePtr(event.TimeWindowStart),
+		ActualStartTime:        sdkTimePtr(event.TimeStarted),
+		ActualEndTime:          sdkTimePtr(event.TimeFinished),
+		EventReceivedTimestamp: now,
+		LastUpdatedTimestamp:   now,
+		RecommendedAction:      pb.RecommendedAction_NONE.String(),
+		Metadata: map[string]string{
+			"maintenanceCategory": string(event.MaintenanceCategory),
+			"maintenanceReason":   string(event.MaintenanceReason),
+			"instanceAction":      string(event.InstanceAction),
+			"estimatedDuration":   stringValue(event.EstimatedDuration),
+			"description":         stringValue(event.Description),
+		},
+	}
+
+	switch lifecycleState {
+	case OCIEventStateScheduled:
+		normalizedEvent.Status = model.StatusDetected
+	case OCIEventStateStarted, OCIEventStateProcessing:
+		normalizedEvent.Status = model.StatusMaintenanceOngoing
+
+		if normalizedEvent.ActualStartTime == nil {
+			normalizedEvent.ActualStartTime = &now
+		}
+	case OCIEventStateSucceeded, OCIEventStateFailed:
+		normalizedEvent.Status = model.StatusMaintenanceComplete
+
+		if normalizedEvent.ActualEndTime == nil {
+			normalizedEvent.ActualEndTime = &now
+		}
+	case OCIEventStateCanceled:
+		normalizedEvent.Status = model.StatusCancelled
+	default:
+		return nil, fmt.Errorf("unknown OCI lifecycle state %q for event %s", lifecycleState, *event.Id)
+	}
+
+	slog.Debug("Normalized OCI event",
+		"node", meta.NodeName,
+		"eventID", normalizedEvent.EventID,
+		"status", normalizedEvent.Status)
+
+	return normalizedEvent, nil
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
+
+func sdkTimePtr(t *common.SDKTime) *time.Time {
+	if t == nil {
+		return nil
+	}
+
+	utc := t.UTC()
+
+	return &utc
+}
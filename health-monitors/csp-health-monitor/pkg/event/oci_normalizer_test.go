@@ -0,0 +1,141 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package event
+
+import (
+	"testing"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/model"
+)
+
+// helper to create an OCI instance maintenance event with the supplied lifecycle state
+func newTestOCIEvent(state core.InstanceMaintenanceEventLifecycleStateEnum) core.InstanceMaintenanceEventSummary {
+	windowStart := time.Now().Add(2 * time.Hour).UTC()
+
+	return core.InstanceMaintenanceEventSummary{
+		Id:                  common.String("ocid1.instancemaintenanceevent.oc1..test"),
+		InstanceId:          common.String("ocid1.instance.oc1..test"),
+		LifecycleState:      state,
+		TimeWindowStart:     &common.SDKTime{Time: windowStart},
+		MaintenanceCategory: core.InstanceMaintenanceEventMaintenanceCategoryFlexible,
+		MaintenanceReason:   core.InstanceMaintenanceEventMaintenanceReasonHardwareReplacement,
+		InstanceAction:      core.InstanceMaintenanceEventInstanceActionRebootMigration,
+		EstimatedDuration:   common.String("PT30M"),
+		Description:         common.String("Hardware replacement"),
+	}
+}
+
+func newTestOCIMetadata() OCIEventMetadata {
+	return OCIEventMetadata{
+		NodeName:    "10.0.10.5",
+		ClusterName: "test-cluster",
+	}
+}
+
+func TestOCINormalizer_LifecycleStates(t *testing.T) {
+	n := &OCINormalizer{}
+
+	tests := []struct {
+		state          core.InstanceMaintenanceEventLifecycleStateEnum
+		expectedStatus model.InternalStatus
+		actualStart    bool
+		actualEnd      bool
+	}{
+		{state: core.InstanceMaintenanceEventLifecycleStateScheduled, expectedStatus: model.StatusDetected},
+		{
+			state:          core.InstanceMaintenanceEventLifecycleStateStarted,
+			expectedStatus: model.StatusMaintenanceOngoing,
+			actualStart:    true,
+		},
+		{
+			state:          core.InstanceMaintenanceEventLifecycleStateProcessing,
+			expectedStatus: model.StatusMaintenanceOngoing,
+			actualStart:    true,
+		},
+		{
+			state:          core.InstanceMaintenanceEventLifecycleStateSucceeded,
+			expectedStatus: model.StatusMaintenanceComplete,
+			actualEnd:      true,
+		},
+		{
+			state:          core.InstanceMaintenanceEventLifecycleStateFailed,
+			expectedStatus: model.StatusMaintenanceComplete,
+			actualEnd:      true,
+		},
+		{state: core.InstanceMaintenanceEventLifecycleStateCanceled, expectedStatus: model.StatusCancelled},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.state), func(t *testing.T) {
+			testEvent := newTestOCIEvent(tt.state)
+			meta := newTestOCIMetadata()
+
+			normalized, err := n.Normalize(testEvent, meta)
+			require.NoError(t, err)
+
+			assert.Equal(t, *testEvent.Id, normalized.EventID)
+			assert.Equal(t, model.CSPOCI, normalized.CSP)
+			assert.Equal(t, *testEvent.InstanceId, normalized.ResourceID)
+			assert.Equal(t, meta.NodeName, normalized.NodeName)
+			assert.Equal(t, tt.expectedStatus, normalized.Status)
+			assert.Equal(t, model.ProviderStatus(tt.state), normalized.CSPStatus)
+			assert.Equal(t, "HARDWARE_REPLACEMENT", normalized.Metadata["maintenanceReason"])
+
+			require.NotNil(t, normalized.ScheduledStartTime)
+			assert.Equal(t, testEvent.TimeWindowStart.UTC(), *normalized.ScheduledStartTime)
+			assert.Equal(t, tt.actualStart, normalized.ActualStartTime != nil)
+			assert.Equal(t, tt.actualEnd, normalized.ActualEndTime != nil)
+		})
+	}
+}
+
+func TestOCINormalizer_Errors(t *testing.T) {
+	n := &OCINormalizer{}
+
+	missingInstance := newTestOCIEvent(core.InstanceMaintenanceEventLifecycleStateScheduled)
+	missingInstance.InstanceId = nil
+
+	tests := []struct {
+		name  string
+		event interface{}
+		meta  []interface{}
+	}{
+		{name: "wrong event type", event: "not-an-event", meta: []interface{}{newTestOCIMetadata()}},
+		{name: "missing metadata", event: newTestOCIEvent(core.InstanceMaintenanceEventLifecycleStateScheduled)},
+		{
+			name:  "wrong metadata type",
+			event: newTestOCIEvent(core.InstanceMaintenanceEventLifecycleStateScheduled),
+			meta:  []interface{}{"not-metadata"},
+		},
+		{name: "missing instance ID", event: missingInstance, meta: []interface{}{newTestOCIMetadata()}},
+		{
+			name:  "unknown lifecycle state",
+			event: newTestOCIEvent(core.InstanceMaintenanceEventLifecycleStateEnum("UNKNOWN")),
+			meta:  []interface{}{newTestOCIMetadata()},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := n.Normalize(tt.event, tt.meta...)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,223 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expedite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/nvidia/nvsentinel/commons/pkg/statemanager"
+	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/config"
+	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/csp"
+	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/datastore"
+	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/metrics"
+	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/model"
+)
+
+// maxExpediteAttempts is how many polls in a row an expedite may fail before FAILED is recorded for the event. Failed
+// expedites are retried on the next poll until then, since most failures are transient CSP API errors.
+const maxExpediteAttempts = 5
+
+// drainedStates are the node states which are only reached after a successful drain.
+var drainedStates = map[string]struct{}{
+	string(statemanager.DrainSucceededLabelValue):       {},
+	string(statemanager.RemediationWaitingLabelValue):   {},
+	string(statemanager.RemediatingLabelValue):          {},
+	string(statemanager.RemediationSucceededLabelValue): {},
+	string(statemanager.RemediationFailedLabelValue):    {},
+}
+
+// Engine polls the datastore for quarantined maintenance events and, once node-drainer has drained their node, asks
+// the CSP to start the maintenance instead of waiting for its scheduled start time.
+type Engine struct {
+	store        datastore.Store
+	expediter    csp.Expediter
+	cspName      model.CSP
+	k8sClient    kubernetes.Interface
+	pollInterval time.Duration
+
+	// failedAttempts counts the failed expedites of each event since the last outcome was recorded
+	failedAttempts map[string]int
+}
+
+// NewEngine constructs a ready-to-run Engine instance.
+func NewEngine(
+	cfg *config.Config,
+	store datastore.Store,
+	cspName model.CSP,
+	expediter csp.Expediter,
+	k8sClient kubernetes.Interface,
+) *Engine {
+	return &Engine{
+		store:        store,
+		expediter:    expediter,
+		cspName:      cspName,
+		k8sClient:    k8sClient,
+		pollInterval: time.Duration(cfg.MaintenanceEventPollIntervalSeconds) * time.Second,
+
+		failedAttempts: map[string]int{},
+	}
+}
+
+// Start begins the polling loop and blocks until ctx is cancelled.
+func (e *Engine) Start(ctx context.Context) {
+	slog.Info("Starting Maintenance Expedite Engine",
+		"csp", e.cspName,
+		"pollInterval", e.pollInterval)
+
+	ticker := time.NewTicker(e.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.Info("Maintenance Expedite Engine stopping due to context cancellation")
+			return
+		case <-ticker.C:
+			if err := e.expediteDrainedEvents(ctx); err != nil {
+				slog.Error("Error during expedite engine poll cycle", "error", err)
+			}
+		}
+	}
+}
+
+// expediteDrainedEvents expedites the maintenance of the events whose node has been drained.
+func (e *Engine) expediteDrainedEvents(ctx context.Context) error {
+	events, err := e.store.FindEventsToExpedite(ctx, e.cspName)
+	if err != nil {
+		return fmt.Errorf("failed to query for events to expedite: %w", err)
+	}
+
+	// Forget the failed attempts of events which no longer need an expedite, e.g. because their maintenance started
+	pending := make(map[string]struct{}, len(events))
+	for _, event := range events {
+		pending[event.EventID] = struct{}{}
+	}
+
+	for eventID := range e.failedAttempts {
+		if _, ok := pending[eventID]; !ok {
+			delete(e.failedAttempts, eventID)
+		}
+	}
+
+	for _, event := range events {
+		drained, err := e.isNodeDrained(ctx, event.NodeName)
+		if err != nil {
+			slog.Error("Failed to check drain state of node, will check next polling interval",
+				"eventID", event.EventID,
+				"node", event.NodeName,
+				"error", err)
+
+			continue
+		}
+
+		if !drained {
+			slog.Debug("Node is not drained yet, not expediting maintenance",
+				"eventID", event.EventID,
+				"node", event.NodeName)
+
+			continue
+		}
+
+		if err := e.expediteEvent(ctx, event); err != nil {
+			slog.Error("Failed to record expedite outcome",
+				"eventID", event.EventID,
+				"node", event.NodeName,
+				"error", err)
+		}
+	}
+
+	return nil
+}
+
+// expediteEvent asks the CSP to start the maintenance of the event and records the outcome on the event. Deferred
+// expedites record nothing, so that they are retried on the next poll. Failed expedites are retried the same way until
+// maxExpediteAttempts is reached.
+func (e *Engine) expediteEvent(ctx context.Context, event model.MaintenanceEvent) error {
+	err := e.expediter.ExpediteMaintenance(ctx, event)
+
+	var (
+		status  model.ExpediteStatus
+		message string
+	)
+
+	switch {
+	case errors.Is(err, csp.ErrExpediteDeferred):
+		slog.Info("Expediting maintenance deferred",
+			"eventID", event.EventID,
+			"node", event.NodeName,
+			"reason", err)
+
+		return nil
+	case errors.Is(err, csp.ErrExpediteNotSupported):
+		status, message = model.ExpediteStatusUnsupported, err.Error()
+	case err != nil:
+		e.failedAttempts[event.EventID]++
+
+		if attempts := e.failedAttempts[event.EventID]; attempts < maxExpediteAttempts {
+			slog.Warn("Expediting maintenance failed, will retry next polling interval",
+				"eventID", event.EventID,
+				"node", event.NodeName,
+				"attempt", attempts,
+				"maxAttempts", maxExpediteAttempts,
+				"error", err)
+
+			return nil
+		}
+
+		status, message = model.ExpediteStatusFailed, err.Error()
+	default:
+		status = model.ExpediteStatusRequested
+	}
+
+	delete(e.failedAttempts, event.EventID)
+	metrics.MaintenanceExpedites.WithLabelValues(string(e.cspName), strings.ToLower(string(status))).Inc()
+	slog.Info("Expedited maintenance after drain",
+		"eventID", event.EventID,
+		"node", event.NodeName,
+		"expediteStatus", status,
+		"message", message)
+
+	return e.store.UpdateEventExpediteStatus(ctx, event.EventID, status, message)
+}
+
+// isNodeDrained reports whether node-drainer has successfully drained the node.
+func (e *Engine) isNodeDrained(ctx context.Context, nodeName string) (bool, error) {
+	if nodeName == "" {
+		return false, nil
+	}
+
+	node, err := e.k8sClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+	if err != nil {
+		return false, fmt.Errorf("failed to get node %s: %w", nodeName, err)
+	}
+
+	return IsNodeDrained(node), nil
+}
+
+// IsNodeDrained reports whether the node state label shows a successful drain.
+func IsNodeDrained(node *corev1.Node) bool {
+	_, drained := drainedStates[node.Labels[statemanager.NVSentinelStateLabelKey]]
+
+	return drained
+}
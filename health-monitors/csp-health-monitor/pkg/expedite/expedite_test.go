@@ -0,0 +1,195 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expedite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/nvidia/nvsentinel/commons/pkg/statemanager"
+	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/config"
+	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/csp"
+	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/datastore"
+	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/model"
+)
+
+const (
+	drainedNodeName   = "drained-node"
+	undrainedNodeName = "undrained-node"
+)
+
+type expediteUpdate struct {
+	status  model.ExpediteStatus
+	message string
+}
+
+// fakeStore returns the configured events to expedite and records expedite updates; the other datastore methods are
+// not used by the engine.
+type fakeStore struct {
+	datastore.Store
+	events  []model.MaintenanceEvent
+	updates map[string]expediteUpdate
+}
+
+func (s *fakeStore) FindEventsToExpedite(ctx context.Context, cspName model.CSP) ([]model.MaintenanceEvent, error) {
+	return s.events, nil
+}
+
+func (s *fakeStore) UpdateEventExpediteStatus(
+	ctx context.Context,
+	eventID string,
+	status model.ExpediteStatus,
+	message string,
+) error {
+	s.updates[eventID] = expediteUpdate{status: status, message: message}
+	return nil
+}
+
+// fakeExpediter returns the configured error for each event and records the expedited events.
+type fakeExpediter struct {
+	errs      map[string]error
+	expedited []string
+}
+
+func (f *fakeExpediter) ExpediteMaintenance(ctx context.Context, event model.MaintenanceEvent) error {
+	f.expedited = append(f.expedited, event.EventID)
+	return f.errs[event.EventID]
+}
+
+func newTestEngine(store *fakeStore, expediter *fakeExpediter) *Engine {
+	k8sClient := fake.NewSimpleClientset(
+		&v1.Node{ObjectMeta: metav1.ObjectMeta{
+			Name: drainedNodeName,
+			Labels: map[string]string{
+				statemanager.NVSentinelStateLabelKey: string(statemanager.DrainSucceededLabelValue),
+			},
+		}},
+		&v1.Node{ObjectMeta: metav1.ObjectMeta{
+			Name: undrainedNodeName,
+			Labels: map[string]string{
+				statemanager.NVSentinelStateLabelKey: string(statemanager.DrainingLabelValue),
+			},
+		}},
+	)
+
+	return NewEngine(&config.Config{MaintenanceEventPollIntervalSeconds: 60}, store, model.CSPGCP, expediter, k8sClient)
+}
+
+func newTestEvent(eventID, nodeName string) model.MaintenanceEvent {
+	scheduledStart := time.Now().Add(4 * time.Hour)
+
+	return model.MaintenanceEvent{
+		EventID:            eventID,
+		CSP:                model.CSPGCP,
+		NodeName:           nodeName,
+		Status:             model.StatusQuarantineTriggered,
+		ScheduledStartTime: &scheduledStart,
+	}
+}
+
+func TestExpediteDrainedEvents(t *testing.T) {
+	store := &fakeStore{
+		events: []model.MaintenanceEvent{
+			newTestEvent("requested", drainedNodeName),
+			newTestEvent("failed", drainedNodeName),
+			newTestEvent("unsupported", drainedNodeName),
+			newTestEvent("deferred", drainedNodeName),
+			newTestEvent("not-drained", undrainedNodeName),
+			newTestEvent("unknown-node", "missing-node"),
+		},
+		updates: map[string]expediteUpdate{},
+	}
+	expediter := &fakeExpediter{errs: map[string]error{
+		"failed":      errors.New("api unavailable"),
+		"unsupported": fmt.Errorf("%w: no reschedulable event", csp.ErrExpediteNotSupported),
+		"deferred":    fmt.Errorf("%w: other resources not drained", csp.ErrExpediteDeferred),
+	}}
+
+	require.NoError(t, newTestEngine(store, expediter).expediteDrainedEvents(context.Background()))
+
+	assert.Equal(t, []string{"requested", "failed", "unsupported", "deferred"}, expediter.expedited,
+		"only events of drained nodes should be expedited")
+	assert.Equal(t, map[string]expediteUpdate{
+		"requested":   {status: model.ExpediteStatusRequested},
+		"unsupported": {status: model.ExpediteStatusUnsupported, message: expediter.errs["unsupported"].Error()},
+	}, store.updates, "deferred and failed expedites should not be recorded before the last attempt")
+}
+
+func TestExpediteDrainedEvents_RetriesFailures(t *testing.T) {
+	store := &fakeStore{
+		events: []model.MaintenanceEvent{
+			newTestEvent("failed", drainedNodeName),
+			newTestEvent("recovered", drainedNodeName),
+		},
+		updates: map[string]expediteUpdate{},
+	}
+	expediter := &fakeExpediter{errs: map[string]error{
+		"failed":    errors.New("api unavailable"),
+		"recovered": errors.New("throttled"),
+	}}
+	engine := newTestEngine(store, expediter)
+
+	require.NoError(t, engine.expediteDrainedEvents(context.Background()))
+	assert.Empty(t, store.updates, "failed expedites should be retried")
+
+	delete(expediter.errs, "recovered")
+	store.events = store.events[:1]
+
+	for range maxExpediteAttempts - 2 {
+		require.NoError(t, engine.expediteDrainedEvents(context.Background()))
+	}
+
+	assert.Empty(t, store.updates)
+	assert.NotContains(t, engine.failedAttempts, "recovered",
+		"attempts of events which are no longer pending should be forgotten")
+
+	require.NoError(t, engine.expediteDrainedEvents(context.Background()))
+	assert.Equal(t, map[string]expediteUpdate{
+		"failed": {status: model.ExpediteStatusFailed, message: "api unavailable"},
+	}, store.updates, "FAILED should be recorded after the last attempt")
+	assert.Empty(t, engine.failedAttempts)
+}
+
+func TestIsNodeDrained(t *testing.T) {
+	tests := []struct {
+		state    string
+		expected bool
+	}{
+		{state: "", expected: false},
+		{state: string(statemanager.QuarantinedLabelValue), expected: false},
+		{state: string(statemanager.DrainingLabelValue), expected: false},
+		{state: string(statemanager.DrainFailedLabelValue), expected: false},
+		{state: string(statemanager.DrainSucceededLabelValue), expected: true},
+		{state: string(statemanager.RemediatingLabelValue), expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.state, func(t *testing.T) {
+			node := &v1.Node{ObjectMeta: metav1.ObjectMeta{
+				Labels: map[string]string{statemanager.NVSentinelStateLabelKey: tt.state},
+			}}
+			assert.Equal(t, tt.expected, IsNodeDrained(node))
+		})
+	}
+}
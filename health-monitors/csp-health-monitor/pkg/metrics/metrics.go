@@ -78,6 +78,14 @@ var (
 		},
 	)
 
+	MaintenanceExpedites = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "csp_health_monitor_maintenance_expedite_total",
+			Help: "Total number of requests to the CSP to start a maintenance early after drain, by outcome.",
+		},
+		[]string{"csp", "status"}, // gcp/aws/azure, requested/failed/unsupported
+	)
+
 	// Normalization Metrics
 	MainEventsToNormalize = promauto.NewCounterVec(
 		prometheus.CounterOpts{
@@ -115,6 +115,24 @@ func (m *MockDatastore) FindActiveEventsByStatuses(ctx context.Context, csp mode
 	return args.Get(0).([]model.MaintenanceEvent), args.Error(1)
 }
 
+func (m *MockDatastore) FindEventsToExpedite(ctx context.Context, csp model.CSP) ([]model.MaintenanceEvent, error) {
+	args := m.Called(ctx, csp)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]model.MaintenanceEvent), args.Error(1)
+}
+
+func (m *MockDatastore) UpdateEventExpediteStatus(
+	ctx context.Context,
+	eventID string,
+	status model.ExpediteStatus,
+	message string,
+) error {
+	args := m.Called(ctx, eventID, status, message)
+	return args.Error(0)
+}
+
 // MockUDSClient is a mock implementation of the pb.PlatformConnectorClient interface
 type MockUDSClient struct {
 	mock.Mock
@@ -0,0 +1,133 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NodeQuarantineRequest condition types
+const (
+	// NodeQuarantineRequestConditionEventPublished indicates whether the health event has been sent
+	// to the platform connector
+	NodeQuarantineRequestConditionEventPublished = "EventPublished"
+)
+
+// NodeQuarantineRequestPhase is the progress of the node through the quarantine pipeline
+type NodeQuarantineRequestPhase string
+
+const (
+	// PhasePending means the health event has not been published yet
+	PhasePending NodeQuarantineRequestPhase = "Pending"
+	// PhaseQuarantined means the node has been cordoned by fault-quarantine
+	PhaseQuarantined NodeQuarantineRequestPhase = "Quarantined"
+	// PhaseDraining means node-drainer is evicting the workloads of the node
+	PhaseDraining NodeQuarantineRequestPhase = "Draining"
+	// PhaseRemediating means fault-remediation is acting on the node
+	PhaseRemediating NodeQuarantineRequestPhase = "Remediating"
+	// PhaseSucceeded means the node completed the pipeline
+	PhaseSucceeded NodeQuarantineRequestPhase = "Succeeded"
+	// PhaseFailed means draining or remediation of the node failed
+	PhaseFailed NodeQuarantineRequestPhase = "Failed"
+)
+
+// NodeQuarantineRequestSpec defines the desired state of NodeQuarantineRequest
+// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec is immutable"
+type NodeQuarantineRequestSpec struct {
+	// NodeName is the name of the node to quarantine
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	NodeName string `json:"nodeName"`
+
+	// Reason explains why the node is quarantined and is used as the health event message
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Reason string `json:"reason"`
+
+	// RecommendedAction is the remediation fault-remediation should perform once the node is drained
+	//nolint:lll // kubebuilder enum marker must stay on one line
+	// +kubebuilder:validation:Enum=NONE;COMPONENT_RESET;CONTACT_SUPPORT;RUN_FIELDDIAG;RESTART_VM;RESTART_BM;REPLACE_VM;RUN_DCGMEUD
+	// +kubebuilder:default:=CONTACT_SUPPORT
+	// +optional
+	RecommendedAction string `json:"recommendedAction,omitempty"`
+
+	// SkipDrain cordons the node without evicting its workloads
+	// +kubebuilder:default:=false
+	// +optional
+	SkipDrain bool `json:"skipDrain,omitempty"`
+}
+
+// NodeQuarantineRequestStatus defines the observed state of NodeQuarantineRequest
+type NodeQuarantineRequestStatus struct {
+	// Phase is the progress of the node through the quarantine pipeline
+	Phase NodeQuarantineRequestPhase `json:"phase,omitempty"`
+
+	// NodeState is the last observed value of the node's NVSentinel state label
+	NodeState string `json:"nodeState,omitempty"`
+
+	// PublishedTime is the time when the health event was published
+	PublishedTime *metav1.Time `json:"publishedTime,omitempty"`
+
+	// CompletionTime is the time when the node reached a terminal phase
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Conditions represent the latest available observations of an object's current state.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,shortName=nqr
+// +kubebuilder:printcolumn:name="Node",type="string",JSONPath=".spec.nodeName"
+// +kubebuilder:printcolumn:name="Action",type="string",JSONPath=".spec.recommendedAction"
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// NodeQuarantineRequest is the Schema for the nodequarantinerequests API
+type NodeQuarantineRequest struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NodeQuarantineRequestSpec   `json:"spec,omitempty"`
+	Status NodeQuarantineRequestStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NodeQuarantineRequestList contains a list of NodeQuarantineRequest
+type NodeQuarantineRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NodeQuarantineRequest `json:"items"`
+}
+
+// IsComplete returns true once the node has reached a terminal phase
+func (r *NodeQuarantineRequest) IsComplete() bool {
+	return r.Status.Phase == PhaseSucceeded || r.Status.Phase == PhaseFailed
+}
+
+// SetPhase updates the phase and records the completion time when a terminal phase is reached
+func (r *NodeQuarantineRequest) SetPhase(phase NodeQuarantineRequestPhase) {
+	r.Status.Phase = phase
+
+	if r.IsComplete() && r.Status.CompletionTime == nil {
+		now := metav1.Now()
+		r.Status.CompletionTime = &now
+	}
+}
+
+func init() {
+	SchemeBuilder.Register(&NodeQuarantineRequest{}, &NodeQuarantineRequestList{})
+}
@@ -76,6 +76,11 @@ var (
 		"EXECUTE_REMEDIATION",
 		"Event processing strategy: EXECUTE_REMEDIATION or STORE_ONLY",
 	)
+	enableQuarantineRequest = flag.Bool(
+		"enable-quarantine-request",
+		false,
+		"Reconcile NodeQuarantineRequest resources into health events",
+	)
 )
 
 func main() {
@@ -107,6 +112,7 @@ func run() error {
 		MaxConcurrentReconciles: *maxConcurrentReconciles,
 		PlatformConnectorSocket: *platformConnectorSocket,
 		ProcessingStrategy:      *processingStrategyFlag,
+		EnableQuarantineRequest: *enableQuarantineRequest,
 	}
 
 	components, err := initializer.InitializeAll(ctx, params)
@@ -0,0 +1,270 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/nvidia/nvsentinel/commons/pkg/statemanager"
+	"github.com/nvidia/nvsentinel/health-monitors/kubernetes-object-monitor/api/v1alpha1"
+	"github.com/nvidia/nvsentinel/health-monitors/kubernetes-object-monitor/pkg/metrics"
+	"github.com/nvidia/nvsentinel/health-monitors/kubernetes-object-monitor/pkg/publisher"
+)
+
+const (
+	// QuarantineRequestFinalizer holds a NodeQuarantineRequest until its healthy event has been published
+	QuarantineRequestFinalizer = "quarantine.dgxc.nvidia.com/health-event"
+
+	quarantineRequestNodeNameField = "spec.nodeName"
+)
+
+type QuarantineRequestPublisher interface {
+	PublishQuarantineRequest(ctx context.Context, req *v1alpha1.NodeQuarantineRequest, isHealthy bool) error
+}
+
+// QuarantineRequestReconciler turns NodeQuarantineRequests into health events and reports the
+// node's progress through quarantine, drain and remediation back on the request status.
+type QuarantineRequestReconciler struct {
+	client.Client
+	publisher QuarantineRequestPublisher
+}
+
+func NewQuarantineRequestReconciler(c client.Client, pub QuarantineRequestPublisher) *QuarantineRequestReconciler {
+	return &QuarantineRequestReconciler{
+		Client:    c,
+		publisher: pub,
+	}
+}
+
+// SetupWithManager registers the controller, watching nodes so that state label changes are
+// reflected on the requests targeting them.
+func (r *QuarantineRequestReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &v1alpha1.NodeQuarantineRequest{},
+		quarantineRequestNodeNameField, func(obj client.Object) []string {
+			return []string{obj.(*v1alpha1.NodeQuarantineRequest).Spec.NodeName}
+		}); err != nil {
+		return fmt.Errorf("failed to index NodeQuarantineRequests by node name: %w", err)
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.NodeQuarantineRequest{}).
+		Watches(&corev1.Node{},
+			handler.EnqueueRequestsFromMapFunc(r.requestsForNode),
+			builder.WithPredicates(predicate.LabelChangedPredicate{})).
+		Complete(r)
+}
+
+func (r *QuarantineRequestReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	qr := &v1alpha1.NodeQuarantineRequest{}
+	if err := r.Get(ctx, req.NamespacedName, qr); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if !qr.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, r.handleDeletion(ctx, qr)
+	}
+
+	if controllerutil.AddFinalizer(qr, QuarantineRequestFinalizer) {
+		if err := r.Update(ctx, qr); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to add finalizer: %w", err)
+		}
+	}
+
+	if qr.IsComplete() {
+		return ctrl.Result{}, nil
+	}
+
+	original := qr.DeepCopy()
+
+	if !meta.IsStatusConditionTrue(qr.Status.Conditions, v1alpha1.NodeQuarantineRequestConditionEventPublished) {
+		if err := r.publishQuarantine(ctx, qr); err != nil {
+			if statusErr := r.Status().Patch(ctx, qr, client.MergeFrom(original)); statusErr != nil {
+				slog.Error("Failed to update quarantine request status", "request", qr.Name, "error", statusErr)
+			}
+
+			return ctrl.Result{}, err
+		}
+	}
+
+	if err := r.syncNodeState(ctx, qr); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if err := r.Status().Patch(ctx, qr, client.MergeFrom(original)); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to update status: %w", err)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+func (r *QuarantineRequestReconciler) publishQuarantine(ctx context.Context, qr *v1alpha1.NodeQuarantineRequest) error {
+	if qr.Status.Phase == "" {
+		qr.SetPhase(v1alpha1.PhasePending)
+	}
+
+	if err := r.publisher.PublishQuarantineRequest(ctx, qr, false); err != nil {
+		metrics.HealthEventsPublishErrors.WithLabelValues(publisher.QuarantineRequestCheckName, "publish_error").Inc()
+		meta.SetStatusCondition(&qr.Status.Conditions, metav1.Condition{
+			Type:    v1alpha1.NodeQuarantineRequestConditionEventPublished,
+			Status:  metav1.ConditionFalse,
+			Reason:  "PublishFailed",
+			Message: err.Error(),
+		})
+
+		return fmt.Errorf("failed to publish health event for quarantine request %s: %w", qr.Name, err)
+	}
+
+	now := metav1.Now()
+	qr.Status.PublishedTime = &now
+	meta.SetStatusCondition(&qr.Status.Conditions, metav1.Condition{
+		Type:    v1alpha1.NodeQuarantineRequestConditionEventPublished,
+		Status:  metav1.ConditionTrue,
+		Reason:  "Published",
+		Message: "Health event published to the platform connector",
+	})
+
+	metrics.QuarantineRequestEvents.WithLabelValues(qr.Spec.NodeName, "quarantine").Inc()
+	slog.Info("Published health event for quarantine request", "request", qr.Name, "node", qr.Spec.NodeName)
+
+	return nil
+}
+
+// syncNodeState derives the request phase from the node's NVSentinel state label
+func (r *QuarantineRequestReconciler) syncNodeState(ctx context.Context, qr *v1alpha1.NodeQuarantineRequest) error {
+	node := &corev1.Node{}
+	if err := r.Get(ctx, types.NamespacedName{Name: qr.Spec.NodeName}, node); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to get node %s: %w", qr.Spec.NodeName, err)
+		}
+
+		// A replaced or terminated node disappears as the outcome of remediation
+		if qr.Status.Phase == v1alpha1.PhaseRemediating {
+			qr.SetPhase(v1alpha1.PhaseSucceeded)
+		} else {
+			qr.SetPhase(v1alpha1.PhaseFailed)
+		}
+
+		qr.Status.NodeState = ""
+
+		return nil
+	}
+
+	state, exists := node.Labels[statemanager.NVSentinelStateLabelKey]
+	qr.Status.NodeState = state
+
+	// An outcome label left over from an earlier cycle must not complete a new request, so outcomes
+	// only count once the node has been seen entering quarantine after the event was published
+	if qr.Status.Phase == v1alpha1.PhasePending && isOutcomeState(state) {
+		slog.Debug("Ignoring node state that predates the quarantine request",
+			"request", qr.Name, "node", qr.Spec.NodeName, "state", state)
+
+		return nil
+	}
+
+	switch statemanager.NVSentinelStateLabelValue(state) {
+	case statemanager.QuarantinedLabelValue, statemanager.DrainWaitingLabelValue:
+		qr.SetPhase(v1alpha1.PhaseQuarantined)
+	case statemanager.DrainingLabelValue:
+		qr.SetPhase(v1alpha1.PhaseDraining)
+	case statemanager.DrainSucceededLabelValue:
+		if qr.Spec.RecommendedAction == "NONE" {
+			qr.SetPhase(v1alpha1.PhaseSucceeded)
+		} else {
+			qr.SetPhase(v1alpha1.PhaseRemediating)
+		}
+	case statemanager.RemediationWaitingLabelValue, statemanager.RemediatingLabelValue:
+		qr.SetPhase(v1alpha1.PhaseRemediating)
+	case statemanager.RemediationSucceededLabelValue:
+		qr.SetPhase(v1alpha1.PhaseSucceeded)
+	case statemanager.DrainFailedLabelValue, statemanager.RemediationFailedLabelValue:
+		qr.SetPhase(v1alpha1.PhaseFailed)
+	default:
+		// The label is removed once remediation completes and the node is released
+		if !exists && qr.Status.Phase == v1alpha1.PhaseRemediating {
+			qr.SetPhase(v1alpha1.PhaseSucceeded)
+		}
+	}
+
+	return nil
+}
+
+// isOutcomeState reports whether the state label records the outcome of a drain or remediation
+func isOutcomeState(state string) bool {
+	switch statemanager.NVSentinelStateLabelValue(state) {
+	case statemanager.DrainSucceededLabelValue, statemanager.DrainFailedLabelValue,
+		statemanager.RemediationSucceededLabelValue, statemanager.RemediationFailedLabelValue:
+		return true
+	default:
+		return false
+	}
+}
+
+// handleDeletion publishes the healthy event that releases the node before removing the finalizer
+func (r *QuarantineRequestReconciler) handleDeletion(ctx context.Context, qr *v1alpha1.NodeQuarantineRequest) error {
+	if !controllerutil.ContainsFinalizer(qr, QuarantineRequestFinalizer) {
+		return nil
+	}
+
+	if meta.IsStatusConditionTrue(qr.Status.Conditions, v1alpha1.NodeQuarantineRequestConditionEventPublished) {
+		if err := r.publisher.PublishQuarantineRequest(ctx, qr, true); err != nil {
+			metrics.HealthEventsPublishErrors.WithLabelValues(publisher.QuarantineRequestCheckName, "publish_error").Inc()
+
+			return fmt.Errorf("failed to publish healthy event for quarantine request %s: %w", qr.Name, err)
+		}
+
+		metrics.QuarantineRequestEvents.WithLabelValues(qr.Spec.NodeName, "release").Inc()
+		slog.Info("Published healthy event for deleted quarantine request", "request", qr.Name, "node", qr.Spec.NodeName)
+	}
+
+	controllerutil.RemoveFinalizer(qr, QuarantineRequestFinalizer)
+
+	if err := r.Update(ctx, qr); err != nil {
+		return fmt.Errorf("failed to remove finalizer: %w", err)
+	}
+
+	return nil
+}
+
+func (r *QuarantineRequestReconciler) requestsForNode(ctx context.Context, obj client.Object) []reconcile.Request {
+	list := &v1alpha1.NodeQuarantineRequestList{}
+	if err := r.List(ctx, list, client.MatchingFields{quarantineRequestNodeNameField: obj.GetName()}); err != nil {
+		slog.Error("Failed to list quarantine requests for node", "node", obj.GetName(), "error", err)
+		metrics.ReconciliationErrors.WithLabelValues("NodeQuarantineRequest", "list_error").Inc()
+
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(list.Items))
+	for _, item := range list.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: item.Name}})
+	}
+
+	return requests
+}
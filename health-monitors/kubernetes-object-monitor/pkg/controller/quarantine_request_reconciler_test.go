@@ -0,0 +1,216 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/nvidia/nvsentinel/commons/pkg/statemanager"
+	"github.com/nvidia/nvsentinel/health-monitors/kubernetes-object-monitor/api/v1alpha1"
+	"github.com/nvidia/nvsentinel/health-monitors/kubernetes-object-monitor/pkg/controller"
+)
+
+type mockQuarantineRequestPublisher struct {
+	err    error
+	events []bool
+}
+
+func (m *mockQuarantineRequestPublisher) PublishQuarantineRequest(
+	_ context.Context, _ *v1alpha1.NodeQuarantineRequest, isHealthy bool,
+) error {
+	if m.err != nil {
+		return m.err
+	}
+
+	m.events = append(m.events, isHealthy)
+
+	return nil
+}
+
+func setupQuarantineRequestTest(
+	t *testing.T, objs ...client.Object,
+) (client.Client, *controller.QuarantineRequestReconciler, *mockQuarantineRequestPublisher) {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&v1alpha1.NodeQuarantineRequest{}).
+		Build()
+	pub := &mockQuarantineRequestPublisher{}
+
+	return c, controller.NewQuarantineRequestReconciler(c, pub), pub
+}
+
+func quarantineRequest(name, nodeName string) *v1alpha1.NodeQuarantineRequest {
+	return &v1alpha1.NodeQuarantineRequest{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1alpha1.NodeQuarantineRequestSpec{
+			NodeName:          nodeName,
+			Reason:            "Suspected NVLink degradation",
+			RecommendedAction: "RESTART_BM",
+		},
+	}
+}
+
+func reconcileQuarantineRequest(
+	t *testing.T, c client.Client, r *controller.QuarantineRequestReconciler, name string,
+) *v1alpha1.NodeQuarantineRequest {
+	t.Helper()
+
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: name}})
+	require.NoError(t, err)
+
+	qr := &v1alpha1.NodeQuarantineRequest{}
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Name: name}, qr))
+
+	return qr
+}
+
+func setNodeState(t *testing.T, c client.Client, nodeName string, state statemanager.NVSentinelStateLabelValue) {
+	t.Helper()
+
+	node := &v1.Node{}
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Name: nodeName}, node))
+
+	if state == "" {
+		delete(node.Labels, statemanager.NVSentinelStateLabelKey)
+	} else {
+		node.Labels = map[string]string{statemanager.NVSentinelStateLabelKey: string(state)}
+	}
+
+	require.NoError(t, c.Update(context.Background(), node))
+}
+
+func TestQuarantineRequestReconciler_Lifecycle(t *testing.T) {
+	node := &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}}
+	c, r, pub := setupQuarantineRequestTest(t, node, quarantineRequest("nqr-1", "node-1"))
+
+	qr := reconcileQuarantineRequest(t, c, r, "nqr-1")
+	assert.Equal(t, []bool{false}, pub.events)
+	assert.Contains(t, qr.Finalizers, controller.QuarantineRequestFinalizer)
+	assert.Equal(t, v1alpha1.PhasePending, qr.Status.Phase)
+	assert.NotNil(t, qr.Status.PublishedTime)
+	assert.True(t, meta.IsStatusConditionTrue(qr.Status.Conditions,
+		v1alpha1.NodeQuarantineRequestConditionEventPublished))
+
+	for _, tc := range []struct {
+		state statemanager.NVSentinelStateLabelValue
+		phase v1alpha1.NodeQuarantineRequestPhase
+	}{
+		{statemanager.QuarantinedLabelValue, v1alpha1.PhaseQuarantined},
+		{statemanager.DrainingLabelValue, v1alpha1.PhaseDraining},
+		{statemanager.DrainSucceededLabelValue, v1alpha1.PhaseRemediating},
+		{statemanager.RemediatingLabelValue, v1alpha1.PhaseRemediating},
+		{"", v1alpha1.PhaseSucceeded},
+	} {
+		setNodeState(t, c, "node-1", tc.state)
+
+		qr = reconcileQuarantineRequest(t, c, r, "nqr-1")
+		assert.Equal(t, tc.phase, qr.Status.Phase, "node state %q", tc.state)
+		assert.Equal(t, string(tc.state), qr.Status.NodeState)
+	}
+
+	assert.NotNil(t, qr.Status.CompletionTime)
+	// The event is published only once for the lifetime of the request.
+	assert.Equal(t, []bool{false}, pub.events)
+
+	require.NoError(t, c.Delete(context.Background(), qr))
+
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: "nqr-1"}})
+	require.NoError(t, err)
+	assert.Equal(t, []bool{false, true}, pub.events)
+
+	err = c.Get(context.Background(), types.NamespacedName{Name: "nqr-1"}, &v1alpha1.NodeQuarantineRequest{})
+	assert.True(t, apierrors.IsNotFound(err), "request should be gone once the finalizer is removed")
+}
+
+func TestQuarantineRequestReconciler_RemediationFailed(t *testing.T) {
+	node := &v1.Node{ObjectMeta: metav1.ObjectMeta{
+		Name:   "node-2",
+		Labels: map[string]string{statemanager.NVSentinelStateLabelKey: string(statemanager.RemediationFailedLabelValue)},
+	}}
+	c, r, _ := setupQuarantineRequestTest(t, node, quarantineRequest("nqr-2", "node-2"))
+
+	qr := reconcileQuarantineRequest(t, c, r, "nqr-2")
+	assert.Equal(t, v1alpha1.PhasePending, qr.Status.Phase, "a leftover outcome label must be ignored")
+	assert.False(t, qr.IsComplete())
+
+	setNodeState(t, c, "node-2", statemanager.QuarantinedLabelValue)
+
+	qr = reconcileQuarantineRequest(t, c, r, "nqr-2")
+	assert.Equal(t, v1alpha1.PhaseQuarantined, qr.Status.Phase)
+
+	setNodeState(t, c, "node-2", statemanager.RemediationFailedLabelValue)
+
+	qr = reconcileQuarantineRequest(t, c, r, "nqr-2")
+	assert.Equal(t, v1alpha1.PhaseFailed, qr.Status.Phase)
+	assert.True(t, qr.IsComplete())
+}
+
+func TestQuarantineRequestReconciler_IgnoresLeftoverSucceededLabel(t *testing.T) {
+	node := &v1.Node{ObjectMeta: metav1.ObjectMeta{
+		Name: "node-4",
+		Labels: map[string]string{
+			statemanager.NVSentinelStateLabelKey: string(statemanager.RemediationSucceededLabelValue),
+		},
+	}}
+	c, r, _ := setupQuarantineRequestTest(t, node, quarantineRequest("nqr-4", "node-4"))
+
+	qr := reconcileQuarantineRequest(t, c, r, "nqr-4")
+	assert.Equal(t, v1alpha1.PhasePending, qr.Status.Phase)
+	assert.Nil(t, qr.Status.CompletionTime)
+}
+
+func TestQuarantineRequestReconciler_PublishError(t *testing.T) {
+	node := &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-3"}}
+	c, r, pub := setupQuarantineRequestTest(t, node, quarantineRequest("nqr-3", "node-3"))
+	pub.err = errors.New("platform connector unavailable")
+
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: "nqr-3"}})
+	require.Error(t, err)
+
+	qr := &v1alpha1.NodeQuarantineRequest{}
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Name: "nqr-3"}, qr))
+	assert.Equal(t, v1alpha1.PhasePending, qr.Status.Phase)
+	assert.True(t, meta.IsStatusConditionFalse(qr.Status.Conditions,
+		v1alpha1.NodeQuarantineRequestConditionEventPublished))
+
+	// Deleting a request whose event was never published does not release the node.
+	require.NoError(t, c.Delete(context.Background(), qr))
+
+	_, err = r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: "nqr-3"}})
+	require.NoError(t, err)
+	assert.Empty(t, pub.events)
+}
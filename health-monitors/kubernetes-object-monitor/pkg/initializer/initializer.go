@@ -27,7 +27,9 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -36,6 +38,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
 	pb "github.com/nvidia/nvsentinel/data-models/pkg/protos"
+	"github.com/nvidia/nvsentinel/health-monitors/kubernetes-object-monitor/api/v1alpha1"
 	"github.com/nvidia/nvsentinel/health-monitors/kubernetes-object-monitor/pkg/annotations"
 	celenv "github.com/nvidia/nvsentinel/health-monitors/kubernetes-object-monitor/pkg/cel"
 	"github.com/nvidia/nvsentinel/health-monitors/kubernetes-object-monitor/pkg/config"
@@ -52,6 +55,7 @@ type Params struct {
 	MaxConcurrentReconciles int
 	PlatformConnectorSocket string
 	ProcessingStrategy      string
+	EnableQuarantineRequest bool
 }
 
 type Components struct {
@@ -120,6 +124,15 @@ func InitializeAll(ctx context.Context, params Params) (*Components, error) {
 		return nil, fmt.Errorf("failed to register controllers: %w", err)
 	}
 
+	if params.EnableQuarantineRequest {
+		if err := controller.NewQuarantineRequestReconciler(mgr.GetClient(), pub).SetupWithManager(ctx, mgr); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("failed to register NodeQuarantineRequest controller: %w", err)
+		}
+
+		slog.Info("Registered NodeQuarantineRequest controller")
+	}
+
 	return &Components{
 		Manager:   mgr,
 		GRPCConn:  conn,
@@ -132,7 +145,17 @@ func InitializeAll(ctx context.Context, params Params) (*Components, error) {
 func createManager(params Params) (ctrl.Manager, error) {
 	config := ctrl.GetConfigOrDie()
 
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		return nil, fmt.Errorf("failed to add client-go types to scheme: %w", err)
+	}
+
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		return nil, fmt.Errorf("failed to add quarantine types to scheme: %w", err)
+	}
+
 	mgrOpts := ctrl.Options{
+		Scheme: scheme,
 		Metrics: server.Options{
 			BindAddress: params.MetricsBindAddress,
 		},
@@ -51,4 +51,12 @@ var (
 		},
 		[]string{"resource_kind", "error_type"},
 	)
+
+	QuarantineRequestEvents = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "k8s_object_monitor_quarantine_request_events_total",
+			Help: "Health events published for NodeQuarantineRequests by node and event type (quarantine or release)",
+		},
+		[]string{"node", "event_type"},
+	)
 )
@@ -26,11 +26,17 @@ import (
 	"k8s.io/apimachinery/pkg/util/wait"
 
 	pb "github.com/nvidia/nvsentinel/data-models/pkg/protos"
+	"github.com/nvidia/nvsentinel/health-monitors/kubernetes-object-monitor/api/v1alpha1"
 	"github.com/nvidia/nvsentinel/health-monitors/kubernetes-object-monitor/pkg/config"
 )
 
 const (
 	agentName = "kubernetes-object-monitor"
+
+	// QuarantineRequestCheckName is the check name of health events synthesised from NodeQuarantineRequests
+	QuarantineRequestCheckName = "NodeQuarantineRequest"
+	// quarantineRequestCreatorID completes the cordon-by label value set by fault-quarantine for forced quarantines
+	quarantineRequestCreatorID = "nodequarantinerequest"
 )
 
 type Publisher struct {
@@ -105,6 +111,57 @@ func (p *Publisher) PublishHealthEvent(ctx context.Context,
 	return p.sendWithRetry(ctx, healthEvents)
 }
 
+// PublishQuarantineRequest publishes the health event for an operator-initiated NodeQuarantineRequest.
+// The unhealthy event forces fault-quarantine to cordon the node regardless of its rulesets, and the
+// healthy event, published when the request is deleted, clears it again. Both events reference the
+// request in entitiesImpacted so that fault-quarantine tracks every request individually.
+func (p *Publisher) PublishQuarantineRequest(ctx context.Context,
+	req *v1alpha1.NodeQuarantineRequest, isHealthy bool) error {
+	message := req.Spec.Reason
+	recommendedAction := mapRecommendedAction(req.Spec.RecommendedAction)
+
+	if isHealthy {
+		message = fmt.Sprintf("NodeQuarantineRequest %s was deleted", req.Name)
+		recommendedAction = pb.RecommendedAction_NONE
+	}
+
+	event := &pb.HealthEvent{
+		Version:            1,
+		Agent:              agentName,
+		CheckName:          QuarantineRequestCheckName,
+		ComponentClass:     "Node",
+		GeneratedTimestamp: timestamppb.New(time.Now()),
+		Message:            message,
+		IsFatal:            !isHealthy,
+		IsHealthy:          isHealthy,
+		NodeName:           req.Spec.NodeName,
+		RecommendedAction:  recommendedAction,
+		// Requests are explicit operator actions and are never run in store-only mode
+		ProcessingStrategy: pb.ProcessingStrategy_EXECUTE_REMEDIATION,
+		EntitiesImpacted: []*pb.Entity{
+			{
+				EntityType:  v1alpha1.GroupVersion.String() + "/NodeQuarantineRequest",
+				EntityValue: req.Name,
+			},
+		},
+		Metadata: map[string]string{
+			"creator_id":         quarantineRequestCreatorID,
+			"quarantine_request": req.Name,
+		},
+		QuarantineOverrides: &pb.BehaviourOverrides{Force: !isHealthy},
+		DrainOverrides:      &pb.BehaviourOverrides{Skip: req.Spec.SkipDrain},
+	}
+
+	healthEvents := &pb.HealthEvents{
+		Version: 1,
+		Events:  []*pb.HealthEvent{event},
+	}
+
+	slog.Info("Publishing quarantine request health event", "request", req.Name, "event", event)
+
+	return p.sendWithRetry(ctx, healthEvents)
+}
+
 func (p *Publisher) sendWithRetry(ctx context.Context, events *pb.HealthEvents) error {
 	backoff := wait.Backoff{
 		Steps:    5,
@@ -20,6 +20,7 @@ package main
 import (
 	"context"
 	"crypto/tls"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net"
@@ -38,6 +39,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
@@ -170,6 +172,244 @@ func (s *janitorProviderServer) SendTerminateSignal(ctx context.Context, req *cs
 	}, nil
 }
 
+func (s *janitorProviderServer) RequestReplacementNode(
+	ctx context.Context, req *cspv1alpha1.RequestReplacementNodeRequest,
+) (*cspv1alpha1.RequestReplacementNodeResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "janitor_provider.RequestReplacementNode")
+	defer span.End()
+
+	replacer, ok := s.cspClient.(model.NodeReplacer)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "the CSP provider does not support replacement nodes")
+	}
+
+	slog.InfoContext(ctx, "Requesting replacement node", "node", req.NodeName)
+
+	node, err := s.k8sClient.CoreV1().Nodes().Get(ctx, req.NodeName, metav1.GetOptions{})
+	if err != nil {
+		span.SetAttributes(
+			attribute.String("janitor_provider.error.type", "grpc_error"),
+			attribute.String("janitor_provider.error.message", err.Error()),
+		)
+		tracing.RecordError(span, err)
+		return nil, status.Errorf(codes.Internal, "failed to get node: %v", err)
+	}
+
+	requestID, err := replacer.RequestReplacementNode(ctx, *node)
+	if err != nil {
+		span.SetAttributes(
+			attribute.String("janitor_provider.error.type", "csp_api_error"),
+			attribute.String("janitor_provider.error.message", err.Error()),
+		)
+		tracing.RecordError(span, err)
+		return nil, status.Errorf(codes.Internal, "failed to request replacement node: %v", err)
+	}
+
+	span.SetAttributes(
+		attribute.String("janitor_provider.replacement.request_ref", string(requestID)),
+	)
+
+	return &cspv1alpha1.RequestReplacementNodeResponse{
+		RequestId: string(requestID),
+	}, nil
+}
+
+func (s *janitorProviderServer) GetReplacementNode(
+	ctx context.Context, req *cspv1alpha1.GetReplacementNodeRequest,
+) (*cspv1alpha1.GetReplacementNodeResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "janitor_provider.GetReplacementNode")
+	defer span.End()
+
+	replacer, ok := s.cspClient.(model.NodeReplacer)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "the CSP provider does not support replacement nodes")
+	}
+
+	slog.InfoContext(ctx, "Getting replacement node", "node", req.NodeName, "requestID", req.RequestId)
+
+	node, err := s.k8sClient.CoreV1().Nodes().Get(ctx, req.NodeName, metav1.GetOptions{})
+	if err != nil {
+		span.SetAttributes(
+			attribute.String("janitor_provider.error.type", "grpc_error"),
+			attribute.String("janitor_provider.error.message", err.Error()),
+		)
+		tracing.RecordError(span, err)
+		return nil, status.Errorf(codes.Internal, "failed to get node: %v", err)
+	}
+
+	replacementNodeName, err := replacer.GetReplacementNode(ctx, *node, req.RequestId)
+	if err != nil {
+		span.SetAttributes(
+			attribute.String("janitor_provider.error.type", "csp_api_error"),
+			attribute.String("janitor_provider.error.message", err.Error()),
+		)
+		tracing.RecordError(span, err)
+		return nil, status.Errorf(codes.Internal, "failed to get replacement node: %v", err)
+	}
+
+	span.SetAttributes(
+		attribute.String("janitor_provider.replacement.node_name", replacementNodeName),
+	)
+
+	return &cspv1alpha1.GetReplacementNodeResponse{
+		ReplacementNodeName: replacementNodeName,
+	}, nil
+}
+
+func (s *janitorProviderServer) GetInstanceStatus(
+	ctx context.Context, req *cspv1alpha1.GetInstanceStatusRequest,
+) (*cspv1alpha1.GetInstanceStatusResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "janitor_provider.GetInstanceStatus")
+	defer span.End()
+
+	getter, ok := s.cspClient.(model.InstanceStatusGetter)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "the CSP provider does not support instance status")
+	}
+
+	slog.InfoContext(ctx, "Getting instance status", "node", req.NodeName)
+
+	node, err := s.k8sClient.CoreV1().Nodes().Get(ctx, req.NodeName, metav1.GetOptions{})
+	if err != nil {
+		span.SetAttributes(
+			attribute.String("janitor_provider.error.type", "grpc_error"),
+			attribute.String("janitor_provider.error.message", err.Error()),
+		)
+		tracing.RecordError(span, err)
+		return nil, status.Errorf(codes.Internal, "failed to get node: %v", err)
+	}
+
+	instanceStatus, err := getter.GetInstanceStatus(ctx, *node)
+	if err != nil {
+		span.SetAttributes(
+			attribute.String("janitor_provider.error.type", "csp_api_error"),
+			attribute.String("janitor_provider.error.message", err.Error()),
+		)
+		tracing.RecordError(span, err)
+		return nil, status.Errorf(cspErrorCode(err), "failed to get instance status: %v", err)
+	}
+
+	span.SetAttributes(
+		attribute.String("janitor_provider.instance_status.provider_state", instanceStatus.ProviderState),
+		attribute.Bool("janitor_provider.instance_status.under_maintenance", instanceStatus.UnderMaintenance),
+		attribute.Int("janitor_provider.instance_status.scheduled_events", len(instanceStatus.ScheduledEvents)),
+	)
+
+	rsp := &cspv1alpha1.GetInstanceStatusResponse{
+		ProviderState:    instanceStatus.ProviderState,
+		UnderMaintenance: instanceStatus.UnderMaintenance,
+	}
+
+	for _, event := range instanceStatus.ScheduledEvents {
+		scheduledEvent := &cspv1alpha1.ScheduledEvent{
+			Id:          event.ID,
+			Type:        event.Type,
+			Description: event.Description,
+		}
+
+		if !event.NotBefore.IsZero() {
+			scheduledEvent.NotBefore = timestamppb.New(event.NotBefore)
+		}
+
+		if !event.NotAfter.IsZero() {
+			scheduledEvent.NotAfter = timestamppb.New(event.NotAfter)
+		}
+
+		rsp.ScheduledEvents = append(rsp.ScheduledEvents, scheduledEvent)
+	}
+
+	return rsp, nil
+}
+
+func (s *janitorProviderServer) SendReimageSignal(
+	ctx context.Context, req *cspv1alpha1.SendReimageSignalRequest,
+) (*cspv1alpha1.SendReimageSignalResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "janitor_provider.SendReimageSignal")
+	defer span.End()
+
+	reimager, ok := s.cspClient.(model.NodeReimager)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "the CSP provider does not support reimaging nodes")
+	}
+
+	slog.InfoContext(ctx, "Sending reimage signal", "node", req.NodeName)
+
+	node, err := s.k8sClient.CoreV1().Nodes().Get(ctx, req.NodeName, metav1.GetOptions{})
+	if err != nil {
+		span.SetAttributes(
+			attribute.String("janitor_provider.error.type", "grpc_error"),
+			attribute.String("janitor_provider.error.message", err.Error()),
+		)
+		tracing.RecordError(span, err)
+		return nil, status.Errorf(codes.Internal, "failed to get node: %v", err)
+	}
+
+	requestID, err := reimager.SendReimageSignal(ctx, *node)
+	if err != nil {
+		span.SetAttributes(
+			attribute.String("janitor_provider.error.type", "csp_api_error"),
+			attribute.String("janitor_provider.error.message", err.Error()),
+		)
+		tracing.RecordError(span, err)
+		return nil, status.Errorf(cspErrorCode(err), "failed to send reimage signal: %v", err)
+	}
+
+	span.SetAttributes(
+		attribute.String("janitor_provider.reimage.request_ref", string(requestID)),
+	)
+
+	return &cspv1alpha1.SendReimageSignalResponse{
+		RequestId: string(requestID),
+	}, nil
+}
+
+func (s *janitorProviderServer) CancelRequest(
+	ctx context.Context, req *cspv1alpha1.CancelRequestRequest,
+) (*cspv1alpha1.CancelRequestResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "janitor_provider.CancelRequest")
+	defer span.End()
+
+	canceller, ok := s.cspClient.(model.RequestCanceller)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "the CSP provider does not support cancelling requests")
+	}
+
+	slog.InfoContext(ctx, "Cancelling request", "node", req.NodeName, "requestID", req.RequestId)
+
+	node, err := s.k8sClient.CoreV1().Nodes().Get(ctx, req.NodeName, metav1.GetOptions{})
+	if err != nil {
+		span.SetAttributes(
+			attribute.String("janitor_provider.error.type", "grpc_error"),
+			attribute.String("janitor_provider.error.message", err.Error()),
+		)
+		tracing.RecordError(span, err)
+		return nil, status.Errorf(codes.Internal, "failed to get node: %v", err)
+	}
+
+	if err := canceller.CancelRequest(ctx, *node, req.RequestId); err != nil {
+		span.SetAttributes(
+			attribute.String("janitor_provider.error.type", "csp_api_error"),
+			attribute.String("janitor_provider.error.message", err.Error()),
+		)
+		tracing.RecordError(span, err)
+		return nil, status.Errorf(cspErrorCode(err), "failed to cancel request: %v", err)
+	}
+
+	span.SetAttributes(attribute.Bool("janitor_provider.cancel.cancelled", true))
+
+	return &cspv1alpha1.CancelRequestResponse{}, nil
+}
+
+// cspErrorCode returns the gRPC code for an error of a CSP client. Operations which the provider cannot perform for
+// the node are reported as Unimplemented, so that janitor can tell them apart from failures.
+func cspErrorCode(err error) codes.Code {
+	if errors.Is(err, model.ErrNotSupported) {
+		return codes.Unimplemented
+	}
+
+	return codes.Internal
+}
+
 func main() {
 	os.Exit(realMain())
 }
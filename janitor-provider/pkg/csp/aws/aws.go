@@ -23,8 +23,10 @@ import (
 	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	corev1 "k8s.io/api/core/v1"
 
 	"github.com/nvidia/nvsentinel/commons/pkg/auditlogger"
@@ -32,7 +34,10 @@ import (
 )
 
 var (
-	_ model.CSPClient = (*Client)(nil)
+	_ model.CSPClient            = (*Client)(nil)
+	_ model.InstanceStatusGetter = (*Client)(nil)
+	_ model.NodeReimager         = (*Client)(nil)
+	_ model.RequestCanceller     = (*Client)(nil)
 )
 
 // EC2 provides a wrapper around a subset of the AWS EC2 client interface,
@@ -43,6 +48,24 @@ type EC2 interface {
 		input *ec2.RebootInstancesInput,
 		opts ...func(*ec2.Options),
 	) (*ec2.RebootInstancesOutput, error)
+
+	DescribeInstanceStatus(
+		ctx context.Context,
+		input *ec2.DescribeInstanceStatusInput,
+		opts ...func(*ec2.Options),
+	) (*ec2.DescribeInstanceStatusOutput, error)
+
+	CreateReplaceRootVolumeTask(
+		ctx context.Context,
+		input *ec2.CreateReplaceRootVolumeTaskInput,
+		opts ...func(*ec2.Options),
+	) (*ec2.CreateReplaceRootVolumeTaskOutput, error)
+
+	DescribeReplaceRootVolumeTasks(
+		ctx context.Context,
+		input *ec2.DescribeReplaceRootVolumeTasksInput,
+		opts ...func(*ec2.Options),
+	) (*ec2.DescribeReplaceRootVolumeTasksOutput, error)
 }
 
 // Client is the AWS implementation of the CSP Client interface.
@@ -129,9 +152,27 @@ func (c *Client) SendRebootSignal(ctx context.Context, node corev1.Node) (model.
 	return model.ResetSignalRequestRef(time.Now().Format(time.RFC3339)), nil
 }
 
-// IsNodeReady checks if the node is ready after a reboot signal was sent.
-// AWS requires a 5-minute cooldown period before the node status is reliable.
+// IsNodeReady checks if the node is ready after a reboot or reimage signal was sent. A reimage is identified by its
+// root volume replacement task, whose state is reported by EC2.
+// AWS requires a 5-minute cooldown period before the node status is reliable after a reboot.
 func (c *Client) IsNodeReady(ctx context.Context, node corev1.Node, requestID string) (bool, error) {
+	if isReplaceRootVolumeTaskID(requestID) {
+		task, err := c.replaceRootVolumeTask(ctx, requestID)
+		if err != nil {
+			return false, err
+		}
+
+		switch task.TaskState {
+		case types.ReplaceRootVolumeTaskStateSucceeded:
+			return true, nil
+		case types.ReplaceRootVolumeTaskStateFailed, types.ReplaceRootVolumeTaskStateFailedDetached:
+			return false, fmt.Errorf("root volume replacement task %s of node %s %s", requestID, node.Name,
+				task.TaskState)
+		default:
+			return false, nil
+		}
+	}
+
 	// Sending a reboot request to AWS doesn't update statuses immediately,
 	// the ec2 instance does not report that it isn't in a running state for some time
 	// and kubernetes still sees the node as ready. Wait five minutes before checking the status
@@ -152,6 +193,164 @@ func (c *Client) SendTerminateSignal(ctx context.Context, node corev1.Node) (mod
 	return model.TerminateNodeRequestRef(""), fmt.Errorf("SendTerminateSignal not implemented for AWS")
 }
 
+// GetInstanceStatus returns the state and the scheduled events of the EC2 instance of the node. The instance is under
+// maintenance while the window of one of its scheduled events is open.
+func (c *Client) GetInstanceStatus(ctx context.Context, node corev1.Node) (model.InstanceStatus, error) {
+	instanceID, err := parseAWSProviderID(node.Spec.ProviderID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to parse provider ID", "error", err)
+
+		return model.InstanceStatus{}, err
+	}
+
+	output, err := c.ec2.DescribeInstanceStatus(ctx, &ec2.DescribeInstanceStatusInput{
+		InstanceIds:         []string{instanceID},
+		IncludeAllInstances: aws.Bool(true),
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to describe instance status", "error", err, "instanceID", instanceID)
+
+		return model.InstanceStatus{}, err
+	}
+
+	if len(output.InstanceStatuses) == 0 {
+		return model.InstanceStatus{}, fmt.Errorf("no status found for instance %s of node %s", instanceID, node.Name)
+	}
+
+	return instanceStatus(output.InstanceStatuses[0], time.Now()), nil
+}
+
+// SendReimageSignal replaces the root volume of the EC2 instance of the node with a volume restored to its launch
+// state. EC2 reboots the instance to replace the volume. The reference is the ID of the replacement task.
+func (c *Client) SendReimageSignal(ctx context.Context, node corev1.Node) (model.ReimageSignalRequestRef, error) {
+	instanceID, err := parseAWSProviderID(node.Spec.ProviderID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to parse provider ID", "error", err)
+
+		return "", err
+	}
+
+	slog.InfoContext(ctx, "Replacing root volume of node", "node", node.Name, "instanceID", instanceID)
+
+	output, err := c.ec2.CreateReplaceRootVolumeTask(ctx, &ec2.CreateReplaceRootVolumeTaskInput{
+		InstanceId:               aws.String(instanceID),
+		DeleteReplacedRootVolume: aws.Bool(true),
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to replace root volume", "error", err, "instanceID", instanceID)
+
+		return "", err
+	}
+
+	if output.ReplaceRootVolumeTask == nil || aws.ToString(output.ReplaceRootVolumeTask.ReplaceRootVolumeTaskId) == "" {
+		return "", fmt.Errorf("no root volume replacement task returned for instance %s of node %s", instanceID,
+			node.Name)
+	}
+
+	taskID := aws.ToString(output.ReplaceRootVolumeTask.ReplaceRootVolumeTaskId)
+
+	slog.InfoContext(ctx, "Root volume replacement started", "node", node.Name, "instanceID", instanceID,
+		"taskID", taskID)
+
+	return model.ReimageSignalRequestRef(taskID), nil
+}
+
+// CancelRequest checks the root volume replacement task of the request. EC2 has no API to withdraw a replacement task
+// or a reboot, so nil is only returned once the task of the request is no longer running. A running task is reported
+// as an error, so that the caller does not assume the node is untouched.
+func (c *Client) CancelRequest(ctx context.Context, node corev1.Node, requestID string) error {
+	if !isReplaceRootVolumeTaskID(requestID) {
+		return fmt.Errorf("request %s of node %s is not a root volume replacement and cannot be cancelled in EC2",
+			requestID, node.Name)
+	}
+
+	task, err := c.replaceRootVolumeTask(ctx, requestID)
+	if err != nil {
+		return err
+	}
+
+	if isActiveReplaceRootVolumeTask(task) {
+		return fmt.Errorf("root volume replacement task %s of node %s is %s and cannot be cancelled in EC2",
+			requestID, node.Name, task.TaskState)
+	}
+
+	slog.InfoContext(ctx, "Root volume replacement of the request is not running", "node", node.Name,
+		"requestID", requestID, "taskState", task.TaskState)
+
+	return nil
+}
+
+// replaceRootVolumeTask returns the root volume replacement task with the ID.
+func (c *Client) replaceRootVolumeTask(ctx context.Context, taskID string) (types.ReplaceRootVolumeTask, error) {
+	output, err := c.ec2.DescribeReplaceRootVolumeTasks(ctx, &ec2.DescribeReplaceRootVolumeTasksInput{
+		ReplaceRootVolumeTaskIds: []string{taskID},
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to describe root volume replacement task", "error", err, "taskID", taskID)
+
+		return types.ReplaceRootVolumeTask{}, err
+	}
+
+	for _, task := range output.ReplaceRootVolumeTasks {
+		if aws.ToString(task.ReplaceRootVolumeTaskId) == taskID {
+			return task, nil
+		}
+	}
+
+	return types.ReplaceRootVolumeTask{}, fmt.Errorf("root volume replacement task %s not found", taskID)
+}
+
+// isReplaceRootVolumeTaskID returns true if the request ID is the ID of a root volume replacement task rather than the
+// time of a reboot.
+func isReplaceRootVolumeTaskID(requestID string) bool {
+	return strings.HasPrefix(requestID, "replacevol-")
+}
+
+// isActiveReplaceRootVolumeTask returns true if the task has not finished yet.
+func isActiveReplaceRootVolumeTask(task types.ReplaceRootVolumeTask) bool {
+	switch task.TaskState {
+	case types.ReplaceRootVolumeTaskStatePending, types.ReplaceRootVolumeTaskStateInProgress,
+		types.ReplaceRootVolumeTaskStateFailing:
+		return true
+	default:
+		return false
+	}
+}
+
+// instanceStatus converts the EC2 status of an instance. Completed and canceled events are skipped, EC2 keeps them
+// for about a week with a description starting with [Completed] or [Canceled].
+func instanceStatus(status types.InstanceStatus, now time.Time) model.InstanceStatus {
+	result := model.InstanceStatus{}
+
+	if status.InstanceState != nil {
+		result.ProviderState = string(status.InstanceState.Name)
+	}
+
+	for _, event := range status.Events {
+		description := aws.ToString(event.Description)
+		if strings.HasPrefix(description, "[Completed]") || strings.HasPrefix(description, "[Canceled]") {
+			continue
+		}
+
+		scheduledEvent := model.ScheduledEvent{
+			ID:          aws.ToString(event.InstanceEventId),
+			Type:        string(event.Code),
+			Description: description,
+			NotBefore:   aws.ToTime(event.NotBefore),
+			NotAfter:    aws.ToTime(event.NotAfter),
+		}
+
+		if !scheduledEvent.NotBefore.IsZero() && !now.Before(scheduledEvent.NotBefore) &&
+			(scheduledEvent.NotAfter.IsZero() || now.Before(scheduledEvent.NotAfter)) {
+			result.UnderMaintenance = true
+		}
+
+		result.ScheduledEvents = append(result.ScheduledEvents, scheduledEvent)
+	}
+
+	return result
+}
+
 // parseAWSProviderID extracts the EC2 instance ID from an AWS provider ID.
 // Example provider ID: aws:///us-west-2/i-1234567890abcdef0
 func parseAWSProviderID(providerID string) (string, error) {
@@ -0,0 +1,386 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/nvidia/nvsentinel/janitor-provider/pkg/model"
+)
+
+const testInstanceID = "i-1234567890abcdef0"
+
+// fakeEC2 returns the configured instance statuses and root volume replacement tasks and records the inputs
+type fakeEC2 struct {
+	instanceStatuses []types.InstanceStatus
+	tasks            []types.ReplaceRootVolumeTask
+	err              error
+
+	describeStatusInput *ec2.DescribeInstanceStatusInput
+	createTaskInput     *ec2.CreateReplaceRootVolumeTaskInput
+	describeTasksInput  *ec2.DescribeReplaceRootVolumeTasksInput
+}
+
+var _ EC2 = (*fakeEC2)(nil)
+
+func (f *fakeEC2) RebootInstances(context.Context, *ec2.RebootInstancesInput,
+	...func(*ec2.Options)) (*ec2.RebootInstancesOutput, error) {
+	return &ec2.RebootInstancesOutput{}, f.err
+}
+
+func (f *fakeEC2) DescribeInstanceStatus(_ context.Context, input *ec2.DescribeInstanceStatusInput,
+	_ ...func(*ec2.Options)) (*ec2.DescribeInstanceStatusOutput, error) {
+	f.describeStatusInput = input
+
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	return &ec2.DescribeInstanceStatusOutput{InstanceStatuses: f.instanceStatuses}, nil
+}
+
+func (f *fakeEC2) CreateReplaceRootVolumeTask(_ context.Context, input *ec2.CreateReplaceRootVolumeTaskInput,
+	_ ...func(*ec2.Options)) (*ec2.CreateReplaceRootVolumeTaskOutput, error) {
+	f.createTaskInput = input
+
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	return &ec2.CreateReplaceRootVolumeTaskOutput{ReplaceRootVolumeTask: &types.ReplaceRootVolumeTask{
+		ReplaceRootVolumeTaskId: aws.String("replacevol-0123456789abcdef0"),
+		InstanceId:              input.InstanceId,
+		TaskState:               types.ReplaceRootVolumeTaskStatePending,
+	}}, nil
+}
+
+func (f *fakeEC2) DescribeReplaceRootVolumeTasks(_ context.Context, input *ec2.DescribeReplaceRootVolumeTasksInput,
+	_ ...func(*ec2.Options)) (*ec2.DescribeReplaceRootVolumeTasksOutput, error) {
+	f.describeTasksInput = input
+
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	var tasks []types.ReplaceRootVolumeTask
+
+	for _, task := range f.tasks {
+		for _, taskID := range input.ReplaceRootVolumeTaskIds {
+			if aws.ToString(task.ReplaceRootVolumeTaskId) == taskID {
+				tasks = append(tasks, task)
+			}
+		}
+	}
+
+	return &ec2.DescribeReplaceRootVolumeTasksOutput{ReplaceRootVolumeTasks: tasks}, nil
+}
+
+func newTestNode() corev1.Node {
+	return corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+		Spec:       corev1.NodeSpec{ProviderID: "aws:///us-west-2a/" + testInstanceID},
+	}
+}
+
+func newTask(taskID string, state types.ReplaceRootVolumeTaskState) types.ReplaceRootVolumeTask {
+	return types.ReplaceRootVolumeTask{
+		ReplaceRootVolumeTaskId: aws.String(taskID),
+		InstanceId:              aws.String(testInstanceID),
+		TaskState:               state,
+	}
+}
+
+func TestGetInstanceStatus(t *testing.T) {
+	notBefore := time.Now().Add(-time.Hour)
+	fake := &fakeEC2{instanceStatuses: []types.InstanceStatus{{
+		InstanceId:    aws.String(testInstanceID),
+		InstanceState: &types.InstanceState{Name: types.InstanceStateNameRunning},
+		Events: []types.InstanceStatusEvent{
+			{
+				InstanceEventId: aws.String("instance-event-1"),
+				Code:            types.EventCodeSystemReboot,
+				Description:     aws.String("scheduled reboot"),
+				NotBefore:       aws.Time(notBefore),
+			},
+			{
+				InstanceEventId: aws.String("instance-event-2"),
+				Code:            types.EventCodeSystemMaintenance,
+				Description:     aws.String("[Completed] scheduled maintenance"),
+				NotBefore:       aws.Time(notBefore),
+			},
+			{
+				InstanceEventId: aws.String("instance-event-3"),
+				Code:            types.EventCodeInstanceRetirement,
+				Description:     aws.String("[Canceled] instance retirement"),
+				NotBefore:       aws.Time(notBefore),
+			},
+		},
+	}}}
+	client := &Client{ec2: fake}
+
+	status, err := client.GetInstanceStatus(context.Background(), newTestNode())
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{testInstanceID}, fake.describeStatusInput.InstanceIds)
+	assert.True(t, aws.ToBool(fake.describeStatusInput.IncludeAllInstances))
+	assert.Equal(t, "running", status.ProviderState)
+	assert.True(t, status.UnderMaintenance)
+	assert.Equal(t, []model.ScheduledEvent{{
+		ID:          "instance-event-1",
+		Type:        "system-reboot",
+		Description: "scheduled reboot",
+		NotBefore:   notBefore,
+	}}, status.ScheduledEvents, "completed and canceled events are skipped")
+}
+
+func TestGetInstanceStatus_Errors(t *testing.T) {
+	ctx := context.Background()
+
+	client := &Client{ec2: &fakeEC2{}}
+	_, err := client.GetInstanceStatus(ctx, newTestNode())
+	assert.ErrorContains(t, err, "no status found")
+
+	client = &Client{ec2: &fakeEC2{err: errors.New("throttled")}}
+	_, err = client.GetInstanceStatus(ctx, newTestNode())
+	assert.ErrorContains(t, err, "throttled")
+
+	_, err = client.GetInstanceStatus(ctx, corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}})
+	assert.ErrorContains(t, err, "invalid provider ID")
+}
+
+func TestInstanceStatus_MaintenanceWindow(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name             string
+		notBefore        *time.Time
+		notAfter         *time.Time
+		underMaintenance bool
+	}{
+		{
+			name:             "window not started",
+			notBefore:        aws.Time(now.Add(time.Second)),
+			notAfter:         aws.Time(now.Add(time.Hour)),
+			underMaintenance: false,
+		},
+		{
+			name:             "window starts now",
+			notBefore:        aws.Time(now),
+			notAfter:         aws.Time(now.Add(time.Hour)),
+			underMaintenance: true,
+		},
+		{
+			name:             "window open",
+			notBefore:        aws.Time(now.Add(-time.Hour)),
+			notAfter:         aws.Time(now.Add(time.Hour)),
+			underMaintenance: true,
+		},
+		{
+			name:             "window ends now",
+			notBefore:        aws.Time(now.Add(-time.Hour)),
+			notAfter:         aws.Time(now),
+			underMaintenance: false,
+		},
+		{
+			name:             "window without end",
+			notBefore:        aws.Time(now.Add(-time.Hour)),
+			underMaintenance: true,
+		},
+		{
+			name:             "window without start",
+			notAfter:         aws.Time(now.Add(time.Hour)),
+			underMaintenance: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status := instanceStatus(types.InstanceStatus{
+				Events: []types.InstanceStatusEvent{{
+					InstanceEventId: aws.String("instance-event-1"),
+					Code:            types.EventCodeSystemReboot,
+					NotBefore:       tt.notBefore,
+					NotAfter:        tt.notAfter,
+				}},
+			}, now)
+
+			assert.Equal(t, tt.underMaintenance, status.UnderMaintenance)
+			assert.Len(t, status.ScheduledEvents, 1)
+		})
+	}
+}
+
+func TestSendReimageSignal(t *testing.T) {
+	ctx := context.Background()
+	fake := &fakeEC2{}
+	client := &Client{ec2: fake}
+
+	ref, err := client.SendReimageSignal(ctx, newTestNode())
+	require.NoError(t, err)
+
+	assert.Equal(t, model.ReimageSignalRequestRef("replacevol-0123456789abcdef0"), ref)
+	assert.Equal(t, testInstanceID, aws.ToString(fake.createTaskInput.InstanceId))
+	assert.True(t, aws.ToBool(fake.createTaskInput.DeleteReplacedRootVolume))
+
+	client = &Client{ec2: &fakeEC2{err: errors.New("unsupported instance type")}}
+	_, err = client.SendReimageSignal(ctx, newTestNode())
+	assert.ErrorContains(t, err, "unsupported instance type")
+}
+
+func TestIsNodeReady_Reimage(t *testing.T) {
+	tests := []struct {
+		name        string
+		state       types.ReplaceRootVolumeTaskState
+		ready       bool
+		errorSubstr string
+	}{
+		{name: "pending", state: types.ReplaceRootVolumeTaskStatePending},
+		{name: "in progress", state: types.ReplaceRootVolumeTaskStateInProgress},
+		{name: "failing", state: types.ReplaceRootVolumeTaskStateFailing},
+		{name: "succeeded", state: types.ReplaceRootVolumeTaskStateSucceeded, ready: true},
+		{name: "failed", state: types.ReplaceRootVolumeTaskStateFailed, errorSubstr: "failed"},
+		{name: "failed detached", state: types.ReplaceRootVolumeTaskStateFailedDetached, errorSubstr: "failed-detached"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeEC2{tasks: []types.ReplaceRootVolumeTask{
+				newTask("replacevol-other", types.ReplaceRootVolumeTaskStateSucceeded),
+				newTask("replacevol-request", tt.state),
+			}}
+			client := &Client{ec2: fake}
+
+			ready, err := client.IsNodeReady(context.Background(), newTestNode(), "replacevol-request")
+			if tt.errorSubstr != "" {
+				assert.ErrorContains(t, err, tt.errorSubstr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.ready, ready)
+			assert.Equal(t, []string{"replacevol-request"}, fake.describeTasksInput.ReplaceRootVolumeTaskIds)
+		})
+	}
+}
+
+func TestIsNodeReady_Reboot(t *testing.T) {
+	ctx := context.Background()
+	client := &Client{ec2: &fakeEC2{}}
+
+	ready, err := client.IsNodeReady(ctx, newTestNode(), time.Now().Format(time.RFC3339))
+	require.NoError(t, err)
+	assert.False(t, ready, "nodes are not ready within five minutes of a reboot")
+
+	ready, err = client.IsNodeReady(ctx, newTestNode(), time.Now().Add(-6*time.Minute).Format(time.RFC3339))
+	require.NoError(t, err)
+	assert.True(t, ready)
+
+	_, err = client.IsNodeReady(ctx, newTestNode(), "not-a-request")
+	assert.Error(t, err)
+}
+
+func TestCancelRequest(t *testing.T) {
+	tests := []struct {
+		name        string
+		tasks       []types.ReplaceRootVolumeTask
+		requestID   string
+		err         error
+		errorSubstr string
+	}{
+		{
+			name: "running task cannot be cancelled",
+			tasks: []types.ReplaceRootVolumeTask{
+				newTask("replacevol-request", types.ReplaceRootVolumeTaskStateInProgress),
+			},
+			requestID:   "replacevol-request",
+			errorSubstr: "is in-progress and cannot be cancelled",
+		},
+		{
+			name: "finished task leaves nothing to cancel",
+			tasks: []types.ReplaceRootVolumeTask{
+				newTask("replacevol-request", types.ReplaceRootVolumeTaskStateSucceeded),
+			},
+			requestID: "replacevol-request",
+		},
+		{
+			name: "running tasks of other requests are ignored",
+			tasks: []types.ReplaceRootVolumeTask{
+				newTask("replacevol-other", types.ReplaceRootVolumeTaskStatePending),
+				newTask("replacevol-request", types.ReplaceRootVolumeTaskStateFailed),
+			},
+			requestID: "replacevol-request",
+		},
+		{
+			name:        "unknown task",
+			requestID:   "replacevol-request",
+			errorSubstr: "not found",
+		},
+		{
+			name:        "reboots cannot be cancelled",
+			requestID:   time.Now().Format(time.RFC3339),
+			errorSubstr: "not a root volume replacement",
+		},
+		{
+			name:        "EC2 error",
+			requestID:   "replacevol-request",
+			err:         errors.New("throttled"),
+			errorSubstr: "throttled",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &Client{ec2: &fakeEC2{tasks: tt.tasks, err: tt.err}}
+
+			err := client.CancelRequest(context.Background(), newTestNode(), tt.requestID)
+			if tt.errorSubstr != "" {
+				assert.ErrorContains(t, err, tt.errorSubstr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestIsActiveReplaceRootVolumeTask(t *testing.T) {
+	tests := []struct {
+		state  types.ReplaceRootVolumeTaskState
+		active bool
+	}{
+		{state: types.ReplaceRootVolumeTaskStatePending, active: true},
+		{state: types.ReplaceRootVolumeTaskStateInProgress, active: true},
+		{state: types.ReplaceRootVolumeTaskStateFailing, active: true},
+		{state: types.ReplaceRootVolumeTaskStateSucceeded, active: false},
+		{state: types.ReplaceRootVolumeTaskStateFailed, active: false},
+		{state: types.ReplaceRootVolumeTaskStateFailedDetached, active: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.state), func(t *testing.T) {
+			assert.Equal(t, tt.active, isActiveReplaceRootVolumeTask(newTask("replacevol-request", tt.state)))
+		})
+	}
+}
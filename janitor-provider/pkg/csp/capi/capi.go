@@ -0,0 +1,372 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/nvidia/nvsentinel/janitor-provider/pkg/model"
+)
+
+const (
+	// Annotations set by Cluster API on the nodes of workload clusters
+	machineAnnotation          = "cluster.x-k8s.io/machine"
+	clusterNamespaceAnnotation = "cluster.x-k8s.io/cluster-namespace"
+
+	// bareMetalHostAnnotation is set by CAPM3 on a Metal3Machine to the namespace/name of its BareMetalHost
+	bareMetalHostAnnotation = "metal3.io/BareMetalHost"
+
+	// rebootAnnotation asks the baremetal-operator to reboot the host; the operator removes it once the
+	// host has been powered off
+	rebootAnnotation = "reboot.metal3.io"
+
+	metal3MachineKind = "Metal3Machine"
+	machinePhaseReady = "Running"
+
+	RebootModeHard = "hard"
+	RebootModeSoft = "soft"
+)
+
+var (
+	machineGVR = schema.GroupVersionResource{
+		Group: "cluster.x-k8s.io", Version: "v1beta1", Resource: "machines",
+	}
+	metal3MachineGVR = schema.GroupVersionResource{
+		Group: "infrastructure.cluster.x-k8s.io", Version: "v1beta1", Resource: "metal3machines",
+	}
+	bareMetalHostGVR = schema.GroupVersionResource{
+		Group: "metal3.io", Version: "v1alpha1", Resource: "baremetalhosts",
+	}
+)
+
+var _ model.CSPClient = (*Client)(nil)
+
+// Config holds the configuration for the Cluster API provider.
+type Config struct {
+	// MachineNamespace restricts the search of the Machine of a node without Cluster API annotations,
+	// all namespaces if empty
+	MachineNamespace string
+	// RebootMode is the Metal3 reboot mode, hard (power cycle) or soft (ACPI shutdown first)
+	RebootMode string
+}
+
+// Client is the Cluster API implementation of the CSP Client interface.
+// It reboots nodes through the Metal3 reboot annotation of their BareMetalHost and terminates them by
+// deleting their Machine, which the owning MachineSet replaces. Replacements requested before termination
+// scale up the MachineDeployment or MachineSet of the Machine.
+type Client struct {
+	dynamicClient dynamic.Interface
+	config        Config
+}
+
+// NewClient creates a new Cluster API provider client. The Cluster API objects are read from the cluster
+// of CAPI_KUBECONFIG, the management cluster, or from the cluster the provider runs in.
+func NewClient(ctx context.Context) (*Client, error) {
+	restConfig, err := managementClusterConfig(os.Getenv("CAPI_KUBECONFIG"))
+	if err != nil {
+		return nil, err
+	}
+
+	dynamicClient, err := dynamic.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create dynamic client: %w", err)
+	}
+
+	config := loadConfigFromEnv()
+	if config.RebootMode != RebootModeHard && config.RebootMode != RebootModeSoft {
+		return nil, fmt.Errorf("CAPI_REBOOT_MODE must be %q or %q, got %q", RebootModeHard, RebootModeSoft,
+			config.RebootMode)
+	}
+
+	return NewClientWithDynamic(dynamicClient, config), nil
+}
+
+// NewClientWithDynamic creates a Cluster API provider client with a provided dynamic client (for testing).
+func NewClientWithDynamic(dynamicClient dynamic.Interface, config Config) *Client {
+	if config.RebootMode == "" {
+		config.RebootMode = RebootModeHard
+	}
+
+	return &Client{
+		dynamicClient: dynamicClient,
+		config:        config,
+	}
+}
+
+// SendRebootSignal sets the Metal3 reboot annotation on the BareMetalHost of the node.
+// Returns the node's pre-reboot bootID as the requestID.
+func (c *Client) SendRebootSignal(ctx context.Context, node corev1.Node) (model.ResetSignalRequestRef, error) {
+	preRebootBootID := node.Status.NodeInfo.BootID
+	if preRebootBootID == "" {
+		slog.ErrorContext(ctx, "Node has no bootID", "node", node.Name)
+		return "", fmt.Errorf("node %s has no bootID", node.Name)
+	}
+
+	machine, err := c.nodeMachine(ctx, node)
+	if err != nil {
+		return "", err
+	}
+
+	host, err := c.machineHost(ctx, machine)
+	if err != nil {
+		return "", err
+	}
+
+	value, err := json.Marshal(map[string]string{"mode": c.config.RebootMode})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode reboot annotation: %w", err)
+	}
+
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]string{rebootAnnotation: string(value)},
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode reboot patch: %w", err)
+	}
+
+	_, err = c.dynamicClient.Resource(bareMetalHostGVR).Namespace(host.GetNamespace()).Patch(ctx, host.GetName(),
+		types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to annotate BareMetalHost %s/%s of node %s for reboot: %w",
+			host.GetNamespace(), host.GetName(), node.Name, err)
+	}
+
+	slog.InfoContext(ctx, "Reboot requested on BareMetalHost", "node", node.Name,
+		"machine", machine.GetNamespace()+"/"+machine.GetName(),
+		"bareMetalHost", host.GetNamespace()+"/"+host.GetName(), "mode", c.config.RebootMode,
+		"bootID", preRebootBootID)
+
+	return model.ResetSignalRequestRef(preRebootBootID), nil
+}
+
+// IsNodeReady checks whether the node has rebooted: the baremetal-operator has consumed the reboot
+// annotation, the Machine is Running, the bootID differs from the pre-reboot bootID (passed as requestID)
+// and the node is Ready.
+func (c *Client) IsNodeReady(ctx context.Context, node corev1.Node, requestID string) (bool, error) {
+	preRebootBootID := requestID
+
+	machine, err := c.nodeMachine(ctx, node)
+	if err != nil {
+		return false, err
+	}
+
+	host, err := c.machineHost(ctx, machine)
+	if err != nil {
+		return false, err
+	}
+
+	if _, pending := host.GetAnnotations()[rebootAnnotation]; pending {
+		slog.InfoContext(ctx, "Reboot not yet started by baremetal-operator", "node", node.Name,
+			"bareMetalHost", host.GetNamespace()+"/"+host.GetName())
+
+		return false, nil
+	}
+
+	phase, _, _ := unstructured.NestedString(machine.Object, "status", "phase")
+	if phase != machinePhaseReady {
+		slog.InfoContext(ctx, "Machine not yet Running", "node", node.Name,
+			"machine", machine.GetNamespace()+"/"+machine.GetName(), "phase", phase)
+
+		return false, nil
+	}
+
+	currentBootID := node.Status.NodeInfo.BootID
+	if currentBootID == preRebootBootID {
+		slog.InfoContext(ctx, "Node has not yet rebooted", "node", node.Name, "bootID", currentBootID)
+		return false, nil
+	}
+
+	if !isNodeReady(node) {
+		slog.InfoContext(ctx, "Node rebooted but not yet Ready", "node", node.Name,
+			"oldBootID", preRebootBootID, "newBootID", currentBootID)
+
+		return false, nil
+	}
+
+	slog.InfoContext(ctx, "Node rebooted and Ready", "node", node.Name,
+		"oldBootID", preRebootBootID, "newBootID", currentBootID)
+
+	return true, nil
+}
+
+// SendTerminateSignal deletes the Machine of the node, letting its MachineSet replace it. Machines whose
+// replacement was requested are removed by scaling down their MachineDeployment or MachineSet instead.
+func (c *Client) SendTerminateSignal(ctx context.Context, node corev1.Node) (model.TerminateNodeRequestRef, error) {
+	machine, err := c.nodeMachine(ctx, node)
+	if err != nil {
+		return "", err
+	}
+
+	ref := machine.GetNamespace() + "/" + machine.GetName()
+
+	if _, replaced := machine.GetAnnotations()[replacementRequestedAtAnnotation]; replaced {
+		// The replacement already added a Machine, so the Machine is removed by scaling down instead of being
+		// replaced by its MachineSet
+		if err := c.scaleDownReplacedMachine(ctx, machine); err != nil {
+			return "", fmt.Errorf("failed to scale down machine %s of node %s: %w", ref, node.Name, err)
+		}
+
+		return model.TerminateNodeRequestRef(ref), nil
+	}
+
+	if !ownedBy(machine, "MachineSet") {
+		slog.WarnContext(ctx, "Machine is not owned by a MachineSet and may not be replaced", "node", node.Name,
+			"machine", ref)
+	}
+
+	propagation := metav1.DeletePropagationBackground
+
+	err = c.dynamicClient.Resource(machineGVR).Namespace(machine.GetNamespace()).Delete(ctx, machine.GetName(),
+		metav1.DeleteOptions{PropagationPolicy: &propagation})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return "", fmt.Errorf("failed to delete machine %s of node %s: %w", ref, node.Name, err)
+	}
+
+	slog.InfoContext(ctx, "Deleted Machine", "node", node.Name, "machine", ref)
+
+	return model.TerminateNodeRequestRef(ref), nil
+}
+
+// nodeMachine returns the Machine of the node, from the Cluster API annotations of the node or else from
+// the nodeRef of the Machines.
+func (c *Client) nodeMachine(ctx context.Context, node corev1.Node) (*unstructured.Unstructured, error) {
+	name := node.Annotations[machineAnnotation]
+	namespace := node.Annotations[clusterNamespaceAnnotation]
+
+	if name != "" && namespace != "" {
+		machine, err := c.dynamicClient.Resource(machineGVR).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get machine %s/%s of node %s: %w", namespace, name, node.Name, err)
+		}
+
+		return machine, nil
+	}
+
+	machines, err := c.dynamicClient.Resource(machineGVR).Namespace(c.config.MachineNamespace).List(ctx,
+		metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list machines: %w", err)
+	}
+
+	for i := range machines.Items {
+		nodeName, _, _ := unstructured.NestedString(machines.Items[i].Object, "status", "nodeRef", "name")
+		if nodeName == node.Name {
+			return &machines.Items[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("no machine found for node %s", node.Name)
+}
+
+// machineHost returns the BareMetalHost of the Metal3Machine of the Machine.
+func (c *Client) machineHost(ctx context.Context, machine *unstructured.Unstructured) (*unstructured.Unstructured,
+	error) {
+	kind, _, _ := unstructured.NestedString(machine.Object, "spec", "infrastructureRef", "kind")
+	name, _, _ := unstructured.NestedString(machine.Object, "spec", "infrastructureRef", "name")
+
+	if kind != metal3MachineKind || name == "" {
+		return nil, fmt.Errorf("machine %s/%s has infrastructure %q, only %s can be rebooted",
+			machine.GetNamespace(), machine.GetName(), kind, metal3MachineKind)
+	}
+
+	metal3Machine, err := c.dynamicClient.Resource(metal3MachineGVR).Namespace(machine.GetNamespace()).Get(ctx,
+		name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Metal3Machine %s/%s: %w", machine.GetNamespace(), name, err)
+	}
+
+	hostRef := metal3Machine.GetAnnotations()[bareMetalHostAnnotation]
+
+	hostNamespace, hostName, found := strings.Cut(hostRef, "/")
+	if !found || hostNamespace == "" || hostName == "" {
+		return nil, fmt.Errorf("invalid %s annotation %q on Metal3Machine %s/%s", bareMetalHostAnnotation, hostRef,
+			machine.GetNamespace(), name)
+	}
+
+	host, err := c.dynamicClient.Resource(bareMetalHostGVR).Namespace(hostNamespace).Get(ctx, hostName,
+		metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get BareMetalHost %s: %w", hostRef, err)
+	}
+
+	return host, nil
+}
+
+func ownedBy(obj *unstructured.Unstructured, kind string) bool {
+	for _, owner := range obj.GetOwnerReferences() {
+		if owner.Kind == kind {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isNodeReady(node corev1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+
+	return false
+}
+
+func managementClusterConfig(kubeconfig string) (*rest.Config, error) {
+	if kubeconfig != "" {
+		restConfig, err := clientcmd.BuildConfigFromFlags("", kubeconfig)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load CAPI_KUBECONFIG %s: %w", kubeconfig, err)
+		}
+
+		return restConfig, nil
+	}
+
+	restConfig, err := rest.InClusterConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create in-cluster config: %w", err)
+	}
+
+	return restConfig, nil
+}
+
+func loadConfigFromEnv() Config {
+	rebootMode := os.Getenv("CAPI_REBOOT_MODE")
+	if rebootMode == "" {
+		rebootMode = RebootModeHard
+	}
+
+	return Config{
+		MachineNamespace: os.Getenv("CAPI_MACHINE_NAMESPACE"),
+		RebootMode:       rebootMode,
+	}
+}
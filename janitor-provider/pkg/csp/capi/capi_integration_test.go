@@ -0,0 +1,160 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build integration
+// +build integration
+
+package capi
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+)
+
+func setupTestEnvironment(t *testing.T) (dynamic.Interface, kubernetes.Interface) {
+	t.Helper()
+
+	testEnv := &envtest.Environment{
+		CRDDirectoryPaths:     []string{filepath.Join("testdata", "crds")},
+		ErrorIfCRDPathMissing: true,
+	}
+
+	cfg, err := testEnv.Start()
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, testEnv.Stop())
+	})
+
+	dynamicClient, err := dynamic.NewForConfig(cfg)
+	require.NoError(t, err)
+
+	k8sClient, err := kubernetes.NewForConfig(cfg)
+	require.NoError(t, err)
+
+	for _, namespace := range []string{testNamespace, "metal3"} {
+		_, err := k8sClient.CoreV1().Namespaces().Create(context.Background(),
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}}, metav1.CreateOptions{})
+		require.NoError(t, err)
+	}
+
+	return dynamicClient, k8sClient
+}
+
+func create(t *testing.T, dynamicClient dynamic.Interface, gvr schema.GroupVersionResource,
+	obj *unstructured.Unstructured) {
+	t.Helper()
+
+	status, hasStatus := obj.Object["status"]
+
+	created, err := dynamicClient.Resource(gvr).Namespace(obj.GetNamespace()).Create(context.Background(), obj,
+		metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	if hasStatus {
+		created.Object["status"] = status
+
+		_, err = dynamicClient.Resource(gvr).Namespace(obj.GetNamespace()).UpdateStatus(context.Background(),
+			created, metav1.UpdateOptions{})
+		require.NoError(t, err)
+	}
+}
+
+func TestCAPIProvider_Integration(t *testing.T) {
+	ctx := context.Background()
+	dynamicClient, k8sClient := setupTestEnvironment(t)
+
+	machine := newMachine("worker-1-abcde", "worker-1", "Provisioned", metal3MachineKind)
+	// Owner references are validated against real objects, which these tests do not create
+	machine.SetOwnerReferences(nil)
+
+	create(t, dynamicClient, machineGVR, machine)
+	create(t, dynamicClient, metal3MachineGVR, newMetal3Machine("worker-1-abcde", "metal3/host-1"))
+	create(t, dynamicClient, bareMetalHostGVR, newBareMetalHost("host-1"))
+
+	node, err := k8sClient.CoreV1().Nodes().Create(ctx, &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "worker-1",
+			Annotations: map[string]string{
+				machineAnnotation:          "worker-1-abcde",
+				clusterNamespaceAnnotation: testNamespace,
+			},
+		},
+	}, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	node.Status = newNode("worker-1", "boot-1", true).Status
+	node, err = k8sClient.CoreV1().Nodes().UpdateStatus(ctx, node, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	client := NewClientWithDynamic(dynamicClient, Config{})
+
+	ref, err := client.SendRebootSignal(ctx, *node)
+	require.NoError(t, err)
+	assert.Equal(t, "boot-1", string(ref))
+
+	host, err := dynamicClient.Resource(bareMetalHostGVR).Namespace("metal3").Get(ctx, "host-1",
+		metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, `{"mode":"hard"}`, host.GetAnnotations()[rebootAnnotation])
+
+	ready, err := client.IsNodeReady(ctx, *node, string(ref))
+	require.NoError(t, err)
+	assert.False(t, ready, "the reboot annotation has not been consumed")
+
+	// The baremetal-operator removes the annotation once the host is powered off
+	unstructured.RemoveNestedField(host.Object, "metadata", "annotations", rebootAnnotation)
+	_, err = dynamicClient.Resource(bareMetalHostGVR).Namespace("metal3").Update(ctx, host, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	node.Status.NodeInfo.BootID = "boot-2"
+	node, err = k8sClient.CoreV1().Nodes().UpdateStatus(ctx, node, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	ready, err = client.IsNodeReady(ctx, *node, string(ref))
+	require.NoError(t, err)
+	assert.False(t, ready, "the machine is not Running")
+
+	current, err := dynamicClient.Resource(machineGVR).Namespace(testNamespace).Get(ctx, "worker-1-abcde",
+		metav1.GetOptions{})
+	require.NoError(t, err)
+	require.NoError(t, unstructured.SetNestedField(current.Object, machinePhaseReady, "status", "phase"))
+	_, err = dynamicClient.Resource(machineGVR).Namespace(testNamespace).UpdateStatus(ctx, current,
+		metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	ready, err = client.IsNodeReady(ctx, *node, string(ref))
+	require.NoError(t, err)
+	assert.True(t, ready)
+
+	terminateRef, err := client.SendTerminateSignal(ctx, *node)
+	require.NoError(t, err)
+	assert.Equal(t, testNamespace+"/worker-1-abcde", string(terminateRef))
+
+	_, err = dynamicClient.Resource(machineGVR).Namespace(testNamespace).Get(ctx, "worker-1-abcde",
+		metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err))
+}
@@ -0,0 +1,274 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/nvidia/nvsentinel/janitor-provider/pkg/model"
+)
+
+const testNamespace = "cluster-1"
+
+func newMachine(name, nodeName, phase, infrastructureKind string) *unstructured.Unstructured {
+	machine := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "cluster.x-k8s.io/v1beta1",
+		"kind":       "Machine",
+		"metadata": map[string]any{
+			"name":      name,
+			"namespace": testNamespace,
+			"ownerReferences": []any{
+				map[string]any{"apiVersion": "cluster.x-k8s.io/v1beta1", "kind": "MachineSet", "name": "workers",
+					"uid": "machineset-uid"},
+			},
+		},
+		"spec": map[string]any{
+			"clusterName": "cluster-1",
+			"infrastructureRef": map[string]any{
+				"apiVersion": "infrastructure.cluster.x-k8s.io/v1beta1",
+				"kind":       infrastructureKind,
+				"name":       name,
+			},
+		},
+		"status": map[string]any{
+			"phase":   phase,
+			"nodeRef": map[string]any{"kind": "Node", "name": nodeName},
+		},
+	}}
+
+	return machine
+}
+
+func newMetal3Machine(name, hostRef string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "infrastructure.cluster.x-k8s.io/v1beta1",
+		"kind":       "Metal3Machine",
+		"metadata": map[string]any{
+			"name":        name,
+			"namespace":   testNamespace,
+			"annotations": map[string]any{bareMetalHostAnnotation: hostRef},
+		},
+	}}
+}
+
+func newBareMetalHost(name string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "metal3.io/v1alpha1",
+		"kind":       "BareMetalHost",
+		"metadata": map[string]any{
+			"name":      name,
+			"namespace": "metal3",
+		},
+	}}
+}
+
+func newNode(name, bootID string, ready bool) corev1.Node {
+	status := corev1.ConditionFalse
+	if ready {
+		status = corev1.ConditionTrue
+	}
+
+	return corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: corev1.NodeStatus{
+			NodeInfo: corev1.NodeSystemInfo{BootID: bootID},
+			Conditions: []corev1.NodeCondition{
+				{Type: corev1.NodeReady, Status: status},
+			},
+		},
+	}
+}
+
+func newTestClient(objects ...runtime.Object) *Client {
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			machineGVR:           "MachineList",
+			metal3MachineGVR:     "Metal3MachineList",
+			bareMetalHostGVR:     "BareMetalHostList",
+			machineDeploymentGVR: "MachineDeploymentList",
+			machineSetGVR:        "MachineSetList",
+		}, objects...)
+
+	return NewClientWithDynamic(dynamicClient, Config{})
+}
+
+func newMetal3TestClient(phase string) *Client {
+	return newTestClient(
+		newMachine("worker-1-abcde", "worker-1", phase, metal3MachineKind),
+		newMetal3Machine("worker-1-abcde", "metal3/host-1"),
+		newBareMetalHost("host-1"),
+	)
+}
+
+func getHost(t *testing.T, client *Client) *unstructured.Unstructured {
+	t.Helper()
+
+	host, err := client.dynamicClient.Resource(bareMetalHostGVR).Namespace("metal3").Get(context.Background(),
+		"host-1", metav1.GetOptions{})
+	require.NoError(t, err)
+
+	return host
+}
+
+func TestSendRebootSignal_AnnotatesBareMetalHost(t *testing.T) {
+	client := newMetal3TestClient(machinePhaseReady)
+
+	ref, err := client.SendRebootSignal(context.Background(), newNode("worker-1", "boot-1", true))
+	require.NoError(t, err)
+	assert.Equal(t, model.ResetSignalRequestRef("boot-1"), ref)
+
+	assert.Equal(t, `{"mode":"hard"}`, getHost(t, client).GetAnnotations()[rebootAnnotation])
+}
+
+func TestSendRebootSignal_UsesNodeAnnotations(t *testing.T) {
+	client := newTestClient(
+		// The Machine has no nodeRef yet, so it can only be found through the node annotations
+		newMachine("worker-1-abcde", "", machinePhaseReady, metal3MachineKind),
+		newMetal3Machine("worker-1-abcde", "metal3/host-1"),
+		newBareMetalHost("host-1"),
+	)
+	client.config.RebootMode = RebootModeSoft
+
+	node := newNode("worker-1", "boot-1", true)
+	node.Annotations = map[string]string{
+		machineAnnotation:          "worker-1-abcde",
+		clusterNamespaceAnnotation: testNamespace,
+	}
+
+	_, err := client.SendRebootSignal(context.Background(), node)
+	require.NoError(t, err)
+
+	assert.Equal(t, `{"mode":"soft"}`, getHost(t, client).GetAnnotations()[rebootAnnotation])
+}
+
+func TestSendRebootSignal_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		objects []runtime.Object
+		node    corev1.Node
+	}{
+		{
+			name: "no bootID",
+			objects: []runtime.Object{
+				newMachine("worker-1-abcde", "worker-1", machinePhaseReady, metal3MachineKind),
+			},
+			node: newNode("worker-1", "", true),
+		},
+		{
+			name: "no machine",
+			node: newNode("worker-1", "boot-1", true),
+		},
+		{
+			name: "not a Metal3 machine",
+			objects: []runtime.Object{
+				newMachine("worker-1-abcde", "worker-1", machinePhaseReady, "AWSMachine"),
+			},
+			node: newNode("worker-1", "boot-1", true),
+		},
+		{
+			name: "no BareMetalHost annotation",
+			objects: []runtime.Object{
+				newMachine("worker-1-abcde", "worker-1", machinePhaseReady, metal3MachineKind),
+				newMetal3Machine("worker-1-abcde", ""),
+			},
+			node: newNode("worker-1", "boot-1", true),
+		},
+		{
+			name: "missing BareMetalHost",
+			objects: []runtime.Object{
+				newMachine("worker-1-abcde", "worker-1", machinePhaseReady, metal3MachineKind),
+				newMetal3Machine("worker-1-abcde", "metal3/host-1"),
+			},
+			node: newNode("worker-1", "boot-1", true),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(tt.objects...)
+
+			_, err := client.SendRebootSignal(context.Background(), tt.node)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestIsNodeReady(t *testing.T) {
+	tests := []struct {
+		name          string
+		rebootPending bool
+		phase         string
+		bootID        string
+		ready         bool
+		expected      bool
+	}{
+		{name: "reboot pending", rebootPending: true, phase: "Running", bootID: "boot-2", ready: true},
+		{name: "machine provisioning", phase: "Provisioning", bootID: "boot-2", ready: true},
+		{name: "same bootID", phase: "Running", bootID: "boot-1", ready: true},
+		{name: "rebooted but not ready", phase: "Running", bootID: "boot-2"},
+		{name: "rebooted and ready", phase: "Running", bootID: "boot-2", ready: true, expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newMetal3TestClient(tt.phase)
+
+			if tt.rebootPending {
+				_, err := client.SendRebootSignal(context.Background(), newNode("worker-1", "boot-1", true))
+				require.NoError(t, err)
+			}
+
+			ready, err := client.IsNodeReady(context.Background(), newNode("worker-1", tt.bootID, tt.ready), "boot-1")
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, ready)
+		})
+	}
+}
+
+func TestSendTerminateSignal_DeletesMachine(t *testing.T) {
+	client := newTestClient(newMachine("worker-1-abcde", "worker-1", machinePhaseReady, "AWSMachine"))
+
+	ref, err := client.SendTerminateSignal(context.Background(), newNode("worker-1", "boot-1", true))
+	require.NoError(t, err)
+	assert.Equal(t, model.TerminateNodeRequestRef(testNamespace+"/worker-1-abcde"), ref)
+
+	_, err = client.dynamicClient.Resource(machineGVR).Namespace(testNamespace).Get(context.Background(),
+		"worker-1-abcde", metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err))
+}
+
+func TestLoadConfigFromEnv(t *testing.T) {
+	config := loadConfigFromEnv()
+	assert.Equal(t, RebootModeHard, config.RebootMode)
+	assert.Empty(t, config.MachineNamespace)
+
+	t.Setenv("CAPI_REBOOT_MODE", RebootModeSoft)
+	t.Setenv("CAPI_MACHINE_NAMESPACE", testNamespace)
+
+	config = loadConfigFromEnv()
+	assert.Equal(t, RebootModeSoft, config.RebootMode)
+	assert.Equal(t, testNamespace, config.MachineNamespace)
+}
@@ -0,0 +1,249 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capi
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/nvidia/nvsentinel/janitor-provider/pkg/model"
+)
+
+const (
+	// Labels set by Cluster API on the Machines of MachineDeployments and MachineSets
+	deploymentNameLabel = "cluster.x-k8s.io/deployment-name"
+	setNameLabel        = "cluster.x-k8s.io/set-name"
+
+	// deleteMachineAnnotation makes MachineSets delete the Machine first when they are scaled down
+	deleteMachineAnnotation = "cluster.x-k8s.io/delete-machine"
+
+	// replacementRequestedAtAnnotation is set on the Machine of a replaced node to the time its MachineDeployment or
+	// MachineSet was scaled up
+	replacementRequestedAtAnnotation = "janitor.dgxc.nvidia.com/replacement-requested-at"
+)
+
+var (
+	machineDeploymentGVR = schema.GroupVersionResource{
+		Group: "cluster.x-k8s.io", Version: "v1beta1", Resource: "machinedeployments",
+	}
+	machineSetGVR = schema.GroupVersionResource{
+		Group: "cluster.x-k8s.io", Version: "v1beta1", Resource: "machinesets",
+	}
+)
+
+var _ model.NodeReplacer = (*Client)(nil)
+
+// RequestReplacementNode scales up the MachineDeployment, or else the MachineSet, of the node's Machine by one and
+// marks the Machine for deletion on the next scale down. Returns the scaled resource as the requestID.
+func (c *Client) RequestReplacementNode(ctx context.Context, node corev1.Node) (model.ReplacementRequestRef, error) {
+	machine, err := c.nodeMachine(ctx, node)
+	if err != nil {
+		return "", err
+	}
+
+	gvr, name, _, err := machineScaleTarget(machine)
+	if err != nil {
+		return "", err
+	}
+
+	ref := model.ReplacementRequestRef(gvr.Resource + "/" + machine.GetNamespace() + "/" + name)
+
+	if _, requested := machine.GetAnnotations()[replacementRequestedAtAnnotation]; requested {
+		slog.InfoContext(ctx, "Replacement already requested", "node", node.Name, "target", ref)
+		return ref, nil
+	}
+
+	// Machines created from now on are replacement candidates. Creation timestamps have a precision of one second.
+	requestedAt := time.Now().UTC().Truncate(time.Second)
+
+	replicas, err := c.scaleBy(ctx, gvr, machine.GetNamespace(), name, 1)
+	if err != nil {
+		return "", err
+	}
+
+	// If this fails after scaling, a retry scales the group up once more and the cluster autoscaler or an operator
+	// has to remove the extra Machine
+	err = c.annotateMachine(ctx, machine, map[string]string{
+		replacementRequestedAtAnnotation: requestedAt.Format(time.RFC3339),
+		deleteMachineAnnotation:          "janitor",
+	})
+	if err != nil {
+		return "", err
+	}
+
+	slog.InfoContext(ctx, "Requested replacement node", "node", node.Name, "target", ref, "replicas", replicas)
+
+	return ref, nil
+}
+
+// GetReplacementNode returns the node of the first Machine of the node's MachineDeployment or MachineSet which was
+// created after the replacement was requested and has a node.
+func (c *Client) GetReplacementNode(ctx context.Context, node corev1.Node, requestID string) (string, error) {
+	machine, err := c.nodeMachine(ctx, node)
+	if err != nil {
+		return "", err
+	}
+
+	requestedAtValue := machine.GetAnnotations()[replacementRequestedAtAnnotation]
+
+	requestedAt, err := time.Parse(time.RFC3339, requestedAtValue)
+	if err != nil {
+		return "", fmt.Errorf("no replacement requested for machine %s/%s of node %s: invalid %s annotation %q: %w",
+			machine.GetNamespace(), machine.GetName(), node.Name, replacementRequestedAtAnnotation, requestedAtValue,
+			err)
+	}
+
+	_, _, selector, err := machineScaleTarget(machine)
+	if err != nil {
+		return "", err
+	}
+
+	machines, err := c.dynamicClient.Resource(machineGVR).Namespace(machine.GetNamespace()).List(ctx,
+		metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return "", fmt.Errorf("failed to list machines: %w", err)
+	}
+
+	var replacement *unstructured.Unstructured
+
+	for i := range machines.Items {
+		candidate := &machines.Items[i]
+		created := candidate.GetCreationTimestamp()
+
+		if candidate.GetName() == machine.GetName() || created.Time.Before(requestedAt) {
+			continue
+		}
+
+		if nodeName, _, _ := unstructured.NestedString(candidate.Object, "status", "nodeRef", "name"); nodeName == "" {
+			continue
+		}
+
+		if replacement == nil || created.Time.Before(replacement.GetCreationTimestamp().Time) {
+			replacement = candidate
+		}
+	}
+
+	if replacement == nil {
+		slog.InfoContext(ctx, "Replacement node not yet registered", "node", node.Name, "requestID", requestID)
+		return "", nil
+	}
+
+	replacementNodeName, _, _ := unstructured.NestedString(replacement.Object, "status", "nodeRef", "name")
+
+	slog.InfoContext(ctx, "Found replacement node", "node", node.Name, "requestID", requestID,
+		"machine", replacement.GetNamespace()+"/"+replacement.GetName(), "replacementNode", replacementNodeName)
+
+	return replacementNodeName, nil
+}
+
+// scaleDownReplacedMachine scales down the MachineDeployment or MachineSet of a Machine whose replacement was
+// requested. The Machine is deleted first because of its delete-machine annotation.
+func (c *Client) scaleDownReplacedMachine(ctx context.Context, machine *unstructured.Unstructured) error {
+	gvr, name, _, err := machineScaleTarget(machine)
+	if err != nil {
+		return err
+	}
+
+	replicas, err := c.scaleBy(ctx, gvr, machine.GetNamespace(), name, -1)
+	if err != nil {
+		return err
+	}
+
+	slog.InfoContext(ctx, "Scaled down replaced Machine", "machine", machine.GetNamespace()+"/"+machine.GetName(),
+		"target", gvr.Resource+"/"+machine.GetNamespace()+"/"+name, "replicas", replicas)
+
+	return nil
+}
+
+// scaleBy changes the replicas of the MachineDeployment or MachineSet by delta and returns the new replicas. The
+// update fails on conflicting changes, which are retried by the caller.
+func (c *Client) scaleBy(ctx context.Context, gvr schema.GroupVersionResource, namespace, name string,
+	delta int64) (int64, error) {
+	target, err := c.dynamicClient.Resource(gvr).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get %s %s/%s: %w", gvr.Resource, namespace, name, err)
+	}
+
+	replicas, found, err := unstructured.NestedInt64(target.Object, "spec", "replicas")
+	if err != nil || !found {
+		return 0, fmt.Errorf("%s %s/%s has no replicas", gvr.Resource, namespace, name)
+	}
+
+	replicas += delta
+	if replicas < 0 {
+		return 0, fmt.Errorf("cannot scale %s %s/%s below zero replicas", gvr.Resource, namespace, name)
+	}
+
+	if err := unstructured.SetNestedField(target.Object, replicas, "spec", "replicas"); err != nil {
+		return 0, fmt.Errorf("failed to set replicas of %s %s/%s: %w", gvr.Resource, namespace, name, err)
+	}
+
+	if _, err := c.dynamicClient.Resource(gvr).Namespace(namespace).Update(ctx, target,
+		metav1.UpdateOptions{}); err != nil {
+		return 0, fmt.Errorf("failed to scale %s %s/%s to %d replicas: %w", gvr.Resource, namespace, name, replicas,
+			err)
+	}
+
+	return replicas, nil
+}
+
+func (c *Client) annotateMachine(ctx context.Context, machine *unstructured.Unstructured,
+	annotations map[string]string) error {
+	merged := machine.GetAnnotations()
+	if merged == nil {
+		merged = map[string]string{}
+	}
+
+	for key, value := range annotations {
+		merged[key] = value
+	}
+
+	machine.SetAnnotations(merged)
+
+	updated, err := c.dynamicClient.Resource(machineGVR).Namespace(machine.GetNamespace()).Update(ctx, machine,
+		metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to annotate machine %s/%s: %w", machine.GetNamespace(), machine.GetName(), err)
+	}
+
+	*machine = *updated
+
+	return nil
+}
+
+// machineScaleTarget returns the MachineDeployment of the Machine, or its MachineSet if it has no
+// MachineDeployment, with the label selector of their Machines.
+func machineScaleTarget(machine *unstructured.Unstructured) (schema.GroupVersionResource, string, string, error) {
+	machineLabels := machine.GetLabels()
+
+	if name := machineLabels[deploymentNameLabel]; name != "" {
+		return machineDeploymentGVR, name, labels.Set{deploymentNameLabel: name}.String(), nil
+	}
+
+	if name := machineLabels[setNameLabel]; name != "" {
+		return machineSetGVR, name, labels.Set{setNameLabel: name}.String(), nil
+	}
+
+	return schema.GroupVersionResource{}, "", "", fmt.Errorf(
+		"machine %s/%s is not part of a MachineDeployment or MachineSet", machine.GetNamespace(), machine.GetName())
+}
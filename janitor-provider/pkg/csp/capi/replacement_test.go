@@ -0,0 +1,143 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/nvidia/nvsentinel/janitor-provider/pkg/model"
+)
+
+func newMachineDeployment(name string, replicas int64) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "cluster.x-k8s.io/v1beta1",
+		"kind":       "MachineDeployment",
+		"metadata": map[string]any{
+			"name":      name,
+			"namespace": testNamespace,
+		},
+		"spec": map[string]any{"replicas": replicas},
+	}}
+}
+
+func newDeploymentMachine(name, nodeName string, created time.Time) *unstructured.Unstructured {
+	machine := newMachine(name, nodeName, machinePhaseReady, "AWSMachine")
+	machine.SetLabels(map[string]string{deploymentNameLabel: "workers"})
+	machine.SetCreationTimestamp(metav1.NewTime(created))
+
+	return machine
+}
+
+func getReplicas(t *testing.T, client *Client) int64 {
+	t.Helper()
+
+	deployment, err := client.dynamicClient.Resource(machineDeploymentGVR).Namespace(testNamespace).Get(
+		context.Background(), "workers", metav1.GetOptions{})
+	require.NoError(t, err)
+
+	replicas, _, err := unstructured.NestedInt64(deployment.Object, "spec", "replicas")
+	require.NoError(t, err)
+
+	return replicas
+}
+
+func getMachine(t *testing.T, client *Client, name string) *unstructured.Unstructured {
+	t.Helper()
+
+	machine, err := client.dynamicClient.Resource(machineGVR).Namespace(testNamespace).Get(context.Background(),
+		name, metav1.GetOptions{})
+	require.NoError(t, err)
+
+	return machine
+}
+
+func TestReplacementNode(t *testing.T) {
+	ctx := context.Background()
+	node := newNode("worker-1", "boot-1", true)
+	client := newTestClient(
+		newMachineDeployment("workers", 2),
+		newDeploymentMachine("worker-1-abcde", "worker-1", time.Now().Add(-time.Hour)),
+		newDeploymentMachine("worker-2-fghij", "worker-2", time.Now().Add(-time.Hour)),
+	)
+
+	ref, err := client.RequestReplacementNode(ctx, node)
+	require.NoError(t, err)
+	assert.Equal(t, model.ReplacementRequestRef("machinedeployments/"+testNamespace+"/workers"), ref)
+	assert.Equal(t, int64(3), getReplicas(t, client))
+
+	annotations := getMachine(t, client, "worker-1-abcde").GetAnnotations()
+	assert.Contains(t, annotations, replacementRequestedAtAnnotation)
+	assert.Contains(t, annotations, deleteMachineAnnotation)
+
+	// Requesting again does not scale up twice
+	_, err = client.RequestReplacementNode(ctx, node)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), getReplicas(t, client))
+
+	replacementNodeName, err := client.GetReplacementNode(ctx, node, string(ref))
+	require.NoError(t, err)
+	assert.Empty(t, replacementNodeName)
+
+	// A new Machine without node is not the replacement yet
+	pending := newDeploymentMachine("worker-3-klmno", "", time.Now().Add(time.Second))
+	unstructured.RemoveNestedField(pending.Object, "status", "nodeRef")
+	_, err = client.dynamicClient.Resource(machineGVR).Namespace(testNamespace).Create(ctx, pending,
+		metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	replacementNodeName, err = client.GetReplacementNode(ctx, node, string(ref))
+	require.NoError(t, err)
+	assert.Empty(t, replacementNodeName)
+
+	require.NoError(t, unstructured.SetNestedField(pending.Object, "worker-3", "status", "nodeRef", "name"))
+	_, err = client.dynamicClient.Resource(machineGVR).Namespace(testNamespace).Update(ctx, pending,
+		metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	replacementNodeName, err = client.GetReplacementNode(ctx, node, string(ref))
+	require.NoError(t, err)
+	assert.Equal(t, "worker-3", replacementNodeName)
+
+	// Terminating the replaced node scales the MachineDeployment down instead of deleting the Machine
+	_, err = client.SendTerminateSignal(ctx, node)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), getReplicas(t, client))
+	getMachine(t, client, "worker-1-abcde")
+}
+
+func TestReplacementNode_Errors(t *testing.T) {
+	ctx := context.Background()
+	node := newNode("worker-1", "boot-1", true)
+
+	// Machines which are not part of a MachineDeployment or MachineSet cannot be replaced
+	client := newTestClient(newMachine("worker-1-abcde", "worker-1", machinePhaseReady, "AWSMachine"))
+	_, err := client.RequestReplacementNode(ctx, node)
+	assert.Error(t, err)
+
+	// Replacement nodes are only looked up after a request
+	client = newTestClient(
+		newMachineDeployment("workers", 1),
+		newDeploymentMachine("worker-1-abcde", "worker-1", time.Now().Add(-time.Hour)),
+	)
+	_, err = client.GetReplacementNode(ctx, node, "machinedeployments/"+testNamespace+"/workers")
+	assert.Error(t, err)
+}
@@ -23,11 +23,14 @@ import (
 
 	"github.com/nvidia/nvsentinel/janitor-provider/pkg/csp/aws"
 	"github.com/nvidia/nvsentinel/janitor-provider/pkg/csp/azure"
+	"github.com/nvidia/nvsentinel/janitor-provider/pkg/csp/capi"
+	"github.com/nvidia/nvsentinel/janitor-provider/pkg/csp/exec"
 	"github.com/nvidia/nvsentinel/janitor-provider/pkg/csp/gcp"
 	"github.com/nvidia/nvsentinel/janitor-provider/pkg/csp/generic"
 	"github.com/nvidia/nvsentinel/janitor-provider/pkg/csp/kind"
 	"github.com/nvidia/nvsentinel/janitor-provider/pkg/csp/nebius"
 	"github.com/nvidia/nvsentinel/janitor-provider/pkg/csp/oci"
+	"github.com/nvidia/nvsentinel/janitor-provider/pkg/csp/redfish"
 	"github.com/nvidia/nvsentinel/janitor-provider/pkg/model"
 )
 
@@ -39,6 +42,9 @@ const (
 	ProviderOCI     Provider = "oci"
 	ProviderNebius  Provider = "nebius"
 	ProviderGeneric Provider = "generic"
+	ProviderRedfish Provider = "redfish"
+	ProviderExec    Provider = "exec"
+	ProviderCAPI    Provider = "capi"
 )
 
 // Provider defines the supported cloud service providers.
@@ -87,6 +93,12 @@ func NewWithProvider(ctx context.Context, provider Provider) (model.CSPClient, e
 		return nebius.NewClientFromEnv(ctx)
 	case ProviderGeneric:
 		return generic.NewClient(ctx)
+	case ProviderRedfish:
+		return redfish.NewClient(ctx)
+	case ProviderExec:
+		return exec.NewClient(ctx)
+	case ProviderCAPI:
+		return capi.NewClient(ctx)
 	default:
 		return nil, fmt.Errorf("unsupported CSP provider: %s", provider)
 	}
@@ -120,6 +132,12 @@ func GetProviderFromString(providerStr string) (Provider, error) {
 		return ProviderNebius, nil
 	case "generic":
 		return ProviderGeneric, nil
+	case "redfish":
+		return ProviderRedfish, nil
+	case "exec":
+		return ProviderExec, nil
+	case "capi":
+		return ProviderCAPI, nil
 	default:
 		return "", fmt.Errorf("unsupported CSP provider: %s", providerStr)
 	}
@@ -36,6 +36,9 @@ func TestProvider_String(t *testing.T) {
 		{"oci provider", ProviderOCI, "oci"},
 		{"nebius provider", ProviderNebius, "nebius"},
 		{"generic provider", ProviderGeneric, "generic"},
+		{"redfish provider", ProviderRedfish, "redfish"},
+		{"exec provider", ProviderExec, "exec"},
+		{"capi provider", ProviderCAPI, "capi"},
 	}
 
 	for _, tt := range tests {
@@ -68,6 +71,9 @@ func TestGetProviderFromEnv_Valid(t *testing.T) {
 		{"oci", "oci", ProviderOCI},
 		{"nebius", "nebius", ProviderNebius},
 		{"generic", "generic", ProviderGeneric},
+		{"redfish", "redfish", ProviderRedfish},
+		{"exec", "exec", ProviderExec},
+		{"capi", "capi", ProviderCAPI},
 	}
 
 	for _, tt := range tests {
@@ -194,6 +200,9 @@ func TestProviderConstants(t *testing.T) {
 	assert.Equal(t, Provider("oci"), ProviderOCI)
 	assert.Equal(t, Provider("nebius"), ProviderNebius)
 	assert.Equal(t, Provider("generic"), ProviderGeneric)
+	assert.Equal(t, Provider("redfish"), ProviderRedfish)
+	assert.Equal(t, Provider("exec"), ProviderExec)
+	assert.Equal(t, Provider("capi"), ProviderCAPI)
 }
 
 func TestNewWithProvider_AllProviders(t *testing.T) {
@@ -301,12 +310,18 @@ func TestGetProviderFromString(t *testing.T) {
 		{"oci lowercase", "oci", ProviderOCI, false},
 		{"nebius lowercase", "nebius", ProviderNebius, false},
 		{"generic lowercase", "generic", ProviderGeneric, false},
+		{"redfish lowercase", "redfish", ProviderRedfish, false},
+		{"exec lowercase", "exec", ProviderExec, false},
+		{"capi lowercase", "capi", ProviderCAPI, false},
 		{"kind uppercase", "KIND", ProviderKind, false}, // case insensitive
 		{"aws uppercase", "AWS", ProviderAWS, false},
 		{"gcp mixed case", "GcP", ProviderGCP, false},
 		{"azure mixed case", "Azure", ProviderAzure, false},
 		{"nebius mixed case", "Nebius", ProviderNebius, false},
 		{"generic mixed case", "Generic", ProviderGeneric, false},
+		{"redfish mixed case", "Redfish", ProviderRedfish, false},
+		{"exec uppercase", "EXEC", ProviderExec, false},
+		{"capi uppercase", "CAPI", ProviderCAPI, false},
 		{"invalid", "invalid", "", true},
 		{"empty", "", "", true},
 	}
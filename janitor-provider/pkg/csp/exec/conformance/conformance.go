@@ -0,0 +1,165 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package conformance checks that an exec provider plugin follows the plugin contract.
+//
+// Plugin authors can run the checks against their plugin without writing Go:
+//
+//	EXEC_PROVIDER_COMMAND=/path/to/plugin go test ./pkg/csp/exec/conformance/ -run TestConformance -v
+//
+// or call Run from their own tests. The checks run every operation of the plugin against the given node,
+// so the plugin must target a test environment.
+package conformance
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	osexec "os/exec"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/nvidia/nvsentinel/janitor-provider/pkg/csp/exec"
+)
+
+// Options configures the conformance checks.
+type Options struct {
+	// Config is the configuration the provider runs the plugin with
+	Config exec.Config
+	// Node is the node the operations target, DefaultNode if unset
+	Node *corev1.Node
+	// SkipTerminate skips SendTerminateSignal, for plugins that do not support terminating nodes
+	SkipTerminate bool
+}
+
+// DefaultNode returns the node the operations target when none is given.
+func DefaultNode() *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "conformance-node",
+			Labels: map[string]string{"kubernetes.io/hostname": "conformance-node"},
+		},
+		Spec: corev1.NodeSpec{ProviderID: "conformance://conformance-node"},
+		Status: corev1.NodeStatus{
+			NodeInfo: corev1.NodeSystemInfo{BootID: "conformance-boot-id"},
+			Conditions: []corev1.NodeCondition{
+				{Type: corev1.NodeReady, Status: corev1.ConditionTrue},
+			},
+		},
+	}
+}
+
+// Run runs the conformance checks against the plugin as subtests of t. Every run of the plugin must complete
+// within the timeout of the configuration.
+func Run(t *testing.T, opts Options) {
+	t.Helper()
+
+	client := exec.NewClientWithConfig(opts.Config)
+
+	node := opts.Node
+	if node == nil {
+		node = DefaultNode()
+	}
+
+	var rebootRef string
+
+	t.Run("SendRebootSignal returns a requestRef", func(t *testing.T) {
+		ref, err := client.SendRebootSignal(context.Background(), *node)
+		if err != nil {
+			t.Fatalf("SendRebootSignal failed: %v", err)
+		}
+
+		rebootRef = string(ref)
+	})
+
+	t.Run("IsNodeReady accepts the requestRef of SendRebootSignal", func(t *testing.T) {
+		if rebootRef == "" {
+			t.Skip("SendRebootSignal failed")
+		}
+
+		if _, err := client.IsNodeReady(context.Background(), *node, rebootRef); err != nil {
+			t.Fatalf("IsNodeReady failed: %v", err)
+		}
+	})
+
+	t.Run("SendTerminateSignal succeeds", func(t *testing.T) {
+		if opts.SkipTerminate {
+			t.Skip("terminate is not supported by the plugin")
+		}
+
+		if _, err := client.SendTerminateSignal(context.Background(), *node); err != nil {
+			t.Fatalf("SendTerminateSignal failed: %v", err)
+		}
+	})
+
+	t.Run("rejects an unsupported apiVersion", func(t *testing.T) {
+		request := exec.NewRequest(exec.OperationIsNodeReady, *node, rebootRef)
+		request.APIVersion = "janitor-provider.nvsentinel.nvidia.com/v0"
+
+		if err := checkRejected(context.Background(), opts.Config, request); err != nil {
+			t.Fatalf("apiVersion %s: %v", request.APIVersion, err)
+		}
+	})
+
+	t.Run("rejects an unknown operation", func(t *testing.T) {
+		request := exec.NewRequest("UnknownOperation", *node, "")
+
+		if err := checkRejected(context.Background(), opts.Config, request); err != nil {
+			t.Fatalf("operation %s: %v", request.Operation, err)
+		}
+	})
+}
+
+// checkRejected runs the plugin with the request and returns an error unless it exits with a non-zero
+// status. The plugin is run directly rather than through the provider, which also fails on responses it
+// cannot decode, so that only the exit status decides.
+func checkRejected(ctx context.Context, config exec.Config, request *exec.Request) error {
+	input, err := json.Marshal(request)
+	if err != nil {
+		return fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	if config.Timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, config.Timeout)
+		defer cancel()
+	}
+
+	var stderr bytes.Buffer
+
+	cmd := osexec.CommandContext(ctx, config.Command, config.Args...)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stderr = &stderr
+
+	err = cmd.Run()
+	if err == nil {
+		return fmt.Errorf("plugin exited with status 0")
+	}
+
+	if ctx.Err() != nil {
+		return fmt.Errorf("plugin did not exit within %s", config.Timeout)
+	}
+
+	var exitErr *osexec.ExitError
+	if !errors.As(err, &exitErr) {
+		return fmt.Errorf("failed to run plugin: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/nvidia/nvsentinel/janitor-provider/pkg/csp/exec"
+)
+
+// referencePluginEnv makes the test binary behave as the reference plugin, or as a plugin that accepts every
+// request when set to "lenient"
+const referencePluginEnv = "EXEC_CONFORMANCE_REFERENCE_PLUGIN"
+
+func TestMain(m *testing.M) {
+	switch os.Getenv(referencePluginEnv) {
+	case "":
+	case "lenient":
+		os.Exit(runLenientPlugin())
+	default:
+		os.Exit(runReferencePlugin())
+	}
+
+	os.Exit(m.Run())
+}
+
+// runLenientPlugin breaks the contract: it answers every request, whatever its apiVersion and operation,
+// with a response of the current version and exits with status 0.
+func runLenientPlugin() int {
+	_ = json.NewEncoder(os.Stdout).Encode(exec.Response{APIVersion: exec.APIVersion, Kind: exec.ResponseKind})
+	return 0
+}
+
+// runReferencePlugin is a minimal plugin that follows the contract.
+func runReferencePlugin() int {
+	var request exec.Request
+	if err := json.NewDecoder(os.Stdin).Decode(&request); err != nil {
+		fmt.Fprintf(os.Stderr, "invalid request: %v\n", err)
+		return 1
+	}
+
+	if request.APIVersion != exec.APIVersion {
+		fmt.Fprintf(os.Stderr, "unsupported apiVersion %s\n", request.APIVersion)
+		return 1
+	}
+
+	response := exec.Response{APIVersion: exec.APIVersion, Kind: exec.ResponseKind}
+
+	switch request.Operation {
+	case exec.OperationSendRebootSignal:
+		response.RequestRef = request.Node.Status.NodeInfo.BootID
+	case exec.OperationIsNodeReady:
+		response.Ready = request.Node.Status.NodeInfo.BootID != request.RequestRef
+	case exec.OperationSendTerminateSignal:
+		response.RequestRef = request.Node.Name
+	default:
+		fmt.Fprintf(os.Stderr, "unsupported operation %s\n", request.Operation)
+		return 1
+	}
+
+	_ = json.NewEncoder(os.Stdout).Encode(response)
+
+	return 0
+}
+
+func TestReferencePlugin(t *testing.T) {
+	t.Setenv(referencePluginEnv, "true")
+
+	Run(t, Options{Config: exec.Config{Command: os.Args[0]}})
+}
+
+func TestCheckRejected(t *testing.T) {
+	config := exec.Config{Command: os.Args[0]}
+	request := exec.NewRequest(exec.OperationIsNodeReady, *DefaultNode(), "")
+	request.APIVersion = "janitor-provider.nvsentinel.nvidia.com/v0"
+
+	t.Setenv(referencePluginEnv, "true")
+	require.NoError(t, checkRejected(context.Background(), config, request))
+
+	t.Setenv(referencePluginEnv, "lenient")
+	require.ErrorContains(t, checkRejected(context.Background(), config, request), "exited with status 0")
+}
+
+// TestConformance runs the checks against the plugin configured with the environment variables of the
+// exec provider. EXEC_CONFORMANCE_NODE_FILE optionally names a JSON file with the node to target, e.g. the
+// output of kubectl get node -o json, and EXEC_CONFORMANCE_SKIP_TERMINATE skips SendTerminateSignal.
+func TestConformance(t *testing.T) {
+	if os.Getenv("EXEC_PROVIDER_COMMAND") == "" {
+		t.Skip("EXEC_PROVIDER_COMMAND is not set")
+	}
+
+	config, err := exec.LoadConfigFromEnv()
+	require.NoError(t, err)
+
+	opts := Options{Config: config}
+
+	if nodeFile := os.Getenv("EXEC_CONFORMANCE_NODE_FILE"); nodeFile != "" {
+		content, err := os.ReadFile(nodeFile)
+		require.NoError(t, err)
+
+		opts.Node = &corev1.Node{}
+		require.NoError(t, json.Unmarshal(content, opts.Node))
+	}
+
+	if value := os.Getenv("EXEC_CONFORMANCE_SKIP_TERMINATE"); value != "" {
+		opts.SkipTerminate, err = strconv.ParseBool(value)
+		require.NoError(t, err)
+	}
+
+	Run(t, opts)
+}
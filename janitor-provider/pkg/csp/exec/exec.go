@@ -0,0 +1,238 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package exec implements a CSP client that delegates every operation to an external plugin binary,
+// following the JSON stdin/stdout contract defined in protocol.go.
+package exec
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	osexec "os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/nvidia/nvsentinel/janitor-provider/pkg/model"
+)
+
+const (
+	defaultTimeoutSeconds = 60
+
+	// waitDelay bounds how long the plugin's output is read after it is killed on timeout
+	waitDelay = 5 * time.Second
+
+	// maxStderrInError bounds how much of the plugin's stderr is included in errors
+	maxStderrInError = 1024
+)
+
+var _ model.CSPClient = (*Client)(nil)
+
+// Config holds the configuration for the exec provider.
+type Config struct {
+	// Command is the path of the plugin binary
+	Command string
+	Args    []string
+	// Timeout bounds each run of the plugin
+	Timeout time.Duration
+}
+
+// Client is the exec implementation of the CSP Client interface.
+// It runs the configured plugin binary for every operation.
+type Client struct {
+	config Config
+}
+
+// NewClient creates a new exec provider client configured from the environment.
+func NewClient(ctx context.Context) (*Client, error) {
+	config, err := LoadConfigFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	if config.Command == "" {
+		return nil, fmt.Errorf("EXEC_PROVIDER_COMMAND must be set for the exec provider")
+	}
+
+	if _, err := osexec.LookPath(config.Command); err != nil {
+		return nil, fmt.Errorf("exec provider plugin %s is not executable: %w", config.Command, err)
+	}
+
+	slog.InfoContext(ctx, "Using exec provider plugin", "command", config.Command, "args", config.Args,
+		"timeout", config.Timeout)
+
+	return NewClientWithConfig(config), nil
+}
+
+// NewClientWithConfig creates an exec provider client with the provided configuration.
+func NewClientWithConfig(config Config) *Client {
+	if config.Timeout <= 0 {
+		config.Timeout = defaultTimeoutSeconds * time.Second
+	}
+
+	return &Client{config: config}
+}
+
+// SendRebootSignal runs the plugin to reboot the node and returns the reference it reports.
+func (c *Client) SendRebootSignal(ctx context.Context, node corev1.Node) (model.ResetSignalRequestRef, error) {
+	resp, err := c.Invoke(ctx, NewRequest(OperationSendRebootSignal, node, ""))
+	if err != nil {
+		return "", err
+	}
+
+	if resp.RequestRef == "" {
+		return "", fmt.Errorf("exec provider plugin returned no requestRef for %s of node %s",
+			OperationSendRebootSignal, node.Name)
+	}
+
+	slog.InfoContext(ctx, "Reboot signal sent by exec provider plugin", "node", node.Name,
+		"requestRef", resp.RequestRef)
+
+	return model.ResetSignalRequestRef(resp.RequestRef), nil
+}
+
+// IsNodeReady runs the plugin to check whether the node rebooted by the request is ready.
+func (c *Client) IsNodeReady(ctx context.Context, node corev1.Node, requestID string) (bool, error) {
+	resp, err := c.Invoke(ctx, NewRequest(OperationIsNodeReady, node, requestID))
+	if err != nil {
+		return false, err
+	}
+
+	return resp.Ready, nil
+}
+
+// SendTerminateSignal runs the plugin to terminate the node and returns the reference it reports.
+func (c *Client) SendTerminateSignal(ctx context.Context, node corev1.Node) (model.TerminateNodeRequestRef, error) {
+	resp, err := c.Invoke(ctx, NewRequest(OperationSendTerminateSignal, node, ""))
+	if err != nil {
+		return "", err
+	}
+
+	slog.InfoContext(ctx, "Terminate signal sent by exec provider plugin", "node", node.Name,
+		"requestRef", resp.RequestRef)
+
+	return model.TerminateNodeRequestRef(resp.RequestRef), nil
+}
+
+// NewRequest returns a request of the current contract version.
+func NewRequest(operation Operation, node corev1.Node, requestRef string) *Request {
+	return &Request{
+		APIVersion: APIVersion,
+		Kind:       RequestKind,
+		Operation:  operation,
+		Node:       node,
+		RequestRef: requestRef,
+	}
+}
+
+// Invoke runs the plugin once with the request and returns its response. The plugin's stderr is logged.
+func (c *Client) Invoke(ctx context.Context, request *Request) (*Response, error) {
+	input, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode exec provider request: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+
+	cmd := osexec.CommandContext(ctx, c.config.Command, c.config.Args...)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	cmd.WaitDelay = waitDelay
+
+	start := time.Now()
+	runErr := cmd.Run()
+
+	logStderr(ctx, request, stderr.String())
+
+	if runErr != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return nil, fmt.Errorf("exec provider plugin timed out after %s for %s of node %s",
+				c.config.Timeout, request.Operation, request.Node.Name)
+		}
+
+		return nil, fmt.Errorf("exec provider plugin failed for %s of node %s: %w: %s",
+			request.Operation, request.Node.Name, runErr, tail(stderr.String(), maxStderrInError))
+	}
+
+	slog.DebugContext(ctx, "Exec provider plugin completed", "operation", request.Operation,
+		"node", request.Node.Name, "duration", time.Since(start))
+
+	resp := &Response{}
+	if err := json.Unmarshal(stdout.Bytes(), resp); err != nil {
+		return nil, fmt.Errorf("failed to decode exec provider plugin response for %s of node %s: %w",
+			request.Operation, request.Node.Name, err)
+	}
+
+	if resp.APIVersion != request.APIVersion || resp.Kind != ResponseKind {
+		return nil, fmt.Errorf("exec provider plugin returned %s %s for %s of node %s, expected %s %s",
+			resp.APIVersion, resp.Kind, request.Operation, request.Node.Name, request.APIVersion, ResponseKind)
+	}
+
+	return resp, nil
+}
+
+func logStderr(ctx context.Context, request *Request, stderr string) {
+	for line := range strings.SplitSeq(stderr, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			slog.InfoContext(ctx, "Exec provider plugin stderr", "operation", request.Operation,
+				"node", request.Node.Name, "line", line)
+		}
+	}
+}
+
+func tail(s string, n int) string {
+	s = strings.TrimSpace(s)
+	if len(s) > n {
+		return "..." + s[len(s)-n:]
+	}
+
+	return s
+}
+
+// LoadConfigFromEnv reads the configuration of the exec provider from the environment.
+func LoadConfigFromEnv() (Config, error) {
+	config := Config{
+		Command: os.Getenv("EXEC_PROVIDER_COMMAND"),
+		Timeout: defaultTimeoutSeconds * time.Second,
+	}
+
+	if args := os.Getenv("EXEC_PROVIDER_ARGS"); args != "" {
+		if err := json.Unmarshal([]byte(args), &config.Args); err != nil {
+			return Config{}, fmt.Errorf("EXEC_PROVIDER_ARGS must be a JSON array of strings: %w", err)
+		}
+	}
+
+	if value := os.Getenv("EXEC_PROVIDER_TIMEOUT_SECONDS"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed <= 0 {
+			slog.Warn("Invalid EXEC_PROVIDER_TIMEOUT_SECONDS, using default", "value", value,
+				"default", defaultTimeoutSeconds)
+		} else {
+			config.Timeout = time.Duration(parsed) * time.Second
+		}
+	}
+
+	return config, nil
+}
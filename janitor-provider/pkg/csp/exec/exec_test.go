@@ -0,0 +1,166 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exec
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/nvidia/nvsentinel/janitor-provider/pkg/model"
+)
+
+// pluginModeEnv makes the test binary behave as a plugin in the given mode
+const pluginModeEnv = "EXEC_PROVIDER_TEST_PLUGIN_MODE"
+
+func TestMain(m *testing.M) {
+	if mode := os.Getenv(pluginModeEnv); mode != "" {
+		os.Exit(runTestPlugin(mode))
+	}
+
+	os.Exit(m.Run())
+}
+
+// runTestPlugin answers the request on stdin according to the mode.
+func runTestPlugin(mode string) int {
+	var request Request
+	if err := json.NewDecoder(os.Stdin).Decode(&request); err != nil {
+		fmt.Fprintf(os.Stderr, "invalid request: %v\n", err)
+		return 1
+	}
+
+	fmt.Fprintf(os.Stderr, "handling %s for %s\n", request.Operation, request.Node.Name)
+
+	response := Response{APIVersion: request.APIVersion, Kind: ResponseKind}
+
+	switch mode {
+	case "fail":
+		fmt.Fprintln(os.Stderr, "BMC unreachable")
+		return 2
+	case "slow":
+		time.Sleep(time.Minute)
+	case "invalid":
+		fmt.Fprint(os.Stdout, "not json")
+		return 0
+	case "wrong-version":
+		response.APIVersion = "janitor-provider.nvsentinel.nvidia.com/v0"
+	case "empty":
+	default:
+		switch request.Operation {
+		case OperationSendRebootSignal:
+			response.RequestRef = request.Node.Status.NodeInfo.BootID
+		case OperationIsNodeReady:
+			response.Ready = request.Node.Status.NodeInfo.BootID != request.RequestRef
+		case OperationSendTerminateSignal:
+			response.RequestRef = "terminate-" + request.Node.Name
+		}
+	}
+
+	_ = json.NewEncoder(os.Stdout).Encode(response)
+
+	return 0
+}
+
+func newTestClient(t *testing.T, mode string, timeout time.Duration) *Client {
+	t.Helper()
+	t.Setenv(pluginModeEnv, mode)
+
+	return NewClientWithConfig(Config{Command: os.Args[0], Timeout: timeout})
+}
+
+func newNode(name, bootID string) corev1.Node {
+	return corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: corev1.NodeStatus{
+			NodeInfo: corev1.NodeSystemInfo{BootID: bootID},
+		},
+	}
+}
+
+func TestSendRebootSignal(t *testing.T) {
+	client := newTestClient(t, "ok", 0)
+
+	ref, err := client.SendRebootSignal(context.Background(), newNode("worker-1", "boot-1"))
+	require.NoError(t, err)
+	assert.Equal(t, model.ResetSignalRequestRef("boot-1"), ref)
+}
+
+func TestIsNodeReady(t *testing.T) {
+	client := newTestClient(t, "ok", 0)
+
+	ready, err := client.IsNodeReady(context.Background(), newNode("worker-1", "boot-1"), "boot-1")
+	require.NoError(t, err)
+	assert.False(t, ready)
+
+	ready, err = client.IsNodeReady(context.Background(), newNode("worker-1", "boot-2"), "boot-1")
+	require.NoError(t, err)
+	assert.True(t, ready)
+}
+
+func TestSendTerminateSignal(t *testing.T) {
+	client := newTestClient(t, "ok", 0)
+
+	ref, err := client.SendTerminateSignal(context.Background(), newNode("worker-1", "boot-1"))
+	require.NoError(t, err)
+	assert.Equal(t, model.TerminateNodeRequestRef("terminate-worker-1"), ref)
+}
+
+func TestPluginErrors(t *testing.T) {
+	tests := []struct {
+		mode        string
+		errContains string
+	}{
+		{mode: "fail", errContains: "BMC unreachable"},
+		{mode: "slow", errContains: "timed out"},
+		{mode: "invalid", errContains: "failed to decode"},
+		{mode: "wrong-version", errContains: "expected " + APIVersion},
+		{mode: "empty", errContains: "no requestRef"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mode, func(t *testing.T) {
+			client := newTestClient(t, tt.mode, time.Second)
+
+			_, err := client.SendRebootSignal(context.Background(), newNode("worker-1", "boot-1"))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errContains)
+		})
+	}
+}
+
+func TestLoadConfigFromEnv(t *testing.T) {
+	t.Setenv("EXEC_PROVIDER_COMMAND", "/opt/plugins/provider")
+	t.Setenv("EXEC_PROVIDER_ARGS", `["--site", "dc-1"]`)
+	t.Setenv("EXEC_PROVIDER_TIMEOUT_SECONDS", "120")
+
+	config, err := LoadConfigFromEnv()
+	require.NoError(t, err)
+	assert.Equal(t, "/opt/plugins/provider", config.Command)
+	assert.Equal(t, []string{"--site", "dc-1"}, config.Args)
+	assert.Equal(t, 2*time.Minute, config.Timeout)
+
+	t.Setenv("EXEC_PROVIDER_ARGS", "--site dc-1")
+
+	_, err = LoadConfigFromEnv()
+	require.Error(t, err)
+}
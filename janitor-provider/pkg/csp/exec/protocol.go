@@ -0,0 +1,65 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exec
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// The plugin contract: for every operation the provider runs the plugin once, writes a Request as JSON to
+// its stdin and reads a Response as JSON from its stdout. A plugin reports a failure by exiting with a
+// non-zero status; its stderr is logged by the provider and included in the error. A plugin must reject a
+// request with an APIVersion or Operation it does not support by exiting with a non-zero status.
+const (
+	// APIVersion is the version of the plugin contract
+	APIVersion = "janitor-provider.nvsentinel.nvidia.com/v1alpha1"
+
+	RequestKind  = "ProviderRequest"
+	ResponseKind = "ProviderResponse"
+)
+
+// Operation is the CSP client method a plugin is run for.
+type Operation string
+
+const (
+	// OperationSendRebootSignal asks the plugin to reboot the node. The plugin returns a RequestRef that
+	// identifies the reboot in the following IsNodeReady requests.
+	OperationSendRebootSignal Operation = "SendRebootSignal"
+	// OperationIsNodeReady asks the plugin whether the node rebooted by the request RequestRef is ready.
+	OperationIsNodeReady Operation = "IsNodeReady"
+	// OperationSendTerminateSignal asks the plugin to terminate the node.
+	OperationSendTerminateSignal Operation = "SendTerminateSignal"
+)
+
+// Request is written to the stdin of the plugin.
+type Request struct {
+	APIVersion string    `json:"apiVersion"`
+	Kind       string    `json:"kind"`
+	Operation  Operation `json:"operation"`
+	// Node is the node to operate on, as read from the Kubernetes API
+	Node corev1.Node `json:"node"`
+	// RequestRef is the reference returned by SendRebootSignal, set for IsNodeReady
+	RequestRef string `json:"requestRef,omitempty"`
+}
+
+// Response is read from the stdout of the plugin.
+type Response struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	// RequestRef identifies the reboot or termination started by SendRebootSignal or SendTerminateSignal
+	RequestRef string `json:"requestRef,omitempty"`
+	// Ready is the answer to IsNodeReady
+	Ready bool `json:"ready,omitempty"`
+}
@@ -16,6 +16,7 @@ package generic
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"os"
@@ -37,10 +38,16 @@ const (
 	defaultRebootJobTTLSeconds = 3600
 	jobLabelKey                = "nvsentinel.nvidia.com/reboot-job"
 	jobNodeLabelKey            = "nvsentinel.nvidia.com/reboot-node"
+	jobRequestLabelKey         = "nvsentinel.nvidia.com/reboot-request"
 	hostMountPath              = "/host"
 )
 
-var _ model.CSPClient = (*Client)(nil)
+var (
+	_ model.CSPClient            = (*Client)(nil)
+	_ model.InstanceStatusGetter = (*Client)(nil)
+	_ model.NodeReimager         = (*Client)(nil)
+	_ model.RequestCanceller     = (*Client)(nil)
+)
 
 // Config holds the configuration for the generic provider.
 type Config struct {
@@ -48,10 +55,14 @@ type Config struct {
 	RebootJobNamespace   string
 	RebootJobTTL         int32
 	RebootJobPullSecrets []string
+	// ReimageCommand is run with sh -c in the host root of the node to reimage it. It has to reprovision the node,
+	// for example by selecting network boot, and reboot it. Reimaging is not supported when it is empty.
+	ReimageCommand string
 }
 
 // Client is the generic bare-metal implementation of the CSP Client interface.
-// It reboots nodes by creating a privileged Job that runs chroot /host reboot.
+// It reboots nodes by creating a privileged Job that runs chroot /host reboot,
+// and reimages them with a Job that runs the configured reimage command.
 type Client struct {
 	k8sClient kubernetes.Interface
 	config    Config
@@ -97,7 +108,7 @@ func (c *Client) SendRebootSignal(ctx context.Context, node corev1.Node) (model.
 		return "", fmt.Errorf("node %s has no bootID", node.Name)
 	}
 
-	job := c.buildRebootJob(node.Name)
+	job := c.buildRebootJob(node.Name, preRebootBootID)
 
 	slog.InfoContext(ctx, "Creating reboot Job", "node", node.Name, "namespace", c.config.RebootJobNamespace)
 
@@ -148,17 +159,89 @@ func (c *Client) SendTerminateSignal(ctx context.Context, node corev1.Node) (mod
 	return "", fmt.Errorf("terminate operation is not supported for generic provider (node: %s)", node.Name)
 }
 
-func (c *Client) buildRebootJob(nodeName string) *batchv1.Job {
+// SendReimageSignal creates a privileged Job on the target node that runs the reimage command in the host root.
+// Returns the node's pre-reimage bootID as the requestID, so that IsNodeReady detects the reboot of the node.
+func (c *Client) SendReimageSignal(ctx context.Context, node corev1.Node) (model.ReimageSignalRequestRef, error) {
+	if c.config.ReimageCommand == "" {
+		return "", fmt.Errorf("%w: GENERIC_REIMAGE_COMMAND is not set (node: %s)", model.ErrNotSupported, node.Name)
+	}
+
+	preReimageBootID := node.Status.NodeInfo.BootID
+	if preReimageBootID == "" {
+		slog.ErrorContext(ctx, "Node has no bootID", "node", node.Name)
+		return "", fmt.Errorf("node %s has no bootID", node.Name)
+	}
+
+	job := c.buildJob("reimage", node.Name, preReimageBootID,
+		[]string{"chroot", hostMountPath, "sh", "-c", c.config.ReimageCommand})
+
+	slog.InfoContext(ctx, "Creating reimage Job", "node", node.Name, "namespace", c.config.RebootJobNamespace)
+
+	created, err := c.k8sClient.BatchV1().Jobs(c.config.RebootJobNamespace).Create(ctx, job, metav1.CreateOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to create reimage job for node %s: %w", node.Name, err)
+	}
+
+	slog.InfoContext(ctx, "Reimage Job created", "node", node.Name, "job", created.Name,
+		"jobNamespace", c.config.RebootJobNamespace, "bootID", preReimageBootID)
+
+	return model.ReimageSignalRequestRef(preReimageBootID), nil
+}
+
+// GetInstanceStatus reports the state of a bare-metal node. Without a provider API, the state is taken from the
+// Ready condition of the node, and the node is under maintenance while a reboot or reimage Job exists for it.
+func (c *Client) GetInstanceStatus(ctx context.Context, node corev1.Node) (model.InstanceStatus, error) {
+	jobs, err := c.listNodeJobs(ctx, node.Name)
+	if err != nil {
+		return model.InstanceStatus{}, err
+	}
+
+	providerState := "not-ready"
+	if isNodeReady(node) {
+		providerState = "ready"
+	}
+
+	return model.InstanceStatus{
+		ProviderState:    providerState,
+		UnderMaintenance: len(jobs.Items) > 0,
+	}, nil
+}
+
+// CancelRequest deletes the reboot and reimage Jobs of the request, which are labeled with the bootID returned as its
+// requestID. Jobs of other requests for the node are kept. A Job whose pod has already run its command cannot be
+// undone, so cancelling only prevents reboots and reimages that have not started yet.
+func (c *Client) CancelRequest(ctx context.Context, node corev1.Node, requestID string) error {
+	if node.Status.NodeInfo.BootID != requestID {
+		slog.InfoContext(ctx, "Node already rebooted, cancelling only cleans up its Jobs", "node", node.Name,
+			"requestID", requestID, "bootID", node.Status.NodeInfo.BootID)
+	}
+
+	if err := c.deleteJobs(ctx, node.Name, requestID); err != nil {
+		return fmt.Errorf("failed to cancel request %s for node %s: %w", requestID, node.Name, err)
+	}
+
+	return nil
+}
+
+func (c *Client) buildRebootJob(nodeName, requestID string) *batchv1.Job {
+	return c.buildJob("reboot", nodeName, requestID, []string{"chroot", hostMountPath, "reboot"})
+}
+
+// buildJob returns a privileged Job on the node which runs the command with the host root mounted at /host.
+// Reimage Jobs carry the reboot Job labels, since IsNodeReady checks and cleans up both the same way. The Job is
+// labeled with the requestID, so that CancelRequest only deletes the Jobs of the cancelled request.
+func (c *Client) buildJob(operation, nodeName, requestID string, command []string) *batchv1.Job {
 	image := c.config.RebootImage
 	ttl := c.config.RebootJobTTL
 
 	return &batchv1.Job{
 		ObjectMeta: metav1.ObjectMeta{
-			GenerateName: fmt.Sprintf("reboot-%s-", nodeName),
+			GenerateName: fmt.Sprintf("%s-%s-", operation, nodeName),
 			Namespace:    c.config.RebootJobNamespace,
 			Labels: map[string]string{
-				jobLabelKey:     "true",
-				jobNodeLabelKey: nodeName,
+				jobLabelKey:        "true",
+				jobNodeLabelKey:    nodeName,
+				jobRequestLabelKey: requestID,
 			},
 		},
 		Spec: batchv1.JobSpec{
@@ -180,9 +263,9 @@ func (c *Client) buildRebootJob(nodeName string) *batchv1.Job {
 					},
 					Containers: []corev1.Container{
 						{
-							Name:    "reboot",
+							Name:    operation,
 							Image:   image,
-							Command: []string{"chroot", hostMountPath, "reboot"},
+							Command: command,
 							SecurityContext: &corev1.SecurityContext{
 								Privileged: ptr.To(true),
 							},
@@ -251,28 +334,69 @@ func (c *Client) checkRebootJobPodStatus(ctx context.Context, nodeName string) e
 }
 
 func (c *Client) cleanupRebootJob(ctx context.Context, nodeName string) {
-	namespace := c.config.RebootJobNamespace
-	labelSelector := fmt.Sprintf("%s=true,%s=%s", jobLabelKey, jobNodeLabelKey, nodeName)
-	propagation := metav1.DeletePropagationBackground
+	if err := c.deleteNodeJobs(ctx, nodeName); err != nil {
+		slog.WarnContext(ctx, "Failed to cleanup reboot jobs", "node", nodeName, "error", err)
+	}
+}
+
+// listNodeJobs returns the reboot and reimage Jobs of the node.
+func (c *Client) listNodeJobs(ctx context.Context, nodeName string) (*batchv1.JobList, error) {
+	return c.listJobs(ctx, fmt.Sprintf("%s=true,%s=%s", jobLabelKey, jobNodeLabelKey, nodeName), nodeName)
+}
+
+// listJobs returns the reboot and reimage Jobs matching the label selector.
+func (c *Client) listJobs(ctx context.Context, labelSelector, nodeName string) (*batchv1.JobList, error) {
 
-	jobs, err := c.k8sClient.BatchV1().Jobs(namespace).List(ctx, metav1.ListOptions{
+	jobs, err := c.k8sClient.BatchV1().Jobs(c.config.RebootJobNamespace).List(ctx, metav1.ListOptions{
 		LabelSelector: labelSelector,
 	})
 	if err != nil {
-		slog.WarnContext(ctx, "Failed to list reboot jobs for cleanup", "node", nodeName, "error", err)
-		return
+		return nil, fmt.Errorf("failed to list jobs of node %s: %w", nodeName, err)
 	}
 
+	return jobs, nil
+}
+
+// deleteNodeJobs deletes the reboot and reimage Jobs of the node with their pods.
+func (c *Client) deleteNodeJobs(ctx context.Context, nodeName string) error {
+	jobs, err := c.listNodeJobs(ctx, nodeName)
+	if err != nil {
+		return err
+	}
+
+	return c.deleteJobList(ctx, jobs, nodeName)
+}
+
+// deleteJobs deletes the reboot and reimage Jobs of the request for the node with their pods.
+func (c *Client) deleteJobs(ctx context.Context, nodeName, requestID string) error {
+	labelSelector := fmt.Sprintf("%s=true,%s=%s,%s=%s", jobLabelKey, jobNodeLabelKey, nodeName,
+		jobRequestLabelKey, requestID)
+
+	jobs, err := c.listJobs(ctx, labelSelector, nodeName)
+	if err != nil {
+		return err
+	}
+
+	return c.deleteJobList(ctx, jobs, nodeName)
+}
+
+// deleteJobList deletes the Jobs with their pods.
+func (c *Client) deleteJobList(ctx context.Context, jobs *batchv1.JobList, nodeName string) error {
+	propagation := metav1.DeletePropagationBackground
+
+	var errs []error
+
 	for i := range jobs.Items {
-		err := c.k8sClient.BatchV1().Jobs(namespace).Delete(ctx, jobs.Items[i].Name, metav1.DeleteOptions{
-			PropagationPolicy: &propagation,
-		})
+		err := c.k8sClient.BatchV1().Jobs(c.config.RebootJobNamespace).Delete(ctx, jobs.Items[i].Name,
+			metav1.DeleteOptions{PropagationPolicy: &propagation})
 		if err != nil {
-			slog.WarnContext(ctx, "Failed to cleanup reboot job", "job", jobs.Items[i].Name, "node", nodeName, "error", err)
+			errs = append(errs, fmt.Errorf("failed to delete job %s: %w", jobs.Items[i].Name, err))
 		} else {
-			slog.InfoContext(ctx, "Cleaned up reboot job", "job", jobs.Items[i].Name, "node", nodeName)
+			slog.InfoContext(ctx, "Deleted job", "job", jobs.Items[i].Name, "node", nodeName)
 		}
 	}
+
+	return errors.Join(errs...)
 }
 
 func buildImagePullSecrets(names []string) []corev1.LocalObjectReference {
@@ -346,5 +470,6 @@ func loadConfigFromEnv() Config {
 		RebootJobNamespace:   namespace,
 		RebootJobTTL:         ttl,
 		RebootJobPullSecrets: pullSecrets,
+		ReimageCommand:       os.Getenv("GENERIC_REIMAGE_COMMAND"),
 	}
 }
@@ -74,6 +74,7 @@ func TestSendRebootSignal_CreatesJob(t *testing.T) {
 	assert.Equal(t, "worker-1", job.Spec.Template.Spec.NodeName)
 	assert.Equal(t, "true", job.Labels[jobLabelKey])
 	assert.Equal(t, "worker-1", job.Labels[jobNodeLabelKey])
+	assert.Equal(t, "boot-id-abc", job.Labels[jobRequestLabelKey])
 	assert.Equal(t, corev1.RestartPolicyNever, job.Spec.Template.Spec.RestartPolicy)
 
 	require.Len(t, job.Spec.Template.Spec.Containers, 1)
@@ -328,12 +329,107 @@ func TestSendRebootSignal_JobCreationFailure(t *testing.T) {
 
 func TestBuildRebootJob_UsesGenerateName(t *testing.T) {
 	client := newTestClient()
-	job := client.buildRebootJob("worker-1")
+	job := client.buildRebootJob("worker-1", "boot-id-abc")
 
 	assert.Empty(t, job.Name)
 	assert.Equal(t, "reboot-worker-1-", job.GenerateName)
 }
 
+func TestSendReimageSignal_CreatesJob(t *testing.T) {
+	client := NewClientWithK8s(fake.NewSimpleClientset(), Config{
+		RebootImage:        "busybox:1.37",
+		RebootJobNamespace: "test-ns",
+		RebootJobTTL:       3600,
+		ReimageCommand:     "ipmitool chassis bootdev pxe && reboot",
+	})
+	ctx := context.Background()
+	node := newNode("worker-1", "boot-id-abc", true)
+
+	ref, err := client.SendReimageSignal(ctx, node)
+	require.NoError(t, err)
+	assert.Equal(t, model.ReimageSignalRequestRef("boot-id-abc"), ref)
+
+	jobs, err := client.k8sClient.BatchV1().Jobs("test-ns").List(ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, jobs.Items, 1)
+
+	job := jobs.Items[0]
+	assert.Equal(t, "reimage-worker-1-", job.GenerateName)
+	assert.Equal(t, "worker-1", job.Labels[jobNodeLabelKey])
+
+	require.Len(t, job.Spec.Template.Spec.Containers, 1)
+	assert.Equal(t, []string{"chroot", "/host", "sh", "-c", "ipmitool chassis bootdev pxe && reboot"},
+		job.Spec.Template.Spec.Containers[0].Command)
+}
+
+func TestSendReimageSignal_NotSupportedWithoutCommand(t *testing.T) {
+	client := newTestClient()
+	node := newNode("worker-1", "boot-id-abc", true)
+
+	_, err := client.SendReimageSignal(context.Background(), node)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, model.ErrNotSupported)
+}
+
+func TestGetInstanceStatus(t *testing.T) {
+	client := newTestClient()
+	ctx := context.Background()
+	node := newNode("worker-1", "boot-id-abc", true)
+
+	status, err := client.GetInstanceStatus(ctx, node)
+	require.NoError(t, err)
+	assert.Equal(t, "ready", status.ProviderState)
+	assert.False(t, status.UnderMaintenance)
+
+	_, err = client.SendRebootSignal(ctx, node)
+	require.NoError(t, err)
+
+	status, err = client.GetInstanceStatus(ctx, node)
+	require.NoError(t, err)
+	assert.True(t, status.UnderMaintenance)
+	assert.Empty(t, status.ScheduledEvents)
+}
+
+func TestCancelRequest_DeletesJobs(t *testing.T) {
+	client := newTestClient()
+	ctx := context.Background()
+	node := newNode("worker-1", "boot-id-abc", true)
+
+	ref, err := client.SendRebootSignal(ctx, node)
+	require.NoError(t, err)
+
+	require.NoError(t, client.CancelRequest(ctx, node, string(ref)))
+
+	jobs, err := client.k8sClient.BatchV1().Jobs("test-ns").List(ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, jobs.Items)
+
+	// Cancelling again finds nothing left to cancel
+	require.NoError(t, client.CancelRequest(ctx, node, string(ref)))
+}
+
+func TestCancelRequest_KeepsJobsOfOtherRequests(t *testing.T) {
+	client := newTestClient()
+	ctx := context.Background()
+
+	// The fake clientset does not generate names, so the Job of the earlier request is named explicitly
+	otherJob := client.buildRebootJob("worker-1", "boot-id-abc")
+	otherJob.Name = "reboot-worker-1-abc12"
+	_, err := client.k8sClient.BatchV1().Jobs("test-ns").Create(ctx, otherJob, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	node := newNode("worker-1", "boot-id-new", true)
+	ref, err := client.SendRebootSignal(ctx, node)
+	require.NoError(t, err)
+
+	require.NoError(t, client.CancelRequest(ctx, node, string(ref)))
+
+	jobs, err := client.k8sClient.BatchV1().Jobs("test-ns").List(ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, jobs.Items, 1)
+	assert.Equal(t, "boot-id-abc", jobs.Items[0].Labels[jobRequestLabelKey])
+}
+
 func TestLoadConfigFromEnv(t *testing.T) {
 	t.Run("defaults", func(t *testing.T) {
 		config := loadConfigFromEnv()
@@ -347,12 +443,14 @@ func TestLoadConfigFromEnv(t *testing.T) {
 		t.Setenv("GENERIC_REBOOT_JOB_NAMESPACE", "custom-ns")
 		t.Setenv("GENERIC_REBOOT_JOB_TTL", "7200")
 		t.Setenv("GENERIC_REBOOT_IMAGE_PULL_SECRETS", "secret-a, secret-b")
+		t.Setenv("GENERIC_REIMAGE_COMMAND", "/usr/local/bin/reprovision")
 
 		config := loadConfigFromEnv()
 		assert.Equal(t, "custom-image:latest", config.RebootImage)
 		assert.Equal(t, "custom-ns", config.RebootJobNamespace)
 		assert.Equal(t, int32(7200), config.RebootJobTTL)
 		assert.Equal(t, []string{"secret-a", "secret-b"}, config.RebootJobPullSecrets)
+		assert.Equal(t, "/usr/local/bin/reprovision", config.ReimageCommand)
 	})
 
 	t.Run("invalid TTL uses default", func(t *testing.T) {
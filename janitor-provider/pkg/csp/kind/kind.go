@@ -29,7 +29,10 @@ import (
 )
 
 var (
-	_ model.CSPClient = (*Client)(nil)
+	_ model.CSPClient            = (*Client)(nil)
+	_ model.InstanceStatusGetter = (*Client)(nil)
+	_ model.NodeReimager         = (*Client)(nil)
+	_ model.RequestCanceller     = (*Client)(nil)
 )
 
 // Client is the Kind implementation of the CSP Client interface.
@@ -64,20 +67,11 @@ func (c *Client) SendTerminateSignal(
 	ctx context.Context,
 	node corev1.Node,
 ) (model.TerminateNodeRequestRef, error) {
-	// Check if provider ID has the correct prefix
-	if !strings.HasPrefix(node.Spec.ProviderID, "kind://") {
-		return "", fmt.Errorf("invalid provider ID format: %s", node.Spec.ProviderID)
-	}
-
-	// Extract container name from provider ID
-	parts := strings.Split(node.Spec.ProviderID, "/")
-	if len(parts) < 5 {
-		return "", fmt.Errorf("invalid provider ID format: %s", node.Spec.ProviderID)
+	clusterName, containerName, err := parseKindProviderID(node.Spec.ProviderID)
+	if err != nil {
+		return "", err
 	}
 
-	containerName := parts[len(parts)-1]
-	clusterName := parts[3]
-
 	slog.InfoContext(ctx, "Attempting to terminate node", "node", node.Name, "container", containerName)
 
 	// Create a timeout context for docker operations
@@ -132,6 +126,53 @@ func (c *Client) SendTerminateSignal(
 	return model.TerminateNodeRequestRef(""), nil
 }
 
+// GetInstanceStatus reports the state of the docker container of a kind node. Kind has no maintenance events.
+func (c *Client) GetInstanceStatus(ctx context.Context, node corev1.Node) (model.InstanceStatus, error) {
+	_, containerName, err := parseKindProviderID(node.Spec.ProviderID)
+	if err != nil {
+		return model.InstanceStatus{}, err
+	}
+
+	dockerCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	// nolint:gosec // G204: Command args are derived from kubernetes API, not user input
+	cmd := exec.CommandContext(dockerCtx, "docker", "inspect", "--format", "{{.State.Status}}", containerName)
+
+	output, err := cmd.Output()
+	if err != nil {
+		if dockerCtx.Err() == context.DeadlineExceeded {
+			return model.InstanceStatus{}, fmt.Errorf("timeout while inspecting container: %w", err)
+		}
+
+		// docker inspect fails for containers which do not exist
+		slog.InfoContext(ctx, "Container not found, assuming deleted", "container", containerName, "error", err)
+
+		return model.InstanceStatus{ProviderState: "deleted"}, nil
+	}
+
+	return model.InstanceStatus{ProviderState: strings.TrimSpace(string(output))}, nil
+}
+
+// SendReimageSignal simulates reimaging a kind node
+func (c *Client) SendReimageSignal(ctx context.Context, node corev1.Node) (model.ReimageSignalRequestRef, error) {
+	// nolint:gosec // G404: Using weak random for simulation is acceptable
+	// wait some random time to simulate a real csp (very short for fast tests)
+	time.Sleep(time.Duration(3+rand.IntN(3)) * time.Second)
+
+	slog.InfoContext(ctx, "Simulated reimage of node", "node", node.Name)
+
+	return model.ReimageSignalRequestRef(""), nil
+}
+
+// CancelRequest simulates cancelling a request for a kind node. Simulated requests complete when they are sent, so
+// nothing is left to cancel.
+func (c *Client) CancelRequest(ctx context.Context, node corev1.Node, requestID string) error {
+	slog.InfoContext(ctx, "Simulated cancellation of request", "node", node.Name, "requestID", requestID)
+
+	return nil
+}
+
 func (c *Client) deleteAndVerifyContainer(
 	ctx, dockerCtx context.Context, containerName string,
 ) error {
@@ -176,3 +217,18 @@ func (c *Client) deleteAndVerifyContainer(
 
 	return nil
 }
+
+// parseKindProviderID returns the cluster and container names of a kind provider ID.
+// Example provider ID: kind://docker/kind/kind-worker
+func parseKindProviderID(providerID string) (string, string, error) {
+	if !strings.HasPrefix(providerID, "kind://") {
+		return "", "", fmt.Errorf("invalid provider ID format: %s", providerID)
+	}
+
+	parts := strings.Split(providerID, "/")
+	if len(parts) < 5 {
+		return "", "", fmt.Errorf("invalid provider ID format: %s", providerID)
+	}
+
+	return parts[3], parts[len(parts)-1], nil
+}
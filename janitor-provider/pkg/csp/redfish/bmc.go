@@ -0,0 +1,195 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redfish
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+const (
+	systemsPath = "/redfish/v1/Systems"
+
+	// maxErrorBodyBytes bounds how much of an error response is included in errors
+	maxErrorBodyBytes = 512
+)
+
+// bmc is a Redfish session against the BMC of a single node.
+type bmc struct {
+	httpClient *http.Client
+	baseURL    string
+	username   string
+	password   string
+}
+
+type collection struct {
+	Members []struct {
+		ID string `json:"@odata.id"`
+	} `json:"Members"`
+}
+
+type computerSystem struct {
+	PowerState string `json:"PowerState"`
+	Actions    struct {
+		Reset struct {
+			Target          string   `json:"target"`
+			AllowableValues []string `json:"ResetType@Redfish.AllowableValues"`
+		} `json:"#ComputerSystem.Reset"`
+	} `json:"Actions"`
+}
+
+type resetRequest struct {
+	ResetType string `json:"ResetType"`
+}
+
+// systemPath returns the path of the ComputerSystem resource, the first member of the Systems collection
+// when no system ID is given.
+func (b *bmc) systemPath(ctx context.Context, systemID string) (string, error) {
+	if systemID != "" {
+		return systemsPath + "/" + systemID, nil
+	}
+
+	var systems collection
+	if err := b.get(ctx, systemsPath, &systems); err != nil {
+		return "", err
+	}
+
+	if len(systems.Members) == 0 || systems.Members[0].ID == "" {
+		return "", fmt.Errorf("BMC %s reports no computer systems", b.baseURL)
+	}
+
+	return systems.Members[0].ID, nil
+}
+
+func (b *bmc) system(ctx context.Context, path string) (*computerSystem, error) {
+	var system computerSystem
+	if err := b.get(ctx, path, &system); err != nil {
+		return nil, err
+	}
+
+	return &system, nil
+}
+
+// reset posts the first of the preferred reset types that the system allows. A system that does not
+// advertise its allowable values is sent the first preferred type.
+func (b *bmc) reset(ctx context.Context, path string, system *computerSystem, preferred ...string) (string, error) {
+	resetType, err := selectResetType(system.Actions.Reset.AllowableValues, preferred)
+	if err != nil {
+		return "", fmt.Errorf("system %s: %w", path, err)
+	}
+
+	target := system.Actions.Reset.Target
+	if target == "" {
+		target = path + "/Actions/ComputerSystem.Reset"
+	}
+
+	if err := b.post(ctx, target, resetRequest{ResetType: resetType}); err != nil {
+		return "", err
+	}
+
+	return resetType, nil
+}
+
+func (b *bmc) get(ctx context.Context, path string, out any) error {
+	resp, err := b.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response of GET %s: %w", path, err)
+	}
+
+	return nil
+}
+
+func (b *bmc) post(ctx context.Context, path string, body any) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to encode request for POST %s: %w", path, err)
+	}
+
+	resp, err := b.do(ctx, http.MethodPost, path, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	return nil
+}
+
+// do sends the request and returns the response when its status is successful.
+func (b *bmc) do(ctx context.Context, method, path string, body io.Reader) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, b.baseURL+path, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request %s %s: %w", method, path, err)
+	}
+
+	req.SetBasicAuth(b.username, b.password)
+	req.Header.Set("Accept", "application/json")
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := b.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%s %s%s failed: %w", method, b.baseURL, path, err)
+	}
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		defer resp.Body.Close()
+
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodyBytes))
+
+		return nil, fmt.Errorf("%s %s%s returned %s: %s", method, b.baseURL, path, resp.Status,
+			strings.TrimSpace(string(message)))
+	}
+
+	return resp, nil
+}
+
+func selectResetType(allowed []string, preferred []string) (string, error) {
+	if len(allowed) == 0 {
+		return preferred[0], nil
+	}
+
+	for _, resetType := range preferred {
+		if slices.Contains(allowed, resetType) {
+			return resetType, nil
+		}
+	}
+
+	return "", fmt.Errorf("none of the reset types %v is allowed (allowed: %v)", preferred, allowed)
+}
+
+// baseURL returns the URL of the BMC address, which defaults to HTTPS when it has no scheme.
+func baseURL(address string) string {
+	address = strings.TrimSuffix(strings.TrimSpace(address), "/")
+	if !strings.Contains(address, "://") {
+		address = "https://" + address
+	}
+
+	return address
+}
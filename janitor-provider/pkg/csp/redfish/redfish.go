@@ -0,0 +1,448 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redfish
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	"github.com/nvidia/nvsentinel/janitor-provider/pkg/model"
+)
+
+const (
+	// BMCAddressAnnotation is the address of the node's BMC, e.g. "10.0.0.5" or "https://bmc-node-1:8443"
+	BMCAddressAnnotation = "redfish.nvsentinel.nvidia.com/bmc-address"
+	// CredentialsSecretAnnotation names the Secret holding the BMC credentials of the node, overriding the
+	// default credentials Secret
+	CredentialsSecretAnnotation = "redfish.nvsentinel.nvidia.com/credentials-secret"
+	// SystemIDAnnotation is the ID of the node's ComputerSystem; the first system of the BMC is used if unset
+	SystemIDAnnotation = "redfish.nvsentinel.nvidia.com/system-id"
+	// CredentialsSecretLabel must be set to "true" on every BMC credentials Secret, so that a node annotation
+	// cannot make the provider read any other Secret of the namespace
+	CredentialsSecretLabel = "redfish.nvsentinel.nvidia.com/bmc-credentials"
+
+	usernameKey = "username"
+	passwordKey = "password"
+
+	defaultResetType             = "PowerCycle"
+	defaultRequestTimeoutSeconds = 30
+
+	powerStateOff = "Off"
+)
+
+var _ model.CSPClient = (*Client)(nil)
+
+// Config holds the configuration for the Redfish provider.
+type Config struct {
+	// SecretNamespace is the namespace of the BMC credentials Secrets
+	SecretNamespace string
+	// DefaultCredentialsSecret is used for nodes without the credentials Secret annotation
+	DefaultCredentialsSecret string
+	// ResetType is the Redfish reset type used to reboot nodes, ForceRestart if the BMC does not allow it
+	ResetType          string
+	InsecureSkipVerify bool
+	CAFile             string
+	RequestTimeout     time.Duration
+	// AllowedBMCNetworks are the networks BMC addresses must resolve to. Credentials are never sent to
+	// addresses outside of them.
+	AllowedBMCNetworks []*net.IPNet
+}
+
+// Client is the Redfish bare-metal implementation of the CSP Client interface.
+// It resets nodes through the ComputerSystem.Reset action of their BMC.
+type Client struct {
+	k8sClient  kubernetes.Interface
+	httpClient *http.Client
+	config     Config
+}
+
+// NewClient creates a new Redfish provider client with an in-cluster Kubernetes client.
+func NewClient(ctx context.Context) (*Client, error) {
+	restConfig, err := rest.InClusterConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create in-cluster config: %w", err)
+	}
+
+	clientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kubernetes client: %w", err)
+	}
+
+	config := loadConfigFromEnv()
+	if config.SecretNamespace == "" {
+		return nil, fmt.Errorf("REDFISH_SECRET_NAMESPACE must be set for the redfish provider")
+	}
+
+	config.AllowedBMCNetworks, err = parseNetworks(os.Getenv("REDFISH_ALLOWED_BMC_CIDRS"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid REDFISH_ALLOWED_BMC_CIDRS: %w", err)
+	}
+
+	if len(config.AllowedBMCNetworks) == 0 {
+		return nil, fmt.Errorf("REDFISH_ALLOWED_BMC_CIDRS must be set for the redfish provider")
+	}
+
+	httpClient, err := newHTTPClient(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		k8sClient:  clientset,
+		httpClient: httpClient,
+		config:     config,
+	}, nil
+}
+
+// NewClientWithK8s creates a Redfish provider client with provided Kubernetes and HTTP clients (for testing).
+func NewClientWithK8s(k8sClient kubernetes.Interface, httpClient *http.Client, config Config) *Client {
+	if config.ResetType == "" {
+		config.ResetType = defaultResetType
+	}
+
+	return &Client{
+		k8sClient:  k8sClient,
+		httpClient: httpClient,
+		config:     config,
+	}
+}
+
+// SendRebootSignal power-cycles the node through its BMC, or powers it on if it is off.
+// Returns the node's pre-reboot bootID as the requestID.
+func (c *Client) SendRebootSignal(ctx context.Context, node corev1.Node) (model.ResetSignalRequestRef, error) {
+	preRebootBootID := node.Status.NodeInfo.BootID
+	if preRebootBootID == "" {
+		slog.ErrorContext(ctx, "Node has no bootID", "node", node.Name)
+		return "", fmt.Errorf("node %s has no bootID", node.Name)
+	}
+
+	b, path, system, err := c.nodeSystem(ctx, node)
+	if err != nil {
+		return "", err
+	}
+
+	preferred := []string{c.config.ResetType, "ForceRestart"}
+	if system.PowerState == powerStateOff {
+		preferred = []string{"On", "ForceOn"}
+	}
+
+	resetType, err := b.reset(ctx, path, system, preferred...)
+	if err != nil {
+		return "", fmt.Errorf("failed to reset node %s: %w", node.Name, err)
+	}
+
+	slog.InfoContext(ctx, "Reset sent to node BMC", "node", node.Name, "bmc", b.baseURL, "system", path,
+		"resetType", resetType, "powerState", system.PowerState, "bootID", preRebootBootID)
+
+	return model.ResetSignalRequestRef(preRebootBootID), nil
+}
+
+// IsNodeReady checks whether the node has rebooted by comparing the current bootID
+// with the pre-reboot bootID (passed as requestID). A node whose BMC reports it powered off is not ready;
+// when the BMC cannot be queried, the bootID and the Ready condition decide.
+func (c *Client) IsNodeReady(ctx context.Context, node corev1.Node, requestID string) (bool, error) {
+	preRebootBootID := requestID
+
+	if b, path, system, err := c.nodeSystem(ctx, node); err != nil {
+		slog.WarnContext(ctx, "Failed to query node power state, relying on node status", "node", node.Name,
+			"error", err)
+	} else if system.PowerState == powerStateOff {
+		slog.InfoContext(ctx, "Node is powered off", "node", node.Name, "bmc", b.baseURL, "system", path)
+		return false, nil
+	}
+
+	currentBootID := node.Status.NodeInfo.BootID
+	if currentBootID == preRebootBootID {
+		slog.InfoContext(ctx, "Node has not yet rebooted", "node", node.Name, "bootID", currentBootID)
+		return false, nil
+	}
+
+	if !isNodeReady(node) {
+		slog.InfoContext(ctx, "Node rebooted but not yet Ready", "node", node.Name,
+			"oldBootID", preRebootBootID, "newBootID", currentBootID)
+
+		return false, nil
+	}
+
+	slog.InfoContext(ctx, "Node rebooted and Ready", "node", node.Name,
+		"oldBootID", preRebootBootID, "newBootID", currentBootID)
+
+	return true, nil
+}
+
+// SendTerminateSignal powers the node off through its BMC. Bare-metal nodes cannot be deleted, so the
+// node stays powered off until it is powered on again, e.g. by a reboot.
+func (c *Client) SendTerminateSignal(ctx context.Context, node corev1.Node) (model.TerminateNodeRequestRef, error) {
+	b, path, system, err := c.nodeSystem(ctx, node)
+	if err != nil {
+		return "", err
+	}
+
+	if system.PowerState == powerStateOff {
+		slog.InfoContext(ctx, "Node is already powered off", "node", node.Name, "bmc", b.baseURL, "system", path)
+		return model.TerminateNodeRequestRef(path), nil
+	}
+
+	resetType, err := b.reset(ctx, path, system, "ForceOff")
+	if err != nil {
+		return "", fmt.Errorf("failed to power off node %s: %w", node.Name, err)
+	}
+
+	slog.InfoContext(ctx, "Power off sent to node BMC", "node", node.Name, "bmc", b.baseURL, "system", path,
+		"resetType", resetType)
+
+	return model.TerminateNodeRequestRef(path), nil
+}
+
+// nodeSystem connects to the BMC of the node and reads its ComputerSystem.
+func (c *Client) nodeSystem(ctx context.Context, node corev1.Node) (*bmc, string, *computerSystem, error) {
+	b, err := c.nodeBMC(ctx, node)
+	if err != nil {
+		return nil, "", nil, err
+	}
+
+	path, err := b.systemPath(ctx, node.Annotations[SystemIDAnnotation])
+	if err != nil {
+		return nil, "", nil, fmt.Errorf("failed to find computer system of node %s: %w", node.Name, err)
+	}
+
+	system, err := b.system(ctx, path)
+	if err != nil {
+		return nil, "", nil, fmt.Errorf("failed to read computer system of node %s: %w", node.Name, err)
+	}
+
+	return b, path, system, nil
+}
+
+// nodeBMC resolves the BMC address of the node from its annotations and the credentials from its Secret.
+// The address must be within the allowed BMC networks and the Secret must carry the credentials label.
+func (c *Client) nodeBMC(ctx context.Context, node corev1.Node) (*bmc, error) {
+	address := node.Annotations[BMCAddressAnnotation]
+	if address == "" {
+		return nil, fmt.Errorf("node %s has no %s annotation", node.Name, BMCAddressAnnotation)
+	}
+
+	if err := c.checkBMCAddress(ctx, address); err != nil {
+		return nil, fmt.Errorf("rejected BMC address of node %s: %w", node.Name, err)
+	}
+
+	secretName := node.Annotations[CredentialsSecretAnnotation]
+	if secretName == "" {
+		secretName = c.config.DefaultCredentialsSecret
+	}
+
+	if secretName == "" {
+		return nil, fmt.Errorf("node %s has no %s annotation and no default credentials secret is configured",
+			node.Name, CredentialsSecretAnnotation)
+	}
+
+	secret, err := c.k8sClient.CoreV1().Secrets(c.config.SecretNamespace).Get(ctx, secretName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get BMC credentials secret %s/%s for node %s: %w",
+			c.config.SecretNamespace, secretName, node.Name, err)
+	}
+
+	if secret.Labels[CredentialsSecretLabel] != "true" {
+		return nil, fmt.Errorf("secret %s/%s for node %s is not labeled %s=true",
+			c.config.SecretNamespace, secretName, node.Name, CredentialsSecretLabel)
+	}
+
+	username := string(secret.Data[usernameKey])
+	password := string(secret.Data[passwordKey])
+
+	if username == "" || password == "" {
+		return nil, fmt.Errorf("BMC credentials secret %s/%s must set %q and %q",
+			c.config.SecretNamespace, secretName, usernameKey, passwordKey)
+	}
+
+	return &bmc{
+		httpClient: c.httpClient,
+		baseURL:    baseURL(address),
+		username:   username,
+		password:   password,
+	}, nil
+}
+
+// checkBMCAddress checks that the BMC address uses HTTP(S) and that every address its host resolves to is
+// within the allowed BMC networks.
+func (c *Client) checkBMCAddress(ctx context.Context, address string) error {
+	u, err := url.Parse(baseURL(address))
+	if err != nil {
+		return fmt.Errorf("invalid BMC address %q: %w", address, err)
+	}
+
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return fmt.Errorf("BMC address %q must use https or http", address)
+	}
+
+	ips, err := net.DefaultResolver.LookupIPAddr(ctx, u.Hostname())
+	if err != nil {
+		return fmt.Errorf("failed to resolve BMC address %q: %w", address, err)
+	}
+
+	for _, ip := range ips {
+		if !allowedIP(c.config.AllowedBMCNetworks, ip.IP) {
+			return fmt.Errorf("BMC address %q resolves to %s, outside the allowed BMC networks", address, ip.IP)
+		}
+	}
+
+	return nil
+}
+
+func allowedIP(networks []*net.IPNet, ip net.IP) bool {
+	for _, network := range networks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseNetworks parses a comma-separated list of CIDRs
+func parseNetworks(value string) ([]*net.IPNet, error) {
+	var networks []*net.IPNet
+
+	for _, cidr := range strings.Split(value, ",") {
+		cidr = strings.TrimSpace(cidr)
+		if cidr == "" {
+			continue
+		}
+
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, err
+		}
+
+		networks = append(networks, network)
+	}
+
+	return networks, nil
+}
+
+func newHTTPClient(config Config) (*http.Client, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: config.InsecureSkipVerify, //nolint:gosec // Opt-in for BMCs with self-signed certificates
+	}
+
+	if config.CAFile != "" {
+		caCert, err := os.ReadFile(config.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read REDFISH_CA_FILE %s: %w", config.CAFile, err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("no certificates found in REDFISH_CA_FILE %s", config.CAFile)
+		}
+
+		tlsConfig.RootCAs = pool
+	}
+
+	// Connections are checked against the allowed BMC networks again when they are made, so that a BMC
+	// hostname cannot resolve to another address after it was checked. BMCs are reached without a proxy.
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+
+			if !allowedIP(config.AllowedBMCNetworks, net.ParseIP(host)) {
+				return fmt.Errorf("BMC address %s is outside the allowed BMC networks", host)
+			}
+
+			return nil
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Transport: transport,
+		Timeout:   config.RequestTimeout,
+	}, nil
+}
+
+func isNodeReady(node corev1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+
+	return false
+}
+
+func loadConfigFromEnv() Config {
+	resetType := os.Getenv("REDFISH_RESET_TYPE")
+	if resetType == "" {
+		resetType = defaultResetType
+	}
+
+	insecureSkipVerify := false
+
+	if value := os.Getenv("REDFISH_INSECURE_SKIP_VERIFY"); value != "" {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			slog.Warn("Invalid REDFISH_INSECURE_SKIP_VERIFY, using default", "value", value, "default", false)
+		} else {
+			insecureSkipVerify = parsed
+		}
+	}
+
+	timeout := defaultRequestTimeoutSeconds * time.Second
+
+	if value := os.Getenv("REDFISH_REQUEST_TIMEOUT_SECONDS"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed <= 0 {
+			slog.Warn("Invalid REDFISH_REQUEST_TIMEOUT_SECONDS, using default", "value", value,
+				"default", defaultRequestTimeoutSeconds)
+		} else {
+			timeout = time.Duration(parsed) * time.Second
+		}
+	}
+
+	return Config{
+		SecretNamespace:          os.Getenv("REDFISH_SECRET_NAMESPACE"),
+		DefaultCredentialsSecret: os.Getenv("REDFISH_DEFAULT_CREDENTIALS_SECRET"),
+		ResetType:                resetType,
+		InsecureSkipVerify:       insecureSkipVerify,
+		CAFile:                   os.Getenv("REDFISH_CA_FILE"),
+		RequestTimeout:           timeout,
+	}
+}
@@ -0,0 +1,423 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redfish
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/nvidia/nvsentinel/janitor-provider/pkg/model"
+)
+
+const (
+	testNamespace = "test-ns"
+	testUsername  = "admin"
+	testPassword  = "secret"
+)
+
+// fakeBMC is an in-process Redfish service exposing a single computer system.
+type fakeBMC struct {
+	mu              sync.Mutex
+	server          *httptest.Server
+	powerState      string
+	allowableValues []string
+	resets          []string
+}
+
+func newFakeBMC(t *testing.T) *fakeBMC {
+	t.Helper()
+
+	f := &fakeBMC{
+		powerState:      "On",
+		allowableValues: []string{"On", "ForceOff", "ForceRestart", "PowerCycle"},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /redfish/v1/Systems", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, map[string]any{
+			"Members": []map[string]string{{"@odata.id": "/redfish/v1/Systems/1"}},
+		})
+	})
+	mux.HandleFunc("GET /redfish/v1/Systems/1", func(w http.ResponseWriter, _ *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		writeJSON(w, map[string]any{
+			"PowerState": f.powerState,
+			"Actions": map[string]any{
+				"#ComputerSystem.Reset": map[string]any{
+					"target":                            "/redfish/v1/Systems/1/Actions/ComputerSystem.Reset",
+					"ResetType@Redfish.AllowableValues": f.allowableValues,
+				},
+			},
+		})
+	})
+	mux.HandleFunc("POST /redfish/v1/Systems/1/Actions/ComputerSystem.Reset",
+		func(w http.ResponseWriter, r *http.Request) {
+			var req resetRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			f.mu.Lock()
+			defer f.mu.Unlock()
+
+			f.resets = append(f.resets, req.ResetType)
+
+			if req.ResetType == "ForceOff" {
+				f.powerState = "Off"
+			}
+
+			w.WriteHeader(http.StatusNoContent)
+		})
+
+	f.server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		if !ok || username != testUsername || password != testPassword {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(f.server.Close)
+
+	return f
+}
+
+func (f *fakeBMC) setPowerState(state string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.powerState = state
+}
+
+func (f *fakeBMC) resetTypes() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]string(nil), f.resets...)
+}
+
+func writeJSON(w http.ResponseWriter, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func newSecret(name, username, password string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: testNamespace,
+			Labels:    map[string]string{CredentialsSecretLabel: "true"},
+		},
+		Data: map[string][]byte{
+			usernameKey: []byte(username),
+			passwordKey: []byte(password),
+		},
+	}
+}
+
+func newTestClient(f *fakeBMC) *Client {
+	return NewClientWithK8s(
+		fake.NewSimpleClientset(newSecret("bmc-default", testUsername, testPassword)),
+		f.server.Client(),
+		Config{
+			SecretNamespace:          testNamespace,
+			DefaultCredentialsSecret: "bmc-default",
+			AllowedBMCNetworks:       loopbackNetworks(),
+		},
+	)
+}
+
+func loopbackNetworks() []*net.IPNet {
+	_, loopback, _ := net.ParseCIDR("127.0.0.0/8")
+	return []*net.IPNet{loopback}
+}
+
+func newNode(name, bootID string, ready bool, address string) corev1.Node {
+	status := corev1.ConditionFalse
+	if ready {
+		status = corev1.ConditionTrue
+	}
+
+	return corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Annotations: map[string]string{BMCAddressAnnotation: address},
+		},
+		Status: corev1.NodeStatus{
+			NodeInfo: corev1.NodeSystemInfo{BootID: bootID},
+			Conditions: []corev1.NodeCondition{
+				{Type: corev1.NodeReady, Status: status},
+			},
+		},
+	}
+}
+
+func TestSendRebootSignal_PowerCycles(t *testing.T) {
+	f := newFakeBMC(t)
+	client := newTestClient(f)
+
+	ref, err := client.SendRebootSignal(context.Background(), newNode("worker-1", "boot-id-abc", true, f.server.URL))
+	require.NoError(t, err)
+	assert.Equal(t, model.ResetSignalRequestRef("boot-id-abc"), ref)
+	assert.Equal(t, []string{"PowerCycle"}, f.resetTypes())
+}
+
+func TestSendRebootSignal_FallsBackToForceRestart(t *testing.T) {
+	f := newFakeBMC(t)
+	f.allowableValues = []string{"On", "ForceOff", "ForceRestart"}
+	client := newTestClient(f)
+
+	_, err := client.SendRebootSignal(context.Background(), newNode("worker-1", "boot-id-abc", true, f.server.URL))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ForceRestart"}, f.resetTypes())
+}
+
+func TestSendRebootSignal_PowersOnOffNode(t *testing.T) {
+	f := newFakeBMC(t)
+	f.setPowerState("Off")
+	client := newTestClient(f)
+
+	_, err := client.SendRebootSignal(context.Background(), newNode("worker-1", "boot-id-abc", false, f.server.URL))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"On"}, f.resetTypes())
+}
+
+func TestSendRebootSignal_NoAllowedResetType(t *testing.T) {
+	f := newFakeBMC(t)
+	f.allowableValues = []string{"On", "ForceOff"}
+	client := newTestClient(f)
+
+	_, err := client.SendRebootSignal(context.Background(), newNode("worker-1", "boot-id-abc", true, f.server.URL))
+	require.Error(t, err)
+	assert.Empty(t, f.resetTypes())
+}
+
+func TestSendRebootSignal_Errors(t *testing.T) {
+	f := newFakeBMC(t)
+
+	tests := []struct {
+		name   string
+		mutate func(node *corev1.Node, client *Client)
+	}{
+		{
+			name: "no bootID",
+			mutate: func(node *corev1.Node, _ *Client) {
+				node.Status.NodeInfo.BootID = ""
+			},
+		},
+		{
+			name: "no BMC address",
+			mutate: func(node *corev1.Node, _ *Client) {
+				delete(node.Annotations, BMCAddressAnnotation)
+			},
+		},
+		{
+			name: "no credentials secret",
+			mutate: func(_ *corev1.Node, client *Client) {
+				client.config.DefaultCredentialsSecret = ""
+			},
+		},
+		{
+			name: "missing credentials secret",
+			mutate: func(node *corev1.Node, _ *Client) {
+				node.Annotations[CredentialsSecretAnnotation] = "missing"
+			},
+		},
+		{
+			name: "wrong credentials",
+			mutate: func(node *corev1.Node, client *Client) {
+				_, err := client.k8sClient.CoreV1().Secrets(testNamespace).Create(context.Background(),
+					newSecret("bmc-wrong", testUsername, "wrong"), metav1.CreateOptions{})
+				require.NoError(t, err)
+
+				node.Annotations[CredentialsSecretAnnotation] = "bmc-wrong"
+			},
+		},
+		{
+			name: "BMC address outside the allowed networks",
+			mutate: func(node *corev1.Node, _ *Client) {
+				node.Annotations[BMCAddressAnnotation] = "https://10.0.0.5"
+			},
+		},
+		{
+			name: "BMC address with another scheme",
+			mutate: func(node *corev1.Node, _ *Client) {
+				node.Annotations[BMCAddressAnnotation] = "ftp://127.0.0.1"
+			},
+		},
+		{
+			name: "credentials secret without the credentials label",
+			mutate: func(node *corev1.Node, client *Client) {
+				secret := newSecret("bmc-unlabeled", testUsername, testPassword)
+				secret.Labels = nil
+
+				_, err := client.k8sClient.CoreV1().Secrets(testNamespace).Create(context.Background(),
+					secret, metav1.CreateOptions{})
+				require.NoError(t, err)
+
+				node.Annotations[CredentialsSecretAnnotation] = "bmc-unlabeled"
+			},
+		},
+		{
+			name: "unknown system",
+			mutate: func(node *corev1.Node, _ *Client) {
+				node.Annotations[SystemIDAnnotation] = "2"
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(f)
+			node := newNode("worker-1", "boot-id-abc", true, f.server.URL)
+			tt.mutate(&node, client)
+
+			_, err := client.SendRebootSignal(context.Background(), node)
+			require.Error(t, err)
+		})
+	}
+
+	assert.Empty(t, f.resetTypes())
+}
+
+func TestSendRebootSignal_NodeCredentialsSecret(t *testing.T) {
+	f := newFakeBMC(t)
+	client := NewClientWithK8s(
+		fake.NewSimpleClientset(newSecret("bmc-worker-1", testUsername, testPassword)),
+		f.server.Client(),
+		Config{SecretNamespace: testNamespace, AllowedBMCNetworks: loopbackNetworks()},
+	)
+
+	node := newNode("worker-1", "boot-id-abc", true, f.server.URL)
+	node.Annotations[CredentialsSecretAnnotation] = "bmc-worker-1"
+	node.Annotations[SystemIDAnnotation] = "1"
+
+	_, err := client.SendRebootSignal(context.Background(), node)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"PowerCycle"}, f.resetTypes())
+}
+
+func TestIsNodeReady(t *testing.T) {
+	tests := []struct {
+		name       string
+		powerState string
+		bootID     string
+		ready      bool
+		address    string
+		expected   bool
+	}{
+		{name: "powered off", powerState: "Off", bootID: "new-boot", ready: true, expected: false},
+		{name: "same bootID", powerState: "On", bootID: "old-boot", ready: true, expected: false},
+		{name: "rebooted but not ready", powerState: "On", bootID: "new-boot", ready: false, expected: false},
+		{name: "rebooted and ready", powerState: "On", bootID: "new-boot", ready: true, expected: true},
+		{name: "BMC unreachable", bootID: "new-boot", ready: true, address: "127.0.0.1:1", expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFakeBMC(t)
+			f.setPowerState(tt.powerState)
+			client := newTestClient(f)
+
+			address := f.server.URL
+			if tt.address != "" {
+				address = tt.address
+			}
+
+			ready, err := client.IsNodeReady(context.Background(), newNode("worker-1", tt.bootID, tt.ready, address),
+				"old-boot")
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, ready)
+		})
+	}
+}
+
+func TestSendTerminateSignal_PowersOff(t *testing.T) {
+	f := newFakeBMC(t)
+	client := newTestClient(f)
+	node := newNode("worker-1", "boot-id-abc", true, f.server.URL)
+
+	ref, err := client.SendTerminateSignal(context.Background(), node)
+	require.NoError(t, err)
+	assert.Equal(t, model.TerminateNodeRequestRef("/redfish/v1/Systems/1"), ref)
+	assert.Equal(t, []string{"ForceOff"}, f.resetTypes())
+
+	// A node that is already off is not reset again
+	_, err = client.SendTerminateSignal(context.Background(), node)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ForceOff"}, f.resetTypes())
+}
+
+func TestBaseURL(t *testing.T) {
+	assert.Equal(t, "https://10.0.0.5", baseURL("10.0.0.5"))
+	assert.Equal(t, "https://bmc-1:8443", baseURL(" https://bmc-1:8443/ "))
+	assert.Equal(t, "http://bmc-1", baseURL("http://bmc-1"))
+}
+
+func TestNewHTTPClient_RejectsConnectionsOutsideAllowedNetworks(t *testing.T) {
+	f := newFakeBMC(t)
+
+	_, documentation, _ := net.ParseCIDR("192.0.2.0/24")
+
+	client, err := newHTTPClient(Config{AllowedBMCNetworks: []*net.IPNet{documentation}})
+	require.NoError(t, err)
+
+	_, err = client.Get(f.server.URL + "/redfish/v1/Systems")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "outside the allowed BMC networks")
+}
+
+func TestParseNetworks(t *testing.T) {
+	networks, err := parseNetworks(" 10.0.0.0/16, ,fd00::/8")
+	require.NoError(t, err)
+	require.Len(t, networks, 2)
+	assert.True(t, allowedIP(networks, net.ParseIP("10.0.3.4")))
+	assert.True(t, allowedIP(networks, net.ParseIP("fd00::5")))
+	assert.False(t, allowedIP(networks, net.ParseIP("10.1.0.1")))
+
+	_, err = parseNetworks("10.0.0.5")
+	require.Error(t, err)
+}
+
+func TestLoadConfigFromEnv(t *testing.T) {
+	t.Setenv("REDFISH_SECRET_NAMESPACE", "bmc-secrets")
+	t.Setenv("REDFISH_DEFAULT_CREDENTIALS_SECRET", "bmc-default")
+	t.Setenv("REDFISH_RESET_TYPE", "ForceRestart")
+	t.Setenv("REDFISH_INSECURE_SKIP_VERIFY", "true")
+	t.Setenv("REDFISH_REQUEST_TIMEOUT_SECONDS", "invalid")
+
+	config := loadConfigFromEnv()
+	assert.Equal(t, "bmc-secrets", config.SecretNamespace)
+	assert.Equal(t, "bmc-default", config.DefaultCredentialsSecret)
+	assert.Equal(t, "ForceRestart", config.ResetType)
+	assert.True(t, config.InsecureSkipVerify)
+	assert.Equal(t, defaultRequestTimeoutSeconds*time.Second, config.RequestTimeout)
+}
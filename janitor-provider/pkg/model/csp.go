@@ -16,10 +16,15 @@ package model
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 )
 
+// ErrNotSupported is returned by CSP clients for operations which the provider cannot perform for a node
+var ErrNotSupported = errors.New("operation not supported by the CSP provider")
+
 // ResetSignalRequestRef represents a reference to a reboot/reset signal request
 type ResetSignalRequestRef string
 
@@ -38,3 +43,70 @@ type CSPClient interface {
 	// SendTerminateSignal sends a termination signal to the node via the CSP
 	SendTerminateSignal(ctx context.Context, node corev1.Node) (TerminateNodeRequestRef, error)
 }
+
+// ReplacementRequestRef represents a reference to a replacement node request
+type ReplacementRequestRef string
+
+// NodeReplacer is implemented by CSP clients which can add a replacement for a node before the node is terminated,
+// for example by scaling up its node group
+type NodeReplacer interface {
+	// RequestReplacementNode requests a node replacing the node
+	RequestReplacementNode(ctx context.Context, node corev1.Node) (ReplacementRequestRef, error)
+
+	// GetReplacementNode returns the name of the node added by the request, or an empty string while it has not
+	// registered. requestID is the reference returned by RequestReplacementNode.
+	GetReplacementNode(ctx context.Context, node corev1.Node, requestID string) (string, error)
+}
+
+// InstanceStatus is the state of the instance of a node as reported by the CSP
+type InstanceStatus struct {
+	// ProviderState is the instance state of the CSP, for example "running" or "stopping"
+	ProviderState string
+
+	// UnderMaintenance indicates whether the CSP is performing maintenance on the instance
+	UnderMaintenance bool
+
+	// ScheduledEvents lists the maintenance events the CSP scheduled for the instance
+	ScheduledEvents []ScheduledEvent
+}
+
+// ScheduledEvent is a maintenance event scheduled by the CSP for an instance
+type ScheduledEvent struct {
+	// ID identifies the event at the CSP
+	ID string
+
+	// Type is the event type of the CSP, for example "system-reboot"
+	Type string
+
+	// Description is the description of the event by the CSP
+	Description string
+
+	// NotBefore is the earliest start of the event, zero if unknown
+	NotBefore time.Time
+
+	// NotAfter is the latest end of the event, zero if unknown
+	NotAfter time.Time
+}
+
+// InstanceStatusGetter is implemented by CSP clients which can report the state of the instance of a node
+type InstanceStatusGetter interface {
+	// GetInstanceStatus returns the state and the scheduled maintenance events of the instance of the node
+	GetInstanceStatus(ctx context.Context, node corev1.Node) (InstanceStatus, error)
+}
+
+// ReimageSignalRequestRef represents a reference to a reimage signal request
+type ReimageSignalRequestRef string
+
+// NodeReimager is implemented by CSP clients which can restore the boot disk of a node to its original image
+type NodeReimager interface {
+	// SendReimageSignal reimages the node. The node reboots with the restored boot disk and IsNodeReady reports when
+	// it is ready, given the returned reference.
+	SendReimageSignal(ctx context.Context, node corev1.Node) (ReimageSignalRequestRef, error)
+}
+
+// RequestCanceller is implemented by CSP clients which can withdraw requests they have not acted on yet
+type RequestCanceller interface {
+	// CancelRequest withdraws the request of the node. requestID is the reference returned when the request was
+	// sent. Returns nil if nothing is left to cancel.
+	CancelRequest(ctx context.Context, node corev1.Node, requestID string) error
+}
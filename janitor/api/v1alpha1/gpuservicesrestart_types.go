@@ -0,0 +1,118 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GPUServicesRestart condition types
+const (
+	// GPUServicesRestartConditionServicesTornDown indicates whether the managed GPU services were removed from the node
+	GPUServicesRestartConditionServicesTornDown = "ServicesTornDown"
+	// GPUServicesRestartConditionDriverReloaded indicates whether the NVIDIA kernel modules were reloaded
+	GPUServicesRestartConditionDriverReloaded = "DriverReloaded"
+	// GPUServicesRestartConditionServicesRestored indicates whether the managed GPU services are ready again
+	GPUServicesRestartConditionServicesRestored = "ServicesRestored"
+	// GPUServicesRestartConditionComplete is set once the restart finished: True if it succeeded, False with the
+	// reason of the first failed step otherwise
+	GPUServicesRestartConditionComplete = "Complete"
+)
+
+// GPUServicesRestart condition reasons
+const (
+	// In-progress reasons
+	GPUServicesRestartReasonTearingDownServices = "TearingDownServices"
+	GPUServicesRestartReasonReloadingDriver     = "ReloadingDriver"
+	GPUServicesRestartReasonRestoringServices   = "RestoringServices"
+
+	// Success reasons
+	GPUServicesRestartReasonSkipped                  = "Skipped"
+	GPUServicesRestartReasonServiceTeardownSucceeded = "ServiceTeardownSucceeded"
+	GPUServicesRestartReasonDriverReloadSucceeded    = "DriverReloadSucceeded"
+	GPUServicesRestartReasonServiceRestoreSucceeded  = "ServiceRestoreSucceeded"
+	GPUServicesRestartReasonSucceeded                = "GPUServicesRestartSucceeded"
+
+	// Failure reasons
+	GPUServicesRestartReasonNodeNotFound                   = "NodeNotFound"
+	GPUServicesRestartReasonServiceTeardownTimeoutExceeded = "ServiceTeardownTimeoutExceeded"
+	GPUServicesRestartReasonDriverReloadNotConfigured      = "DriverReloadNotConfigured"
+	GPUServicesRestartReasonDriverReloadJobNotFound        = "DriverReloadJobNotFound"
+	GPUServicesRestartReasonDriverReloadFailed             = "DriverReloadFailed"
+	GPUServicesRestartReasonRestoreTimeoutExceeded         = "RestoreTimeoutExceeded"
+)
+
+// GPUServicesRestartSpec defines the desired state of GPUServicesRestart
+type GPUServicesRestartSpec struct {
+	// NodeName is the name of the node whose GPU services are restarted
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	NodeName string `json:"nodeName"`
+
+	// ReloadDriver indicates whether to reload the NVIDIA kernel modules with a job on the node while the GPU
+	// services are torn down. Requires the driver reload job to be configured in janitor.
+	// +kubebuilder:default:=false
+	// +optional
+	ReloadDriver bool `json:"reloadDriver,omitempty"`
+}
+
+// GPUServicesRestartStatus defines the observed state of GPUServicesRestart
+type GPUServicesRestartStatus struct {
+	// StartTime is the time when the restart was initiated
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime is the time when the restart finished, regardless of the outcome
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Conditions represent the latest available observations of an object's current state.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// JobRef is a reference to the job reloading the NVIDIA kernel modules, if requested
+	// +optional
+	JobRef *v1.ObjectReference `json:"jobRef,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="Node",type="string",JSONPath=".spec.nodeName"
+// +kubebuilder:printcolumn:name="ReloadDriver",type="boolean",JSONPath=".spec.reloadDriver"
+// +kubebuilder:printcolumn:name="Result",type="string",JSONPath=".status.conditions[?(@.type=='Complete')].reason"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// GPUServicesRestart is the Schema for the gpuservicesrestarts API. It restarts the GPU software stack of a node,
+// tearing down and restoring the managed GPU services and optionally reloading the NVIDIA kernel modules, without
+// rebooting the node.
+type GPUServicesRestart struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GPUServicesRestartSpec   `json:"spec,omitempty"`
+	Status GPUServicesRestartStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GPUServicesRestartList contains a list of GPUServicesRestart
+type GPUServicesRestartList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GPUServicesRestart `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&GPUServicesRestart{}, &GPUServicesRestartList{})
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// PendingApprovalConditionType indicates whether a maintenance resource of a controller in manual mode is waiting
+	// for approval. It is True while waiting and False once the maintenance was approved.
+	PendingApprovalConditionType = "PendingApproval"
+)
+
+// Kinds of the maintenance resources covered by the approval workflow
+const (
+	RebootNodeKind         = "RebootNode"
+	TerminateNodeKind      = "TerminateNode"
+	GPUResetKind           = "GPUReset"
+	GPUServicesRestartKind = "GPUServicesRestart"
+	ReimageNodeKind        = "ReimageNode"
+)
+
+// PendingApproval condition reasons
+const (
+	// AwaitingApprovalReason indicates that neither an approval nor an auto-approve policy matches the maintenance
+	AwaitingApprovalReason = "AwaitingApproval"
+	// ApprovedReason indicates that the maintenance was approved by a MaintenanceApproval
+	ApprovedReason = "Approved"
+	// AutoApprovedReason indicates that the maintenance was approved by an auto-approve policy
+	AutoApprovedReason = "AutoApproved"
+)
+
+// MaintenanceApprovalSpec defines the maintenance approved by a MaintenanceApproval
+// +kubebuilder:validation:XValidation:rule="has(self.name)||has(self.nodeName)",message="name or nodeName is required"
+type MaintenanceApprovalSpec struct {
+	// Kind is the kind of the approved maintenance resources
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=RebootNode;TerminateNode;GPUReset;GPUServicesRestart;ReimageNode
+	Kind string `json:"kind"`
+
+	// Name approves the maintenance resource of the given kind with this name
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// NodeName approves all maintenance resources of the given kind targeting this node
+	// +optional
+	NodeName string `json:"nodeName,omitempty"`
+
+	// ExpiresAt is the time after which the approval no longer approves maintenance. Maintenance approved before
+	// this time stays approved. Defaults to the approval TTL configured in janitor, if any.
+	// +optional
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+
+	// Reason is a free-form explanation of the approval
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// ApprovedBy is the user who created the approval. It is set by the janitor admission webhook and any value
+	// provided on creation is overwritten.
+	// +optional
+	ApprovedBy string `json:"approvedBy,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="Kind",type="string",JSONPath=".spec.kind"
+// +kubebuilder:printcolumn:name="Target",type="string",JSONPath=".spec.name"
+// +kubebuilder:printcolumn:name="Node",type="string",JSONPath=".spec.nodeName"
+// +kubebuilder:printcolumn:name="ApprovedBy",type="string",JSONPath=".spec.approvedBy"
+// +kubebuilder:printcolumn:name="ExpiresAt",type="date",JSONPath=".spec.expiresAt"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// MaintenanceApproval is the Schema for the maintenanceapprovals API. It approves maintenance resources waiting in
+// the PendingApproval condition, either by name or for every resource of a kind targeting a node.
+type MaintenanceApproval struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec MaintenanceApprovalSpec `json:"spec,omitempty"`
+}
+
+// Matches returns true if the approval covers the maintenance resource of the given kind and name on the node.
+func (a *MaintenanceApproval) Matches(kind, name, nodeName string) bool {
+	if a.Spec.Kind != kind {
+		return false
+	}
+
+	if a.Spec.Name != "" && a.Spec.Name != name {
+		return false
+	}
+
+	if a.Spec.NodeName != "" && a.Spec.NodeName != nodeName {
+		return false
+	}
+
+	return a.Spec.Name != "" || a.Spec.NodeName != ""
+}
+
+// IsExpired returns true if the approval expired at the given time.
+func (a *MaintenanceApproval) IsExpired(now metav1.Time) bool {
+	return a.Spec.ExpiresAt != nil && !now.Before(a.Spec.ExpiresAt)
+}
+
+// +kubebuilder:object:root=true
+
+// MaintenanceApprovalList contains a list of MaintenanceApproval
+type MaintenanceApprovalList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MaintenanceApproval `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&MaintenanceApproval{}, &MaintenanceApprovalList{})
+}
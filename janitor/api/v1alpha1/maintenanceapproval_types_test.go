@@ -0,0 +1,80 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestMaintenanceApproval_Matches(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     MaintenanceApprovalSpec
+		expected bool
+	}{
+		{
+			name:     "matching name",
+			spec:     MaintenanceApprovalSpec{Kind: RebootNodeKind, Name: "reboot-1"},
+			expected: true,
+		},
+		{
+			name:     "matching node",
+			spec:     MaintenanceApprovalSpec{Kind: RebootNodeKind, NodeName: "node-1"},
+			expected: true,
+		},
+		{
+			name:     "matching name and node",
+			spec:     MaintenanceApprovalSpec{Kind: RebootNodeKind, Name: "reboot-1", NodeName: "node-1"},
+			expected: true,
+		},
+		{
+			name:     "different kind",
+			spec:     MaintenanceApprovalSpec{Kind: TerminateNodeKind, NodeName: "node-1"},
+			expected: false,
+		},
+		{
+			name:     "matching name on a different node",
+			spec:     MaintenanceApprovalSpec{Kind: RebootNodeKind, Name: "reboot-1", NodeName: "node-2"},
+			expected: false,
+		},
+		{
+			name:     "neither name nor node",
+			spec:     MaintenanceApprovalSpec{Kind: RebootNodeKind},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			approval := &MaintenanceApproval{Spec: tt.spec}
+			assert.Equal(t, tt.expected, approval.Matches(RebootNodeKind, "reboot-1", "node-1"))
+		})
+	}
+}
+
+func TestMaintenanceApproval_IsExpired(t *testing.T) {
+	now := metav1.Now()
+	past := metav1.NewTime(now.Add(-time.Minute))
+	future := metav1.NewTime(now.Add(time.Minute))
+
+	assert.False(t, (&MaintenanceApproval{}).IsExpired(now))
+	assert.True(t, (&MaintenanceApproval{Spec: MaintenanceApprovalSpec{ExpiresAt: &past}}).IsExpired(now))
+	assert.True(t, (&MaintenanceApproval{Spec: MaintenanceApprovalSpec{ExpiresAt: &now}}).IsExpired(now))
+	assert.False(t, (&MaintenanceApproval{Spec: MaintenanceApprovalSpec{ExpiresAt: &future}}).IsExpired(now))
+}
@@ -26,6 +26,17 @@ const (
 	RebootNodeConditionNodeReady = "NodeReady"
 	// ManualModeConditionType indicates that manual mode is enabled and outside actor is required
 	ManualModeConditionType = "ManualMode"
+	// WaitingForExclusionGroupConditionType indicates that the maintenance is waiting for other nodes in the same
+	// exclusion group (for example the same NVLink domain or rack) to finish their maintenance
+	WaitingForExclusionGroupConditionType = "WaitingForExclusionGroup"
+)
+
+// WaitingForExclusionGroup condition reasons
+const (
+	// ExclusionGroupFullReason indicates that an exclusion group of the node has no free maintenance slot
+	ExclusionGroupFullReason = "ExclusionGroupFull"
+	// ExclusionGroupsAcquiredReason indicates that the maintenance holds a slot in every exclusion group of the node
+	ExclusionGroupsAcquiredReason = "ExclusionGroupsAcquired"
 )
 
 // RebootNodeSpec defines the desired state of RebootNode
@@ -0,0 +1,188 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ReimageNode condition types
+const (
+	// ReimageNodeConditionSignalSent indicates whether the reimage signal has been sent to the CSP
+	ReimageNodeConditionSignalSent = "SignalSent"
+	// ReimageNodeConditionNodeReady indicates whether the node has returned to ready state after the reimage
+	ReimageNodeConditionNodeReady = "NodeReady"
+)
+
+// ReimageNode condition reasons
+const (
+	// ProviderMaintenanceReason indicates that the reimage signal is held back while the CSP performs maintenance on
+	// the instance of the node
+	ProviderMaintenanceReason = "ProviderMaintenance"
+)
+
+// ReimageNodeSpec defines the desired state of ReimageNode
+type ReimageNodeSpec struct {
+	// NodeName is the name of the node to reimage
+	// +kubebuilder:validation:Required
+	NodeName string `json:"nodeName"`
+}
+
+// ReimageNodeStatus defines the observed state of ReimageNode
+type ReimageNodeStatus struct {
+	// StartTime is the time when the reimage was initiated
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime is the time when the reimage was completed
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Conditions represent the latest available observations of an object's current state.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="Node",type="string",JSONPath=".spec.nodeName"
+// +kubebuilder:printcolumn:name="NodeReady",type="string",JSONPath=".status.conditions[?(@.type=='NodeReady')].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ReimageNode is the Schema for the reimagenodes API. It restores the boot disk of a node to its original image
+// through the CSP and waits for the node to return to ready state.
+type ReimageNode struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ReimageNodeSpec   `json:"spec,omitempty"`
+	Status ReimageNodeStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ReimageNodeList contains a list of ReimageNode
+type ReimageNodeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ReimageNode `json:"items"`
+}
+
+// IsReimageInProgress returns true if the reimage signal was sent and the node is not yet ready
+func (r *ReimageNode) IsReimageInProgress() bool {
+	signalSent := false
+	nodeNotReady := false
+
+	for _, condition := range r.Status.Conditions {
+		if condition.Type == ReimageNodeConditionSignalSent && condition.Status == metav1.ConditionTrue {
+			signalSent = true
+		}
+
+		if condition.Type == ReimageNodeConditionNodeReady && condition.Status != metav1.ConditionTrue {
+			nodeNotReady = true
+		}
+	}
+
+	return signalSent && nodeNotReady
+}
+
+// GetCSPReqRef returns the reference of the reimage request returned by the CSP
+func (r *ReimageNode) GetCSPReqRef() string {
+	for _, condition := range r.Status.Conditions {
+		if condition.Type == ReimageNodeConditionSignalSent && condition.Status == metav1.ConditionTrue {
+			return condition.Message
+		}
+	}
+
+	return ""
+}
+
+// SetInitialConditions sets the initial conditions for the ReimageNode to Unknown state
+func (r *ReimageNode) SetInitialConditions() {
+	now := metav1.Now()
+
+	hasSignalSent := false
+	hasNodeReady := false
+
+	for _, condition := range r.Status.Conditions {
+		if condition.Type == ReimageNodeConditionSignalSent {
+			hasSignalSent = true
+		}
+
+		if condition.Type == ReimageNodeConditionNodeReady {
+			hasNodeReady = true
+		}
+	}
+
+	if !hasSignalSent {
+		r.SetCondition(metav1.Condition{
+			Type:               ReimageNodeConditionSignalSent,
+			Status:             metav1.ConditionUnknown,
+			Reason:             "Initializing",
+			Message:            "Reimage signal not yet sent",
+			LastTransitionTime: now,
+		})
+	}
+
+	if !hasNodeReady {
+		r.SetCondition(metav1.Condition{
+			Type:               ReimageNodeConditionNodeReady,
+			Status:             metav1.ConditionUnknown,
+			Reason:             "Initializing",
+			Message:            "Node ready state not yet determined",
+			LastTransitionTime: now,
+		})
+	}
+}
+
+// SetCondition updates a condition only if it has changed
+func (r *ReimageNode) SetCondition(newCondition metav1.Condition) {
+	for i, condition := range r.Status.Conditions {
+		if condition.Type == newCondition.Type {
+			if condition.Status == newCondition.Status &&
+				condition.Reason == newCondition.Reason &&
+				condition.Message == newCondition.Message {
+				return
+			}
+
+			r.Status.Conditions[i].Status = newCondition.Status
+			r.Status.Conditions[i].LastTransitionTime = newCondition.LastTransitionTime
+			r.Status.Conditions[i].Reason = newCondition.Reason
+			r.Status.Conditions[i].Message = newCondition.Message
+
+			return
+		}
+	}
+
+	r.Status.Conditions = append(r.Status.Conditions, newCondition)
+}
+
+// SetStartTime sets the start time to now if not set
+func (r *ReimageNode) SetStartTime() {
+	if r.Status.StartTime == nil {
+		now := metav1.Now()
+		r.Status.StartTime = &now
+	}
+}
+
+// SetCompletionTime sets the completion time to now if not set
+func (r *ReimageNode) SetCompletionTime() {
+	if r.Status.CompletionTime == nil {
+		now := metav1.Now()
+		r.Status.CompletionTime = &now
+	}
+}
+
+func init() {
+	SchemeBuilder.Register(&ReimageNode{}, &ReimageNodeList{})
+}
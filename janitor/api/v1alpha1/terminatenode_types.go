@@ -24,8 +24,28 @@ const (
 	TerminateNodeConditionSignalSent = "SignalSent"
 	// TerminateNodeConditionNodeTerminated indicates whether the node has been terminated
 	TerminateNodeConditionNodeTerminated = "NodeTerminated"
+	// TerminateNodeConditionReplacementRequested indicates whether a replacement for the node has been requested
+	TerminateNodeConditionReplacementRequested = "ReplacementRequested"
+	// TerminateNodeConditionReplacementReady indicates whether the replacement node is Ready. It is False when the
+	// node is terminated or the termination fails without a Ready replacement.
+	TerminateNodeConditionReplacementReady = "ReplacementReady"
 )
 
+// ReplacementStatus tracks the replacement of a node which is only terminated once its replacement is Ready
+type ReplacementStatus struct {
+	// Strategy is the strategy used to request the replacement, karpenter or provider
+	Strategy string `json:"strategy,omitempty"`
+
+	// StartTime is the time when the controller started to request the replacement
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// RequestID references the replacement request, the name of the NodeClaim for the karpenter strategy
+	RequestID string `json:"requestID,omitempty"`
+
+	// NodeName is the name of the replacement node once it has registered
+	NodeName string `json:"nodeName,omitempty"`
+}
+
 // TerminateNodeSpec defines the desired state of TerminateNode
 type TerminateNodeSpec struct {
 	// Force indicates whether to force terminate the node
@@ -48,6 +68,10 @@ type TerminateNodeStatus struct {
 
 	// Conditions represent the latest available observations of an object's current state.
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Replacement tracks the replacement of the node when replacement-first termination is enabled
+	// +optional
+	Replacement *ReplacementStatus `json:"replacement,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -162,6 +186,18 @@ func (t *TerminateNode) SetCondition(newCondition metav1.Condition) {
 	t.Status.Conditions = append(t.Status.Conditions, newCondition)
 }
 
+// IsReplacementSettled returns true if the replacement phase has ended, either with a Ready replacement node or
+// without one
+func (t *TerminateNode) IsReplacementSettled() bool {
+	for _, condition := range t.Status.Conditions {
+		if condition.Type == TerminateNodeConditionReplacementReady {
+			return condition.Status != metav1.ConditionUnknown
+		}
+	}
+
+	return false
+}
+
 // SetStartTime sets the start time to now if not set
 func (t *TerminateNode) SetStartTime() {
 	if t.Status.StartTime == nil {
@@ -156,9 +156,13 @@ func run() error {
 		metrics.WithRegisterer(crmetrics.Registry),
 	)
 	ff.Set("manual_mode", cfg.Global.ManualMode != nil && *cfg.Global.ManualMode)
+	ff.Set("approval_enabled", cfg.Global.Approval.Enabled)
 	ff.Set("controller_reboot_node_enabled", cfg.RebootNode.Enabled)
 	ff.Set("controller_terminate_node_enabled", cfg.TerminateNode.Enabled)
+	ff.Set("terminate_node_replacement_enabled", cfg.TerminateNode.Replacement.Enabled)
 	ff.Set("controller_gpu_reset_enabled", cfg.GPUReset.Enabled)
+	ff.Set("controller_gpu_services_restart_enabled", cfg.GPUServicesRestart.Enabled)
+	ff.Set("controller_reimage_node_enabled", cfg.ReimageNode.Enabled)
 	ff.Set("csp_provider_auth_enabled", cfg.Global.CSPProviderTokenPath != "")
 
 	// 3. Setup config server (port, handler, server)
@@ -238,7 +242,29 @@ func run() error {
 		return err
 	}
 
-	slog.Info("RebootNode, TerminateNode, and GPUReset controllers registered")
+	if err = (&controller.GPUServicesRestartReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		Config:        &cfg.GPUServicesRestart,
+		LockNamespace: podNamespace,
+	}).SetupWithManager(mgr); err != nil {
+		slog.Error("Unable to create controller", "controller", "GPUServicesRestart", "error", err)
+
+		return err
+	}
+
+	if err = (&controller.ReimageNodeReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		Config:        &cfg.ReimageNode,
+		LockNamespace: podNamespace,
+	}).SetupWithManager(mgr); err != nil {
+		slog.Error("Unable to create controller", "controller", "ReimageNode", "error", err)
+
+		return err
+	}
+
+	slog.Info("RebootNode, TerminateNode, GPUReset, GPUServicesRestart, and ReimageNode controllers registered")
 
 	// Register TTL reconcilers for each maintenance CR kind. See
 	// docs/designs/037-janitor-cr-ttl-cleanup.md for the design.
@@ -530,7 +556,17 @@ func registerTTLReconcilers(mgr ctrl.Manager, enabled bool, defaultTTL time.Dura
 		return err
 	}
 
-	slog.Info("TTL reconcilers registered for RebootNode, GPUReset, TerminateNode",
+	if err := setupTTL[*janitordgxcnvidiacomv1alpha1.GPUServicesRestart](
+		mgr, "gpuservicesrestart-ttl", "GPUServicesRestart", defaultTTL); err != nil {
+		return err
+	}
+
+	if err := setupTTL[*janitordgxcnvidiacomv1alpha1.ReimageNode](
+		mgr, "reimagenode-ttl", "ReimageNode", defaultTTL); err != nil {
+		return err
+	}
+
+	slog.Info("TTL reconcilers registered for RebootNode, GPUReset, TerminateNode, GPUServicesRestart, ReimageNode",
 		"default-ttl", defaultTTL)
 
 	return nil
@@ -0,0 +1,167 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package approval decides whether the maintenance resources of janitor controllers in manual mode are approved.
+package approval
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nvidia/nvsentinel/janitor/api/v1alpha1"
+	"github.com/nvidia/nvsentinel/janitor/pkg/config"
+)
+
+// Decision is the outcome of evaluating the approval of a maintenance resource.
+type Decision struct {
+	// Approved indicates whether the maintenance can proceed
+	Approved bool
+	// Reason and Message are recorded on the PendingApproval condition of the maintenance resource
+	Reason  string
+	Message string
+}
+
+// Evaluate decides whether the maintenance resource of the given kind on the node is approved. It is approved by an
+// unexpired MaintenanceApproval matching it or by an auto-approve policy, in that order. Approvals are only granted
+// through MaintenanceApprovals, whose approver and expiry are set by the janitor webhook.
+func Evaluate(ctx context.Context, c client.Reader, cfg config.ApprovalConfig, kind string,
+	maintenanceObject client.Object, nodeName string) (Decision, error) {
+	var approvals v1alpha1.MaintenanceApprovalList
+	if err := c.List(ctx, &approvals); err != nil {
+		return Decision{}, fmt.Errorf("failed to list MaintenanceApprovals: %w", err)
+	}
+
+	now := metav1.Now()
+
+	var expired []string
+
+	for i := range approvals.Items {
+		approval := &approvals.Items[i]
+		if !approval.Matches(kind, maintenanceObject.GetName(), nodeName) {
+			continue
+		}
+
+		if approval.IsExpired(now) {
+			expired = append(expired, approval.Name)
+			continue
+		}
+
+		return Decision{
+			Approved: true,
+			Reason:   v1alpha1.ApprovedReason,
+			Message:  approvedMessage(approval),
+		}, nil
+	}
+
+	policy, err := matchAutoApprovePolicy(ctx, c, cfg.AutoApprove, kind, nodeName)
+	if err != nil {
+		return Decision{}, err
+	}
+
+	if policy != "" {
+		return Decision{
+			Approved: true,
+			Reason:   v1alpha1.AutoApprovedReason,
+			Message:  fmt.Sprintf("Approved by auto-approve policy %s", policy),
+		}, nil
+	}
+
+	message := "Waiting for a matching MaintenanceApproval"
+	if len(expired) > 0 {
+		message += fmt.Sprintf(", expired MaintenanceApprovals: %s", strings.Join(expired, ", "))
+	}
+
+	return Decision{
+		Reason:  v1alpha1.AwaitingApprovalReason,
+		Message: message,
+	}, nil
+}
+
+func approvedMessage(approval *v1alpha1.MaintenanceApproval) string {
+	message := fmt.Sprintf("Approved by MaintenanceApproval %s", approval.Name)
+	if approval.Spec.ApprovedBy != "" {
+		message += fmt.Sprintf(" of %s", approval.Spec.ApprovedBy)
+	}
+
+	if approval.Spec.Reason != "" {
+		message += fmt.Sprintf(": %s", approval.Spec.Reason)
+	}
+
+	return message
+}
+
+// matchAutoApprovePolicy returns the name of the first policy approving the kind of maintenance on the node, or an
+// empty string if none does. The node is only fetched if a policy selects nodes by label.
+func matchAutoApprovePolicy(ctx context.Context, c client.Reader, policies []config.AutoApprovePolicy,
+	kind, nodeName string) (string, error) {
+	var (
+		nodeLabels labels.Set
+		fetched    bool
+	)
+
+	for _, policy := range policies {
+		if len(policy.Kinds) > 0 && !slices.Contains(policy.Kinds, kind) {
+			continue
+		}
+
+		if policy.NodeSelector == nil {
+			return policy.Name, nil
+		}
+
+		if !fetched {
+			var err error
+
+			nodeLabels, err = getNodeLabels(ctx, c, nodeName)
+			if err != nil {
+				return "", err
+			}
+
+			fetched = true
+		}
+
+		selector, err := metav1.LabelSelectorAsSelector(policy.NodeSelector)
+		if err != nil {
+			return "", fmt.Errorf("invalid node selector in auto-approve policy %s: %w", policy.Name, err)
+		}
+
+		if selector.Matches(nodeLabels) {
+			return policy.Name, nil
+		}
+	}
+
+	return "", nil
+}
+
+// getNodeLabels returns the labels of the node. Nodes which do not exist have no labels, so that they only match
+// selectors without requirements.
+func getNodeLabels(ctx context.Context, c client.Reader, nodeName string) (labels.Set, error) {
+	var node corev1.Node
+	if err := c.Get(ctx, client.ObjectKey{Name: nodeName}, &node); err != nil {
+		if apierrors.IsNotFound(err) {
+			return labels.Set{}, nil
+		}
+
+		return nil, fmt.Errorf("failed to get node %s: %w", nodeName, err)
+	}
+
+	return labels.Set(node.Labels), nil
+}
@@ -0,0 +1,180 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package approval
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/nvidia/nvsentinel/janitor/api/v1alpha1"
+	"github.com/nvidia/nvsentinel/janitor/pkg/config"
+)
+
+func newApproval(name, kind, target, nodeName string, expiresAt *metav1.Time) *v1alpha1.MaintenanceApproval {
+	return &v1alpha1.MaintenanceApproval{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1alpha1.MaintenanceApprovalSpec{
+			Kind:       kind,
+			Name:       target,
+			NodeName:   nodeName,
+			ExpiresAt:  expiresAt,
+			Reason:     "planned maintenance",
+			ApprovedBy: "admin",
+		},
+	}
+}
+
+func newFakeClient(t *testing.T, objects ...client.Object) client.Client {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+}
+
+func TestEvaluate(t *testing.T) {
+	past := metav1.NewTime(time.Now().Add(-time.Hour))
+	future := metav1.NewTime(time.Now().Add(time.Hour))
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+		Name:   "node-1",
+		Labels: map[string]string{"example.com/pool": "staging"},
+	}}
+
+	testCases := []struct {
+		name            string
+		annotations     map[string]string
+		objects         []client.Object
+		policies        []config.AutoApprovePolicy
+		expectedReason  string
+		expectedMessage string
+	}{
+		{
+			name:            "Should wait without approvals or policies",
+			expectedReason:  v1alpha1.AwaitingApprovalReason,
+			expectedMessage: "Waiting for a matching MaintenanceApproval",
+		},
+		{
+			name:           "Should not approve by the former approve annotation",
+			annotations:    map[string]string{"janitor.dgxc.nvidia.com/approve": "true"},
+			expectedReason: v1alpha1.AwaitingApprovalReason,
+		},
+		{
+			name:            "Should approve by a MaintenanceApproval for the resource name",
+			objects:         []client.Object{newApproval("approval-1", "RebootNode", "reboot-1", "", nil)},
+			expectedReason:  v1alpha1.ApprovedReason,
+			expectedMessage: "Approved by MaintenanceApproval approval-1 of admin: planned maintenance",
+		},
+		{
+			name:            "Should approve by an unexpired MaintenanceApproval for the node",
+			objects:         []client.Object{newApproval("approval-1", "RebootNode", "", "node-1", &future)},
+			expectedReason:  v1alpha1.ApprovedReason,
+			expectedMessage: "approval-1",
+		},
+		{
+			name: "Should ignore MaintenanceApprovals for other kinds, resources or nodes",
+			objects: []client.Object{
+				newApproval("approval-1", "TerminateNode", "", "node-1", nil),
+				newApproval("approval-2", "RebootNode", "reboot-2", "", nil),
+				newApproval("approval-3", "RebootNode", "", "node-2", nil),
+				newApproval("approval-4", "RebootNode", "reboot-1", "node-2", nil),
+			},
+			expectedReason: v1alpha1.AwaitingApprovalReason,
+		},
+		{
+			name:            "Should report expired MaintenanceApprovals",
+			objects:         []client.Object{newApproval("approval-1", "RebootNode", "", "node-1", &past)},
+			expectedReason:  v1alpha1.AwaitingApprovalReason,
+			expectedMessage: "expired MaintenanceApprovals: approval-1",
+		},
+		{
+			name:            "Should auto-approve by kind",
+			policies:        []config.AutoApprovePolicy{{Name: "reboots", Kinds: []string{"GPUReset", "RebootNode"}}},
+			expectedReason:  v1alpha1.AutoApprovedReason,
+			expectedMessage: "Approved by auto-approve policy reboots",
+		},
+		{
+			name:           "Should not auto-approve other kinds",
+			policies:       []config.AutoApprovePolicy{{Name: "resets", Kinds: []string{"GPUReset"}}},
+			expectedReason: v1alpha1.AwaitingApprovalReason,
+		},
+		{
+			name:    "Should auto-approve by node selector",
+			objects: []client.Object{node},
+			policies: []config.AutoApprovePolicy{
+				{
+					Name:         "production",
+					NodeSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"example.com/pool": "production"}},
+				},
+				{
+					Name:         "staging",
+					NodeSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"example.com/pool": "staging"}},
+				},
+			},
+			expectedReason:  v1alpha1.AutoApprovedReason,
+			expectedMessage: "staging",
+		},
+		{
+			name: "Should not auto-approve by node selector if the node does not exist",
+			policies: []config.AutoApprovePolicy{{
+				Name:         "staging",
+				NodeSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"example.com/pool": "staging"}},
+			}},
+			expectedReason: v1alpha1.AwaitingApprovalReason,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := newFakeClient(t, tc.objects...)
+			rebootNode := &v1alpha1.RebootNode{
+				ObjectMeta: metav1.ObjectMeta{Name: "reboot-1", Annotations: tc.annotations},
+				Spec:       v1alpha1.RebootNodeSpec{NodeName: "node-1"},
+			}
+
+			decision, err := Evaluate(context.Background(), c, config.ApprovalConfig{Enabled: true, AutoApprove: tc.policies},
+				"RebootNode", rebootNode, "node-1")
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if decision.Reason != tc.expectedReason {
+				t.Errorf("Expected reason %q, got %q", tc.expectedReason, decision.Reason)
+			}
+
+			if decision.Approved != (tc.expectedReason != v1alpha1.AwaitingApprovalReason) {
+				t.Errorf("Unexpected approved %v for reason %q", decision.Approved, decision.Reason)
+			}
+
+			if !strings.Contains(decision.Message, tc.expectedMessage) {
+				t.Errorf("Expected message to contain %q, got %q", tc.expectedMessage, decision.Message)
+			}
+		})
+	}
+}
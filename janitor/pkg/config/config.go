@@ -19,14 +19,18 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/go-viper/mapstructure/v2"
 	"github.com/spf13/viper"
 	batchv1 "k8s.io/api/batch/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/utils/ptr"
 
+	"github.com/nvidia/nvsentinel/janitor/api/v1alpha1"
 	"github.com/nvidia/nvsentinel/janitor/pkg/gpuservices"
 )
 
@@ -36,6 +40,9 @@ type Config struct {
 	RebootNode    RebootNodeControllerConfig    `mapstructure:"rebootNodeController" json:"rebootNodeController"`
 	TerminateNode TerminateNodeControllerConfig `mapstructure:"terminateNodeController" json:"terminateNodeController"`
 	GPUReset      GPUResetControllerConfig      `mapstructure:"gpuResetController" json:"gpuResetController"`
+	//nolint:lll // struct tags
+	GPUServicesRestart GPUServicesRestartControllerConfig `mapstructure:"gpuServicesRestartController" json:"gpuServicesRestartController"`
+	ReimageNode        ReimageNodeControllerConfig        `mapstructure:"reimageNodeController" json:"reimageNodeController"`
 }
 
 // GlobalConfig contains global janitor settings
@@ -47,6 +54,10 @@ type GlobalConfig struct {
 	CSPProviderCAPath    string        `mapstructure:"cspProviderCAPath" json:"cspProviderCAPath,omitempty"`
 	CSPProviderInsecure  bool          `mapstructure:"cspProviderInsecure" json:"cspProviderInsecure,omitempty"`
 	CSPProviderTokenPath string        `mapstructure:"cspProviderTokenPath" json:"cspProviderTokenPath,omitempty"`
+	// ExclusionGroups limits concurrent maintenance on nodes which share a failure or performance domain
+	ExclusionGroups []ExclusionGroup `mapstructure:"exclusionGroups" json:"exclusionGroups,omitempty"`
+	// Approval replaces the manual mode behavior of the controllers with an approval workflow
+	Approval ApprovalConfig `mapstructure:"approval" json:"approval"`
 }
 
 // NodeConfig contains configuration for nodes
@@ -54,6 +65,39 @@ type NodeConfig struct {
 	Exclusions []metav1.LabelSelector `mapstructure:"exclusions" json:"exclusions"`
 }
 
+// ExclusionGroup groups nodes by the value of a node label (for example an NVLink domain, rack or leaf switch) and
+// limits how many nodes of the same group can be under maintenance at the same time across all janitor controllers.
+// Nodes without the label are not part of the group.
+type ExclusionGroup struct {
+	// Name identifies the group and is used in the names of the leases which hold its slots
+	Name string `mapstructure:"name" json:"name"`
+	// LabelKey is the node label whose value selects the group a node belongs to
+	LabelKey string `mapstructure:"labelKey" json:"labelKey"`
+	// MaxConcurrent is the number of nodes of the same group which can be under maintenance at once (default: 1)
+	MaxConcurrent int `mapstructure:"maxConcurrent" json:"maxConcurrent"`
+}
+
+// ApprovalConfig configures the approval workflow. When it is enabled, maintenance resources of controllers in manual
+// mode wait in the PendingApproval condition until they are approved, instead of waiting for an outside actor.
+type ApprovalConfig struct {
+	// Enabled indicates if controllers in manual mode wait for approvals
+	Enabled bool `mapstructure:"enabled" json:"enabled"`
+	// TTL is the default lifetime of MaintenanceApprovals created without an expiry time (0 means no expiry)
+	TTL time.Duration `mapstructure:"ttl" json:"ttl"`
+	// AutoApprove lists the policies approving maintenance without an operator
+	AutoApprove []AutoApprovePolicy `mapstructure:"autoApprove" json:"autoApprove,omitempty"`
+}
+
+// AutoApprovePolicy approves maintenance resources of the listed kinds on the nodes matching the node selector.
+type AutoApprovePolicy struct {
+	// Name identifies the policy in the PendingApproval condition of the maintenance resources it approves
+	Name string `mapstructure:"name" json:"name"`
+	// Kinds lists the maintenance kinds approved by the policy, for example RebootNode (empty means all kinds)
+	Kinds []string `mapstructure:"kinds" json:"kinds,omitempty"`
+	// NodeSelector selects the nodes on which maintenance is approved (nil means all nodes)
+	NodeSelector *metav1.LabelSelector `mapstructure:"nodeSelector" json:"nodeSelector,omitempty"`
+}
+
 // RebootNodeControllerConfig contains configuration for reboot node controller
 type RebootNodeControllerConfig struct {
 	// Enabled indicates if the controller is enabled
@@ -73,6 +117,10 @@ type RebootNodeControllerConfig struct {
 	CSPProviderInsecure bool
 	// CSPProviderTokenPath is the path to the SA token file for gRPC auth
 	CSPProviderTokenPath string
+	// ExclusionGroups is copied from the global configuration so that all controllers share the same groups
+	ExclusionGroups []ExclusionGroup `mapstructure:"-"`
+	// Approval is copied from the global configuration
+	Approval ApprovalConfig `mapstructure:"-"`
 }
 
 // TerminateNodeControllerConfig contains configuration for terminate node controller
@@ -94,6 +142,68 @@ type TerminateNodeControllerConfig struct {
 	CSPProviderInsecure bool
 	// CSPProviderTokenPath is the path to the SA token file for gRPC auth
 	CSPProviderTokenPath string
+	// Replacement configures replacement-first termination
+	Replacement ReplacementConfig `mapstructure:"replacement" json:"replacement"`
+	// ExclusionGroups is copied from the global configuration so that all controllers share the same groups
+	ExclusionGroups []ExclusionGroup `mapstructure:"-"`
+	// Approval is copied from the global configuration
+	Approval ApprovalConfig `mapstructure:"-"`
+}
+
+// Replacement strategies
+const (
+	// ReplacementStrategyKarpenter creates a Karpenter NodeClaim from the NodeClaim of the node
+	ReplacementStrategyKarpenter = "karpenter"
+	// ReplacementStrategyProvider asks the CSP provider to scale up the node group of the node
+	ReplacementStrategyProvider = "provider"
+)
+
+// Actions taken when no replacement node is Ready before the replacement timeout
+const (
+	// ReplacementOnTimeoutTerminate terminates the node without a replacement
+	ReplacementOnTimeoutTerminate = "terminate"
+	// ReplacementOnTimeoutFail fails the termination and keeps the node
+	ReplacementOnTimeoutFail = "fail"
+)
+
+// ReplacementConfig configures replacement-first termination. When it is enabled, the terminate node controller
+// requests a replacement for the node and waits for the replacement node to become Ready before it terminates the
+// node.
+type ReplacementConfig struct {
+	// Enabled indicates if nodes are only terminated once their replacement is Ready
+	Enabled bool `mapstructure:"enabled" json:"enabled"`
+	// Strategy selects how replacements are requested, karpenter or provider
+	Strategy string `mapstructure:"strategy" json:"strategy"`
+	// Timeout is how long to wait for the replacement node to become Ready (default: 20m)
+	Timeout time.Duration `mapstructure:"timeout" json:"timeout"`
+	// OnTimeout is the action taken when no replacement is Ready after the timeout, terminate or fail
+	// (default: terminate)
+	OnTimeout string `mapstructure:"onTimeout" json:"onTimeout"`
+}
+
+// ReimageNodeControllerConfig contains configuration for reimage node controller
+type ReimageNodeControllerConfig struct {
+	// Enabled indicates if the controller is enabled
+	Enabled bool
+	// ManualMode indicates if the controller should skip sending reimage signals
+	ManualMode *bool
+	// Timeout for reimage operations
+	Timeout time.Duration
+	// Exclusions defines label selectors for nodes that should be excluded from reimage operations
+	// Nodes matching any of these label selectors will be rejected by the admission webhook
+	Exclusions []metav1.LabelSelector
+	// CSPProviderHost is the host of the CSP provider
+	CSPProviderHost string
+	// CSPProviderCAPath is the path to the CA bundle PEM file for TLS verification
+	CSPProviderCAPath string
+	// CSPProviderInsecure skips TLS when true (for local development)
+	CSPProviderInsecure bool
+	// CSPProviderTokenPath is the path to the SA token file for gRPC auth
+	CSPProviderTokenPath string
+	// ExclusionGroups is copied from the global configuration so that all controllers share the same groups
+	ExclusionGroups []ExclusionGroup `mapstructure:"-"`
+	// Approval is copied from the global configuration
+	Approval ApprovalConfig `mapstructure:"-"`
 }
 
 // GPUResetControllerConfig contains configuration for gpu reset controller
@@ -108,6 +218,26 @@ type GPUResetControllerConfig struct {
 	// reset ResetJob will be used to construct the ResolvedJobTemplate from the default Job template
 	ResetJob            ResetJobConfig `mapstructure:"resetJob" json:"resetJob"`
 	ResolvedJobTemplate *batchv1.JobTemplateSpec
+	// ExclusionGroups is copied from the global configuration so that all controllers share the same groups
+	ExclusionGroups []ExclusionGroup `mapstructure:"-" json:"-"`
+	// Approval is copied from the global configuration
+	Approval ApprovalConfig `mapstructure:"-" json:"-"`
+}
+
+// GPUServicesRestartControllerConfig contains configuration for the GPU services restart controller
+type GPUServicesRestartControllerConfig struct {
+	Enabled        bool                   `mapstructure:"enabled" json:"enabled"`
+	ManualMode     *bool                  `mapstructure:"manualMode" json:"manualMode"`
+	Exclusions     []metav1.LabelSelector `mapstructure:"exclusions" json:"exclusions"`
+	ServiceManager gpuservices.Manager    `mapstructure:"serviceManager" json:"serviceManager"`
+	// DriverReloadJob will be used to construct the ResolvedJobTemplate of the job reloading the NVIDIA kernel
+	// modules. Driver reloads are only supported when its image is set.
+	DriverReloadJob     ResetJobConfig           `mapstructure:"driverReloadJob" json:"driverReloadJob"`
+	ResolvedJobTemplate *batchv1.JobTemplateSpec `mapstructure:"-" json:"-"`
+	// ExclusionGroups is copied from the global configuration so that all controllers share the same groups
+	ExclusionGroups []ExclusionGroup `mapstructure:"-" json:"-"`
+	// Approval is copied from the global configuration
+	Approval ApprovalConfig `mapstructure:"-" json:"-"`
 }
 
 type ResetJobConfig struct {
@@ -172,6 +302,18 @@ func LoadConfig(configPath string, namespace string) (*Config, error) {
 
 	applyConfigDefaults(&config)
 
+	if err := validateExclusionGroups(config.Global.ExclusionGroups); err != nil {
+		return nil, err
+	}
+
+	if err := validateApproval(config.Global.Approval); err != nil {
+		return nil, err
+	}
+
+	if err := validateReplacement(config.TerminateNode.Replacement); err != nil {
+		return nil, err
+	}
+
 	if config.GPUReset.Enabled {
 		if len(config.GPUReset.ResetJob.ImageConfig.Image) == 0 {
 			return nil, fmt.Errorf("ResetJob.ImageConfig.Image is required but not set")
@@ -193,6 +335,15 @@ func LoadConfig(configPath string, namespace string) (*Config, error) {
 		config.GPUReset.ResolvedJobTemplate = jobTemplate
 	}
 
+	if config.GPUServicesRestart.Enabled && len(config.GPUServicesRestart.DriverReloadJob.ImageConfig.Image) > 0 {
+		jobTemplate, err := getDefaultDriverReloadJobTemplate(namespace, config.GPUServicesRestart.DriverReloadJob)
+		if err != nil {
+			return nil, err
+		}
+
+		config.GPUServicesRestart.ResolvedJobTemplate = jobTemplate
+	}
+
 	cfgJSON, err := json.Marshal(config)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal config: %w", err)
@@ -202,3 +353,94 @@ func LoadConfig(configPath string, namespace string) (*Config, error) {
 
 	return &config, nil
 }
+
+func validateExclusionGroups(groups []ExclusionGroup) error {
+	names := make(map[string]bool, len(groups))
+
+	for _, group := range groups {
+		if errs := validation.IsDNS1123Label(group.Name); len(errs) > 0 {
+			return fmt.Errorf("invalid exclusion group name %q: %s", group.Name, strings.Join(errs, ", "))
+		}
+
+		if names[group.Name] {
+			return fmt.Errorf("duplicate exclusion group name %q", group.Name)
+		}
+
+		names[group.Name] = true
+
+		if errs := validation.IsQualifiedName(group.LabelKey); len(errs) > 0 {
+			return fmt.Errorf("invalid label key %q for exclusion group %q: %s", group.LabelKey, group.Name,
+				strings.Join(errs, ", "))
+		}
+
+		if group.MaxConcurrent < 1 {
+			return fmt.Errorf("maxConcurrent for exclusion group %q must be at least 1, got %d",
+				group.Name, group.MaxConcurrent)
+		}
+	}
+
+	return nil
+}
+
+// approvableKinds are the kinds of the maintenance resources covered by the approval workflow
+var approvableKinds = []string{
+	v1alpha1.RebootNodeKind, v1alpha1.TerminateNodeKind, v1alpha1.GPUResetKind, v1alpha1.GPUServicesRestartKind,
+	v1alpha1.ReimageNodeKind,
+}
+
+func validateApproval(approval ApprovalConfig) error {
+	if approval.TTL < 0 {
+		return fmt.Errorf("approval ttl must not be negative, got %s", approval.TTL)
+	}
+
+	names := make(map[string]bool, len(approval.AutoApprove))
+
+	for _, policy := range approval.AutoApprove {
+		if errs := validation.IsDNS1123Label(policy.Name); len(errs) > 0 {
+			return fmt.Errorf("invalid auto-approve policy name %q: %s", policy.Name, strings.Join(errs, ", "))
+		}
+
+		if names[policy.Name] {
+			return fmt.Errorf("duplicate auto-approve policy name %q", policy.Name)
+		}
+
+		names[policy.Name] = true
+
+		for _, kind := range policy.Kinds {
+			if !slices.Contains(approvableKinds, kind) {
+				return fmt.Errorf("invalid kind %q in auto-approve policy %q, must be one of %s", kind, policy.Name,
+					strings.Join(approvableKinds, ", "))
+			}
+		}
+
+		if policy.NodeSelector != nil {
+			if _, err := metav1.LabelSelectorAsSelector(policy.NodeSelector); err != nil {
+				return fmt.Errorf("invalid node selector in auto-approve policy %q: %w", policy.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateReplacement(replacement ReplacementConfig) error {
+	if !replacement.Enabled {
+		return nil
+	}
+
+	if replacement.Strategy != ReplacementStrategyKarpenter && replacement.Strategy != ReplacementStrategyProvider {
+		return fmt.Errorf("replacement strategy must be %q or %q, got %q", ReplacementStrategyKarpenter,
+			ReplacementStrategyProvider, replacement.Strategy)
+	}
+
+	if replacement.Timeout < 0 {
+		return fmt.Errorf("replacement timeout must not be negative, got %s", replacement.Timeout)
+	}
+
+	if replacement.OnTimeout != ReplacementOnTimeoutTerminate && replacement.OnTimeout != ReplacementOnTimeoutFail {
+		return fmt.Errorf("replacement onTimeout must be %q or %q, got %q", ReplacementOnTimeoutTerminate,
+			ReplacementOnTimeoutFail, replacement.OnTimeout)
+	}
+
+	return nil
+}
@@ -308,6 +308,94 @@ gpuResetController:
 	assert.Equal(t, gpuservices.Manager{Name: "gpu-operator"}, config.GPUReset.ServiceManager)
 }
 
+func TestLoadConfig_GPUServicesRestartControllerConfig(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "janitor-config.yaml")
+
+	configContent := `
+gpuServicesRestartController:
+  enabled: true
+  driverReloadJob:
+    runtimeClassName: nvidia
+    imageConfig:
+      image: "alpine:latest"
+  serviceManager:
+    name: "gpu-operator"
+`
+
+	err := os.WriteFile(configPath, []byte(configContent), 0644)
+	require.NoError(t, err)
+
+	config, err := LoadConfig(configPath, testNamespace)
+	require.NoError(t, err)
+	require.NotNil(t, config)
+
+	assert.True(t, config.GPUServicesRestart.Enabled)
+	assert.Equal(t, gpuservices.Manager{Name: "gpu-operator"}, config.GPUServicesRestart.ServiceManager)
+
+	jobTemplate := config.GPUServicesRestart.ResolvedJobTemplate
+	require.NotNil(t, jobTemplate)
+	assert.Equal(t, testNamespace, jobTemplate.Namespace)
+	assert.Equal(t, "nvidia", *jobTemplate.Spec.Template.Spec.RuntimeClassName)
+
+	container := jobTemplate.Spec.Template.Spec.Containers[0]
+	assert.Equal(t, DriverReloadContainerName, container.Name)
+	assert.Equal(t, "alpine:latest", container.Image)
+	assert.Equal(t, []string{DriverReloadCommand}, container.Command)
+}
+
+func TestLoadConfig_GPUServicesRestartWithoutDriverReloadJob(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "janitor-config.yaml")
+
+	configContent := `
+gpuServicesRestartController:
+  enabled: true
+`
+
+	err := os.WriteFile(configPath, []byte(configContent), 0644)
+	require.NoError(t, err)
+
+	config, err := LoadConfig(configPath, testNamespace)
+	require.NoError(t, err)
+	require.NotNil(t, config)
+
+	// Driver reloads are rejected without a driver reload job
+	assert.True(t, config.GPUServicesRestart.Enabled)
+	assert.Nil(t, config.GPUServicesRestart.ResolvedJobTemplate)
+}
+
+func TestLoadConfig_ReimageNodeControllerConfig(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "reimage-node-config.yaml")
+
+	configContent := `
+global:
+  timeout: 30m
+  manualMode: true
+  cspProviderHost: janitor-provider.nvsentinel.svc.cluster.local:50051
+  cspProviderTokenPath: /var/run/secrets/tokens/janitor-provider
+reimageNodeController:
+  enabled: true
+  timeout: 45m
+`
+
+	err := os.WriteFile(configPath, []byte(configContent), 0644)
+	require.NoError(t, err)
+
+	config, err := LoadConfig(configPath, testNamespace)
+	require.NoError(t, err)
+	require.NotNil(t, config)
+
+	assert.True(t, config.ReimageNode.Enabled)
+	assert.Equal(t, 45*time.Minute, config.ReimageNode.Timeout)
+
+	// Verify unset settings cascade from the global config
+	require.NotNil(t, config.ReimageNode.ManualMode)
+	assert.True(t, *config.ReimageNode.ManualMode)
+	assert.Equal(t, config.Global.CSPProviderHost, config.ReimageNode.CSPProviderHost)
+	assert.Equal(t, config.Global.CSPProviderTokenPath, config.ReimageNode.CSPProviderTokenPath)
+}
+
 func TestLoadConfig_GPUResetControllerConfigEmptyRuntimeClass(t *testing.T) {
 	// Create a temporary config file
 	tmpDir := t.TempDir()
@@ -598,3 +686,259 @@ global:
 	assert.Equal(t, "node-role.kubernetes.io/control-plane", config.RebootNode.Exclusions[1].MatchExpressions[0].Key)
 	assert.Equal(t, metav1.LabelSelectorOpExists, config.RebootNode.Exclusions[1].MatchExpressions[0].Operator)
 }
+
+func TestLoadConfig_ExclusionGroups(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "exclusion-groups-config.yaml")
+
+	configContent := `
+global:
+  exclusionGroups:
+    - name: nvlink-domain
+      labelKey: nvidia.com/gpu.clique
+    - name: rack
+      labelKey: topology.kubernetes.io/rack
+      maxConcurrent: 2
+`
+
+	err := os.WriteFile(configPath, []byte(configContent), 0644)
+	require.NoError(t, err)
+
+	config, err := LoadConfig(configPath, testNamespace)
+	require.NoError(t, err)
+	require.NotNil(t, config)
+
+	require.Len(t, config.Global.ExclusionGroups, 2)
+	assert.Equal(t, ExclusionGroup{Name: "nvlink-domain", LabelKey: "nvidia.com/gpu.clique", MaxConcurrent: 1},
+		config.Global.ExclusionGroups[0])
+	assert.Equal(t, ExclusionGroup{Name: "rack", LabelKey: "topology.kubernetes.io/rack", MaxConcurrent: 2},
+		config.Global.ExclusionGroups[1])
+
+	// Verify exclusion groups are shared by all controllers
+	assert.Equal(t, config.Global.ExclusionGroups, config.RebootNode.ExclusionGroups)
+	assert.Equal(t, config.Global.ExclusionGroups, config.TerminateNode.ExclusionGroups)
+	assert.Equal(t, config.Global.ExclusionGroups, config.GPUReset.ExclusionGroups)
+	assert.Equal(t, config.Global.ExclusionGroups, config.GPUServicesRestart.ExclusionGroups)
+	assert.Equal(t, config.Global.ExclusionGroups, config.ReimageNode.ExclusionGroups)
+}
+
+func TestLoadConfig_InvalidExclusionGroups(t *testing.T) {
+	tests := []struct {
+		name   string
+		groups string
+	}{
+		{
+			name: "invalid name",
+			groups: `
+    - name: NVLink_Domain
+      labelKey: nvidia.com/gpu.clique`,
+		},
+		{
+			name: "duplicate name",
+			groups: `
+    - name: rack
+      labelKey: topology.kubernetes.io/rack
+    - name: rack
+      labelKey: example.com/rack`,
+		},
+		{
+			name: "missing label key",
+			groups: `
+    - name: rack`,
+		},
+		{
+			name: "negative maxConcurrent",
+			groups: `
+    - name: rack
+      labelKey: topology.kubernetes.io/rack
+      maxConcurrent: -1`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "exclusion-groups-config.yaml")
+
+			err := os.WriteFile(configPath, []byte("global:\n  exclusionGroups:"+tt.groups+"\n"), 0644)
+			require.NoError(t, err)
+
+			config, err := LoadConfig(configPath, testNamespace)
+			assert.Error(t, err)
+			assert.Nil(t, config)
+		})
+	}
+}
+
+func TestLoadConfig_Approval(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "approval-config.yaml")
+
+	configContent := `
+global:
+  manualMode: true
+  approval:
+    enabled: true
+    ttl: 12h
+    autoApprove:
+      - name: gpu-resets
+        kinds: [GPUReset, GPUServicesRestart]
+      - name: staging
+        nodeSelector:
+          matchLabels:
+            example.com/pool: staging
+          matchExpressions:
+            - key: example.com/rack
+              operator: In
+              values: [rack-1]
+`
+
+	err := os.WriteFile(configPath, []byte(configContent), 0644)
+	require.NoError(t, err)
+
+	config, err := LoadConfig(configPath, testNamespace)
+	require.NoError(t, err)
+	require.NotNil(t, config)
+
+	approval := config.Global.Approval
+	assert.True(t, approval.Enabled)
+	assert.Equal(t, 12*time.Hour, approval.TTL)
+	require.Len(t, approval.AutoApprove, 2)
+	assert.Equal(t, []string{"GPUReset", "GPUServicesRestart"}, approval.AutoApprove[0].Kinds)
+	assert.Nil(t, approval.AutoApprove[0].NodeSelector)
+	require.NotNil(t, approval.AutoApprove[1].NodeSelector)
+	assert.Equal(t, map[string]string{"example.com/pool": "staging"}, approval.AutoApprove[1].NodeSelector.MatchLabels)
+	require.Len(t, approval.AutoApprove[1].NodeSelector.MatchExpressions, 1)
+	assert.Equal(t, metav1.LabelSelectorOpIn, approval.AutoApprove[1].NodeSelector.MatchExpressions[0].Operator)
+
+	// Verify the approval workflow is shared by all controllers and manual mode cascades to GPUServicesRestart
+	assert.Equal(t, approval, config.RebootNode.Approval)
+	assert.Equal(t, approval, config.TerminateNode.Approval)
+	assert.Equal(t, approval, config.GPUReset.Approval)
+	assert.Equal(t, approval, config.GPUServicesRestart.Approval)
+	assert.True(t, *config.GPUServicesRestart.ManualMode)
+}
+
+func TestLoadConfig_InvalidApproval(t *testing.T) {
+	tests := []struct {
+		name     string
+		approval string
+	}{
+		{
+			name: "negative ttl",
+			approval: `
+    ttl: -1h`,
+		},
+		{
+			name: "invalid policy name",
+			approval: `
+    autoApprove:
+      - name: GPU_Resets`,
+		},
+		{
+			name: "duplicate policy name",
+			approval: `
+    autoApprove:
+      - name: all
+      - name: all`,
+		},
+		{
+			name: "unknown kind",
+			approval: `
+    autoApprove:
+      - name: drains
+        kinds: [DrainNode]`,
+		},
+		{
+			name: "invalid node selector",
+			approval: `
+    autoApprove:
+      - name: staging
+        nodeSelector:
+          matchExpressions:
+            - key: example.com/pool
+              operator: Equals`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "approval-config.yaml")
+
+			err := os.WriteFile(configPath, []byte("global:\n  approval:"+tt.approval+"\n"), 0644)
+			require.NoError(t, err)
+
+			config, err := LoadConfig(configPath, testNamespace)
+			assert.Error(t, err)
+			assert.Nil(t, config)
+		})
+	}
+}
+
+func TestLoadConfig_Replacement(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "replacement-config.yaml")
+
+	configContent := `
+terminateNodeController:
+  replacement:
+    enabled: true
+    strategy: karpenter
+`
+
+	err := os.WriteFile(configPath, []byte(configContent), 0644)
+	require.NoError(t, err)
+
+	config, err := LoadConfig(configPath, testNamespace)
+	require.NoError(t, err)
+	require.NotNil(t, config)
+
+	replacement := config.TerminateNode.Replacement
+	assert.True(t, replacement.Enabled)
+	assert.Equal(t, ReplacementStrategyKarpenter, replacement.Strategy)
+	assert.Equal(t, 20*time.Minute, replacement.Timeout)
+	assert.Equal(t, ReplacementOnTimeoutTerminate, replacement.OnTimeout)
+}
+
+func TestLoadConfig_InvalidReplacement(t *testing.T) {
+	tests := []struct {
+		name        string
+		replacement string
+	}{
+		{
+			name: "missing strategy",
+			replacement: `
+    enabled: true`,
+		},
+		{
+			name: "unknown strategy",
+			replacement: `
+    enabled: true
+    strategy: autoscaler`,
+		},
+		{
+			name: "negative timeout",
+			replacement: `
+    enabled: true
+    strategy: provider
+    timeout: -1m`,
+		},
+		{
+			name: "unknown timeout action",
+			replacement: `
+    enabled: true
+    strategy: provider
+    onTimeout: retry`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "replacement-config.yaml")
+
+			err := os.WriteFile(configPath, []byte("terminateNodeController:\n  replacement:"+tt.replacement+"\n"), 0644)
+			require.NoError(t, err)
+
+			config, err := LoadConfig(configPath, testNamespace)
+			assert.Error(t, err)
+			assert.Nil(t, config)
+		})
+	}
+}
@@ -37,6 +37,10 @@ const (
 	HostSysVolumeName      = "host-sys"
 	HostSysPath            = "/sys"
 	WriteSyslogEventEnvVar = "WRITE_SYSLOG_EVENT"
+	// DriverReloadContainerName is the container of driver reload jobs, which run the GPU reset image
+	DriverReloadContainerName = "driver-reload"
+	// DriverReloadCommand is the driver reload script shipped in the GPU reset image
+	DriverReloadCommand = "/usr/local/bin/driver_reload.sh"
 )
 
 func applyConfigDefaults(config *Config) {
@@ -45,6 +49,9 @@ func applyConfigDefaults(config *Config) {
 	applyManualModeDefaults(config)
 	applyExclusionsDefaults(config)
 	applyCSPProviderHostDefaults(config)
+	applyExclusionGroupDefaults(config)
+	applyApprovalDefaults(config)
+	applyReplacementDefaults(config)
 }
 
 func applyGlobalDefaults(config *Config) {
@@ -69,6 +76,10 @@ func applyTimeoutDefaults(config *Config) {
 	if config.GPUReset.Timeout == 0 {
 		config.GPUReset.Timeout = config.Global.Timeout
 	}
+
+	if config.ReimageNode.Timeout == 0 {
+		config.ReimageNode.Timeout = config.Global.Timeout
+	}
 }
 
 func applyManualModeDefaults(config *Config) {
@@ -83,6 +94,14 @@ func applyManualModeDefaults(config *Config) {
 	if config.GPUReset.ManualMode == nil {
 		config.GPUReset.ManualMode = config.Global.ManualMode
 	}
+
+	if config.GPUServicesRestart.ManualMode == nil {
+		config.GPUServicesRestart.ManualMode = config.Global.ManualMode
+	}
+
+	if config.ReimageNode.ManualMode == nil {
+		config.ReimageNode.ManualMode = config.Global.ManualMode
+	}
 }
 
 func applyExclusionsDefaults(config *Config) {
@@ -97,6 +116,14 @@ func applyExclusionsDefaults(config *Config) {
 	if len(config.GPUReset.Exclusions) == 0 {
 		config.GPUReset.Exclusions = config.Global.Nodes.Exclusions
 	}
+
+	if len(config.GPUServicesRestart.Exclusions) == 0 {
+		config.GPUServicesRestart.Exclusions = config.Global.Nodes.Exclusions
+	}
+
+	if len(config.ReimageNode.Exclusions) == 0 {
+		config.ReimageNode.Exclusions = config.Global.Nodes.Exclusions
+	}
 }
 
 func applyCSPProviderHostDefaults(config *Config) {
@@ -112,6 +139,10 @@ func applyCSPProviderHostDefaults(config *Config) {
 		config.GPUReset.CSPProviderHost = config.Global.CSPProviderHost
 	}
 
+	if len(config.ReimageNode.CSPProviderHost) == 0 {
+		config.ReimageNode.CSPProviderHost = config.Global.CSPProviderHost
+	}
+
 	// Cascade CSP provider TLS settings from global to controller-specific configs
 	if len(config.RebootNode.CSPProviderCAPath) == 0 {
 		config.RebootNode.CSPProviderCAPath = config.Global.CSPProviderCAPath
@@ -129,6 +160,14 @@ func applyCSPProviderHostDefaults(config *Config) {
 		config.TerminateNode.CSPProviderInsecure = config.Global.CSPProviderInsecure
 	}
 
+	if len(config.ReimageNode.CSPProviderCAPath) == 0 {
+		config.ReimageNode.CSPProviderCAPath = config.Global.CSPProviderCAPath
+	}
+
+	if !config.ReimageNode.CSPProviderInsecure {
+		config.ReimageNode.CSPProviderInsecure = config.Global.CSPProviderInsecure
+	}
+
 	// Cascade CSP provider token path from global to controller-specific configs
 	if len(config.RebootNode.CSPProviderTokenPath) == 0 {
 		config.RebootNode.CSPProviderTokenPath = config.Global.CSPProviderTokenPath
@@ -137,6 +176,10 @@ func applyCSPProviderHostDefaults(config *Config) {
 	if len(config.TerminateNode.CSPProviderTokenPath) == 0 {
 		config.TerminateNode.CSPProviderTokenPath = config.Global.CSPProviderTokenPath
 	}
+
+	if len(config.ReimageNode.CSPProviderTokenPath) == 0 {
+		config.ReimageNode.CSPProviderTokenPath = config.Global.CSPProviderTokenPath
+	}
 }
 
 func getResources(resources ResourceRequirements) (*corev1.ResourceRequirements, error) {
@@ -294,3 +337,54 @@ func getDefaultGPUResetJobTemplate(namespace string, image string, secrets []Ima
 
 	return job, nil
 }
+
+// getDefaultDriverReloadJobTemplate returns the default JobTemplateSpec for driver reload jobs. They run the driver
+// reload script of the GPU reset image with the same host mounts as GPU reset jobs.
+func getDefaultDriverReloadJobTemplate(namespace string, jobConfig ResetJobConfig) (*batchv1.JobTemplateSpec, error) {
+	job, err := getDefaultGPUResetJobTemplate(namespace, jobConfig.ImageConfig.Image,
+		jobConfig.ImageConfig.ImagePullSecrets, jobConfig.Resources, jobConfig.RuntimeClassName, false)
+	if err != nil {
+		return nil, err
+	}
+
+	container := &job.Spec.Template.Spec.Containers[0]
+	container.Name = DriverReloadContainerName
+	container.Command = []string{DriverReloadCommand}
+
+	return job, nil
+}
+
+func applyExclusionGroupDefaults(config *Config) {
+	for i := range config.Global.ExclusionGroups {
+		if config.Global.ExclusionGroups[i].MaxConcurrent == 0 {
+			config.Global.ExclusionGroups[i].MaxConcurrent = 1
+		}
+	}
+
+	// Exclusion groups are enforced across controllers, so they cannot be overridden per controller
+	config.RebootNode.ExclusionGroups = config.Global.ExclusionGroups
+	config.TerminateNode.ExclusionGroups = config.Global.ExclusionGroups
+	config.GPUReset.ExclusionGroups = config.Global.ExclusionGroups
+	config.GPUServicesRestart.ExclusionGroups = config.Global.ExclusionGroups
+	config.ReimageNode.ExclusionGroups = config.Global.ExclusionGroups
+}
+
+func applyApprovalDefaults(config *Config) {
+	// Approvals are granted by MaintenanceApprovals which are not specific to a controller, so the workflow is only
+	// configured globally
+	config.RebootNode.Approval = config.Global.Approval
+	config.TerminateNode.Approval = config.Global.Approval
+	config.GPUReset.Approval = config.Global.Approval
+	config.GPUServicesRestart.Approval = config.Global.Approval
+	config.ReimageNode.Approval = config.Global.Approval
+}
+
+func applyReplacementDefaults(config *Config) {
+	if config.TerminateNode.Replacement.Timeout == 0 {
+		config.TerminateNode.Replacement.Timeout = 20 * time.Minute
+	}
+
+	if config.TerminateNode.Replacement.OnTimeout == "" {
+		config.TerminateNode.Replacement.OnTimeout = ReplacementOnTimeoutTerminate
+	}
+}
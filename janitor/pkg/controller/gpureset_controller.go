@@ -17,10 +17,8 @@ package controller
 
 import (
 	"context"
-	"crypto/sha256"
 	"errors"
 	"fmt"
-	"maps"
 	"reflect"
 	"strings"
 	"sync"
@@ -59,11 +57,6 @@ const (
 	gpuResetsEnvVar = "NVIDIA_GPU_RESETS"
 	// jobNameSuffix is the static string appended to the GPUReset name to form the Job's name.
 	jobNameSuffix = "-reset-job"
-	// podNameSuffixLength is the 6-character suffix (e.g., "-abc12") added by K8s to a Job's name to create its Pods.
-	podNameSuffixLength = 6
-	// maxJobNameBaseLength calculates the maximum allowed length for the base name (derived from the GPUReset name)
-	// to ensure the final name for Pods created by the Job does not exceed the 63-character limit.
-	maxJobNameBaseLength = validation.DNS1123LabelMaxLength - len(jobNameSuffix) - 1 - 8 - podNameSuffixLength
 )
 
 // checkPodsTerminatedFn is a function signature used for checking if managed service pods have terminated.
@@ -89,6 +82,7 @@ type GPUResetReconciler struct {
 	checkPodsReadyFn checkPodsReadyFn
 	// NodeLock provides node-level locking across Janitor controllers
 	NodeLock      distributedlock.NodeLock
+	GroupLock     distributedlock.GroupLock
 	LockNamespace string
 	// resetSessionSpans holds one long-lived "reset_session" span per CR, keyed by CR name.
 	// Started on the first reconcile, ended on completion/failure/deletion.
@@ -131,6 +125,19 @@ func (r *GPUResetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 		ctx, span := tracing.StartSpan(sessionCtx, "janitor.gpureset.reconcile")
 		defer span.End()
 
+		if !reconcileDelete {
+			approved, err := checkApproval(ctx, r.Client, r.Config.Approval, r.Config.ManualMode, v1alpha1.GPUResetKind,
+				&gpuReset, &gpuReset.Status.Conditions, gpuReset.Spec.NodeName)
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+
+			// Pending maintenance does not hold the node lock or exclusion group slots, see RebootNodeReconciler.
+			if !approved {
+				return ctrl.Result{RequeueAfter: pendingApprovalRequeueInterval}, nil
+			}
+		}
+
 		locked := r.NodeLock.LockNode(ctx, &gpuReset, gpuReset.Spec.NodeName)
 		if !locked {
 			return ctrl.Result{RequeueAfter: time.Second * 2}, nil
@@ -151,6 +158,16 @@ func (r *GPUResetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 			return result, err
 		}
 
+		locked, err := lockExclusionGroups(ctx, r.Client, r.GroupLock, &gpuReset, &gpuReset.Status.Conditions,
+			gpuReset.Spec.NodeName)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		if !locked {
+			return ctrl.Result{RequeueAfter: exclusionGroupRequeueInterval}, nil
+		}
+
 		result, err := r.reconcileHelper(ctx, &gpuReset)
 		// We will always re-queue the object and check if Unlock is needed on the next reconcile rather than
 		// re-fetch the object or require reconcileHelper to specify it completed reconciling. If the controller
@@ -170,7 +187,9 @@ func (r *GPUResetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 	r.endResetSession(crKey)
 
 	retryUnlock := r.NodeLock.CheckUnlock(ctx, &gpuReset, gpuReset.Spec.NodeName)
-	if retryUnlock {
+	retryUnlockGroups := r.GroupLock.CheckUnlockGroups(ctx, &gpuReset, gpuReset.Spec.NodeName)
+
+	if retryUnlock || retryUnlockGroups {
 		return ctrl.Result{RequeueAfter: time.Second * 2}, nil
 	}
 
@@ -261,6 +280,7 @@ func (r *GPUResetReconciler) SetupWithManager(mgr ctrl.Manager) error {
 
 	// Initialize NodeLock for distributed locking across maintenance operations
 	r.NodeLock = distributedlock.NewNodeLock(mgr.GetClient(), r.LockNamespace)
+	r.GroupLock = distributedlock.NewGroupLock(mgr.GetClient(), r.LockNamespace, r.Config.ExclusionGroups)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.GPUReset{}).
@@ -484,24 +504,11 @@ func (r *GPUResetReconciler) tearDownServices(ctx context.Context, gr *v1alpha1.
 	}
 
 	nodeToUpdate := node.DeepCopy()
-	nodeUpdated := false
 
 	// Set node labels to disable managed services
-	for _, app := range r.serviceManager.Spec.Apps {
-		if value, exists := nodeToUpdate.Labels[app.NodeLabel]; !exists || value != app.DisabledValue {
-			log.V(1).Info("Setting node label to disable managed services", "node", node.Name, "manager", managerName,
-				"label", app.NodeLabel, "value", app.DisabledValue)
+	if r.serviceManager.SetNodeLabels(nodeToUpdate, false) {
+		log.V(1).Info("Setting node labels to disable managed services", "node", node.Name, "manager", managerName)
 
-			if nodeToUpdate.Labels == nil {
-				nodeToUpdate.Labels = make(map[string]string)
-			}
-
-			nodeToUpdate.Labels[app.NodeLabel] = app.DisabledValue
-			nodeUpdated = true
-		}
-	}
-
-	if nodeUpdated {
 		if err := r.Patch(ctx, nodeToUpdate, client.MergeFrom(node)); err != nil {
 			span.SetAttributes(
 				attribute.String("janitor.error.type", "node_update_failed"),
@@ -758,24 +765,11 @@ func (r *GPUResetReconciler) restoreServices(ctx context.Context, gr *v1alpha1.G
 	}
 
 	nodeToUpdate := node.DeepCopy()
-	nodeUpdated := false
 
 	// Set node labels back to enabled value to restore services
-	for _, app := range r.serviceManager.Spec.Apps {
-		if value, exists := nodeToUpdate.Labels[app.NodeLabel]; !exists || value != app.EnabledValue {
-			log.V(1).Info("Setting node label to enable managed service", "node", node.Name, "manager", managerName,
-				"label", app.NodeLabel, "value", app.EnabledValue)
-
-			if nodeToUpdate.Labels == nil {
-				nodeToUpdate.Labels = make(map[string]string)
-			}
+	if r.serviceManager.SetNodeLabels(nodeToUpdate, true) {
+		log.V(1).Info("Setting node labels to enable managed services", "node", node.Name, "manager", managerName)
 
-			nodeToUpdate.Labels[app.NodeLabel] = app.EnabledValue
-			nodeUpdated = true
-		}
-	}
-
-	if nodeUpdated {
 		if err := r.Patch(ctx, nodeToUpdate, client.MergeFrom(node)); err != nil {
 			span.SetAttributes(
 				attribute.String("janitor.error.type", "node_update_failed"),
@@ -869,20 +863,7 @@ func (r *GPUResetReconciler) reconcileCompletion(ctx context.Context, gr *v1alph
 // expectedJobName returns a valid and deterministic name for a Job. It ensures
 // the name does not exceed 63 characters to avoid issues with derived Pod names.
 func (r *GPUResetReconciler) expectedJobName(gr *v1alpha1.GPUReset) string {
-	baseName := gr.Name
-
-	if len(baseName)+len(jobNameSuffix) <= validation.DNS1123LabelMaxLength {
-		return baseName + jobNameSuffix
-	}
-
-	if len(baseName) > maxJobNameBaseLength {
-		baseName = baseName[0:maxJobNameBaseLength]
-	}
-
-	hash := sha256.Sum256([]byte(gr.Name))
-	shortHash := fmt.Sprintf("%x", hash)[:8]
-
-	return fmt.Sprintf("%s-%s%s", baseName, shortHash, jobNameSuffix)
+	return boundedJobName(gr.Name, jobNameSuffix)
 }
 
 // getOrCreateJob ensures the GPU reset job exists for the given GPUReset.
@@ -1103,98 +1084,13 @@ func (r *GPUResetReconciler) newGpuResetJob(ctx context.Context, gr *v1alpha1.GP
 // checkPodsTerminated verifies that all pods belonging to the managed services
 // have been successfully terminated on the target node.
 func (r *GPUResetReconciler) checkPodsTerminated(ctx context.Context, nodeName string) (bool, error) {
-	log := log.FromContext(ctx)
-
-	if len(r.serviceManager.Spec.Apps) == 0 {
-		return true, nil
-	}
-
-	managerName := r.serviceManager.Name
-
-	for _, app := range r.serviceManager.Spec.Apps {
-		finalSelectorMap := make(map[string]string)
-		maps.Copy(finalSelectorMap, r.serviceManager.Spec.ManagerSelector)
-		maps.Copy(finalSelectorMap, app.AppSelector)
-
-		listOptions := []client.ListOption{
-			client.InNamespace(r.serviceManager.Spec.Namespace),
-			client.MatchingLabels(finalSelectorMap),
-			client.MatchingFields{"spec.nodeName": nodeName},
-		}
-
-		pods := &corev1.PodList{}
-		if err := r.List(ctx, pods, listOptions...); err != nil {
-			return false, fmt.Errorf("failed to list %s pods for termination check for node %s: %w",
-				managerName, nodeName, err)
-		}
-
-		if len(pods.Items) > 0 {
-			log.V(1).Info("Managed service pod still running on node", "node", nodeName, "manager", managerName,
-				"count", len(pods.Items), "selector", finalSelectorMap)
-
-			return false, nil
-		}
-	}
-
-	return true, nil
+	return r.serviceManager.PodsTerminated(ctx, r.Client, nodeName)
 }
 
 // checkPodsReady verifies that all pods belonging to the managed services
 // have been successfully re-deployed and are in a Ready state on the target node.
 func (r *GPUResetReconciler) checkPodsReady(ctx context.Context, nodeName string) (bool, error) {
-	log := log.FromContext(ctx)
-
-	if len(r.serviceManager.Spec.Apps) == 0 {
-		return true, nil
-	}
-
-	managerName := r.serviceManager.Name
-
-	for _, app := range r.serviceManager.Spec.Apps {
-		finalSelectorMap := make(map[string]string)
-		maps.Copy(finalSelectorMap, r.serviceManager.Spec.ManagerSelector)
-		maps.Copy(finalSelectorMap, app.AppSelector)
-
-		listOptions := []client.ListOption{
-			client.InNamespace(r.serviceManager.Spec.Namespace),
-			client.MatchingLabels(finalSelectorMap),
-			client.MatchingFields{"spec.nodeName": nodeName},
-		}
-
-		pods := &corev1.PodList{}
-		if err := r.List(ctx, pods, listOptions...); err != nil {
-			return false, fmt.Errorf("failed to list %s managed service pods for ready check for node %s: %w",
-				managerName, nodeName, err)
-		}
-
-		if len(pods.Items) == 0 {
-			log.V(1).Info("Waiting for managed service pod to be created", "node", nodeName, "manager", managerName,
-				"selector", finalSelectorMap)
-
-			return false, nil
-		}
-
-		// Check if at least one pod is ready for the given app selector
-		for _, pod := range pods.Items {
-			isReady := false
-
-			for _, cond := range pod.Status.Conditions {
-				if cond.Type == corev1.PodReady && cond.Status == corev1.ConditionTrue {
-					isReady = true
-					break
-				}
-			}
-
-			if !isReady {
-				log.V(1).Info("Waiting for managed service pod to become Ready", "node", nodeName,
-					"manager", managerName, "pod", pod.Name, "phase", pod.Status.Phase)
-
-				return false, nil
-			}
-		}
-	}
-
-	return true, nil
+	return r.serviceManager.PodsReady(ctx, r.Client, nodeName)
 }
 
 // reconcileTerminalFailure updates the GPUReset status to a terminal failed state
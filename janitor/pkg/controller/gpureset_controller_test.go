@@ -58,6 +58,18 @@ func (m *mockNodeLock) CheckUnlock(ctx context.Context, maintenanceObject client
 	return false
 }
 
+type mockGroupLock struct{}
+
+func (m *mockGroupLock) LockGroups(ctx context.Context, maintenanceObject client.Object,
+	nodeName string) (locked bool, waitingFor string) {
+	return true, ""
+}
+
+func (m *mockGroupLock) CheckUnlockGroups(ctx context.Context, maintenanceObject client.Object,
+	nodeName string) (retryUnlock bool) {
+	return false
+}
+
 var _ = Describe("GPUReset Controller", func() {
 	var reconciler *GPUResetReconciler
 	var mgrClient client.Client
@@ -148,6 +160,7 @@ var _ = Describe("GPUReset Controller", func() {
 				ResolvedJobTemplate: customTemplate,
 			},
 			NodeLock:       &mockNodeLock{},
+			GroupLock:      &mockGroupLock{},
 			serviceManager: testServiceManager,
 		}
 
@@ -0,0 +1,641 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/nvidia/nvsentinel/commons/pkg/tracing"
+	"github.com/nvidia/nvsentinel/janitor/api/v1alpha1"
+	"github.com/nvidia/nvsentinel/janitor/pkg/config"
+	"github.com/nvidia/nvsentinel/janitor/pkg/distributedlock"
+	"github.com/nvidia/nvsentinel/janitor/pkg/gpuservices"
+	"github.com/nvidia/nvsentinel/janitor/pkg/metrics"
+)
+
+const (
+	// gpuServicesRestartFinalizer ensures the managed services are restored if a GPUServicesRestart is deleted while
+	// they are torn down. It is removed once the restart completed.
+	gpuServicesRestartFinalizer = "janitor.dgxc.nvidia.com/finalizer"
+	// driverReloadJobNameSuffix is appended to the GPUServicesRestart name to form the name of its driver reload Job.
+	driverReloadJobNameSuffix = "-reload-job"
+)
+
+// GPUServicesRestartReconciler reconciles a GPUServicesRestart object. It restarts the GPU software stack of a node
+// without rebooting it: the managed GPU services are torn down by toggling their node labels, the NVIDIA kernel
+// modules are optionally reloaded by a Job on the node, and the services are restored. Services are restored even if
+// the teardown or the driver reload failed, so that a failed restart leaves the node as it found it.
+type GPUServicesRestartReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+	// Config holds controller-specific configuration.
+	Config *config.GPUServicesRestartControllerConfig
+	// serviceManager is the configuration for the managed GPU services that are torn down and restored.
+	serviceManager gpuservices.Manager
+	// checkPodsTerminatedFn is a function pointer for checking pod termination status, allowing for mocking in tests.
+	checkPodsTerminatedFn checkPodsTerminatedFn
+	// checkPodsReadyFn is a function pointer for checking pod readiness status, allowing for mocking in tests.
+	checkPodsReadyFn checkPodsReadyFn
+	// NodeLock provides node-level locking across Janitor controllers
+	NodeLock      distributedlock.NodeLock
+	GroupLock     distributedlock.GroupLock
+	LockNamespace string
+}
+
+//nolint:lll // kubebuilder RBAC marker must stay on one line
+// +kubebuilder:rbac:groups=janitor.dgxc.nvidia.com,resources=gpuservicesrestarts,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=janitor.dgxc.nvidia.com,resources=gpuservicesrestarts/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=janitor.dgxc.nvidia.com,resources=gpuservicesrestarts/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch;patch;update
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups="coordination.k8s.io",resources=leases,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;delete
+
+func (r *GPUServicesRestartReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	var restart v1alpha1.GPUServicesRestart
+	if err := r.Get(ctx, req.NamespacedName, &restart); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if restart.Status.CompletionTime == nil {
+		ctx, span := tracing.StartSpan(ctx, "janitor.gpuservicesrestart.reconcile")
+		defer span.End()
+
+		if restart.DeletionTimestamp.IsZero() {
+			approved, err := checkApproval(ctx, r.Client, r.Config.Approval, r.Config.ManualMode,
+				v1alpha1.GPUServicesRestartKind, &restart, &restart.Status.Conditions, restart.Spec.NodeName)
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+
+			// Pending maintenance does not hold the node lock or exclusion group slots, see RebootNodeReconciler.
+			if !approved {
+				return ctrl.Result{RequeueAfter: pendingApprovalRequeueInterval}, nil
+			}
+		}
+
+		locked := r.NodeLock.LockNode(ctx, &restart, restart.Spec.NodeName)
+		if !locked {
+			return ctrl.Result{RequeueAfter: time.Second * 2}, nil
+		}
+
+		// Like GPUReset, deletion restores the services under the node lock so that it cannot conflict with the
+		// teardown of another maintenance resource. The lease is garbage collected with the deleted resource.
+		if !restart.DeletionTimestamp.IsZero() {
+			return r.reconcileDelete(ctx, &restart)
+		}
+
+		locked, err := lockExclusionGroups(ctx, r.Client, r.GroupLock, &restart, &restart.Status.Conditions,
+			restart.Spec.NodeName)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		if !locked {
+			return ctrl.Result{RequeueAfter: exclusionGroupRequeueInterval}, nil
+		}
+
+		result, err := r.reconcileHelper(ctx, &restart)
+		// Always re-queue so that the locks are released on the reconcile after completion, see RebootNodeReconciler.
+		if err != nil || result.RequeueAfter > 0 {
+			return result, err
+		}
+
+		return ctrl.Result{RequeueAfter: time.Second * 2}, nil
+	}
+
+	if err := r.removeFinalizer(ctx, &restart); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	retryUnlock := r.NodeLock.CheckUnlock(ctx, &restart, restart.Spec.NodeName)
+	retryUnlockGroups := r.GroupLock.CheckUnlockGroups(ctx, &restart, restart.Spec.NodeName)
+
+	if retryUnlock || retryUnlockGroups {
+		return ctrl.Result{RequeueAfter: time.Second * 2}, nil
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// reconcileHelper runs the next step of the restart and persists the status changes it made.
+func (r *GPUServicesRestartReconciler) reconcileHelper(ctx context.Context,
+	restart *v1alpha1.GPUServicesRestart) (ctrl.Result, error) {
+	if restart.Status.StartTime == nil {
+		return r.initialize(ctx, restart)
+	}
+
+	original := restart.DeepCopy()
+
+	var (
+		result ctrl.Result
+		err    error
+	)
+
+	switch {
+	case !stepFinished(restart.Status.Conditions, v1alpha1.GPUServicesRestartConditionServicesTornDown,
+		v1alpha1.GPUServicesRestartReasonTearingDownServices):
+		result, err = r.tearDownServices(ctx, restart)
+	case r.driverReloadPending(restart):
+		result, err = r.reloadDriver(ctx, restart)
+	case !stepFinished(restart.Status.Conditions, v1alpha1.GPUServicesRestartConditionServicesRestored,
+		v1alpha1.GPUServicesRestartReasonRestoringServices):
+		result, err = r.restoreServices(ctx, restart)
+	default:
+		r.complete(ctx, restart)
+	}
+
+	if statusErr := r.updateStatusIfChanged(ctx, original, restart); statusErr != nil {
+		return ctrl.Result{}, statusErr
+	}
+
+	return result, err
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *GPUServicesRestartReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	serviceManager, err := gpuservices.NewManager(r.Config.ServiceManager.Name, r.Config.ServiceManager.Spec)
+	if err != nil {
+		return fmt.Errorf("failed to construct GPU service manager: %w", err)
+	}
+
+	r.serviceManager = serviceManager
+	// Set concrete implementations for checking pod status, which can be overridden in tests.
+	r.checkPodsTerminatedFn = func(ctx context.Context, nodeName string) (bool, error) {
+		return r.serviceManager.PodsTerminated(ctx, r.Client, nodeName)
+	}
+	r.checkPodsReadyFn = func(ctx context.Context, nodeName string) (bool, error) {
+		return r.serviceManager.PodsReady(ctx, r.Client, nodeName)
+	}
+
+	r.NodeLock = distributedlock.NewNodeLock(mgr.GetClient(), r.LockNamespace)
+	r.GroupLock = distributedlock.NewGroupLock(mgr.GetClient(), r.LockNamespace, r.Config.ExclusionGroups)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.GPUServicesRestart{}).
+		// Reconcile the owning GPUServicesRestart when its driver reload Job changes state.
+		Owns(&batchv1.Job{}).
+		Named("gpuservicesrestart").
+		Complete(r)
+}
+
+// initialize adds the finalizer and records the start time.
+func (r *GPUServicesRestartReconciler) initialize(ctx context.Context,
+	restart *v1alpha1.GPUServicesRestart) (ctrl.Result, error) {
+	if !controllerutil.ContainsFinalizer(restart, gpuServicesRestartFinalizer) {
+		controllerutil.AddFinalizer(restart, gpuServicesRestartFinalizer)
+
+		if err := r.Update(ctx, restart); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to add finalizer to %s: %w", restart.Name, err)
+		}
+	}
+
+	original := restart.DeepCopy()
+	now := metav1.Now()
+	restart.Status.StartTime = &now
+
+	if err := r.updateStatusIfChanged(ctx, original, restart); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	metrics.GlobalMetrics.IncActionCount(metrics.ActionTypeRestartGPUServices, metrics.StatusStarted,
+		restart.Spec.NodeName)
+
+	return ctrl.Result{}, nil
+}
+
+// tearDownServices disables the managed services by setting their node labels to the disabled value and waits for
+// their pods to terminate.
+func (r *GPUServicesRestartReconciler) tearDownServices(ctx context.Context,
+	restart *v1alpha1.GPUServicesRestart) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+
+	nodeName := restart.Spec.NodeName
+	managerName := r.serviceManager.Name
+	conditions := &restart.Status.Conditions
+
+	if len(r.serviceManager.Spec.Apps) == 0 {
+		setStepCondition(conditions, v1alpha1.GPUServicesRestartConditionServicesTornDown, metav1.ConditionTrue,
+			v1alpha1.GPUServicesRestartReasonSkipped,
+			"No services manager configured, or has no managed services specified")
+
+		return ctrl.Result{}, nil
+	}
+
+	currentCond := meta.FindStatusCondition(*conditions, v1alpha1.GPUServicesRestartConditionServicesTornDown)
+	if currentCond == nil {
+		setStepCondition(conditions, v1alpha1.GPUServicesRestartConditionServicesTornDown, metav1.ConditionFalse,
+			v1alpha1.GPUServicesRestartReasonTearingDownServices,
+			fmt.Sprintf("Removing %s managed services", managerName))
+
+		return ctrl.Result{}, nil
+	}
+
+	teardownTimeout := r.serviceManager.Spec.TeardownTimeout
+	if time.Since(currentCond.LastTransitionTime.Time) > teardownTimeout {
+		log.Error(nil, "Managed service teardown timeout exceeded", "manager", managerName, "timeout", teardownTimeout)
+		setStepCondition(conditions, v1alpha1.GPUServicesRestartConditionServicesTornDown, metav1.ConditionFalse,
+			v1alpha1.GPUServicesRestartReasonServiceTeardownTimeoutExceeded,
+			fmt.Sprintf("Failed to teardown %s managed services within the timeout period", managerName))
+
+		return ctrl.Result{}, nil
+	}
+
+	var node corev1.Node
+	if err := r.Get(ctx, client.ObjectKey{Name: nodeName}, &node); err != nil {
+		if apierrors.IsNotFound(err) {
+			setStepCondition(conditions, v1alpha1.GPUServicesRestartConditionServicesTornDown, metav1.ConditionFalse,
+				v1alpha1.GPUServicesRestartReasonNodeNotFound, "Target node for GPU services restart was not found")
+
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, fmt.Errorf("failed to get node %s for service teardown: %w", nodeName, err)
+	}
+
+	nodeToUpdate := node.DeepCopy()
+	if r.serviceManager.SetNodeLabels(nodeToUpdate, false) {
+		if err := r.Patch(ctx, nodeToUpdate, client.MergeFrom(&node)); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to patch node %s to disable %s managed services: %w",
+				nodeName, managerName, err)
+		}
+
+		log.Info("Managed services disabled", "node", nodeName, "manager", managerName)
+
+		return ctrl.Result{RequeueAfter: time.Second * 2}, nil
+	}
+
+	podsAreGone, err := r.checkPodsTerminatedFn(ctx, nodeName)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to check pod termination status for node %s: %w", nodeName, err)
+	}
+
+	if !podsAreGone {
+		log.V(1).Info("Waiting for managed service pods to be terminated", "node", nodeName, "manager", managerName)
+
+		return ctrl.Result{RequeueAfter: 3 * time.Second}, nil
+	}
+
+	setStepCondition(conditions, v1alpha1.GPUServicesRestartConditionServicesTornDown, metav1.ConditionTrue,
+		v1alpha1.GPUServicesRestartReasonServiceTeardownSucceeded,
+		fmt.Sprintf("%s managed services have been removed", managerName))
+
+	return ctrl.Result{}, nil
+}
+
+// driverReloadPending returns whether the driver reload was requested and has not finished yet. The driver is only
+// reloaded once the services were torn down, since they hold the kernel modules.
+func (r *GPUServicesRestartReconciler) driverReloadPending(restart *v1alpha1.GPUServicesRestart) bool {
+	return restart.Spec.ReloadDriver &&
+		meta.IsStatusConditionTrue(restart.Status.Conditions, v1alpha1.GPUServicesRestartConditionServicesTornDown) &&
+		!stepFinished(restart.Status.Conditions, v1alpha1.GPUServicesRestartConditionDriverReloaded,
+			v1alpha1.GPUServicesRestartReasonReloadingDriver)
+}
+
+// reloadDriver claims the driver reload Job in the status, creates it and waits for it to finish.
+func (r *GPUServicesRestartReconciler) reloadDriver(ctx context.Context,
+	restart *v1alpha1.GPUServicesRestart) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+
+	conditions := &restart.Status.Conditions
+
+	if r.Config.ResolvedJobTemplate == nil {
+		setStepCondition(conditions, v1alpha1.GPUServicesRestartConditionDriverReloaded, metav1.ConditionFalse,
+			v1alpha1.GPUServicesRestartReasonDriverReloadNotConfigured,
+			"The driver reload job is not configured for the GPU services restart controller")
+
+		return ctrl.Result{}, nil
+	}
+
+	if restart.Status.JobRef == nil {
+		// Persist the Job reference before creating the Job, so that a restart never creates a second Job
+		restart.Status.JobRef = &corev1.ObjectReference{
+			Kind:      "Job",
+			Name:      boundedJobName(restart.Name, driverReloadJobNameSuffix),
+			Namespace: r.Config.ResolvedJobTemplate.Namespace,
+		}
+		setStepCondition(conditions, v1alpha1.GPUServicesRestartConditionDriverReloaded, metav1.ConditionFalse,
+			v1alpha1.GPUServicesRestartReasonReloadingDriver, "Reloading NVIDIA kernel modules")
+
+		return ctrl.Result{}, nil
+	}
+
+	jobRef := restart.Status.JobRef
+
+	var job batchv1.Job
+
+	err := r.Get(ctx, client.ObjectKey{Namespace: jobRef.Namespace, Name: jobRef.Name}, &job)
+	if apierrors.IsNotFound(err) {
+		return r.createDriverReloadJob(ctx, restart)
+	}
+
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to get Job %s/%s: %w", jobRef.Namespace, jobRef.Name, err)
+	}
+
+	if !metav1.IsControlledBy(&job, restart) {
+		setStepCondition(conditions, v1alpha1.GPUServicesRestartConditionDriverReloaded, metav1.ConditionFalse,
+			v1alpha1.GPUServicesRestartReasonDriverReloadJobNotFound,
+			fmt.Sprintf("Job %s/%s exists but is not owned by %s", job.Namespace, job.Name, restart.Name))
+
+		return ctrl.Result{}, nil
+	}
+
+	switch {
+	case job.Status.Succeeded > 0:
+		log.V(1).Info("Driver reload job completed successfully", "job", job.Name, "namespace", job.Namespace)
+		setStepCondition(conditions, v1alpha1.GPUServicesRestartConditionDriverReloaded, metav1.ConditionTrue,
+			v1alpha1.GPUServicesRestartReasonDriverReloadSucceeded, "NVIDIA kernel modules reloaded")
+	case job.Status.Failed > 0:
+		log.Info("Driver reload job failed", "job", job.Name, "namespace", job.Namespace)
+		setStepCondition(conditions, v1alpha1.GPUServicesRestartConditionDriverReloaded, metav1.ConditionFalse,
+			v1alpha1.GPUServicesRestartReasonDriverReloadFailed,
+			fmt.Sprintf("Failed to reload NVIDIA kernel modules, see Job %s/%s", job.Namespace, job.Name))
+	default:
+		log.V(1).Info("Waiting for driver reload job to complete", "job", job.Name, "namespace", job.Namespace)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// createDriverReloadJob creates the driver reload Job after checking that the managed services did not come back
+// since they were torn down.
+func (r *GPUServicesRestartReconciler) createDriverReloadJob(ctx context.Context,
+	restart *v1alpha1.GPUServicesRestart) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+
+	nodeName := restart.Spec.NodeName
+
+	podsAreGone, err := r.checkPodsTerminatedFn(ctx, nodeName)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to run %s managed service drift check on node %s: %w",
+			r.serviceManager.Name, nodeName, err)
+	}
+
+	if !podsAreGone {
+		log.Info("Drift detected: managed service pods are still running, re-initiating teardown", "node", nodeName,
+			"manager", r.serviceManager.Name)
+		setStepCondition(&restart.Status.Conditions, v1alpha1.GPUServicesRestartConditionServicesTornDown,
+			metav1.ConditionFalse, v1alpha1.GPUServicesRestartReasonTearingDownServices,
+			fmt.Sprintf("Drift detected: %s managed service pods are still running", r.serviceManager.Name))
+
+		return ctrl.Result{}, nil
+	}
+
+	job, err := r.newDriverReloadJob(restart)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if err := r.Create(ctx, job); err != nil && !apierrors.IsAlreadyExists(err) {
+		return ctrl.Result{}, fmt.Errorf("failed to create job %s/%s for GPUServicesRestart %s: %w",
+			job.Namespace, job.Name, restart.Name, err)
+	}
+
+	log.Info("Driver reload job created", "job", job.Name, "namespace", job.Namespace, "node", nodeName)
+
+	return ctrl.Result{}, nil
+}
+
+// newDriverReloadJob constructs the driver reload Job from the resolved job template.
+func (r *GPUServicesRestartReconciler) newDriverReloadJob(restart *v1alpha1.GPUServicesRestart) (*batchv1.Job, error) {
+	jobMeta := *r.Config.ResolvedJobTemplate.ObjectMeta.DeepCopy()
+	jobMeta.Name = restart.Status.JobRef.Name
+	jobMeta.Namespace = restart.Status.JobRef.Namespace
+
+	if jobMeta.Labels == nil {
+		jobMeta.Labels = make(map[string]string)
+	}
+
+	if len(restart.Name) <= validation.DNS1123LabelMaxLength {
+		jobMeta.Labels["gpuservicesrestart-name"] = restart.Name
+	}
+
+	jobSpec := *r.Config.ResolvedJobTemplate.Spec.DeepCopy()
+	jobSpec.Template.Spec.NodeName = restart.Spec.NodeName
+
+	if jobSpec.Template.Spec.RestartPolicy == "" {
+		jobSpec.Template.Spec.RestartPolicy = corev1.RestartPolicyOnFailure
+	}
+
+	job := &batchv1.Job{
+		ObjectMeta: jobMeta,
+		Spec:       jobSpec,
+	}
+
+	if err := ctrl.SetControllerReference(restart, job, r.Scheme); err != nil {
+		return nil, fmt.Errorf("failed to set controller reference on job %s/%s: %w", job.Namespace, job.Name, err)
+	}
+
+	return job, nil
+}
+
+// restoreServices re-enables the managed services by setting their node labels to the enabled value and waits for
+// their pods to become ready.
+func (r *GPUServicesRestartReconciler) restoreServices(ctx context.Context,
+	restart *v1alpha1.GPUServicesRestart) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+
+	nodeName := restart.Spec.NodeName
+	managerName := r.serviceManager.Name
+	conditions := &restart.Status.Conditions
+
+	var node corev1.Node
+
+	err := r.Get(ctx, client.ObjectKey{Name: nodeName}, &node)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return ctrl.Result{}, fmt.Errorf("failed to get node %s for service restoration: %w", nodeName, err)
+	}
+
+	if len(r.serviceManager.Spec.Apps) == 0 || apierrors.IsNotFound(err) {
+		setStepCondition(conditions, v1alpha1.GPUServicesRestartConditionServicesRestored, metav1.ConditionTrue,
+			v1alpha1.GPUServicesRestartReasonSkipped,
+			"No services manager configured, has no managed services specified, or the node no longer exists")
+
+		return ctrl.Result{}, nil
+	}
+
+	currentCond := meta.FindStatusCondition(*conditions, v1alpha1.GPUServicesRestartConditionServicesRestored)
+	if currentCond == nil {
+		setStepCondition(conditions, v1alpha1.GPUServicesRestartConditionServicesRestored, metav1.ConditionFalse,
+			v1alpha1.GPUServicesRestartReasonRestoringServices,
+			fmt.Sprintf("Re-deploying %s managed services", managerName))
+
+		return ctrl.Result{}, nil
+	}
+
+	restoreTimeout := r.serviceManager.Spec.RestoreTimeout
+	if time.Since(currentCond.LastTransitionTime.Time) > restoreTimeout {
+		log.Error(nil, "Managed service restoration timeout exceeded", "manager", managerName, "timeout", restoreTimeout)
+		setStepCondition(conditions, v1alpha1.GPUServicesRestartConditionServicesRestored, metav1.ConditionFalse,
+			v1alpha1.GPUServicesRestartReasonRestoreTimeoutExceeded,
+			fmt.Sprintf("Failed to restore %s managed services within the timeout period", managerName))
+
+		return ctrl.Result{}, nil
+	}
+
+	nodeToUpdate := node.DeepCopy()
+	if r.serviceManager.SetNodeLabels(nodeToUpdate, true) {
+		if err := r.Patch(ctx, nodeToUpdate, client.MergeFrom(&node)); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to patch node %s to re-enable %s managed services: %w",
+				nodeName, managerName, err)
+		}
+
+		log.Info("Managed services re-enabled", "node", nodeName, "manager", managerName)
+
+		return ctrl.Result{RequeueAfter: time.Second * 2}, nil
+	}
+
+	podsReady, err := r.checkPodsReadyFn(ctx, nodeName)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to check %s managed service pods readiness for node %s: %w",
+			managerName, nodeName, err)
+	}
+
+	if !podsReady {
+		log.V(1).Info("Waiting for managed service pods to become Ready", "node", nodeName, "manager", managerName)
+
+		return ctrl.Result{RequeueAfter: time.Second * 2}, nil
+	}
+
+	setStepCondition(conditions, v1alpha1.GPUServicesRestartConditionServicesRestored, metav1.ConditionTrue,
+		v1alpha1.GPUServicesRestartReasonServiceRestoreSucceeded,
+		fmt.Sprintf("%s managed services are Ready", managerName))
+
+	return ctrl.Result{}, nil
+}
+
+// complete sets the completion time and the Complete condition. The restart failed if any of its steps failed, in
+// which case Complete is False with the reason and message of the first failed step.
+func (r *GPUServicesRestartReconciler) complete(ctx context.Context, restart *v1alpha1.GPUServicesRestart) {
+	nodeName := restart.Spec.NodeName
+	now := metav1.Now()
+	restart.Status.CompletionTime = &now
+
+	for _, condType := range []string{
+		v1alpha1.GPUServicesRestartConditionServicesTornDown,
+		v1alpha1.GPUServicesRestartConditionDriverReloaded,
+		v1alpha1.GPUServicesRestartConditionServicesRestored,
+	} {
+		cond := meta.FindStatusCondition(restart.Status.Conditions, condType)
+		if cond == nil || cond.Status == metav1.ConditionTrue {
+			continue
+		}
+
+		log.FromContext(ctx).Info("GPU services restart failed", "node", nodeName, "reason", cond.Reason)
+		setStepCondition(&restart.Status.Conditions, v1alpha1.GPUServicesRestartConditionComplete,
+			metav1.ConditionFalse, cond.Reason, cond.Message)
+		metrics.GlobalMetrics.IncActionCount(metrics.ActionTypeRestartGPUServices, metrics.StatusFailed, nodeName)
+
+		return
+	}
+
+	log.FromContext(ctx).Info("GPU services restart successful", "node", nodeName)
+	setStepCondition(&restart.Status.Conditions, v1alpha1.GPUServicesRestartConditionComplete, metav1.ConditionTrue,
+		v1alpha1.GPUServicesRestartReasonSucceeded, "GPU services restarted")
+	metrics.GlobalMetrics.IncActionCount(metrics.ActionTypeRestartGPUServices, metrics.StatusSucceeded, nodeName)
+	metrics.GlobalMetrics.RecordActionMTTR(metrics.ActionTypeRestartGPUServices,
+		time.Since(restart.CreationTimestamp.Time))
+}
+
+// reconcileDelete restores the managed services of a GPUServicesRestart deleted before it completed and then
+// removes the finalizer.
+func (r *GPUServicesRestartReconciler) reconcileDelete(ctx context.Context,
+	restart *v1alpha1.GPUServicesRestart) (ctrl.Result, error) {
+	if !controllerutil.ContainsFinalizer(restart, gpuServicesRestartFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	if !stepFinished(restart.Status.Conditions, v1alpha1.GPUServicesRestartConditionServicesRestored,
+		v1alpha1.GPUServicesRestartReasonRestoringServices) {
+		log.FromContext(ctx).Info("Restoring managed services before deletion", "node", restart.Spec.NodeName)
+
+		original := restart.DeepCopy()
+
+		result, err := r.restoreServices(ctx, restart)
+		if statusErr := r.updateStatusIfChanged(ctx, original, restart); statusErr != nil {
+			return ctrl.Result{}, statusErr
+		}
+
+		if err != nil || result.RequeueAfter > 0 {
+			return result, err
+		}
+
+		return ctrl.Result{RequeueAfter: time.Second * 2}, nil
+	}
+
+	return ctrl.Result{}, r.removeFinalizer(ctx, restart)
+}
+
+func (r *GPUServicesRestartReconciler) removeFinalizer(ctx context.Context,
+	restart *v1alpha1.GPUServicesRestart) error {
+	if !controllerutil.ContainsFinalizer(restart, gpuServicesRestartFinalizer) {
+		return nil
+	}
+
+	controllerutil.RemoveFinalizer(restart, gpuServicesRestartFinalizer)
+
+	if err := r.Update(ctx, restart); err != nil {
+		return fmt.Errorf("failed to remove finalizer from %s: %w", restart.Name, err)
+	}
+
+	return nil
+}
+
+func (r *GPUServicesRestartReconciler) updateStatusIfChanged(ctx context.Context,
+	original, restart *v1alpha1.GPUServicesRestart) error {
+	if reflect.DeepEqual(original.Status, restart.Status) {
+		return nil
+	}
+
+	if err := r.Status().Patch(ctx, restart, client.MergeFrom(original)); err != nil {
+		return fmt.Errorf("failed to patch GPUServicesRestart %s status: %w", restart.Name, err)
+	}
+
+	return nil
+}
+
+// stepFinished returns whether the condition of a restart step reached its final state: True once the step
+// succeeded, or False with a failure reason rather than the in-progress reason.
+func stepFinished(conditions []metav1.Condition, condType, inProgressReason string) bool {
+	cond := meta.FindStatusCondition(conditions, condType)
+
+	return cond != nil && (cond.Status == metav1.ConditionTrue || cond.Reason != inProgressReason)
+}
+
+func setStepCondition(conditions *[]metav1.Condition, condType string, status metav1.ConditionStatus,
+	reason, message string) {
+	meta.SetStatusCondition(conditions, metav1.Condition{
+		Type:    condType,
+		Status:  status,
+		Reason:  reason,
+		Message: message,
+	})
+}
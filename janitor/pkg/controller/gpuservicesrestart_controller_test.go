@@ -0,0 +1,303 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/nvidia/nvsentinel/janitor/api/v1alpha1"
+	"github.com/nvidia/nvsentinel/janitor/pkg/config"
+	"github.com/nvidia/nvsentinel/janitor/pkg/gpuservices"
+)
+
+var _ = Describe("GPUServicesRestart Controller", func() {
+	const (
+		nodeName    = "services-restart-test-node"
+		restartName = "services-restart-test"
+	)
+
+	var (
+		reconciler         *GPUServicesRestartReconciler
+		node               *corev1.Node
+		typeNamespacedName = types.NamespacedName{Name: restartName}
+	)
+
+	gpuOperatorServiceManager, err := gpuservices.NewManager("gpu-operator", gpuservices.ManagerSpec{})
+	Expect(err).NotTo(HaveOccurred())
+
+	reconcileUntil := func(check func(g Gomega, restart *v1alpha1.GPUServicesRestart)) {
+		Eventually(func(g Gomega) {
+			_, _ = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+
+			var restart v1alpha1.GPUServicesRestart
+			g.Expect(k8sClient.Get(ctx, typeNamespacedName, &restart)).To(Succeed())
+			check(g, &restart)
+		}, "10s", "250ms").Should(Succeed())
+	}
+
+	getReloadJob := func() *batchv1.Job {
+		var job batchv1.Job
+		Eventually(func(g Gomega) {
+			_, _ = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+
+			g.Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: "default",
+				Name: restartName + driverReloadJobNameSuffix}, &job)).To(Succeed())
+		}, "10s", "250ms").Should(Succeed())
+
+		return &job
+	}
+
+	BeforeEach(func() {
+		backoffLimit := int32(2)
+
+		reconciler = &GPUServicesRestartReconciler{
+			Client: k8sClient,
+			Scheme: k8sClient.Scheme(),
+			Config: &config.GPUServicesRestartControllerConfig{
+				ServiceManager: gpuOperatorServiceManager,
+				ResolvedJobTemplate: &batchv1.JobTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "default"},
+					Spec: batchv1.JobSpec{
+						BackoffLimit: &backoffLimit,
+						Template: corev1.PodTemplateSpec{
+							Spec: corev1.PodSpec{
+								RestartPolicy: corev1.RestartPolicyOnFailure,
+								Containers: []corev1.Container{
+									{
+										Name:    config.DriverReloadContainerName,
+										Image:   "ghcr.io/nvidia/nvsentinel/gpu-reset:latest",
+										Command: []string{config.DriverReloadCommand},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			NodeLock:       &mockNodeLock{},
+			GroupLock:      &mockGroupLock{},
+			serviceManager: gpuOperatorServiceManager,
+		}
+		reconciler.checkPodsTerminatedFn = func(ctx context.Context, nodeName string) (bool, error) {
+			return true, nil
+		}
+		reconciler.checkPodsReadyFn = func(ctx context.Context, nodeName string) (bool, error) {
+			return true, nil
+		}
+
+		By("Creating a test node")
+		node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: nodeName, Labels: make(map[string]string)}}
+		Expect(k8sClient.Create(ctx, node)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		By("Cleaning up resources")
+		if err := k8sClient.Delete(ctx, node); err != nil && !apierrors.IsNotFound(err) {
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		var restart v1alpha1.GPUServicesRestart
+		if err := k8sClient.Get(ctx, typeNamespacedName, &restart); err == nil {
+			controllerutil.RemoveFinalizer(&restart, gpuServicesRestartFinalizer)
+			Expect(k8sClient.Update(ctx, &restart)).To(Succeed())
+			Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, &restart))).To(Succeed())
+		}
+
+		job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Namespace: "default",
+			Name: restartName + driverReloadJobNameSuffix}}
+		err := k8sClient.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground))
+		Expect(client.IgnoreNotFound(err)).To(Succeed())
+	})
+
+	It("should restart the GPU services without reloading the driver", func() {
+		restart := &v1alpha1.GPUServicesRestart{
+			ObjectMeta: metav1.ObjectMeta{Name: restartName},
+			Spec:       v1alpha1.GPUServicesRestartSpec{NodeName: nodeName},
+		}
+		Expect(k8sClient.Create(ctx, restart)).To(Succeed())
+
+		By("Waiting for the services to be torn down")
+		reconcileUntil(func(g Gomega, restart *v1alpha1.GPUServicesRestart) {
+			g.Expect(restart.Status.StartTime).NotTo(BeNil())
+			g.Expect(controllerutil.ContainsFinalizer(restart, gpuServicesRestartFinalizer)).To(BeTrue())
+			g.Expect(meta.IsStatusConditionTrue(restart.Status.Conditions,
+				v1alpha1.GPUServicesRestartConditionServicesTornDown)).To(BeTrue())
+		})
+
+		var updatedNode corev1.Node
+		Expect(k8sClient.Get(ctx, client.ObjectKey{Name: nodeName}, &updatedNode)).To(Succeed())
+		Expect(updatedNode.Labels).To(HaveKeyWithValue("nvidia.com/gpu.deploy.device-plugin", "false"))
+
+		By("Waiting for the restart to complete")
+		reconcileUntil(func(g Gomega, restart *v1alpha1.GPUServicesRestart) {
+			g.Expect(restart.Status.CompletionTime).NotTo(BeNil())
+			g.Expect(controllerutil.ContainsFinalizer(restart, gpuServicesRestartFinalizer)).To(BeFalse())
+
+			cond := meta.FindStatusCondition(restart.Status.Conditions, v1alpha1.GPUServicesRestartConditionComplete)
+			g.Expect(cond).NotTo(BeNil())
+			g.Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+			g.Expect(cond.Reason).To(Equal(v1alpha1.GPUServicesRestartReasonSucceeded))
+			g.Expect(meta.FindStatusCondition(restart.Status.Conditions,
+				v1alpha1.GPUServicesRestartConditionDriverReloaded)).To(BeNil())
+			g.Expect(restart.Status.JobRef).To(BeNil())
+		})
+
+		Expect(k8sClient.Get(ctx, client.ObjectKey{Name: nodeName}, &updatedNode)).To(Succeed())
+		Expect(updatedNode.Labels).To(HaveKeyWithValue("nvidia.com/gpu.deploy.device-plugin", "true"))
+	})
+
+	It("should reload the driver while the GPU services are torn down", func() {
+		restart := &v1alpha1.GPUServicesRestart{
+			ObjectMeta: metav1.ObjectMeta{Name: restartName},
+			Spec:       v1alpha1.GPUServicesRestartSpec{NodeName: nodeName, ReloadDriver: true},
+		}
+		Expect(k8sClient.Create(ctx, restart)).To(Succeed())
+
+		By("Waiting for the driver reload job to be created")
+		job := getReloadJob()
+		Expect(job.Spec.Template.Spec.NodeName).To(Equal(nodeName))
+		Expect(job.Spec.Template.Spec.Containers[0].Command).To(Equal([]string{config.DriverReloadCommand}))
+		Expect(metav1.IsControlledBy(job, restart)).To(BeTrue())
+
+		By("Simulating the job succeeding")
+		job.Status.Succeeded = 1
+		Expect(k8sClient.Status().Update(ctx, job)).To(Succeed())
+
+		By("Waiting for the restart to complete")
+		reconcileUntil(func(g Gomega, restart *v1alpha1.GPUServicesRestart) {
+			g.Expect(restart.Status.CompletionTime).NotTo(BeNil())
+			g.Expect(meta.IsStatusConditionTrue(restart.Status.Conditions,
+				v1alpha1.GPUServicesRestartConditionDriverReloaded)).To(BeTrue())
+			g.Expect(meta.IsStatusConditionTrue(restart.Status.Conditions,
+				v1alpha1.GPUServicesRestartConditionComplete)).To(BeTrue())
+		})
+	})
+
+	It("should restore the GPU services and fail when the driver reload job fails", func() {
+		restart := &v1alpha1.GPUServicesRestart{
+			ObjectMeta: metav1.ObjectMeta{Name: restartName},
+			Spec:       v1alpha1.GPUServicesRestartSpec{NodeName: nodeName, ReloadDriver: true},
+		}
+		Expect(k8sClient.Create(ctx, restart)).To(Succeed())
+
+		By("Simulating the job failing")
+		job := getReloadJob()
+		job.Status.Failed = 1
+		Expect(k8sClient.Status().Update(ctx, job)).To(Succeed())
+
+		By("Waiting for the restart to fail after restoring the services")
+		reconcileUntil(func(g Gomega, restart *v1alpha1.GPUServicesRestart) {
+			g.Expect(restart.Status.CompletionTime).NotTo(BeNil())
+			g.Expect(meta.IsStatusConditionTrue(restart.Status.Conditions,
+				v1alpha1.GPUServicesRestartConditionServicesRestored)).To(BeTrue())
+
+			cond := meta.FindStatusCondition(restart.Status.Conditions, v1alpha1.GPUServicesRestartConditionComplete)
+			g.Expect(cond).NotTo(BeNil())
+			g.Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+			g.Expect(cond.Reason).To(Equal(v1alpha1.GPUServicesRestartReasonDriverReloadFailed))
+		})
+
+		var updatedNode corev1.Node
+		Expect(k8sClient.Get(ctx, client.ObjectKey{Name: nodeName}, &updatedNode)).To(Succeed())
+		Expect(updatedNode.Labels).To(HaveKeyWithValue("nvidia.com/gpu.deploy.device-plugin", "true"))
+	})
+
+	It("should fail a driver reload when the driver reload job is not configured", func() {
+		reconciler.Config.ResolvedJobTemplate = nil
+
+		restart := &v1alpha1.GPUServicesRestart{
+			ObjectMeta: metav1.ObjectMeta{Name: restartName},
+			Spec:       v1alpha1.GPUServicesRestartSpec{NodeName: nodeName, ReloadDriver: true},
+		}
+		Expect(k8sClient.Create(ctx, restart)).To(Succeed())
+
+		reconcileUntil(func(g Gomega, restart *v1alpha1.GPUServicesRestart) {
+			cond := meta.FindStatusCondition(restart.Status.Conditions, v1alpha1.GPUServicesRestartConditionComplete)
+			g.Expect(cond).NotTo(BeNil())
+			g.Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+			g.Expect(cond.Reason).To(Equal(v1alpha1.GPUServicesRestartReasonDriverReloadNotConfigured))
+		})
+	})
+
+	It("should fail when the services are not torn down within the timeout", func() {
+		reconciler.checkPodsTerminatedFn = func(ctx context.Context, nodeName string) (bool, error) {
+			return false, nil
+		}
+		reconciler.serviceManager.Spec.TeardownTimeout = time.Second
+
+		restart := &v1alpha1.GPUServicesRestart{
+			ObjectMeta: metav1.ObjectMeta{Name: restartName},
+			Spec:       v1alpha1.GPUServicesRestartSpec{NodeName: nodeName},
+		}
+		Expect(k8sClient.Create(ctx, restart)).To(Succeed())
+
+		reconcileUntil(func(g Gomega, restart *v1alpha1.GPUServicesRestart) {
+			g.Expect(restart.Status.CompletionTime).NotTo(BeNil())
+
+			cond := meta.FindStatusCondition(restart.Status.Conditions, v1alpha1.GPUServicesRestartConditionComplete)
+			g.Expect(cond).NotTo(BeNil())
+			g.Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+			g.Expect(cond.Reason).To(Equal(v1alpha1.GPUServicesRestartReasonServiceTeardownTimeoutExceeded))
+		})
+	})
+
+	It("should restore the GPU services when deleted before completion", func() {
+		reconciler.checkPodsTerminatedFn = func(ctx context.Context, nodeName string) (bool, error) {
+			return false, nil
+		}
+
+		restart := &v1alpha1.GPUServicesRestart{
+			ObjectMeta: metav1.ObjectMeta{Name: restartName},
+			Spec:       v1alpha1.GPUServicesRestartSpec{NodeName: nodeName},
+		}
+		Expect(k8sClient.Create(ctx, restart)).To(Succeed())
+
+		By("Waiting for the services to be disabled")
+		Eventually(func(g Gomega) {
+			_, _ = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+
+			var updatedNode corev1.Node
+			g.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: nodeName}, &updatedNode)).To(Succeed())
+			g.Expect(updatedNode.Labels).To(HaveKeyWithValue("nvidia.com/gpu.deploy.device-plugin", "false"))
+		}, "10s", "250ms").Should(Succeed())
+
+		By("Deleting the GPUServicesRestart")
+		Expect(k8sClient.Delete(ctx, restart)).To(Succeed())
+
+		Eventually(func(g Gomega) {
+			_, _ = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+
+			var deleted v1alpha1.GPUServicesRestart
+			g.Expect(apierrors.IsNotFound(k8sClient.Get(ctx, typeNamespacedName, &deleted))).To(BeTrue())
+		}, "10s", "250ms").Should(Succeed())
+
+		var updatedNode corev1.Node
+		Expect(k8sClient.Get(ctx, client.ObjectKey{Name: nodeName}, &updatedNode)).To(Succeed())
+		Expect(updatedNode.Labels).To(HaveKeyWithValue("nvidia.com/gpu.deploy.device-plugin", "true"))
+	})
+})
@@ -55,6 +55,7 @@ type RebootNodeReconciler struct {
 	Scheme        *runtime.Scheme
 	Config        *config.RebootNodeControllerConfig
 	NodeLock      distributedlock.NodeLock
+	GroupLock     distributedlock.GroupLock
 	LockNamespace string
 
 	// dialProviderFunc overrides the default gRPC dial behavior.
@@ -98,11 +99,34 @@ func (r *RebootNodeReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	completedReconciling := rebootNode.Status.CompletionTime != nil
 
 	if !completedReconciling {
+		approved, err := checkApproval(ctx, r.Client, r.Config.Approval, r.Config.ManualMode,
+			janitordgxcnvidiacomv1alpha1.RebootNodeKind, &rebootNode, &rebootNode.Status.Conditions,
+			rebootNode.Spec.NodeName)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		// Pending maintenance does not hold the node lock or exclusion group slots, so that it does not block
+		// approved maintenance on the node or in its groups.
+		if !approved {
+			return ctrl.Result{RequeueAfter: pendingApprovalRequeueInterval}, nil
+		}
+
 		locked := r.NodeLock.LockNode(ctx, &rebootNode, rebootNode.Spec.NodeName)
 		if !locked {
 			return ctrl.Result{RequeueAfter: time.Second * 2}, nil
 		}
 
+		locked, err = lockExclusionGroups(ctx, r.Client, r.GroupLock, &rebootNode, &rebootNode.Status.Conditions,
+			rebootNode.Spec.NodeName)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		if !locked {
+			return ctrl.Result{RequeueAfter: exclusionGroupRequeueInterval}, nil
+		}
+
 		sessionCtx, _ := r.startRebootSessionIfNeeded(ctx, crKey, traceID, spanID)
 
 		ctx, span := tracing.StartSpan(sessionCtx, "janitor.rebootnode.reconcile")
@@ -125,7 +149,9 @@ func (r *RebootNodeReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	r.endRebootSession(crKey)
 
 	retryUnlock := r.NodeLock.CheckUnlock(ctx, &rebootNode, rebootNode.Spec.NodeName)
-	if retryUnlock {
+	retryUnlockGroups := r.GroupLock.CheckUnlockGroups(ctx, &rebootNode, rebootNode.Spec.NodeName)
+
+	if retryUnlock || retryUnlockGroups {
 		return ctrl.Result{RequeueAfter: time.Second * 2}, nil
 	}
 
@@ -388,7 +414,7 @@ func (r *RebootNodeReconciler) checkNodeReadyFromCSP(
 	ctx context.Context, cspClient cspv1alpha1.CSPProviderServiceClient,
 	rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode, nodeName string,
 ) (bool, error) {
-	if *r.Config.ManualMode {
+	if requiresOutsideActor(r.Config.ManualMode, r.Config.Approval) {
 		return true, nil
 	}
 
@@ -425,7 +451,7 @@ func (r *RebootNodeReconciler) handleRebootNotStarted(
 		return ctrl.Result{RequeueAfter: 30 * time.Second}
 	}
 
-	if *r.Config.ManualMode {
+	if requiresOutsideActor(r.Config.ManualMode, r.Config.Approval) {
 		return r.handleManualMode(ctx, rebootNode, node)
 	}
 
@@ -572,6 +598,7 @@ func (r *RebootNodeReconciler) SetupWithManager(mgr ctrl.Manager) error {
 
 	// Initialize NodeLock for distributed locking across maintenance operations
 	r.NodeLock = distributedlock.NewNodeLock(mgr.GetClient(), r.LockNamespace)
+	r.GroupLock = distributedlock.NewGroupLock(mgr.GetClient(), r.LockNamespace, r.Config.ExclusionGroups)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&janitordgxcnvidiacomv1alpha1.RebootNode{}).
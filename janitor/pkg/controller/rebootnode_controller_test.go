@@ -164,6 +164,7 @@ var _ = Describe("RebootNode Controller", func() {
 				return mockCSP.Client, func() {}, nil
 			},
 			NodeLock:  distributedlock.NewNodeLock(k8sClient, "default"),
+			GroupLock: distributedlock.NewGroupLock(k8sClient, "default", nil),
 		}
 
 		// Default to success behavior - tests can override as needed
@@ -230,6 +231,71 @@ var _ = Describe("RebootNode Controller", func() {
 		})
 	})
 
+	Context("when the exclusion group of the node is full", func() {
+		var otherRebootNode *janitordgxcnvidiacomv1alpha1.RebootNode
+
+		BeforeEach(func() {
+			cliqueLabels := map[string]string{"nvidia.com/gpu.clique": "clique-" + uniqueSuffix}
+
+			testNode.Labels = cliqueLabels
+			Expect(k8sClient.Update(ctx, testNode)).To(Succeed())
+
+			otherNode := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "other-" + nodeName, Labels: cliqueLabels}}
+			Expect(k8sClient.Create(ctx, otherNode)).To(Succeed())
+
+			otherRebootNode = &janitordgxcnvidiacomv1alpha1.RebootNode{
+				ObjectMeta: metav1.ObjectMeta{Name: "other-" + crName},
+				Spec:       janitordgxcnvidiacomv1alpha1.RebootNodeSpec{NodeName: otherNode.Name},
+			}
+			Expect(k8sClient.Create(ctx, otherRebootNode)).To(Succeed())
+
+			reconciler.GroupLock = distributedlock.NewGroupLock(k8sClient, "default", []config.ExclusionGroup{
+				{Name: "nvlink-domain", LabelKey: "nvidia.com/gpu.clique", MaxConcurrent: 1},
+			})
+
+			locked, _ := reconciler.GroupLock.LockGroups(ctx, otherRebootNode, otherNode.Name)
+			Expect(locked).To(BeTrue())
+		})
+
+		It("should wait for a free slot before sending the reboot signal", func() {
+			req := reconcile.Request{NamespacedName: types.NamespacedName{Name: testRebootNode.Name}}
+
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(exclusionGroupRequeueInterval))
+
+			var updatedRebootNode janitordgxcnvidiacomv1alpha1.RebootNode
+			Expect(k8sClient.Get(ctx, req.NamespacedName, &updatedRebootNode)).To(Succeed())
+
+			waitingCondition := findCondition(updatedRebootNode.Status.Conditions,
+				janitordgxcnvidiacomv1alpha1.WaitingForExclusionGroupConditionType)
+			Expect(waitingCondition).NotTo(BeNil())
+			Expect(waitingCondition.Status).To(Equal(metav1.ConditionTrue))
+			Expect(waitingCondition.Reason).To(Equal(janitordgxcnvidiacomv1alpha1.ExclusionGroupFullReason))
+			Expect(waitingCondition.Message).To(ContainSubstring(otherRebootNode.Spec.NodeName))
+			Expect(findCondition(updatedRebootNode.Status.Conditions,
+				janitordgxcnvidiacomv1alpha1.RebootNodeConditionSignalSent)).To(BeNil())
+			Expect(updatedRebootNode.Status.StartTime).To(BeNil())
+
+			By("releasing the slot held by the other node")
+			Expect(reconciler.GroupLock.CheckUnlockGroups(ctx, otherRebootNode, otherRebootNode.Spec.NodeName)).
+				To(BeFalse())
+
+			result, err = reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(30 * time.Second))
+
+			Expect(k8sClient.Get(ctx, req.NamespacedName, &updatedRebootNode)).To(Succeed())
+
+			waitingCondition = findCondition(updatedRebootNode.Status.Conditions,
+				janitordgxcnvidiacomv1alpha1.WaitingForExclusionGroupConditionType)
+			Expect(waitingCondition).NotTo(BeNil())
+			Expect(waitingCondition.Status).To(Equal(metav1.ConditionFalse))
+			Expect(waitingCondition.Reason).To(Equal(janitordgxcnvidiacomv1alpha1.ExclusionGroupsAcquiredReason))
+			Expect(updatedRebootNode.IsRebootInProgress()).To(BeTrue())
+		})
+	})
+
 	Context("when reboot is in progress", func() {
 		BeforeEach(func() {
 			// Set up RebootNode as if reboot signal was already sent
@@ -668,6 +734,92 @@ var _ = Describe("RebootNode Controller", func() {
 			Expect(finalRebootNode.Status.CompletionTime).NotTo(BeNil())
 		})
 	})
+
+	Context("when the approval workflow is enabled", func() {
+		BeforeEach(func() {
+			reconciler.Config.ManualMode = ptr.To(true)
+			reconciler.Config.Approval = config.ApprovalConfig{Enabled: true}
+		})
+
+		It("should wait for a MaintenanceApproval before sending the reboot signal", func() {
+			req := reconcile.Request{NamespacedName: types.NamespacedName{Name: testRebootNode.Name}}
+
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(pendingApprovalRequeueInterval))
+
+			var updatedRebootNode janitordgxcnvidiacomv1alpha1.RebootNode
+			Expect(k8sClient.Get(ctx, req.NamespacedName, &updatedRebootNode)).To(Succeed())
+
+			pendingCondition := findCondition(updatedRebootNode.Status.Conditions,
+				janitordgxcnvidiacomv1alpha1.PendingApprovalConditionType)
+			Expect(pendingCondition).NotTo(BeNil())
+			Expect(pendingCondition.Status).To(Equal(metav1.ConditionTrue))
+			Expect(pendingCondition.Reason).To(Equal(janitordgxcnvidiacomv1alpha1.AwaitingApprovalReason))
+			Expect(findCondition(updatedRebootNode.Status.Conditions,
+				janitordgxcnvidiacomv1alpha1.RebootNodeConditionSignalSent)).To(BeNil())
+			Expect(updatedRebootNode.Status.StartTime).To(BeNil())
+
+			By("approving the reboot of the node")
+			approval := &janitordgxcnvidiacomv1alpha1.MaintenanceApproval{
+				ObjectMeta: metav1.ObjectMeta{Name: "approval-" + uniqueSuffix},
+				Spec: janitordgxcnvidiacomv1alpha1.MaintenanceApprovalSpec{
+					Kind:       janitordgxcnvidiacomv1alpha1.RebootNodeKind,
+					NodeName:   nodeName,
+					ApprovedBy: "admin",
+				},
+			}
+			Expect(k8sClient.Create(ctx, approval)).To(Succeed())
+
+			result, err = reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(30 * time.Second))
+
+			Expect(k8sClient.Get(ctx, req.NamespacedName, &updatedRebootNode)).To(Succeed())
+
+			pendingCondition = findCondition(updatedRebootNode.Status.Conditions,
+				janitordgxcnvidiacomv1alpha1.PendingApprovalConditionType)
+			Expect(pendingCondition).NotTo(BeNil())
+			Expect(pendingCondition.Status).To(Equal(metav1.ConditionFalse))
+			Expect(pendingCondition.Reason).To(Equal(janitordgxcnvidiacomv1alpha1.ApprovedReason))
+			Expect(pendingCondition.Message).To(ContainSubstring("admin"))
+			Expect(findCondition(updatedRebootNode.Status.Conditions,
+				janitordgxcnvidiacomv1alpha1.ManualModeConditionType)).To(BeNil())
+			Expect(updatedRebootNode.IsRebootInProgress()).To(BeTrue())
+
+			By("keeping the maintenance approved once the approval is deleted")
+			Expect(k8sClient.Delete(ctx, approval)).To(Succeed())
+
+			_, err = reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, req.NamespacedName, &updatedRebootNode)).To(Succeed())
+			pendingCondition = findCondition(updatedRebootNode.Status.Conditions,
+				janitordgxcnvidiacomv1alpha1.PendingApprovalConditionType)
+			Expect(pendingCondition.Status).To(Equal(metav1.ConditionFalse))
+		})
+
+		It("should send the reboot signal when an auto-approve policy matches", func() {
+			reconciler.Config.Approval.AutoApprove = []config.AutoApprovePolicy{
+				{Name: "reboots", Kinds: []string{janitordgxcnvidiacomv1alpha1.RebootNodeKind}},
+			}
+
+			req := reconcile.Request{NamespacedName: types.NamespacedName{Name: testRebootNode.Name}}
+
+			_, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			var updatedRebootNode janitordgxcnvidiacomv1alpha1.RebootNode
+			Expect(k8sClient.Get(ctx, req.NamespacedName, &updatedRebootNode)).To(Succeed())
+
+			pendingCondition := findCondition(updatedRebootNode.Status.Conditions,
+				janitordgxcnvidiacomv1alpha1.PendingApprovalConditionType)
+			Expect(pendingCondition).NotTo(BeNil())
+			Expect(pendingCondition.Reason).To(Equal(janitordgxcnvidiacomv1alpha1.AutoApprovedReason))
+			Expect(pendingCondition.Message).To(ContainSubstring("reboots"))
+			Expect(updatedRebootNode.IsRebootInProgress()).To(BeTrue())
+		})
+	})
 })
 
 // Helper function to find a condition by type
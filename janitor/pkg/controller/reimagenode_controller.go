@@ -0,0 +1,608 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cspv1alpha1 "github.com/nvidia/nvsentinel/api/gen/go/csp/v1alpha1"
+	"github.com/nvidia/nvsentinel/commons/pkg/tracing"
+	"github.com/nvidia/nvsentinel/janitor/api/v1alpha1"
+	grpcclient "github.com/nvidia/nvsentinel/janitor/pkg/client"
+	"github.com/nvidia/nvsentinel/janitor/pkg/config"
+	"github.com/nvidia/nvsentinel/janitor/pkg/distributedlock"
+	"github.com/nvidia/nvsentinel/janitor/pkg/metrics"
+)
+
+// providerMaintenanceRequeueInterval is the delay before checking again whether the CSP finished its maintenance on
+// the instance of a node.
+const providerMaintenanceRequeueInterval = 60 * time.Second
+
+// ReimageNodeReconciler reconciles a ReimageNode object
+type ReimageNodeReconciler struct {
+	client.Client
+	Scheme        *runtime.Scheme
+	Config        *config.ReimageNodeControllerConfig
+	NodeLock      distributedlock.NodeLock
+	GroupLock     distributedlock.GroupLock
+	LockNamespace string
+
+	// dialProviderFunc overrides the default gRPC dial behavior.
+	// Used in tests to inject a mock CSP client.
+	dialProviderFunc cspProviderDialFunc
+
+	// reimageSessionSpans holds one long-lived "reimage_session" span per CR, keyed by CR name.
+	reimageSessionSpans sync.Map
+}
+
+// errReimageNodeDeleted is returned when the ReimageNode was deleted during status update (do not requeue).
+var errReimageNodeDeleted = errors.New("reimagenode deleted during status update")
+
+//nolint:lll // kubebuilder RBAC marker must stay on one line
+// +kubebuilder:rbac:groups=janitor.dgxc.nvidia.com,resources=reimagenodes,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=janitor.dgxc.nvidia.com,resources=reimagenodes/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=janitor.dgxc.nvidia.com,resources=reimagenodes/finalizers,verbs=update
+// +kubebuilder:rbac:groups=core,resources=nodes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=coordination.k8s.io,resources=leases,verbs=get;list;watch;create;delete
+
+// Reconcile sends the reimage signal for the node of a ReimageNode once the CSP has no maintenance in progress on its
+// instance, and waits for the node to return to ready state.
+//
+//nolint:dupl // Structural duplication with RebootNode is acceptable - different business logic
+func (r *ReimageNodeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	var reimageNode v1alpha1.ReimageNode
+	if err := r.Get(ctx, req.NamespacedName, &reimageNode); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	annotations := reimageNode.GetAnnotations()
+	traceID := annotations[tracing.TraceIDAnnotationKey]
+	spanID := annotations[tracing.SpanIDAnnotationKey]
+
+	crKey := reimageNode.Name
+
+	if reimageNode.Status.CompletionTime == nil {
+		approved, err := checkApproval(ctx, r.Client, r.Config.Approval, r.Config.ManualMode,
+			v1alpha1.ReimageNodeKind, &reimageNode, &reimageNode.Status.Conditions, reimageNode.Spec.NodeName)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		if !approved {
+			return ctrl.Result{RequeueAfter: pendingApprovalRequeueInterval}, nil
+		}
+
+		locked := r.NodeLock.LockNode(ctx, &reimageNode, reimageNode.Spec.NodeName)
+		if !locked {
+			return ctrl.Result{RequeueAfter: time.Second * 2}, nil
+		}
+
+		locked, err = lockExclusionGroups(ctx, r.Client, r.GroupLock, &reimageNode, &reimageNode.Status.Conditions,
+			reimageNode.Spec.NodeName)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		if !locked {
+			return ctrl.Result{RequeueAfter: exclusionGroupRequeueInterval}, nil
+		}
+
+		sessionCtx := r.startReimageSessionIfNeeded(ctx, crKey, traceID, spanID)
+
+		ctx, span := tracing.StartSpan(sessionCtx, "janitor.reimagenode.reconcile")
+		defer span.End()
+
+		// Like RebootNode, always requeue so that the node lock is released on the next reconcile after completion.
+		result, err := r.reconcileHelper(ctx, &reimageNode)
+		if err != nil || result.RequeueAfter > 0 {
+			return result, err
+		}
+
+		return ctrl.Result{RequeueAfter: time.Second * 2}, nil
+	}
+
+	r.endReimageSession(crKey)
+
+	retryUnlock := r.NodeLock.CheckUnlock(ctx, &reimageNode, reimageNode.Spec.NodeName)
+	retryUnlockGroups := r.GroupLock.CheckUnlockGroups(ctx, &reimageNode, reimageNode.Spec.NodeName)
+
+	if retryUnlock || retryUnlockGroups {
+		return ctrl.Result{RequeueAfter: time.Second * 2}, nil
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// startReimageSessionIfNeeded creates or retrieves the long-lived "janitor.reimagenode.reimage_session" span for a
+// ReimageNode CR and returns a context carrying it.
+func (r *ReimageNodeReconciler) startReimageSessionIfNeeded(ctx context.Context, crKey,
+	traceID, spanID string,
+) context.Context {
+	if existing, ok := r.reimageSessionSpans.Load(crKey); ok {
+		span := existing.(trace.Span) //nolint:errcheck,forcetypeassert // value is always trace.Span
+		return trace.ContextWithSpan(ctx, span)
+	}
+
+	_, span := tracing.StartSpanWithLinkFromTraceContext(ctx, traceID, spanID, "janitor.reimagenode.reimage_session")
+
+	r.reimageSessionSpans.Store(crKey, span)
+
+	return trace.ContextWithSpan(ctx, span)
+}
+
+// endReimageSession ends the long-lived session span for a ReimageNode CR.
+func (r *ReimageNodeReconciler) endReimageSession(crKey string) {
+	val, ok := r.reimageSessionSpans.LoadAndDelete(crKey)
+	if !ok {
+		return
+	}
+
+	span, _ := val.(trace.Span) //nolint:errcheck,forcetypeassert // value is always trace.Span
+	if span == nil {
+		return
+	}
+
+	span.End()
+}
+
+// reconcileHelper contains the main reconciliation logic.
+func (r *ReimageNodeReconciler) reconcileHelper(
+	ctx context.Context, reimageNode *v1alpha1.ReimageNode,
+) (ctrl.Result, error) {
+	originalReimageNode := reimageNode.DeepCopy()
+
+	reimageNode.SetInitialConditions()
+	reimageNode.SetStartTime()
+
+	var node corev1.Node
+	if err := r.Get(ctx, client.ObjectKey{Name: reimageNode.Spec.NodeName}, &node); err != nil {
+		if !apierrors.IsNotFound(err) {
+			span := tracing.SpanFromContext(ctx)
+			span.SetAttributes(
+				attribute.String("janitor.error.type", "node_fetch_failed"),
+				attribute.String("janitor.error.message", err.Error()),
+			)
+			tracing.RecordError(span, err)
+		}
+
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	// Create a fresh gRPC connection per reconciliation so that rotated
+	// CA bundles and SA tokens are picked up from disk automatically.
+	cspClient, cleanup, err := r.dialProvider(ctx)
+	if err != nil {
+		span := tracing.SpanFromContext(ctx)
+		span.SetAttributes(
+			attribute.String("janitor.error.type", "dial_csp_provider_failed"),
+			attribute.String("janitor.error.message", err.Error()),
+		)
+		tracing.RecordError(span, err)
+
+		return ctrl.Result{}, fmt.Errorf("dial csp-provider: %w", err)
+	}
+	defer cleanup()
+
+	var result ctrl.Result
+
+	if reimageNode.IsReimageInProgress() {
+		result = r.handleReimageInProgress(ctx, cspClient, reimageNode, &node)
+	} else {
+		result = r.handleReimageNotStarted(ctx, cspClient, reimageNode, &node)
+	}
+
+	if err := r.updateReimageNodeStatusIfChanged(ctx, originalReimageNode, reimageNode); err != nil {
+		if errors.Is(err, errReimageNodeDeleted) {
+			return ctrl.Result{}, nil
+		}
+
+		span := tracing.SpanFromContext(ctx)
+		span.SetAttributes(
+			attribute.String("janitor.error.type", "status_update_failed"),
+			attribute.String("janitor.error.message", err.Error()),
+		)
+		tracing.RecordError(span, err)
+
+		return ctrl.Result{}, err
+	}
+
+	if reimageNode.Status.CompletionTime != nil {
+		r.endReimageSession(reimageNode.Name)
+	}
+
+	return result, nil
+}
+
+// updateReimageNodeStatusIfChanged refreshes and updates status when it has changed;
+// returns errReimageNodeDeleted if the object was deleted.
+func (r *ReimageNodeReconciler) updateReimageNodeStatusIfChanged(
+	ctx context.Context, original, reimageNode *v1alpha1.ReimageNode,
+) error {
+	if reflect.DeepEqual(original.Status, reimageNode.Status) {
+		return nil
+	}
+
+	var freshReimageNode v1alpha1.ReimageNode
+
+	if err := r.Get(ctx, client.ObjectKey{Name: reimageNode.Name}, &freshReimageNode); err != nil {
+		if apierrors.IsNotFound(err) {
+			slog.Info("Post-reconciliation status update: not found, object assumed deleted", "node", reimageNode.Name)
+
+			return errReimageNodeDeleted
+		}
+
+		return fmt.Errorf("refreshing ReimageNode %q before status update: %w", reimageNode.Name, err)
+	}
+
+	freshReimageNode.Status = reimageNode.Status
+
+	if err := r.Status().Update(ctx, &freshReimageNode); err != nil {
+		return fmt.Errorf("updating ReimageNode %q status: %w", reimageNode.Name, err)
+	}
+
+	slog.Info("ReimageNode status updated", "node", reimageNode.Spec.NodeName)
+
+	return nil
+}
+
+// --- Reimage in progress: node ready checks and outcome ---
+
+// handleReimageInProgress evaluates node ready state and returns the appropriate requeue result.
+func (r *ReimageNodeReconciler) handleReimageInProgress(
+	ctx context.Context, cspClient cspv1alpha1.CSPProviderServiceClient,
+	reimageNode *v1alpha1.ReimageNode, node *corev1.Node,
+) ctrl.Result {
+	cspReady, nodeReadyErr := r.checkNodeReadyFromCSP(ctx, cspClient, reimageNode, node.Name)
+
+	if nodeReadyErr != nil {
+		if isTransientGRPCError(nodeReadyErr) {
+			slog.WarnContext(ctx, "Transient CSP error during node ready check, will requeue",
+				"node", node.Name, "error", nodeReadyErr, "requeueAfter", requeueBackoffForTransientCSPError)
+
+			return ctrl.Result{RequeueAfter: requeueBackoffForTransientCSPError}
+		}
+
+		span := tracing.SpanFromContext(ctx)
+		span.SetAttributes(
+			attribute.String("janitor.error.type", "node_ready_check_failed"),
+			attribute.String("janitor.error.message", nodeReadyErr.Error()),
+		)
+		tracing.RecordError(span, nodeReadyErr)
+
+		slog.ErrorContext(ctx, "Node ready status check failed", "node", node.Name, "error", nodeReadyErr)
+
+		return r.completeNodeReadyCheck(reimageNode, node, metav1.ConditionFalse, "Failed",
+			fmt.Sprintf("Node status could not be checked from CSP: %s", nodeReadyErr), metrics.StatusFailed)
+	}
+
+	if cspReady && isNodeKubernetesReady(node) {
+		slog.InfoContext(ctx, "Node reached ready state post-reimage", "node", node.Name)
+		metrics.GlobalMetrics.RecordActionMTTR(metrics.ActionTypeReimage, time.Since(reimageNode.CreationTimestamp.Time))
+
+		return r.completeNodeReadyCheck(reimageNode, node, metav1.ConditionTrue, "Succeeded",
+			"Node reached ready state post-reimage", metrics.StatusSucceeded)
+	}
+
+	if time.Since(reimageNode.Status.StartTime.Time) > r.getTimeout() {
+		span := tracing.SpanFromContext(ctx)
+		span.SetAttributes(
+			attribute.String("janitor.error.type", "reimage_timeout"),
+			attribute.Float64("janitor.reimagenode.reimage_session.timeout_seconds", r.getTimeout().Seconds()),
+		)
+
+		slog.ErrorContext(ctx, "Node reimage timed out", "node", node.Name, "timeout", r.getTimeout())
+
+		return r.completeNodeReadyCheck(reimageNode, node, metav1.ConditionFalse, "Timeout",
+			"Node failed to return to ready state after timeout duration", metrics.StatusFailed)
+	}
+
+	return ctrl.Result{RequeueAfter: 60 * time.Second}
+}
+
+func (r *ReimageNodeReconciler) completeNodeReadyCheck(
+	reimageNode *v1alpha1.ReimageNode, node *corev1.Node,
+	conditionStatus metav1.ConditionStatus, reason, message, metricsStatus string,
+) ctrl.Result {
+	reimageNode.SetCompletionTime()
+	reimageNode.SetCondition(metav1.Condition{
+		Type:               v1alpha1.ReimageNodeConditionNodeReady,
+		Status:             conditionStatus,
+		Reason:             reason,
+		Message:            message,
+		LastTransitionTime: metav1.Now(),
+	})
+	metrics.GlobalMetrics.IncActionCount(metrics.ActionTypeReimage, metricsStatus, node.Name)
+
+	return ctrl.Result{}
+}
+
+func (r *ReimageNodeReconciler) checkNodeReadyFromCSP(
+	ctx context.Context, cspClient cspv1alpha1.CSPProviderServiceClient,
+	reimageNode *v1alpha1.ReimageNode, nodeName string,
+) (bool, error) {
+	if requiresOutsideActor(r.Config.ManualMode, r.Config.Approval) {
+		return true, nil
+	}
+
+	rsp, err := cspClient.IsNodeReady(ctx, &cspv1alpha1.IsNodeReadyRequest{
+		NodeName:  nodeName,
+		RequestId: reimageNode.GetCSPReqRef(),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return rsp.IsReady, nil
+}
+
+// --- Reimage not started: manual mode / provider maintenance / send signal ---
+
+// handleReimageNotStarted handles the case when the reimage has not yet started.
+func (r *ReimageNodeReconciler) handleReimageNotStarted(
+	ctx context.Context, cspClient cspv1alpha1.CSPProviderServiceClient,
+	reimageNode *v1alpha1.ReimageNode, node *corev1.Node,
+) ctrl.Result {
+	if hasConditionTrue(reimageNode.Status.Conditions, v1alpha1.ReimageNodeConditionSignalSent) {
+		return ctrl.Result{RequeueAfter: 30 * time.Second}
+	}
+
+	if requiresOutsideActor(r.Config.ManualMode, r.Config.Approval) {
+		return r.handleManualMode(ctx, reimageNode, node)
+	}
+
+	if result, waiting := r.waitForProviderMaintenance(ctx, cspClient, reimageNode, node); waiting {
+		return result
+	}
+
+	return r.sendReimageSignalAndSetCondition(ctx, cspClient, reimageNode, node)
+}
+
+func (r *ReimageNodeReconciler) handleManualMode(
+	ctx context.Context, reimageNode *v1alpha1.ReimageNode, node *corev1.Node,
+) ctrl.Result {
+	if !hasConditionTrue(reimageNode.Status.Conditions, v1alpha1.ManualModeConditionType) {
+		reimageNode.SetCondition(metav1.Condition{
+			Type:               v1alpha1.ManualModeConditionType,
+			Status:             metav1.ConditionTrue,
+			Reason:             "OutsideActorRequired",
+			Message:            "Janitor is in manual mode, outside actor required to send reimage signal",
+			LastTransitionTime: metav1.Now(),
+		})
+		metrics.GlobalMetrics.IncActionCount(metrics.ActionTypeReimage, metrics.StatusStarted, node.Name)
+	}
+
+	slog.InfoContext(ctx, "Manual mode enabled, janitor will not send reimage signal for node", "node", node.Name)
+
+	return ctrl.Result{}
+}
+
+// waitForProviderMaintenance holds back the reimage signal while the CSP performs maintenance on the instance of the
+// node, because a reimage during a host migration or an instance retirement would fail or be undone. Returns true
+// while the reimage has to wait. CSP providers which cannot report the instance status do not hold back the signal.
+func (r *ReimageNodeReconciler) waitForProviderMaintenance(
+	ctx context.Context, cspClient cspv1alpha1.CSPProviderServiceClient,
+	reimageNode *v1alpha1.ReimageNode, node *corev1.Node,
+) (ctrl.Result, bool) {
+	rsp, err := cspClient.GetInstanceStatus(ctx, &cspv1alpha1.GetInstanceStatusRequest{NodeName: node.Name})
+
+	switch {
+	case status.Code(err) == codes.Unimplemented:
+		return ctrl.Result{}, false
+	case isTransientGRPCError(err):
+		slog.WarnContext(ctx, "Transient CSP error getting instance status, will requeue",
+			"node", node.Name, "error", err, "requeueAfter", requeueBackoffForTransientCSPError)
+
+		return ctrl.Result{RequeueAfter: requeueBackoffForTransientCSPError}, true
+	case err != nil:
+		// The instance status only guards the reimage, so a provider which fails to report it does not block the
+		// reimage.
+		slog.WarnContext(ctx, "Failed to get instance status, sending reimage signal anyway",
+			"node", node.Name, "error", err)
+
+		return ctrl.Result{}, false
+	case !rsp.GetUnderMaintenance():
+		return ctrl.Result{}, false
+	}
+
+	if time.Since(reimageNode.Status.StartTime.Time) > r.getTimeout() {
+		slog.ErrorContext(ctx, "Provider maintenance did not finish before the reimage timeout",
+			"node", node.Name, "timeout", r.getTimeout())
+
+		reimageNode.SetCompletionTime()
+		reimageNode.SetCondition(metav1.Condition{
+			Type:               v1alpha1.ReimageNodeConditionSignalSent,
+			Status:             metav1.ConditionFalse,
+			Reason:             "Timeout",
+			Message:            "Provider maintenance did not finish before the timeout: " + scheduledEventsMessage(rsp),
+			LastTransitionTime: metav1.Now(),
+		})
+		metrics.GlobalMetrics.IncActionCount(metrics.ActionTypeReimage, metrics.StatusFailed, node.Name)
+
+		return ctrl.Result{}, true
+	}
+
+	slog.InfoContext(ctx, "Instance is under provider maintenance, holding back reimage signal",
+		"node", node.Name, "providerState", rsp.GetProviderState())
+
+	reimageNode.SetCondition(metav1.Condition{
+		Type:               v1alpha1.ReimageNodeConditionSignalSent,
+		Status:             metav1.ConditionUnknown,
+		Reason:             v1alpha1.ProviderMaintenanceReason,
+		Message:            scheduledEventsMessage(rsp),
+		LastTransitionTime: metav1.Now(),
+	})
+
+	return ctrl.Result{RequeueAfter: providerMaintenanceRequeueInterval}, true
+}
+
+// scheduledEventsMessage describes the scheduled events of an instance for a condition message.
+func scheduledEventsMessage(rsp *cspv1alpha1.GetInstanceStatusResponse) string {
+	events := make([]string, 0, len(rsp.GetScheduledEvents()))
+
+	for _, event := range rsp.GetScheduledEvents() {
+		description := event.GetType()
+		if event.GetId() != "" {
+			description += " " + event.GetId()
+		}
+
+		if event.GetDescription() != "" {
+			description += ": " + event.GetDescription()
+		}
+
+		events = append(events, description)
+	}
+
+	if len(events) == 0 {
+		return fmt.Sprintf("Instance is under maintenance (state %q)", rsp.GetProviderState())
+	}
+
+	return "Instance is under maintenance: " + strings.Join(events, "; ")
+}
+
+func (r *ReimageNodeReconciler) sendReimageSignalAndSetCondition(
+	ctx context.Context, cspClient cspv1alpha1.CSPProviderServiceClient,
+	reimageNode *v1alpha1.ReimageNode, node *corev1.Node,
+) ctrl.Result {
+	ctx, span := tracing.StartSpan(ctx, "janitor.reimagenode.signal_sent")
+	defer span.End()
+
+	metrics.GlobalMetrics.IncActionCount(metrics.ActionTypeReimage, metrics.StatusStarted, node.Name)
+	slog.InfoContext(ctx, "Sending reimage signal to node", "node", node.Name)
+
+	rsp, reimageErr := cspClient.SendReimageSignal(ctx, &cspv1alpha1.SendReimageSignalRequest{
+		NodeName: node.Name,
+	})
+	if reimageErr == nil {
+		reimageNode.SetCondition(metav1.Condition{
+			Type:               v1alpha1.ReimageNodeConditionSignalSent,
+			Status:             metav1.ConditionTrue,
+			Reason:             "Succeeded",
+			Message:            rsp.RequestId,
+			LastTransitionTime: metav1.Now(),
+		})
+
+		return ctrl.Result{RequeueAfter: 30 * time.Second}
+	}
+
+	if isTransientGRPCError(reimageErr) {
+		slog.WarnContext(ctx, "Transient CSP error sending reimage signal, will requeue",
+			"node", node.Name, "error", reimageErr)
+
+		return ctrl.Result{RequeueAfter: 30 * time.Second}
+	}
+
+	reason := "Failed"
+	if status.Code(reimageErr) == codes.Unimplemented {
+		reason = "NotSupported"
+	}
+
+	reimageNode.SetCompletionTime()
+	reimageNode.SetCondition(metav1.Condition{
+		Type:               v1alpha1.ReimageNodeConditionSignalSent,
+		Status:             metav1.ConditionFalse,
+		Reason:             reason,
+		Message:            reimageErr.Error(),
+		LastTransitionTime: metav1.Now(),
+	})
+	metrics.GlobalMetrics.IncActionCount(metrics.ActionTypeReimage, metrics.StatusFailed, node.Name)
+
+	span.SetAttributes(
+		attribute.String("janitor.error.type", "reimage_signal_failed"),
+		attribute.String("janitor.error.message", reimageErr.Error()),
+	)
+	tracing.RecordError(span, reimageErr)
+
+	return ctrl.Result{}
+}
+
+// dialProvider creates a fresh gRPC connection to the CSP provider.
+//
+//nolint:dupl // Structural duplication with RebootNode is acceptable - same dial pattern
+func (r *ReimageNodeReconciler) dialProvider(
+	ctx context.Context,
+) (cspv1alpha1.CSPProviderServiceClient, func(), error) {
+	if r.dialProviderFunc != nil {
+		return r.dialProviderFunc(ctx)
+	}
+
+	dialOpts, err := grpcclient.NewCSPProviderDialOptions(r.Config.CSPProviderCAPath, r.Config.CSPProviderInsecure)
+	if err != nil {
+		return nil, nil, fmt.Errorf("create dial options: %w", err)
+	}
+
+	dialOpts = append(dialOpts, grpc.WithStatsHandler(otelgrpc.NewClientHandler()))
+
+	if !r.Config.CSPProviderInsecure {
+		tokenPath := r.Config.CSPProviderTokenPath
+		if tokenPath == "" {
+			tokenPath = grpcclient.DefaultSATokenPath
+		}
+
+		dialOpts = append(dialOpts,
+			grpc.WithUnaryInterceptor(grpcclient.TokenInterceptor(tokenPath)))
+	}
+
+	conn, err := grpc.NewClient(r.Config.CSPProviderHost, dialOpts...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("dial csp-provider: %w", err)
+	}
+
+	return cspv1alpha1.NewCSPProviderServiceClient(conn), func() { conn.Close() }, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ReimageNodeReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	slog.Info("Configuring CSP provider connection for ReimageNode",
+		"host", r.Config.CSPProviderHost,
+		"insecure", r.Config.CSPProviderInsecure)
+
+	r.NodeLock = distributedlock.NewNodeLock(mgr.GetClient(), r.LockNamespace)
+	r.GroupLock = distributedlock.NewGroupLock(mgr.GetClient(), r.LockNamespace, r.Config.ExclusionGroups)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.ReimageNode{}).
+		Named("reimagenode").
+		Complete(r)
+}
+
+// getTimeout returns the timeout for reimage operations
+func (r *ReimageNodeReconciler) getTimeout() time.Duration {
+	cfg := r.Config
+	if cfg == nil || cfg.Timeout == 0 {
+		return 30 * time.Minute // fallback default
+	}
+
+	return cfg.Timeout
+}
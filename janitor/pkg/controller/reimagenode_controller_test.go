@@ -0,0 +1,195 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	cspv1alpha1 "github.com/nvidia/nvsentinel/api/gen/go/csp/v1alpha1"
+	"github.com/nvidia/nvsentinel/janitor/api/v1alpha1"
+	"github.com/nvidia/nvsentinel/janitor/pkg/config"
+	"github.com/nvidia/nvsentinel/janitor/pkg/distributedlock"
+)
+
+var _ = Describe("ReimageNode Controller", func() {
+	var (
+		ctx             context.Context
+		reconciler      *ReimageNodeReconciler
+		testNode        *corev1.Node
+		testReimageNode *v1alpha1.ReimageNode
+		req             reconcile.Request
+	)
+
+	getReimageNode := func() *v1alpha1.ReimageNode {
+		var reimageNode v1alpha1.ReimageNode
+		Expect(k8sClient.Get(ctx, req.NamespacedName, &reimageNode)).To(Succeed())
+
+		return &reimageNode
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		uniqueSuffix := fmt.Sprintf("%d", time.Now().UnixNano())
+
+		testNode = &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-node-" + uniqueSuffix},
+			Status: corev1.NodeStatus{
+				Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+			},
+		}
+		Expect(k8sClient.Create(ctx, testNode)).To(Succeed())
+
+		testReimageNode = &v1alpha1.ReimageNode{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-reimage-node-" + uniqueSuffix},
+			Spec:       v1alpha1.ReimageNodeSpec{NodeName: testNode.Name},
+		}
+		Expect(k8sClient.Create(ctx, testReimageNode)).To(Succeed())
+
+		req = reconcile.Request{NamespacedName: types.NamespacedName{Name: testReimageNode.Name}}
+
+		reconciler = &ReimageNodeReconciler{
+			Client: k8sClient,
+			Scheme: scheme.Scheme,
+			Config: &config.ReimageNodeControllerConfig{
+				Timeout:    30 * time.Minute,
+				ManualMode: ptr.To(false),
+			},
+			dialProviderFunc: func(_ context.Context) (cspv1alpha1.CSPProviderServiceClient, func(), error) {
+				return mockCSP.Client, func() {}, nil
+			},
+			NodeLock:  distributedlock.NewNodeLock(k8sClient, "default"),
+			GroupLock: distributedlock.NewGroupLock(k8sClient, "default", nil),
+		}
+
+		mockCSP.Server.SetSuccess()
+	})
+
+	AfterEach(func() {
+		checkStatusConditions(getReimageNode().Status.Conditions)
+	})
+
+	It("should send the reimage signal and complete once the node is ready", func() {
+		result, err := reconciler.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(30 * time.Second))
+
+		reimageNode := getReimageNode()
+		signalSent := meta.FindStatusCondition(reimageNode.Status.Conditions, v1alpha1.ReimageNodeConditionSignalSent)
+		Expect(signalSent).NotTo(BeNil())
+		Expect(signalSent.Status).To(Equal(metav1.ConditionTrue))
+		Expect(reimageNode.GetCSPReqRef()).To(Equal("test-reimage-request-ref"))
+		Expect(reimageNode.IsReimageInProgress()).To(BeTrue())
+
+		_, err = reconciler.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+
+		reimageNode = getReimageNode()
+		nodeReady := meta.FindStatusCondition(reimageNode.Status.Conditions, v1alpha1.ReimageNodeConditionNodeReady)
+		Expect(nodeReady).NotTo(BeNil())
+		Expect(nodeReady.Status).To(Equal(metav1.ConditionTrue))
+		Expect(reimageNode.Status.CompletionTime).NotTo(BeNil())
+	})
+
+	It("should hold back the reimage signal while the instance is under provider maintenance", func() {
+		mockCSP.Server.SetUnderMaintenance(true, &cspv1alpha1.ScheduledEvent{
+			Id:          "instance-event-0123",
+			Type:        "system-maintenance",
+			Description: "scheduled host maintenance",
+		})
+
+		result, err := reconciler.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(providerMaintenanceRequeueInterval))
+
+		reimageNode := getReimageNode()
+		signalSent := meta.FindStatusCondition(reimageNode.Status.Conditions, v1alpha1.ReimageNodeConditionSignalSent)
+		Expect(signalSent).NotTo(BeNil())
+		Expect(signalSent.Status).To(Equal(metav1.ConditionUnknown))
+		Expect(signalSent.Reason).To(Equal(v1alpha1.ProviderMaintenanceReason))
+		Expect(signalSent.Message).To(ContainSubstring("system-maintenance instance-event-0123"))
+		Expect(reimageNode.Status.CompletionTime).To(BeNil())
+
+		By("sending the signal once the maintenance is over")
+		mockCSP.Server.SetUnderMaintenance(false)
+
+		_, err = reconciler.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getReimageNode().GetCSPReqRef()).To(Equal("test-reimage-request-ref"))
+	})
+
+	It("should fail when the provider maintenance outlasts the timeout", func() {
+		reconciler.Config.Timeout = time.Nanosecond
+		mockCSP.Server.SetUnderMaintenance(true)
+
+		_, err := reconciler.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+
+		reimageNode := getReimageNode()
+		signalSent := meta.FindStatusCondition(reimageNode.Status.Conditions, v1alpha1.ReimageNodeConditionSignalSent)
+		Expect(signalSent).NotTo(BeNil())
+		Expect(signalSent.Status).To(Equal(metav1.ConditionFalse))
+		Expect(signalSent.Reason).To(Equal("Timeout"))
+		Expect(reimageNode.Status.CompletionTime).NotTo(BeNil())
+	})
+
+	It("should send the reimage signal when the provider cannot report the instance status", func() {
+		mockCSP.Server.SetInstanceStatusError(status.Error(codes.Unimplemented, "not implemented"))
+
+		_, err := reconciler.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getReimageNode().GetCSPReqRef()).To(Equal("test-reimage-request-ref"))
+	})
+
+	It("should fail when the provider does not support reimages", func() {
+		mockCSP.Server.SetReimageFailure(status.Error(codes.Unimplemented, "not implemented"))
+
+		_, err := reconciler.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+
+		reimageNode := getReimageNode()
+		signalSent := meta.FindStatusCondition(reimageNode.Status.Conditions, v1alpha1.ReimageNodeConditionSignalSent)
+		Expect(signalSent).NotTo(BeNil())
+		Expect(signalSent.Status).To(Equal(metav1.ConditionFalse))
+		Expect(signalSent.Reason).To(Equal("NotSupported"))
+		Expect(reimageNode.Status.CompletionTime).NotTo(BeNil())
+	})
+
+	It("should retry transient errors when sending the reimage signal", func() {
+		mockCSP.Server.SetReimageFailure(status.Error(codes.Unavailable, "unavailable"))
+
+		result, err := reconciler.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(30 * time.Second))
+
+		reimageNode := getReimageNode()
+		Expect(reimageNode.Status.CompletionTime).To(BeNil())
+		Expect(reimageNode.GetCSPReqRef()).To(BeEmpty())
+	})
+})
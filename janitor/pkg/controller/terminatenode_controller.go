@@ -43,6 +43,7 @@ import (
 	"github.com/nvidia/nvsentinel/janitor/pkg/config"
 	"github.com/nvidia/nvsentinel/janitor/pkg/distributedlock"
 	"github.com/nvidia/nvsentinel/janitor/pkg/metrics"
+	"github.com/nvidia/nvsentinel/janitor/pkg/replacement"
 )
 
 // TerminateNodeReconciler manages the terminate node operation.
@@ -51,6 +52,7 @@ type TerminateNodeReconciler struct {
 	Scheme        *runtime.Scheme
 	Config        *config.TerminateNodeControllerConfig
 	NodeLock      distributedlock.NodeLock
+	GroupLock     distributedlock.GroupLock
 	LockNamespace string
 
 	// dialProviderFunc overrides the default gRPC dial behavior.
@@ -67,14 +69,16 @@ type TerminateNodeReconciler struct {
 // +kubebuilder:rbac:groups=janitor.dgxc.nvidia.com,resources=terminatenodes/finalizers,verbs=update
 // +kubebuilder:rbac:groups=core,resources=nodes,verbs=get;list;watch;delete
 // +kubebuilder:rbac:groups=coordination.k8s.io,resources=leases,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups=karpenter.sh,resources=nodeclaims,verbs=get;list;create;patch;delete
 
 // Steps of the terminate node reconciliation loop:
 // 1. Initialize conditions and start time.
 // 2. Check if a terminate signal has already been sent.
 // 3. Delete the node if it is not ready.
 // 4. If node is ready, check for timeout.
-// 5. If signal has not been sent, send it to the CSP instance.
-// 6. Write status updates to the TerminateNode CR.
+// 5. If replacement-first termination is enabled, request a replacement and wait for it to become Ready.
+// 6. If signal has not been sent, send it to the CSP instance.
+// 7. Write status updates to the TerminateNode CR.
 //
 //nolint:dupl // Structural duplication with RebootNode is acceptable - different business logic
 func (r *TerminateNodeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -90,11 +94,33 @@ func (r *TerminateNodeReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 	completedReconciling := terminateNode.Status.CompletionTime != nil
 
 	if !completedReconciling {
+		approved, err := checkApproval(ctx, r.Client, r.Config.Approval, r.Config.ManualMode,
+			janitordgxcnvidiacomv1alpha1.TerminateNodeKind, &terminateNode, &terminateNode.Status.Conditions,
+			terminateNode.Spec.NodeName)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		// Pending maintenance does not hold the node lock or exclusion group slots, see RebootNodeReconciler.
+		if !approved {
+			return ctrl.Result{RequeueAfter: pendingApprovalRequeueInterval}, nil
+		}
+
 		locked := r.NodeLock.LockNode(ctx, &terminateNode, terminateNode.Spec.NodeName)
 		if !locked {
 			return ctrl.Result{RequeueAfter: time.Second * 2}, nil
 		}
 
+		locked, err = lockExclusionGroups(ctx, r.Client, r.GroupLock, &terminateNode, &terminateNode.Status.Conditions,
+			terminateNode.Spec.NodeName)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		if !locked {
+			return ctrl.Result{RequeueAfter: exclusionGroupRequeueInterval}, nil
+		}
+
 		sessionCtx, _ := r.startTerminateSessionIfNeeded(ctx, crKey, traceID, spanID)
 
 		ctx, span := tracing.StartSpan(sessionCtx, "janitor.terminatenode.reconcile")
@@ -117,7 +143,9 @@ func (r *TerminateNodeReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 	r.endTerminateSession(crKey)
 
 	retryUnlock := r.NodeLock.CheckUnlock(ctx, &terminateNode, terminateNode.Spec.NodeName)
-	if retryUnlock {
+	retryUnlockGroups := r.GroupLock.CheckUnlockGroups(ctx, &terminateNode, terminateNode.Spec.NodeName)
+
+	if retryUnlock || retryUnlockGroups {
 		return ctrl.Result{RequeueAfter: time.Second * 2}, nil
 	}
 
@@ -310,7 +338,7 @@ func (r *TerminateNodeReconciler) reconcileHelper(
 
 			result = ctrl.Result{RequeueAfter: 30 * time.Second}
 		} else {
-			if *r.Config.ManualMode {
+			if requiresOutsideActor(r.Config.ManualMode, r.Config.Approval) {
 				isManualModeConditionSet := false
 
 				for _, condition := range terminateNode.Status.Conditions {
@@ -335,6 +363,8 @@ func (r *TerminateNodeReconciler) reconcileHelper(
 				slog.InfoContext(ctx, "Manual mode enabled, janitor will not send terminate signal for node", "node", node.Name)
 
 				result = ctrl.Result{}
+			} else if proceed, replacementResult := r.reconcileReplacement(ctx, cspClient, terminateNode, node); !proceed {
+				result = replacementResult
 			} else {
 				slog.InfoContext(ctx, "Sending terminate signal to node", "node", terminateNode.Spec.NodeName)
 				metrics.GlobalMetrics.IncActionCount(metrics.ActionTypeTerminate, metrics.StatusStarted, node.Name)
@@ -384,6 +414,21 @@ func (r *TerminateNodeReconciler) reconcileHelper(
 		}
 	}
 
+	if originalTerminateNode.Status.CompletionTime == nil && terminateNode.Status.CompletionTime != nil {
+		if err := r.releaseReplacement(ctx, cspClient, terminateNode); err != nil {
+			slog.ErrorContext(ctx, "failed to release replacement", "node", terminateNode.Spec.NodeName, "error", err)
+
+			span := tracing.SpanFromContext(ctx)
+			span.SetAttributes(
+				attribute.String("janitor.error.type", "replacement_release_failed"),
+				attribute.String("janitor.error.message", err.Error()),
+			)
+			tracing.RecordError(span, err)
+
+			return ctrl.Result{}, err
+		}
+	}
+
 	// Compare status to see if anything changed, and push updates if needed
 	if !reflect.DeepEqual(originalTerminateNode.Status, terminateNode.Status) {
 		// Refresh the object before updating to avoid precondition failures
@@ -435,6 +480,218 @@ func (r *TerminateNodeReconciler) reconcileHelper(
 	return result, nil
 }
 
+// reconcileReplacement requests a replacement for the node and waits for the replacement node to become Ready when
+// replacement-first termination is enabled. It returns true once the terminate signal can be sent, because the
+// replacement is Ready or the controller gave up on it, and otherwise the result to return.
+func (r *TerminateNodeReconciler) reconcileReplacement(
+	ctx context.Context, cspClient cspv1alpha1.CSPProviderServiceClient,
+	terminateNode *janitordgxcnvidiacomv1alpha1.TerminateNode, node *corev1.Node,
+) (bool, ctrl.Result) {
+	cfg := r.Config.Replacement
+	if !cfg.Enabled || terminateNode.IsReplacementSettled() {
+		return true, ctrl.Result{}
+	}
+
+	if terminateNode.Status.Replacement == nil {
+		now := metav1.Now()
+		terminateNode.Status.Replacement = &janitordgxcnvidiacomv1alpha1.ReplacementStatus{
+			Strategy:  cfg.Strategy,
+			StartTime: &now,
+		}
+		terminateNode.SetCondition(metav1.Condition{
+			Type:               janitordgxcnvidiacomv1alpha1.TerminateNodeConditionReplacementRequested,
+			Status:             metav1.ConditionUnknown,
+			Reason:             "Initializing",
+			Message:            "Replacement not yet requested",
+			LastTransitionTime: now,
+		})
+		terminateNode.SetCondition(metav1.Condition{
+			Type:               janitordgxcnvidiacomv1alpha1.TerminateNodeConditionReplacementReady,
+			Status:             metav1.ConditionUnknown,
+			Reason:             "Initializing",
+			Message:            "Replacement node not yet Ready",
+			LastTransitionTime: now,
+		})
+	}
+
+	replacementStatus := terminateNode.Status.Replacement
+	requester := r.replacementRequester(replacementStatus.Strategy, cspClient)
+	timedOut := time.Since(replacementStatus.StartTime.Time) > cfg.Timeout
+
+	if replacementStatus.RequestID == "" {
+		requestID, err := requester.Request(ctx, node)
+		if errors.Is(err, replacement.ErrNotSupported) {
+			slog.WarnContext(ctx, "Replacement not supported for node", "node", node.Name, "error", err)
+
+			terminateNode.SetCondition(metav1.Condition{
+				Type:               janitordgxcnvidiacomv1alpha1.TerminateNodeConditionReplacementRequested,
+				Status:             metav1.ConditionFalse,
+				Reason:             "NotSupported",
+				Message:            err.Error(),
+				LastTransitionTime: metav1.Now(),
+			})
+
+			return r.giveUpReplacement(ctx, terminateNode, node, requester, "NotSupported",
+				"Replacement not supported for the node")
+		}
+
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to request replacement for node", "node", node.Name, "error", err)
+
+			terminateNode.SetCondition(metav1.Condition{
+				Type:               janitordgxcnvidiacomv1alpha1.TerminateNodeConditionReplacementRequested,
+				Status:             metav1.ConditionFalse,
+				Reason:             "Failed",
+				Message:            err.Error(),
+				LastTransitionTime: metav1.Now(),
+			})
+
+			if timedOut {
+				return r.giveUpReplacement(ctx, terminateNode, node, requester, "Timeout",
+					fmt.Sprintf("Replacement could not be requested within %s", cfg.Timeout))
+			}
+
+			return false, ctrl.Result{RequeueAfter: 30 * time.Second}
+		}
+
+		slog.InfoContext(ctx, "Requested replacement for node", "node", node.Name,
+			"strategy", replacementStatus.Strategy, "requestID", requestID)
+
+		replacementStatus.RequestID = requestID
+		terminateNode.SetCondition(metav1.Condition{
+			Type:   janitordgxcnvidiacomv1alpha1.TerminateNodeConditionReplacementRequested,
+			Status: metav1.ConditionTrue,
+			Reason: "Succeeded",
+			Message: fmt.Sprintf("Replacement requested with the %s strategy: %s", replacementStatus.Strategy,
+				requestID),
+			LastTransitionTime: metav1.Now(),
+		})
+	}
+
+	message := "Waiting for the replacement node to register"
+
+	replacementNodeName, err := requester.ReplacementNode(ctx, node.Name, replacementStatus.RequestID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get replacement node", "node", node.Name, "error", err)
+
+		message = err.Error()
+	} else if replacementNodeName != "" {
+		replacementStatus.NodeName = replacementNodeName
+		message = fmt.Sprintf("Waiting for replacement node %s to become Ready", replacementNodeName)
+
+		replacementNode := &corev1.Node{}
+		if err := r.Get(ctx, client.ObjectKey{Name: replacementNodeName}, replacementNode); err == nil &&
+			isNodeKubernetesReady(replacementNode) {
+			slog.InfoContext(ctx, "Replacement node is Ready", "node", node.Name, "replacementNode", replacementNodeName)
+
+			terminateNode.SetCondition(metav1.Condition{
+				Type:               janitordgxcnvidiacomv1alpha1.TerminateNodeConditionReplacementReady,
+				Status:             metav1.ConditionTrue,
+				Reason:             "Succeeded",
+				Message:            fmt.Sprintf("Replacement node %s is Ready", replacementNodeName),
+				LastTransitionTime: metav1.Now(),
+			})
+
+			return true, ctrl.Result{}
+		} else if client.IgnoreNotFound(err) != nil {
+			message = err.Error()
+		}
+	}
+
+	if timedOut {
+		return r.giveUpReplacement(ctx, terminateNode, node, requester, "Timeout",
+			fmt.Sprintf("Replacement node not Ready within %s", cfg.Timeout))
+	}
+
+	terminateNode.SetCondition(metav1.Condition{
+		Type:               janitordgxcnvidiacomv1alpha1.TerminateNodeConditionReplacementReady,
+		Status:             metav1.ConditionUnknown,
+		Reason:             "Waiting",
+		Message:            message,
+		LastTransitionTime: metav1.Now(),
+	})
+
+	return false, ctrl.Result{RequeueAfter: 30 * time.Second}
+}
+
+// giveUpReplacement ends the replacement phase without a Ready replacement node. Depending on the configured timeout
+// action, the node is terminated anyway or the termination fails and the replacement request is cancelled.
+func (r *TerminateNodeReconciler) giveUpReplacement(
+	ctx context.Context, terminateNode *janitordgxcnvidiacomv1alpha1.TerminateNode, node *corev1.Node,
+	requester replacement.Requester, reason, message string,
+) (bool, ctrl.Result) {
+	if r.Config.Replacement.OnTimeout != config.ReplacementOnTimeoutFail {
+		slog.WarnContext(ctx, "Terminating node without replacement", "node", node.Name, "reason", reason)
+
+		terminateNode.SetCondition(metav1.Condition{
+			Type:               janitordgxcnvidiacomv1alpha1.TerminateNodeConditionReplacementReady,
+			Status:             metav1.ConditionFalse,
+			Reason:             reason,
+			Message:            message + ", terminating the node without replacement",
+			LastTransitionTime: metav1.Now(),
+		})
+
+		return true, ctrl.Result{}
+	}
+
+	slog.ErrorContext(ctx, "Not terminating node without replacement", "node", node.Name, "reason", reason)
+
+	if requestID := terminateNode.Status.Replacement.RequestID; requestID != "" {
+		if err := requester.Cancel(ctx, node.Name, requestID); err != nil {
+			slog.ErrorContext(ctx, "failed to cancel replacement request", "node", node.Name, "requestID", requestID,
+				"error", err)
+		}
+	}
+
+	now := metav1.Now()
+	terminateNode.SetCondition(metav1.Condition{
+		Type:               janitordgxcnvidiacomv1alpha1.TerminateNodeConditionReplacementReady,
+		Status:             metav1.ConditionFalse,
+		Reason:             reason,
+		Message:            message + ", not terminating the node",
+		LastTransitionTime: now,
+	})
+	terminateNode.SetCondition(metav1.Condition{
+		Type:               janitordgxcnvidiacomv1alpha1.TerminateNodeConditionNodeTerminated,
+		Status:             metav1.ConditionFalse,
+		Reason:             "ReplacementNotReady",
+		Message:            "Node not terminated because its replacement is not Ready",
+		LastTransitionTime: now,
+	})
+	terminateNode.SetCompletionTime()
+
+	metrics.GlobalMetrics.IncActionCount(metrics.ActionTypeTerminate, metrics.StatusFailed, node.Name)
+
+	return false, ctrl.Result{}
+}
+
+// releaseReplacement hands the replacement requested for the node over to its node group once the TerminateNode
+// completed. The completion is not recorded until the replacement is released, so that a failed release is retried.
+func (r *TerminateNodeReconciler) releaseReplacement(
+	ctx context.Context, cspClient cspv1alpha1.CSPProviderServiceClient,
+	terminateNode *janitordgxcnvidiacomv1alpha1.TerminateNode,
+) error {
+	replacementStatus := terminateNode.Status.Replacement
+	if replacementStatus == nil || replacementStatus.RequestID == "" {
+		return nil
+	}
+
+	requester := r.replacementRequester(replacementStatus.Strategy, cspClient)
+
+	return requester.Release(ctx, terminateNode.Spec.NodeName, replacementStatus.RequestID)
+}
+
+// replacementRequester returns the Requester of the replacement strategy
+func (r *TerminateNodeReconciler) replacementRequester(
+	strategy string, cspClient cspv1alpha1.CSPProviderServiceClient,
+) replacement.Requester {
+	if strategy == config.ReplacementStrategyKarpenter {
+		return replacement.NewKarpenterRequester(r.Client)
+	}
+
+	return replacement.NewProviderRequester(cspClient)
+}
+
 // isNodeNotReady returns true if the node is not in Ready state
 func isNodeNotReady(node *corev1.Node) bool {
 	for _, condition := range node.Status.Conditions {
@@ -508,6 +765,7 @@ func (r *TerminateNodeReconciler) SetupWithManager(mgr ctrl.Manager) error {
 
 	// Initialize NodeLock for distributed locking across maintenance operations
 	r.NodeLock = distributedlock.NewNodeLock(mgr.GetClient(), r.LockNamespace)
+	r.GroupLock = distributedlock.NewGroupLock(mgr.GetClient(), r.LockNamespace, r.Config.ExclusionGroups)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&janitordgxcnvidiacomv1alpha1.TerminateNode{}).
@@ -16,23 +16,33 @@ package controller
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"testing"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
 	cspv1alpha1 "github.com/nvidia/nvsentinel/api/gen/go/csp/v1alpha1"
 	janitordgxcnvidiacomv1alpha1 "github.com/nvidia/nvsentinel/janitor/api/v1alpha1"
 	"github.com/nvidia/nvsentinel/janitor/pkg/config"
 	"github.com/nvidia/nvsentinel/janitor/pkg/distributedlock"
+	"github.com/nvidia/nvsentinel/janitor/pkg/replacement"
 )
 
 var _ = Describe("TerminateNodeReconciler", func() {
@@ -98,6 +108,7 @@ var _ = Describe("TerminateNodeReconciler", func() {
 				return mockCSP.Client, func() {}, nil
 			},
 			NodeLock:  distributedlock.NewNodeLock(k8sClient, "default"),
+			GroupLock: distributedlock.NewGroupLock(k8sClient, "default", nil),
 		}
 
 		// Default to success behavior - tests can override as needed
@@ -532,6 +543,136 @@ var _ = Describe("TerminateNodeReconciler", func() {
 		})
 	})
 
+	Context("when replacement-first termination is enabled", func() {
+		var req ctrl.Request
+
+		BeforeEach(func() {
+			reconciler.Config.Replacement = config.ReplacementConfig{
+				Enabled:   true,
+				Strategy:  config.ReplacementStrategyProvider,
+				Timeout:   time.Hour,
+				OnTimeout: config.ReplacementOnTimeoutTerminate,
+			}
+			req = ctrl.Request{NamespacedName: types.NamespacedName{Name: crName}}
+		})
+
+		getTerminateNode := func() *janitordgxcnvidiacomv1alpha1.TerminateNode {
+			var updatedTerminateNode janitordgxcnvidiacomv1alpha1.TerminateNode
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: crName}, &updatedTerminateNode)).To(Succeed())
+
+			return &updatedTerminateNode
+		}
+
+		It("should send the terminate signal once the replacement node is Ready", func() {
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(30 * time.Second))
+
+			updatedTerminateNode := getTerminateNode()
+			Expect(updatedTerminateNode.Status.Replacement).NotTo(BeNil())
+			Expect(updatedTerminateNode.Status.Replacement.RequestID).To(Equal("test-replacement-request-ref"))
+
+			requestedCondition := findTerminateCondition(updatedTerminateNode.Status.Conditions,
+				janitordgxcnvidiacomv1alpha1.TerminateNodeConditionReplacementRequested)
+			Expect(requestedCondition).NotTo(BeNil())
+			Expect(requestedCondition.Status).To(Equal(metav1.ConditionTrue))
+
+			readyCondition := findTerminateCondition(updatedTerminateNode.Status.Conditions,
+				janitordgxcnvidiacomv1alpha1.TerminateNodeConditionReplacementReady)
+			Expect(readyCondition).NotTo(BeNil())
+			Expect(readyCondition.Status).To(Equal(metav1.ConditionUnknown))
+
+			signalSentCondition := findTerminateCondition(updatedTerminateNode.Status.Conditions,
+				janitordgxcnvidiacomv1alpha1.TerminateNodeConditionSignalSent)
+			Expect(signalSentCondition.Status).To(Equal(metav1.ConditionUnknown))
+
+			// The replacement node registers but is not Ready yet
+			replacementNode := &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "replacement-" + uniqueSuffix},
+			}
+			Expect(k8sClient.Create(ctx, replacementNode)).To(Succeed())
+			replacementNode.Status.Conditions = []corev1.NodeCondition{
+				{Type: corev1.NodeReady, Status: corev1.ConditionFalse},
+			}
+			Expect(k8sClient.Status().Update(ctx, replacementNode)).To(Succeed())
+			mockCSP.Server.SetReplacementNode(replacementNode.Name)
+
+			_, err = reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			updatedTerminateNode = getTerminateNode()
+			Expect(updatedTerminateNode.Status.Replacement.NodeName).To(Equal(replacementNode.Name))
+			readyCondition = findTerminateCondition(updatedTerminateNode.Status.Conditions,
+				janitordgxcnvidiacomv1alpha1.TerminateNodeConditionReplacementReady)
+			Expect(readyCondition.Status).To(Equal(metav1.ConditionUnknown))
+			Expect(readyCondition.Message).To(ContainSubstring(replacementNode.Name))
+
+			replacementNode.Status.Conditions[0].Status = corev1.ConditionTrue
+			Expect(k8sClient.Status().Update(ctx, replacementNode)).To(Succeed())
+
+			_, err = reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			updatedTerminateNode = getTerminateNode()
+			readyCondition = findTerminateCondition(updatedTerminateNode.Status.Conditions,
+				janitordgxcnvidiacomv1alpha1.TerminateNodeConditionReplacementReady)
+			Expect(readyCondition.Status).To(Equal(metav1.ConditionTrue))
+
+			signalSentCondition = findTerminateCondition(updatedTerminateNode.Status.Conditions,
+				janitordgxcnvidiacomv1alpha1.TerminateNodeConditionSignalSent)
+			Expect(signalSentCondition.Status).To(Equal(metav1.ConditionTrue))
+
+			Expect(k8sClient.Delete(ctx, replacementNode)).To(Succeed())
+		})
+
+		It("should terminate without replacement when the provider does not support replacements", func() {
+			mockCSP.Server.SetReplacementFailure(status.Errorf(codes.Unimplemented, "not implemented"))
+
+			_, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			updatedTerminateNode := getTerminateNode()
+			requestedCondition := findTerminateCondition(updatedTerminateNode.Status.Conditions,
+				janitordgxcnvidiacomv1alpha1.TerminateNodeConditionReplacementRequested)
+			Expect(requestedCondition.Status).To(Equal(metav1.ConditionFalse))
+			Expect(requestedCondition.Reason).To(Equal("NotSupported"))
+
+			readyCondition := findTerminateCondition(updatedTerminateNode.Status.Conditions,
+				janitordgxcnvidiacomv1alpha1.TerminateNodeConditionReplacementReady)
+			Expect(readyCondition.Status).To(Equal(metav1.ConditionFalse))
+			Expect(readyCondition.Reason).To(Equal("NotSupported"))
+
+			signalSentCondition := findTerminateCondition(updatedTerminateNode.Status.Conditions,
+				janitordgxcnvidiacomv1alpha1.TerminateNodeConditionSignalSent)
+			Expect(signalSentCondition.Status).To(Equal(metav1.ConditionTrue))
+		})
+
+		It("should fail without terminating the node when no replacement is Ready before the timeout", func() {
+			reconciler.Config.Replacement.Timeout = time.Nanosecond
+			reconciler.Config.Replacement.OnTimeout = config.ReplacementOnTimeoutFail
+
+			_, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			updatedTerminateNode := getTerminateNode()
+			Expect(updatedTerminateNode.Status.CompletionTime).NotTo(BeNil())
+
+			readyCondition := findTerminateCondition(updatedTerminateNode.Status.Conditions,
+				janitordgxcnvidiacomv1alpha1.TerminateNodeConditionReplacementReady)
+			Expect(readyCondition.Status).To(Equal(metav1.ConditionFalse))
+			Expect(readyCondition.Reason).To(Equal("Timeout"))
+
+			terminatedCondition := findTerminateCondition(updatedTerminateNode.Status.Conditions,
+				janitordgxcnvidiacomv1alpha1.TerminateNodeConditionNodeTerminated)
+			Expect(terminatedCondition.Status).To(Equal(metav1.ConditionFalse))
+			Expect(terminatedCondition.Reason).To(Equal("ReplacementNotReady"))
+
+			signalSentCondition := findTerminateCondition(updatedTerminateNode.Status.Conditions,
+				janitordgxcnvidiacomv1alpha1.TerminateNodeConditionSignalSent)
+			Expect(signalSentCondition.Status).To(Equal(metav1.ConditionUnknown))
+		})
+	})
+
 })
 
 // Helper function to find a condition by type for TerminateNode
@@ -543,3 +684,120 @@ func findTerminateCondition(conditions []metav1.Condition, conditionType string)
 	}
 	return nil
 }
+
+func TestTerminateNodeReconciler_releasesReplacementOnCompletion(t *testing.T) {
+	tests := []struct {
+		name           string
+		releaseErr     error
+		expectReleased bool
+	}{
+		{
+			name:           "removes do-not-disrupt once the node is terminated",
+			expectReleased: true,
+		},
+		{
+			name:       "does not complete the TerminateNode while the release fails",
+			releaseErr: errors.New("api server unavailable"),
+		},
+	}
+
+	testScheme := runtime.NewScheme()
+	if err := corev1.AddToScheme(testScheme); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if err := janitordgxcnvidiacomv1alpha1.AddToScheme(testScheme); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			nodeClaim := &unstructured.Unstructured{}
+			nodeClaim.SetGroupVersionKind(replacement.NodeClaimGVK)
+			nodeClaim.SetName("gpu-abcde")
+			nodeClaim.SetAnnotations(map[string]string{replacement.DoNotDisruptAnnotation: "true"})
+
+			startTime := metav1.Now()
+			terminateNode := &janitordgxcnvidiacomv1alpha1.TerminateNode{
+				ObjectMeta: metav1.ObjectMeta{Name: "terminate-node-1"},
+				Spec:       janitordgxcnvidiacomv1alpha1.TerminateNodeSpec{NodeName: "node-1"},
+				Status: janitordgxcnvidiacomv1alpha1.TerminateNodeStatus{
+					StartTime: &startTime,
+					Conditions: []metav1.Condition{
+						{
+							Type:               janitordgxcnvidiacomv1alpha1.TerminateNodeConditionSignalSent,
+							Status:             metav1.ConditionTrue,
+							Reason:             "Succeeded",
+							LastTransitionTime: startTime,
+						},
+						{
+							Type:               janitordgxcnvidiacomv1alpha1.TerminateNodeConditionNodeTerminated,
+							Status:             metav1.ConditionUnknown,
+							Reason:             "Initializing",
+							LastTransitionTime: startTime,
+						},
+					},
+					Replacement: &janitordgxcnvidiacomv1alpha1.ReplacementStatus{
+						Strategy:  config.ReplacementStrategyKarpenter,
+						StartTime: &startTime,
+						RequestID: nodeClaim.GetName(),
+					},
+				},
+			}
+
+			c := fake.NewClientBuilder().
+				WithScheme(testScheme).
+				WithObjects(terminateNode, nodeClaim).
+				WithStatusSubresource(terminateNode).
+				WithInterceptorFuncs(interceptor.Funcs{
+					Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch,
+						opts ...client.PatchOption) error {
+						if tt.releaseErr != nil {
+							return tt.releaseErr
+						}
+
+						return c.Patch(ctx, obj, patch, opts...)
+					},
+				}).
+				Build()
+
+			r := &TerminateNodeReconciler{
+				Client: c,
+				Scheme: testScheme,
+				Config: &config.TerminateNodeControllerConfig{ManualMode: ptr.To(false)},
+				dialProviderFunc: func(_ context.Context) (cspv1alpha1.CSPProviderServiceClient, func(), error) {
+					return nil, func() {}, nil
+				},
+			}
+
+			// The node is gone, so the termination completes
+			_, err := r.reconcileHelper(ctx, terminateNode.DeepCopy())
+			if (err != nil) != (tt.releaseErr != nil) {
+				t.Fatalf("reconcileHelper() error = %v, want %v", err, tt.releaseErr)
+			}
+
+			updatedNodeClaim := &unstructured.Unstructured{}
+			updatedNodeClaim.SetGroupVersionKind(replacement.NodeClaimGVK)
+
+			if err := c.Get(ctx, client.ObjectKeyFromObject(nodeClaim), updatedNodeClaim); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			_, found := updatedNodeClaim.GetAnnotations()[replacement.DoNotDisruptAnnotation]
+			if found == tt.expectReleased {
+				t.Errorf("do-not-disrupt annotation present = %v, want %v", found, !tt.expectReleased)
+			}
+
+			updatedTerminateNode := &janitordgxcnvidiacomv1alpha1.TerminateNode{}
+			if err := c.Get(ctx, client.ObjectKeyFromObject(terminateNode), updatedTerminateNode); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if completed := updatedTerminateNode.Status.CompletionTime != nil; completed != tt.expectReleased {
+				t.Errorf("TerminateNode completed = %v, want %v", completed, tt.expectReleased)
+			}
+		})
+	}
+}
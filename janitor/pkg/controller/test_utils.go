@@ -45,6 +45,20 @@ type MockCSPBehavior struct {
 	// IsNodeReady behavior
 	IsNodeReadyError error
 	IsNodeReady      bool
+
+	// RequestReplacementNode and GetReplacementNode behavior
+	ReplacementError     error
+	ReplacementRequestID string
+	ReplacementNodeName  string
+
+	// SendReimageSignal behavior
+	ReimageError     error
+	ReimageRequestID string
+
+	// GetInstanceStatus behavior
+	InstanceStatusError error
+	UnderMaintenance    bool
+	ScheduledEvents     []*cspv1alpha1.ScheduledEvent
 }
 
 // DefaultSuccessBehavior returns a MockCSPBehavior configured for all operations to succeed.
@@ -53,6 +67,9 @@ func DefaultSuccessBehavior() *MockCSPBehavior {
 		TerminateRequestID: "test-terminate-request-ref",
 		RebootRequestID:    "test-request-ref",
 		IsNodeReady:        true,
+
+		ReplacementRequestID: "test-replacement-request-ref",
+		ReimageRequestID:     "test-reimage-request-ref",
 	}
 }
 
@@ -62,6 +79,7 @@ func DefaultFailureBehavior() *MockCSPBehavior {
 		TerminateError:   status.Errorf(codes.Internal, "failed to send terminate signal"),
 		RebootError:      status.Errorf(codes.Internal, "failed to send reboot signal"),
 		IsNodeReadyError: status.Errorf(codes.Internal, "failed to check if node is ready"),
+		ReimageError:     status.Errorf(codes.Internal, "failed to send reimage signal"),
 	}
 }
 
@@ -150,6 +168,48 @@ func (s *MockCSPServer) SetNodeReadyError(err error) {
 	s.behavior.IsNodeReadyError = err
 }
 
+// SetReplacementNode configures the GetReplacementNode response.
+func (s *MockCSPServer) SetReplacementNode(nodeName string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.behavior.ReplacementNodeName = nodeName
+}
+
+// SetReplacementFailure configures replacement operations to fail.
+func (s *MockCSPServer) SetReplacementFailure(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.behavior.ReplacementError = err
+}
+
+// SetReimageFailure configures only reimage operations to fail.
+func (s *MockCSPServer) SetReimageFailure(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.behavior.ReimageError = err
+}
+
+// SetUnderMaintenance configures the GetInstanceStatus response.
+func (s *MockCSPServer) SetUnderMaintenance(underMaintenance bool, events ...*cspv1alpha1.ScheduledEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.behavior.InstanceStatusError = nil
+	s.behavior.UnderMaintenance = underMaintenance
+	s.behavior.ScheduledEvents = events
+}
+
+// SetInstanceStatusError configures GetInstanceStatus to return an error.
+func (s *MockCSPServer) SetInstanceStatusError(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.behavior.InstanceStatusError = err
+}
+
 func (s *MockCSPServer) SendTerminateSignal(
 	ctx context.Context,
 	req *cspv1alpha1.SendTerminateSignalRequest,
@@ -198,6 +258,72 @@ func (s *MockCSPServer) IsNodeReady(
 	}, nil
 }
 
+func (s *MockCSPServer) RequestReplacementNode(
+	ctx context.Context,
+	req *cspv1alpha1.RequestReplacementNodeRequest,
+) (*cspv1alpha1.RequestReplacementNodeResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.behavior.ReplacementError != nil {
+		return nil, s.behavior.ReplacementError
+	}
+
+	return &cspv1alpha1.RequestReplacementNodeResponse{
+		RequestId: s.behavior.ReplacementRequestID,
+	}, nil
+}
+
+func (s *MockCSPServer) GetReplacementNode(
+	ctx context.Context,
+	req *cspv1alpha1.GetReplacementNodeRequest,
+) (*cspv1alpha1.GetReplacementNodeResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.behavior.ReplacementError != nil {
+		return nil, s.behavior.ReplacementError
+	}
+
+	return &cspv1alpha1.GetReplacementNodeResponse{
+		ReplacementNodeName: s.behavior.ReplacementNodeName,
+	}, nil
+}
+
+func (s *MockCSPServer) SendReimageSignal(
+	ctx context.Context,
+	req *cspv1alpha1.SendReimageSignalRequest,
+) (*cspv1alpha1.SendReimageSignalResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.behavior.ReimageError != nil {
+		return nil, s.behavior.ReimageError
+	}
+
+	return &cspv1alpha1.SendReimageSignalResponse{
+		RequestId: s.behavior.ReimageRequestID,
+	}, nil
+}
+
+func (s *MockCSPServer) GetInstanceStatus(
+	ctx context.Context,
+	req *cspv1alpha1.GetInstanceStatusRequest,
+) (*cspv1alpha1.GetInstanceStatusResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.behavior.InstanceStatusError != nil {
+		return nil, s.behavior.InstanceStatusError
+	}
+
+	return &cspv1alpha1.GetInstanceStatusResponse{
+		ProviderState:    "running",
+		UnderMaintenance: s.behavior.UnderMaintenance,
+		ScheduledEvents:  s.behavior.ScheduledEvents,
+	}, nil
+}
+
 // MockCSPTestHelper manages the mock gRPC server lifecycle and provides a CSP client.
 // This should be created once per test suite and used across all tests.
 type MockCSPTestHelper struct {
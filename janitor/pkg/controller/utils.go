@@ -16,25 +16,45 @@ package controller
 
 import (
 	"context"
+	"crypto/sha256"
 	"fmt"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/nvidia/nvsentinel/janitor/api/v1alpha1"
+	"github.com/nvidia/nvsentinel/janitor/pkg/approval"
 	"github.com/nvidia/nvsentinel/janitor/pkg/config"
+	"github.com/nvidia/nvsentinel/janitor/pkg/distributedlock"
+	"github.com/nvidia/nvsentinel/janitor/pkg/gpuservices"
+)
+
+const (
+	// exclusionGroupRequeueInterval is the delay before retrying to acquire the slots of a full exclusion group.
+	exclusionGroupRequeueInterval = 10 * time.Second
+	// pendingApprovalRequeueInterval is the delay before checking again whether a pending maintenance was approved.
+	pendingApprovalRequeueInterval = 30 * time.Second
+	// podNameSuffixLength is the 6-character suffix (e.g., "-abc12") added by K8s to a Job's name to create its Pods.
+	podNameSuffixLength = 6
 )
 
 func ConfigureFieldIndexers(mgr ctrl.Manager, cfg *config.Config) error {
 	managerFieldIndexer := mgr.GetFieldIndexer()
 
-	if cfg.GPUReset.Enabled {
-		if err := managerFieldIndexer.IndexField(context.Background(), &corev1.Pod{}, "spec.nodeName",
+	// Pods are indexed by node to find the managed GPU service pods torn down by GPUReset and GPUServicesRestart.
+	if cfg.GPUReset.Enabled || cfg.GPUServicesRestart.Enabled {
+		if err := managerFieldIndexer.IndexField(context.Background(), &corev1.Pod{}, gpuservices.PodNodeNameField,
 			podNodeNameIndexer); err != nil {
 			return fmt.Errorf("failed to add indexer on Pods for spec.nodeName: %w", err)
 		}
+	}
 
+	if cfg.GPUReset.Enabled {
 		if err := managerFieldIndexer.IndexField(context.Background(), &v1alpha1.GPUReset{}, "spec.nodeName",
 			gpuResetNodeNameIndexer); err != nil {
 			return fmt.Errorf("failed to add indexer on GPUResets for spec.nodeName: %w", err)
@@ -44,6 +64,28 @@ func ConfigureFieldIndexers(mgr ctrl.Manager, cfg *config.Config) error {
 	return nil
 }
 
+// boundedJobName returns a valid and deterministic name for a Job owned by the named maintenance resource. Long
+// owner names are truncated and suffixed with a hash, so that the names of the Pods created by the Job do not exceed
+// 63 characters.
+func boundedJobName(ownerName, suffix string) string {
+	if len(ownerName)+len(suffix) <= validation.DNS1123LabelMaxLength {
+		return ownerName + suffix
+	}
+
+	// Leave room for the hash, its separator and the Pod name suffix.
+	maxBaseLength := validation.DNS1123LabelMaxLength - len(suffix) - 1 - 8 - podNameSuffixLength
+
+	baseName := ownerName
+	if len(baseName) > maxBaseLength {
+		baseName = baseName[0:maxBaseLength]
+	}
+
+	hash := sha256.Sum256([]byte(ownerName))
+	shortHash := fmt.Sprintf("%x", hash)[:8]
+
+	return fmt.Sprintf("%s-%s%s", baseName, shortHash, suffix)
+}
+
 func podNodeNameIndexer(obj client.Object) []string {
 	p, ok := obj.(*corev1.Pod)
 	if !ok || p.Spec.NodeName == "" {
@@ -61,3 +103,89 @@ func gpuResetNodeNameIndexer(obj client.Object) []string {
 
 	return []string{gr.Spec.NodeName}
 }
+
+// lockExclusionGroups acquires the exclusion group slots for the node of a maintenance resource and records on its
+// WaitingForExclusionGroup condition whether it is waiting for one. The condition is only added once the maintenance
+// resource had to wait, so that it does not clutter nodes which are not in a full group.
+func lockExclusionGroups(ctx context.Context, c client.Client, groupLock distributedlock.GroupLock,
+	maintenanceObject client.Object, conditions *[]metav1.Condition, nodeName string) (bool, error) {
+	original := maintenanceObject.DeepCopyObject().(client.Object) //nolint:forcetypeassert // deep copy of same type
+
+	locked, waitingFor := groupLock.LockGroups(ctx, maintenanceObject, nodeName)
+
+	changed := false
+
+	switch {
+	case !locked:
+		changed = meta.SetStatusCondition(conditions, metav1.Condition{
+			Type:    v1alpha1.WaitingForExclusionGroupConditionType,
+			Status:  metav1.ConditionTrue,
+			Reason:  v1alpha1.ExclusionGroupFullReason,
+			Message: waitingFor,
+		})
+	case meta.FindStatusCondition(*conditions, v1alpha1.WaitingForExclusionGroupConditionType) != nil:
+		changed = meta.SetStatusCondition(conditions, metav1.Condition{
+			Type:    v1alpha1.WaitingForExclusionGroupConditionType,
+			Status:  metav1.ConditionFalse,
+			Reason:  v1alpha1.ExclusionGroupsAcquiredReason,
+			Message: "Acquired a maintenance slot in every exclusion group of the node",
+		})
+	}
+
+	if changed {
+		if err := c.Status().Patch(ctx, maintenanceObject, client.MergeFrom(original)); err != nil {
+			return false, fmt.Errorf("failed to update exclusion group condition of %s: %w",
+				maintenanceObject.GetName(), err)
+		}
+	}
+
+	return locked, nil
+}
+
+// requiresOutsideActor returns whether a controller in manual mode leaves the maintenance to an outside actor. With
+// the approval workflow, approved maintenance is carried out by the controller instead.
+func requiresOutsideActor(manualMode *bool, approvalConfig config.ApprovalConfig) bool {
+	return *manualMode && !approvalConfig.Enabled
+}
+
+// +kubebuilder:rbac:groups=janitor.dgxc.nvidia.com,resources=maintenanceapprovals,verbs=get;list;watch
+
+// checkApproval returns whether a maintenance resource of a controller in manual mode was approved when the approval
+// workflow is enabled, and records the decision on its PendingApproval condition. Maintenance resources are always
+// approved otherwise. An approval is final: once the condition is False the approval is not evaluated again, so that
+// an approval expiring or being deleted does not interrupt a started maintenance.
+func checkApproval(ctx context.Context, c client.Client, cfg config.ApprovalConfig, manualMode *bool, kind string,
+	maintenanceObject client.Object, conditions *[]metav1.Condition, nodeName string) (bool, error) {
+	if !cfg.Enabled || manualMode == nil || !*manualMode {
+		return true, nil
+	}
+
+	if meta.IsStatusConditionFalse(*conditions, v1alpha1.PendingApprovalConditionType) {
+		return true, nil
+	}
+
+	original := maintenanceObject.DeepCopyObject().(client.Object) //nolint:forcetypeassert // deep copy of same type
+
+	decision, err := approval.Evaluate(ctx, c, cfg, kind, maintenanceObject, nodeName)
+	if err != nil {
+		return false, fmt.Errorf("failed to evaluate approval of %s: %w", maintenanceObject.GetName(), err)
+	}
+
+	status := metav1.ConditionTrue
+	if decision.Approved {
+		status = metav1.ConditionFalse
+	}
+
+	if meta.SetStatusCondition(conditions, metav1.Condition{
+		Type:    v1alpha1.PendingApprovalConditionType,
+		Status:  status,
+		Reason:  decision.Reason,
+		Message: decision.Message,
+	}) {
+		if err := c.Status().Patch(ctx, maintenanceObject, client.MergeFrom(original)); err != nil {
+			return false, fmt.Errorf("failed to update approval condition of %s: %w", maintenanceObject.GetName(), err)
+		}
+	}
+
+	return decision.Approved, nil
+}
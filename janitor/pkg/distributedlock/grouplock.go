@@ -0,0 +1,259 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package distributedlock
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nvidia/nvsentinel/janitor/pkg/config"
+	"github.com/nvidia/nvsentinel/janitor/pkg/metrics"
+)
+
+const (
+	// ExclusionGroupLabel is set on exclusion group leases to the name of their group
+	ExclusionGroupLabel = "janitor.dgxc.nvidia.com/exclusion-group"
+	// HolderUIDLabel is set on exclusion group leases to the UID of the maintenance resource holding the slot
+	HolderUIDLabel = "janitor.dgxc.nvidia.com/holder-uid"
+	// ExclusionGroupValueAnnotation records the node label value which selected the group
+	ExclusionGroupValueAnnotation = "janitor.dgxc.nvidia.com/exclusion-group-value"
+	// NodeAnnotation records the node of the maintenance resource holding the slot
+	NodeAnnotation = "janitor.dgxc.nvidia.com/node"
+)
+
+/*
+The GroupLock interface limits how many nodes sharing an exclusion group, such as the compute trays of an NVL72 rack
+or the nodes behind a leaf switch, can be under maintenance at the same time. Exclusion groups are configured globally
+with a node label key and a maximum concurrency, and every distinct value of the label on nodes forms one group. A
+group with a maximum concurrency of N has N slots, each implemented with a lease named
+exclusion-<group name>-<hash of the label value>-<slot index>. Like NodeLock, a slot is held as long as its lease exists
+with an owner reference to the maintenance resource, and acquiring a free slot requires successful creation so that
+competing controllers cannot both acquire it.
+
+Controller + CRD requirements for leveraging GroupLock:
+1. GroupLock is used in addition to NodeLock: controllers should call LockGroups() after LockNode() succeeded and
+CheckUnlockGroups() alongside CheckUnlock(), following the same rules for when locking and unlocking is required.
+2. Lock ordering: a maintenance resource acquires its groups one at a time, in configuration order, and keeps the
+slots it already acquired while waiting for the next group. Since every controller acquires groups in the same order,
+two maintenance resources cannot wait on each other.
+3. Waiting condition: LockGroups() returns a message describing the group which is full so that controllers can
+surface it on the maintenance resource while it waits.
+4. RBAC requirements: controllers leveraging GroupLock need the NodeLock permissions and get on nodes.
+
+Metrics: like NodeLock, we emit janitor_actions_count with action_type lock or unlock and status failed only for
+unexpected failures, not for conflicts with other maintenance resources.
+*/
+type GroupLock interface {
+	LockGroups(ctx context.Context, maintenanceObject client.Object, nodeName string) (locked bool, waitingFor string)
+	CheckUnlockGroups(ctx context.Context, maintenanceObject client.Object, nodeName string) (retryUnlock bool)
+}
+
+// NewGroupLock creates a new GroupLock instance for the given exclusion groups
+func NewGroupLock(client client.Client, namespace string, groups []config.ExclusionGroup) GroupLock {
+	return &groupLock{
+		Client:    client,
+		namespace: namespace,
+		groups:    groups,
+	}
+}
+
+type groupLock struct {
+	client.Client
+	namespace string
+	groups    []config.ExclusionGroup
+}
+
+func (lock *groupLock) LockGroups(ctx context.Context, maintenanceObject client.Object,
+	nodeName string) (locked bool, waitingFor string) {
+	if len(lock.groups) == 0 {
+		return true, ""
+	}
+
+	var node corev1.Node
+
+	err := lock.Get(ctx, types.NamespacedName{Name: nodeName}, &node)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			// Controllers handle maintenance resources for missing nodes, which cannot conflict with other nodes
+			return true, ""
+		}
+
+		slog.ErrorContext(ctx, "Got an error fetching node for exclusion groups",
+			"error", err, "maintenanceResource", maintenanceObject.GetName(), "node", nodeName)
+		metrics.IncActionCount(metrics.ActionTypeLock, metrics.StatusFailed, nodeName)
+
+		return false, fmt.Sprintf("Failed to read the exclusion groups of node %s", nodeName)
+	}
+
+	for _, group := range lock.groups {
+		value := node.Labels[group.LabelKey]
+		if value == "" {
+			continue
+		}
+
+		acquired, holders, err := lock.acquireSlot(ctx, maintenanceObject, nodeName, group, value)
+		if err != nil {
+			slog.ErrorContext(ctx, "Got an error acquiring exclusion group slot",
+				"error", err, "maintenanceResource", maintenanceObject.GetName(), "exclusionGroup", group.Name)
+			metrics.IncActionCount(metrics.ActionTypeLock, metrics.StatusFailed, nodeName)
+
+			return false, fmt.Sprintf("Failed to acquire a slot in exclusion group %s (%s=%s)",
+				group.Name, group.LabelKey, value)
+		}
+
+		if !acquired {
+			slog.DebugContext(ctx, "Exclusion group is full, waiting for a free slot",
+				"maintenanceResource", maintenanceObject.GetName(), "exclusionGroup", group.Name, "holders", holders)
+
+			return false, waitingMessage(group, value, holders)
+		}
+	}
+
+	return true, ""
+}
+
+// acquireSlot returns whether the maintenance resource holds a slot of the group, acquiring a free slot if it does
+// not hold one yet, and the nodes holding the other slots.
+func (lock *groupLock) acquireSlot(ctx context.Context, maintenanceObject client.Object, nodeName string,
+	group config.ExclusionGroup, value string) (bool, []string, error) {
+	var (
+		freeSlots []string
+		holders   []string
+	)
+
+	for slot := range group.MaxConcurrent {
+		leaseName := groupLeaseName(group.Name, value, slot)
+
+		var lease coordinationv1.Lease
+
+		err := lock.Get(ctx, types.NamespacedName{Name: leaseName, Namespace: lock.namespace}, &lease)
+		if apierrors.IsNotFound(err) {
+			freeSlots = append(freeSlots, leaseName)
+
+			continue
+		}
+
+		if err != nil {
+			return false, nil, err
+		}
+
+		if lease.Labels[HolderUIDLabel] == string(maintenanceObject.GetUID()) {
+			return true, nil, nil
+		}
+
+		holders = append(holders, lease.Annotations[NodeAnnotation])
+	}
+
+	for _, leaseName := range freeSlots {
+		lease := &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      leaseName,
+				Namespace: lock.namespace,
+				Labels: map[string]string{
+					ExclusionGroupLabel: group.Name,
+					HolderUIDLabel:      string(maintenanceObject.GetUID()),
+				},
+				Annotations: map[string]string{
+					ExclusionGroupValueAnnotation: value,
+					NodeAnnotation:                nodeName,
+				},
+				OwnerReferences: []metav1.OwnerReference{ownerReference(maintenanceObject)},
+			},
+		}
+
+		err := lock.Create(ctx, lease)
+		if err == nil {
+			slog.InfoContext(ctx, "Successfully created exclusion group lease and acquired slot",
+				"maintenanceResource", maintenanceObject.GetName(), "exclusionGroup", group.Name,
+				"exclusionGroupLeaseName", leaseName)
+
+			return true, nil, nil
+		}
+
+		if !apierrors.IsAlreadyExists(err) {
+			return false, nil, err
+		}
+
+		// The slot was taken since it was read, possibly by this maintenance resource if the read was served from a
+		// stale cache. Stop here rather than acquiring a second slot and retry on the next reconcile.
+		slog.DebugContext(ctx, "Exclusion group lease already exists, failed to acquire slot",
+			"maintenanceResource", maintenanceObject.GetName(), "exclusionGroupLeaseName", leaseName)
+
+		return false, holders, nil
+	}
+
+	return false, holders, nil
+}
+
+// CheckUnlockGroups releases every exclusion group slot held by the maintenance resource. Slots are found by the
+// holder label rather than the current node labels, so that they are released even if the labels or the exclusion
+// group configuration changed while they were held.
+func (lock *groupLock) CheckUnlockGroups(ctx context.Context, maintenanceObject client.Object,
+	nodeName string) (retryUnlock bool) {
+	var leases coordinationv1.LeaseList
+
+	err := lock.List(ctx, &leases, client.InNamespace(lock.namespace),
+		client.MatchingLabels{HolderUIDLabel: string(maintenanceObject.GetUID())})
+	if err != nil {
+		return handleNotFoundError(err, "exclusion group leases", nodeName)
+	}
+
+	for i := range leases.Items {
+		lease := &leases.Items[i]
+
+		err = lock.Delete(ctx, lease)
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return handleNotFoundError(err, lease.GetName(), nodeName)
+		}
+
+		slog.InfoContext(ctx, "Exclusion group slot successfully released for maintenance resource",
+			"maintenanceResource", maintenanceObject.GetName(), "exclusionGroupLeaseName", lease.GetName())
+	}
+
+	return false
+}
+
+func waitingMessage(group config.ExclusionGroup, value string, holders []string) string {
+	message := fmt.Sprintf("Waiting for exclusion group %s (%s=%s): no free maintenance slot out of %d",
+		group.Name, group.LabelKey, value, group.MaxConcurrent)
+	if len(holders) > 0 {
+		message += ", held by nodes " + strings.Join(holders, ", ")
+	}
+
+	return message
+}
+
+// groupLeaseName returns the name of a slot lease. Label values can contain characters which are not valid in lease
+// names and can be as long as a name, so the value is hashed.
+func groupLeaseName(groupName, value string, slot int) string {
+	hash := sha256.Sum256([]byte(value))
+
+	return fmt.Sprintf("exclusion-%s-%s-%d", groupName, hex.EncodeToString(hash[:])[:10], slot)
+}
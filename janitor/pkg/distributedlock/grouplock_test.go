@@ -0,0 +1,226 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package distributedlock
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	janitordgxcnvidiacomv1alpha1 "github.com/nvidia/nvsentinel/janitor/api/v1alpha1"
+	"github.com/nvidia/nvsentinel/janitor/pkg/config"
+)
+
+const cliqueLabel = "nvidia.com/gpu.clique"
+
+func newGroupTestNode(name, clique string) *corev1.Node {
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	if clique != "" {
+		node.Labels = map[string]string{cliqueLabel: clique}
+	}
+
+	return node
+}
+
+func newGroupTestRebootNode(nodeName string) *janitordgxcnvidiacomv1alpha1.RebootNode {
+	return &janitordgxcnvidiacomv1alpha1.RebootNode{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "reboot-" + nodeName,
+			UID:  types.UID("uid-" + nodeName),
+		},
+		Spec: janitordgxcnvidiacomv1alpha1.RebootNodeSpec{NodeName: nodeName},
+	}
+}
+
+var _ = Describe("GroupLock", func() {
+	var (
+		ctx       context.Context
+		scheme    *runtime.Scheme
+		objects   []client.Object
+		groups    []config.ExclusionGroup
+		k8sClient client.Client
+		groupLock GroupLock
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		scheme = runtime.NewScheme()
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		Expect(coordinationv1.AddToScheme(scheme)).To(Succeed())
+		Expect(janitordgxcnvidiacomv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		objects = []client.Object{
+			newGroupTestNode("node-a", "clique-1"),
+			newGroupTestNode("node-b", "clique-1"),
+			newGroupTestNode("node-c", "clique-1"),
+			newGroupTestNode("node-d", "clique-2"),
+			newGroupTestNode("node-e", ""),
+		}
+		groups = []config.ExclusionGroup{{Name: "nvlink-domain", LabelKey: cliqueLabel, MaxConcurrent: 1}}
+	})
+
+	JustBeforeEach(func() {
+		k8sClient = fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+		groupLock = NewGroupLock(k8sClient, testNamespace, groups)
+	})
+
+	It("acquires a slot when the group is free", func() {
+		rebootNode := newGroupTestRebootNode("node-a")
+
+		locked, waitingFor := groupLock.LockGroups(ctx, rebootNode, "node-a")
+		Expect(locked).To(BeTrue())
+		Expect(waitingFor).To(BeEmpty())
+
+		var lease coordinationv1.Lease
+		Expect(k8sClient.Get(ctx, types.NamespacedName{
+			Name:      groupLeaseName("nvlink-domain", "clique-1", 0),
+			Namespace: testNamespace,
+		}, &lease)).To(Succeed())
+		Expect(lease.Labels).To(HaveKeyWithValue(ExclusionGroupLabel, "nvlink-domain"))
+		Expect(lease.Labels).To(HaveKeyWithValue(HolderUIDLabel, string(rebootNode.UID)))
+		Expect(lease.Annotations).To(HaveKeyWithValue(NodeAnnotation, "node-a"))
+		Expect(lease.OwnerReferences).To(HaveLen(1))
+		Expect(lease.OwnerReferences[0].UID).To(Equal(rebootNode.UID))
+
+		By("keeping the slot on subsequent reconciles")
+		locked, _ = groupLock.LockGroups(ctx, rebootNode, "node-a")
+		Expect(locked).To(BeTrue())
+	})
+
+	It("blocks other nodes of the same group until the slot is released", func() {
+		rebootNodeA := newGroupTestRebootNode("node-a")
+		rebootNodeB := newGroupTestRebootNode("node-b")
+
+		locked, _ := groupLock.LockGroups(ctx, rebootNodeA, "node-a")
+		Expect(locked).To(BeTrue())
+
+		locked, waitingFor := groupLock.LockGroups(ctx, rebootNodeB, "node-b")
+		Expect(locked).To(BeFalse())
+		Expect(waitingFor).To(ContainSubstring("nvlink-domain"))
+		Expect(waitingFor).To(ContainSubstring(cliqueLabel + "=clique-1"))
+		Expect(waitingFor).To(ContainSubstring("node-a"))
+
+		By("releasing the slot of the first node")
+		Expect(groupLock.CheckUnlockGroups(ctx, rebootNodeA, "node-a")).To(BeFalse())
+
+		locked, _ = groupLock.LockGroups(ctx, rebootNodeB, "node-b")
+		Expect(locked).To(BeTrue())
+	})
+
+	It("does not block nodes of other groups or without the label", func() {
+		locked, _ := groupLock.LockGroups(ctx, newGroupTestRebootNode("node-a"), "node-a")
+		Expect(locked).To(BeTrue())
+
+		locked, _ = groupLock.LockGroups(ctx, newGroupTestRebootNode("node-d"), "node-d")
+		Expect(locked).To(BeTrue())
+
+		locked, _ = groupLock.LockGroups(ctx, newGroupTestRebootNode("node-e"), "node-e")
+		Expect(locked).To(BeTrue())
+
+		locked, _ = groupLock.LockGroups(ctx, newGroupTestRebootNode("missing-node"), "missing-node")
+		Expect(locked).To(BeTrue())
+	})
+
+	Context("with a maximum concurrency of 2", func() {
+		BeforeEach(func() {
+			groups[0].MaxConcurrent = 2
+		})
+
+		It("allows two nodes of the same group", func() {
+			locked, _ := groupLock.LockGroups(ctx, newGroupTestRebootNode("node-a"), "node-a")
+			Expect(locked).To(BeTrue())
+
+			locked, _ = groupLock.LockGroups(ctx, newGroupTestRebootNode("node-b"), "node-b")
+			Expect(locked).To(BeTrue())
+
+			locked, waitingFor := groupLock.LockGroups(ctx, newGroupTestRebootNode("node-c"), "node-c")
+			Expect(locked).To(BeFalse())
+			Expect(waitingFor).To(ContainSubstring("node-a, node-b"))
+		})
+	})
+
+	Context("with several groups", func() {
+		BeforeEach(func() {
+			objects[0].SetLabels(map[string]string{cliqueLabel: "clique-1", "example.com/rack": "rack-1"})
+			objects[3].SetLabels(map[string]string{cliqueLabel: "clique-2", "example.com/rack": "rack-1"})
+			groups = append(groups, config.ExclusionGroup{Name: "rack", LabelKey: "example.com/rack", MaxConcurrent: 1})
+		})
+
+		It("requires a slot in every group of the node", func() {
+			locked, _ := groupLock.LockGroups(ctx, newGroupTestRebootNode("node-a"), "node-a")
+			Expect(locked).To(BeTrue())
+
+			locked, waitingFor := groupLock.LockGroups(ctx, newGroupTestRebootNode("node-d"), "node-d")
+			Expect(locked).To(BeFalse())
+			Expect(waitingFor).To(ContainSubstring("rack"))
+
+			By("keeping the slot acquired in the first group while waiting for the second")
+			rebootNode := newGroupTestRebootNode("node-d")
+			rebootNode.UID = "uid-other"
+
+			locked, waitingFor = groupLock.LockGroups(ctx, rebootNode, "node-d")
+			Expect(locked).To(BeFalse())
+			Expect(waitingFor).To(ContainSubstring("nvlink-domain"))
+		})
+	})
+
+	It("does not acquire a slot when creating the lease fails", func() {
+		k8sClient = fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).
+			WithInterceptorFuncs(interceptor.Funcs{
+				Create: func(ctx context.Context, client client.WithWatch, obj client.Object,
+					opts ...client.CreateOption) error {
+					return fmt.Errorf("internal error")
+				},
+			}).Build()
+		groupLock = NewGroupLock(k8sClient, testNamespace, groups)
+
+		locked, waitingFor := groupLock.LockGroups(ctx, newGroupTestRebootNode("node-a"), "node-a")
+		Expect(locked).To(BeFalse())
+		Expect(waitingFor).To(ContainSubstring("Failed to acquire"))
+	})
+
+	It("retries releasing slots when deleting a lease fails", func() {
+		rebootNode := newGroupTestRebootNode("node-a")
+
+		locked, _ := groupLock.LockGroups(ctx, rebootNode, "node-a")
+		Expect(locked).To(BeTrue())
+
+		failingClient := interceptor.NewClient(k8sClient.(client.WithWatch), interceptor.Funcs{
+			Delete: func(ctx context.Context, client client.WithWatch, obj client.Object,
+				opts ...client.DeleteOption) error {
+				return fmt.Errorf("internal error")
+			},
+		})
+
+		Expect(NewGroupLock(failingClient, testNamespace, groups).CheckUnlockGroups(ctx, rebootNode, "node-a")).
+			To(BeTrue())
+		Expect(groupLock.CheckUnlockGroups(ctx, rebootNode, "node-a")).To(BeFalse())
+
+		var leases coordinationv1.LeaseList
+		Expect(k8sClient.List(ctx, &leases, client.InNamespace(testNamespace))).To(Succeed())
+		Expect(leases.Items).To(BeEmpty())
+	})
+})
@@ -102,41 +102,11 @@ func (lock *nodeLock) LockNode(ctx context.Context, maintenanceObject client.Obj
 	slog.DebugContext(ctx, "Node lock lease does not exist, attempting to acquire the lock",
 		"maintenanceResource", maintenanceObject.GetName(), "nodeLockName", nodeLockName)
 
-	// Get GVK from the object - if empty, infer from object type
-	gvk := maintenanceObject.GetObjectKind().GroupVersionKind()
-	apiVersion := gvk.GroupVersion().String()
-	kind := gvk.Kind
-
-	// If GVK is not set (common in tests), try to get it from the object's type
-	if apiVersion == "" || kind == "" {
-		// Try to extract from the object's type name
-		typeName := fmt.Sprintf("%T", maintenanceObject)
-
-		if apiVersion == "" {
-			apiVersion = "janitor.dgxc.nvidia.com/v1alpha1"
-		}
-
-		if kind == "" {
-			// Extract kind from type name (e.g., "*v1alpha1.RebootNode" -> "RebootNode")
-			if idx := strings.LastIndex(typeName, "."); idx != -1 {
-				kind = strings.TrimPrefix(typeName[idx+1:], "*")
-			}
-		}
-	}
-
 	lease = &coordinationv1.Lease{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      nodeLockName,
-			Namespace: lock.namespace,
-			OwnerReferences: []metav1.OwnerReference{
-				{
-					APIVersion:         apiVersion,
-					Kind:               kind,
-					Name:               maintenanceObject.GetName(),
-					UID:                maintenanceObject.GetUID(),
-					BlockOwnerDeletion: ptr.To(true),
-				},
-			},
+			Name:            nodeLockName,
+			Namespace:       lock.namespace,
+			OwnerReferences: []metav1.OwnerReference{ownerReference(maintenanceObject)},
 		},
 		// There's no need to populate a HolderIdentity, LeaseDurationSeconds, nor RenewTime in Spec, we only need to identify
 		// the corresponding node and maintenance resource which is accomplished by the lease name and ownerReference.
@@ -212,6 +182,39 @@ func (lock *nodeLock) CheckUnlock(ctx context.Context,
 	return false
 }
 
+// ownerReference returns the owner reference which marks a lease as held by the given maintenance resource
+func ownerReference(maintenanceObject client.Object) metav1.OwnerReference {
+	// Get GVK from the object - if empty, infer from object type
+	gvk := maintenanceObject.GetObjectKind().GroupVersionKind()
+	apiVersion := gvk.GroupVersion().String()
+	kind := gvk.Kind
+
+	// If GVK is not set (common in tests), try to get it from the object's type
+	if apiVersion == "" || kind == "" {
+		// Try to extract from the object's type name
+		typeName := fmt.Sprintf("%T", maintenanceObject)
+
+		if apiVersion == "" {
+			apiVersion = "janitor.dgxc.nvidia.com/v1alpha1"
+		}
+
+		if kind == "" {
+			// Extract kind from type name (e.g., "*v1alpha1.RebootNode" -> "RebootNode")
+			if idx := strings.LastIndex(typeName, "."); idx != -1 {
+				kind = strings.TrimPrefix(typeName[idx+1:], "*")
+			}
+		}
+	}
+
+	return metav1.OwnerReference{
+		APIVersion:         apiVersion,
+		Kind:               kind,
+		Name:               maintenanceObject.GetName(),
+		UID:                maintenanceObject.GetUID(),
+		BlockOwnerDeletion: ptr.To(true),
+	}
+}
+
 func (lock *nodeLock) getNodeLockLease(ctx context.Context, nodeName string) (string, *coordinationv1.Lease, error) {
 	nodeLockNamespaceName := types.NamespacedName{
 		Name:      nodeName,
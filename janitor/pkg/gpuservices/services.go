@@ -0,0 +1,135 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gpuservices
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// PodNodeNameField is the field index on Pods required by PodsTerminated and PodsReady.
+const PodNodeNameField = "spec.nodeName"
+
+// SetNodeLabels sets the node labels controlling the deployment of the managed apps to their enabled or disabled
+// value. It returns whether any label was changed, in which case the caller is responsible for patching the node.
+func (m Manager) SetNodeLabels(node *corev1.Node, enabled bool) bool {
+	changed := false
+
+	for _, app := range m.Spec.Apps {
+		value := app.DisabledValue
+		if enabled {
+			value = app.EnabledValue
+		}
+
+		if current, exists := node.Labels[app.NodeLabel]; exists && current == value {
+			continue
+		}
+
+		if node.Labels == nil {
+			node.Labels = make(map[string]string)
+		}
+
+		node.Labels[app.NodeLabel] = value
+		changed = true
+	}
+
+	return changed
+}
+
+// PodsTerminated verifies that all pods belonging to the managed apps have been terminated on the given node.
+func (m Manager) PodsTerminated(ctx context.Context, c client.Reader, nodeName string) (bool, error) {
+	log := log.FromContext(ctx)
+
+	for _, app := range m.Spec.Apps {
+		pods, selector, err := m.listAppPods(ctx, c, app, nodeName)
+		if err != nil {
+			return false, fmt.Errorf("failed to list %s pods for termination check for node %s: %w",
+				m.Name, nodeName, err)
+		}
+
+		if len(pods.Items) > 0 {
+			log.V(1).Info("Managed service pod still running on node", "node", nodeName, "manager", m.Name,
+				"count", len(pods.Items), "selector", selector)
+
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// PodsReady verifies that all pods belonging to the managed apps have been re-deployed and are in a Ready state on
+// the given node.
+func (m Manager) PodsReady(ctx context.Context, c client.Reader, nodeName string) (bool, error) {
+	log := log.FromContext(ctx)
+
+	for _, app := range m.Spec.Apps {
+		pods, selector, err := m.listAppPods(ctx, c, app, nodeName)
+		if err != nil {
+			return false, fmt.Errorf("failed to list %s managed service pods for ready check for node %s: %w",
+				m.Name, nodeName, err)
+		}
+
+		if len(pods.Items) == 0 {
+			log.V(1).Info("Waiting for managed service pod to be created", "node", nodeName, "manager", m.Name,
+				"selector", selector)
+
+			return false, nil
+		}
+
+		for _, pod := range pods.Items {
+			if !isPodReady(&pod) {
+				log.V(1).Info("Waiting for managed service pod to become Ready", "node", nodeName,
+					"manager", m.Name, "pod", pod.Name, "phase", pod.Status.Phase)
+
+				return false, nil
+			}
+		}
+	}
+
+	return true, nil
+}
+
+func (m Manager) listAppPods(ctx context.Context, c client.Reader, app AppSpec,
+	nodeName string) (*corev1.PodList, map[string]string, error) {
+	selector := make(map[string]string)
+	maps.Copy(selector, m.Spec.ManagerSelector)
+	maps.Copy(selector, app.AppSelector)
+
+	pods := &corev1.PodList{}
+
+	err := c.List(ctx, pods,
+		client.InNamespace(m.Spec.Namespace),
+		client.MatchingLabels(selector),
+		client.MatchingFields{PodNodeNameField: nodeName},
+	)
+
+	return pods, selector, err
+}
+
+func isPodReady(pod *corev1.Pod) bool {
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.PodReady && cond.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,174 @@
+// Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gpuservices
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newServicePod(name, nodeName string, ready bool) *corev1.Pod {
+	status := corev1.ConditionFalse
+	if ready {
+		status = corev1.ConditionTrue
+	}
+
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: validCustomSpec.Namespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/managed-by": "custom-operator",
+				"app":                          "custom-app",
+			},
+		},
+		Spec: corev1.PodSpec{NodeName: nodeName},
+		Status: corev1.PodStatus{
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: status}},
+		},
+	}
+}
+
+func newFakeReader(t *testing.T, pods ...client.Object) client.Reader {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	return fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(pods...).
+		WithIndex(&corev1.Pod{}, PodNodeNameField, func(obj client.Object) []string {
+			return []string{obj.(*corev1.Pod).Spec.NodeName}
+		}).
+		Build()
+}
+
+func TestSetNodeLabels(t *testing.T) {
+	manager := Manager{Name: "custom", Spec: validCustomSpec}
+
+	testCases := []struct {
+		name            string
+		labels          map[string]string
+		enabled         bool
+		expectedChanged bool
+		expectedValue   string
+	}{
+		{
+			name:            "Should disable the app on a node without labels",
+			labels:          nil,
+			enabled:         false,
+			expectedChanged: true,
+			expectedValue:   "false",
+		},
+		{
+			name:            "Should enable a disabled app",
+			labels:          map[string]string{customAppSpec.NodeLabel: "false"},
+			enabled:         true,
+			expectedChanged: true,
+			expectedValue:   "true",
+		},
+		{
+			name:            "Should not change a label already set to the desired value",
+			labels:          map[string]string{customAppSpec.NodeLabel: "true"},
+			enabled:         true,
+			expectedChanged: false,
+			expectedValue:   "true",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1", Labels: tc.labels}}
+
+			changed := manager.SetNodeLabels(node, tc.enabled)
+			if changed != tc.expectedChanged {
+				t.Errorf("Expected changed %v, got %v", tc.expectedChanged, changed)
+			}
+
+			if value := node.Labels[customAppSpec.NodeLabel]; value != tc.expectedValue {
+				t.Errorf("Expected label value %q, got %q", tc.expectedValue, value)
+			}
+		})
+	}
+}
+
+func TestPodsTerminatedAndReady(t *testing.T) {
+	manager := Manager{Name: "custom", Spec: validCustomSpec}
+
+	testCases := []struct {
+		name               string
+		pods               []client.Object
+		expectedTerminated bool
+		expectedReady      bool
+	}{
+		{
+			name:               "Should report terminated but not ready without pods",
+			pods:               nil,
+			expectedTerminated: true,
+			expectedReady:      false,
+		},
+		{
+			name:               "Should ignore pods on other nodes",
+			pods:               []client.Object{newServicePod("pod-1", "node-2", true)},
+			expectedTerminated: true,
+			expectedReady:      false,
+		},
+		{
+			name:               "Should report not ready while a pod is not Ready",
+			pods:               []client.Object{newServicePod("pod-1", "node-1", false)},
+			expectedTerminated: false,
+			expectedReady:      false,
+		},
+		{
+			name:               "Should report ready once all pods are Ready",
+			pods:               []client.Object{newServicePod("pod-1", "node-1", true)},
+			expectedTerminated: false,
+			expectedReady:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			reader := newFakeReader(t, tc.pods...)
+
+			terminated, err := manager.PodsTerminated(context.Background(), reader, "node-1")
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if terminated != tc.expectedTerminated {
+				t.Errorf("Expected terminated %v, got %v", tc.expectedTerminated, terminated)
+			}
+
+			ready, err := manager.PodsReady(context.Background(), reader, "node-1")
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if ready != tc.expectedReady {
+				t.Errorf("Expected ready %v, got %v", tc.expectedReady, ready)
+			}
+		})
+	}
+}
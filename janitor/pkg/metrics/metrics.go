@@ -24,10 +24,12 @@ import (
 
 // Action types for metrics labeling
 const (
-	ActionTypeReboot    = "reboot"
-	ActionTypeTerminate = "terminate"
-	ActionTypeLock      = "lock"
-	ActionTypeUnlock    = "unlock"
+	ActionTypeReboot             = "reboot"
+	ActionTypeTerminate          = "terminate"
+	ActionTypeRestartGPUServices = "restart_gpu_services"
+	ActionTypeReimage            = "reimage"
+	ActionTypeLock               = "lock"
+	ActionTypeUnlock             = "unlock"
 )
 
 // Status values for action metrics
@@ -0,0 +1,218 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replacement
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// NodePoolLabel is set by Karpenter on the NodeClaims and nodes of a NodePool
+	NodePoolLabel = "karpenter.sh/nodepool"
+
+	// ReplacesLabel is set on the NodeClaims created by janitor to the name of the node they replace
+	ReplacesLabel = "janitor.dgxc.nvidia.com/replaces"
+
+	// DoNotDisruptAnnotation prevents Karpenter from consolidating, expiring or replacing the node of a NodeClaim
+	DoNotDisruptAnnotation = "karpenter.sh/do-not-disrupt"
+)
+
+// NodeClaimGVK is the Karpenter NodeClaim kind
+var NodeClaimGVK = schema.GroupVersionKind{Group: "karpenter.sh", Version: "v1", Kind: "NodeClaim"}
+
+// KarpenterRequester requests replacements by creating a Karpenter NodeClaim with the spec of the NodeClaim of the
+// node. Karpenter launches an instance for the NodeClaim like for the NodeClaims of its NodePools.
+type KarpenterRequester struct {
+	client client.Client
+}
+
+var _ Requester = (*KarpenterRequester)(nil)
+
+// NewKarpenterRequester creates a Requester using Karpenter NodeClaims.
+func NewKarpenterRequester(c client.Client) *KarpenterRequester {
+	return &KarpenterRequester{client: c}
+}
+
+// Request creates a NodeClaim replacing the NodeClaim of the node and returns its name. A replacement NodeClaim
+// created earlier for the node is returned instead, so that a request whose name could not be recorded does not
+// launch a second instance when it is retried.
+func (k *KarpenterRequester) Request(ctx context.Context, node *corev1.Node) (string, error) {
+	if node.Labels[NodePoolLabel] == "" {
+		return "", fmt.Errorf("%w: node %s is not managed by Karpenter", ErrNotSupported, node.Name)
+	}
+
+	existing, err := k.replacementOf(ctx, node.Name)
+	if err != nil {
+		return "", err
+	}
+
+	if existing != "" {
+		return existing, nil
+	}
+
+	nodeClaim, err := k.nodeClaimOf(ctx, node)
+	if err != nil {
+		return "", err
+	}
+
+	spec, found, err := unstructured.NestedMap(nodeClaim.Object, "spec")
+	if err != nil || !found {
+		return "", fmt.Errorf("NodeClaim %s of node %s has no spec", nodeClaim.GetName(), node.Name)
+	}
+
+	replacement := &unstructured.Unstructured{}
+	replacement.SetGroupVersionKind(NodeClaimGVK)
+
+	nodePool := node.Labels[NodePoolLabel]
+	replacement.SetGenerateName(nodePool + "-")
+	replacement.SetLabels(map[string]string{
+		NodePoolLabel: nodePool,
+		ReplacesLabel: node.Name,
+	})
+
+	// The NodePool hash annotations are copied so that Karpenter does not consider the replacement drifted. Karpenter
+	// must not disrupt the replacement while the node is terminated, since it is not accounted for by the NodePool
+	// and would not be replaced. Release removes the annotation once the termination completed.
+	annotations := maps.Clone(nodeClaim.GetAnnotations())
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+
+	annotations[DoNotDisruptAnnotation] = "true"
+	replacement.SetAnnotations(annotations)
+	replacement.SetOwnerReferences(nodeClaim.GetOwnerReferences())
+
+	if err := unstructured.SetNestedMap(replacement.Object, spec, "spec"); err != nil {
+		return "", fmt.Errorf("failed to set spec of the replacement NodeClaim: %w", err)
+	}
+
+	if err := k.client.Create(ctx, replacement); err != nil {
+		return "", fmt.Errorf("failed to create replacement NodeClaim for node %s: %w", node.Name, err)
+	}
+
+	return replacement.GetName(), nil
+}
+
+// ReplacementNode returns the node of the NodeClaim once Karpenter has registered it.
+func (k *KarpenterRequester) ReplacementNode(ctx context.Context, nodeName, requestID string) (string, error) {
+	nodeClaim := &unstructured.Unstructured{}
+	nodeClaim.SetGroupVersionKind(NodeClaimGVK)
+
+	if err := k.client.Get(ctx, client.ObjectKey{Name: requestID}, nodeClaim); err != nil {
+		if apierrors.IsNotFound(err) {
+			// Karpenter deletes NodeClaims which fail to launch, for example when no capacity is available
+			return "", fmt.Errorf("replacement NodeClaim %s no longer exists", requestID)
+		}
+
+		return "", fmt.Errorf("failed to get replacement NodeClaim %s: %w", requestID, err)
+	}
+
+	replacementNodeName, _, _ := unstructured.NestedString(nodeClaim.Object, "status", "nodeName")
+
+	return replacementNodeName, nil
+}
+
+// Cancel deletes the replacement NodeClaim, which terminates its instance.
+func (k *KarpenterRequester) Cancel(ctx context.Context, nodeName, requestID string) error {
+	nodeClaim := &unstructured.Unstructured{}
+	nodeClaim.SetGroupVersionKind(NodeClaimGVK)
+	nodeClaim.SetName(requestID)
+
+	if err := k.client.Delete(ctx, nodeClaim); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete replacement NodeClaim %s: %w", requestID, err)
+	}
+
+	return nil
+}
+
+// Release removes the do-not-disrupt annotation from the replacement NodeClaim, so that Karpenter consolidates,
+// expires and replaces it like the other NodeClaims of its NodePool.
+func (k *KarpenterRequester) Release(ctx context.Context, nodeName, requestID string) error {
+	nodeClaim := &unstructured.Unstructured{}
+	nodeClaim.SetGroupVersionKind(NodeClaimGVK)
+
+	if err := k.client.Get(ctx, client.ObjectKey{Name: requestID}, nodeClaim); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+
+		return fmt.Errorf("failed to get replacement NodeClaim %s: %w", requestID, err)
+	}
+
+	if _, found := nodeClaim.GetAnnotations()[DoNotDisruptAnnotation]; !found || nodeClaim.GetDeletionTimestamp() != nil {
+		return nil
+	}
+
+	original := nodeClaim.DeepCopy()
+	annotations := nodeClaim.GetAnnotations()
+	delete(annotations, DoNotDisruptAnnotation)
+	nodeClaim.SetAnnotations(annotations)
+
+	if err := k.client.Patch(ctx, nodeClaim, client.MergeFrom(original)); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to release replacement NodeClaim %s: %w", requestID, err)
+	}
+
+	return nil
+}
+
+// replacementOf returns the name of the replacement NodeClaim created for the node, or an empty string if there is
+// none. NodeClaims being deleted, for example by Cancel, are not replacements anymore.
+func (k *KarpenterRequester) replacementOf(ctx context.Context, nodeName string) (string, error) {
+	nodeClaims := &unstructured.UnstructuredList{}
+	nodeClaims.SetGroupVersionKind(NodeClaimGVK.GroupVersion().WithKind(NodeClaimGVK.Kind + "List"))
+
+	if err := k.client.List(ctx, nodeClaims, client.MatchingLabels{ReplacesLabel: nodeName}); err != nil {
+		return "", fmt.Errorf("failed to list replacement NodeClaims of node %s: %w", nodeName, err)
+	}
+
+	for _, nodeClaim := range nodeClaims.Items {
+		if nodeClaim.GetDeletionTimestamp() == nil {
+			return nodeClaim.GetName(), nil
+		}
+	}
+
+	return "", nil
+}
+
+// nodeClaimOf returns the NodeClaim of the node, matched by node name or provider ID.
+func (k *KarpenterRequester) nodeClaimOf(ctx context.Context, node *corev1.Node) (*unstructured.Unstructured, error) {
+	nodeClaims := &unstructured.UnstructuredList{}
+	nodeClaims.SetGroupVersionKind(NodeClaimGVK.GroupVersion().WithKind(NodeClaimGVK.Kind + "List"))
+
+	if err := k.client.List(ctx, nodeClaims); err != nil {
+		return nil, fmt.Errorf("failed to list NodeClaims: %w", err)
+	}
+
+	for i := range nodeClaims.Items {
+		nodeClaim := &nodeClaims.Items[i]
+
+		claimNodeName, _, _ := unstructured.NestedString(nodeClaim.Object, "status", "nodeName")
+		providerID, _, _ := unstructured.NestedString(nodeClaim.Object, "status", "providerID")
+
+		if claimNodeName == node.Name || (providerID != "" && providerID == node.Spec.ProviderID) {
+			return nodeClaim, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: no NodeClaim found for node %s", ErrNotSupported, node.Name)
+}
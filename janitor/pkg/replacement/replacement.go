@@ -0,0 +1,113 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package replacement requests replacement capacity for nodes which are terminated only once their replacement is
+// Ready.
+package replacement
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+
+	cspv1alpha1 "github.com/nvidia/nvsentinel/api/gen/go/csp/v1alpha1"
+)
+
+// ErrNotSupported is returned when replacements cannot be requested for a node, for example because the CSP
+// provider does not implement replacements or Karpenter does not manage the node.
+var ErrNotSupported = errors.New("replacement not supported")
+
+// Requester requests a replacement for a node and reports the replacement node once it has registered.
+type Requester interface {
+	// Request asks for a replacement of the node and returns a reference to the request
+	Request(ctx context.Context, node *corev1.Node) (string, error)
+
+	// ReplacementNode returns the name of the node replacing the node of the request, or an empty string while the
+	// replacement node has not registered
+	ReplacementNode(ctx context.Context, nodeName, requestID string) (string, error)
+
+	// Cancel withdraws the request when the node is not terminated after all
+	Cancel(ctx context.Context, nodeName, requestID string) error
+
+	// Release hands the replacement over to its node group once the termination of the node completed
+	Release(ctx context.Context, nodeName, requestID string) error
+}
+
+// ProviderRequester requests replacements from the CSP provider, which scales up the node group of the node.
+type ProviderRequester struct {
+	cspClient cspv1alpha1.CSPProviderServiceClient
+}
+
+var _ Requester = (*ProviderRequester)(nil)
+
+// NewProviderRequester creates a Requester using the CSP provider.
+func NewProviderRequester(cspClient cspv1alpha1.CSPProviderServiceClient) *ProviderRequester {
+	return &ProviderRequester{cspClient: cspClient}
+}
+
+// Request asks the CSP provider to add a node to the node group of the node.
+func (p *ProviderRequester) Request(ctx context.Context, node *corev1.Node) (string, error) {
+	resp, err := p.cspClient.RequestReplacementNode(ctx, &cspv1alpha1.RequestReplacementNodeRequest{
+		NodeName: node.Name,
+	})
+	if err != nil {
+		return "", providerError(err)
+	}
+
+	return resp.GetRequestId(), nil
+}
+
+// ReplacementNode asks the CSP provider for the node added by the request.
+func (p *ProviderRequester) ReplacementNode(ctx context.Context, nodeName, requestID string) (string, error) {
+	resp, err := p.cspClient.GetReplacementNode(ctx, &cspv1alpha1.GetReplacementNodeRequest{
+		NodeName:  nodeName,
+		RequestId: requestID,
+	})
+	if err != nil {
+		return "", providerError(err)
+	}
+
+	return resp.GetReplacementNodeName(), nil
+}
+
+// Cancel asks the CSP provider to withdraw the request. Providers which cannot withdraw replacement requests leave the
+// additional node to the cluster autoscaler or an operator.
+func (p *ProviderRequester) Cancel(ctx context.Context, nodeName, requestID string) error {
+	_, err := p.cspClient.CancelRequest(ctx, &cspv1alpha1.CancelRequestRequest{
+		NodeName:  nodeName,
+		RequestId: requestID,
+	})
+	if err != nil && status.Code(err) != codes.Unimplemented {
+		return fmt.Errorf("csp-provider: %w", err)
+	}
+
+	return nil
+}
+
+// Release does nothing, since the node added by the CSP provider belongs to the node group of the node.
+func (p *ProviderRequester) Release(_ context.Context, _, _ string) error {
+	return nil
+}
+
+func providerError(err error) error {
+	if status.Code(err) == codes.Unimplemented {
+		return fmt.Errorf("%w by the CSP provider: %w", ErrNotSupported, err)
+	}
+
+	return fmt.Errorf("csp-provider: %w", err)
+}
@@ -0,0 +1,287 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replacement
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	cspv1alpha1 "github.com/nvidia/nvsentinel/api/gen/go/csp/v1alpha1"
+)
+
+func newNodeClaim(name, nodeName string) *unstructured.Unstructured {
+	nodeClaim := &unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{
+			"nodeClassRef": map[string]any{"group": "karpenter.k8s.aws", "kind": "EC2NodeClass", "name": "gpu"},
+			"requirements": []any{map[string]any{
+				"key": "node.kubernetes.io/instance-type", "operator": "In", "values": []any{"p5.48xlarge"},
+			}},
+		},
+		"status": map[string]any{"nodeName": nodeName},
+	}}
+	nodeClaim.SetGroupVersionKind(NodeClaimGVK)
+	nodeClaim.SetName(name)
+	nodeClaim.SetLabels(map[string]string{NodePoolLabel: "gpu"})
+	nodeClaim.SetAnnotations(map[string]string{"karpenter.sh/nodepool-hash": "12345"})
+
+	return nodeClaim
+}
+
+func newFakeClient(t *testing.T, objects ...client.Object) client.Client {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+}
+
+func TestKarpenterRequester(t *testing.T) {
+	ctx := context.Background()
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+		Name:   "node-1",
+		Labels: map[string]string{NodePoolLabel: "gpu"},
+	}}
+	c := newFakeClient(t, newNodeClaim("gpu-abcde", "node-1"), newNodeClaim("gpu-fghij", "node-2"))
+	requester := NewKarpenterRequester(c)
+
+	requestID, err := requester.Request(ctx, node)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	replacement := &unstructured.Unstructured{}
+	replacement.SetGroupVersionKind(NodeClaimGVK)
+
+	if err := c.Get(ctx, client.ObjectKey{Name: requestID}, replacement); err != nil {
+		t.Fatalf("Expected the replacement NodeClaim %q to exist: %v", requestID, err)
+	}
+
+	if replacement.GetLabels()[ReplacesLabel] != "node-1" || replacement.GetLabels()[NodePoolLabel] != "gpu" {
+		t.Errorf("Unexpected labels of the replacement NodeClaim: %v", replacement.GetLabels())
+	}
+
+	if replacement.GetAnnotations()["karpenter.sh/nodepool-hash"] != "12345" {
+		t.Errorf("Expected the NodePool hash to be copied, got %v", replacement.GetAnnotations())
+	}
+
+	if replacement.GetAnnotations()[DoNotDisruptAnnotation] != "true" {
+		t.Errorf("Expected the replacement NodeClaim not to be disrupted, got %v", replacement.GetAnnotations())
+	}
+
+	nodeClass, _, _ := unstructured.NestedString(replacement.Object, "spec", "nodeClassRef", "name")
+	if nodeClass != "gpu" {
+		t.Errorf("Expected the spec to be copied, got %v", replacement.Object["spec"])
+	}
+
+	retriedRequestID, err := requester.Request(ctx, node)
+	if err != nil || retriedRequestID != requestID {
+		t.Fatalf("Expected a retried request to return %q, got %q, %v", requestID, retriedRequestID, err)
+	}
+
+	nodeName, err := requester.ReplacementNode(ctx, "node-1", requestID)
+	if err != nil || nodeName != "" {
+		t.Fatalf("Expected no replacement node before registration, got %q, %v", nodeName, err)
+	}
+
+	if err := unstructured.SetNestedField(replacement.Object, "node-3", "status", "nodeName"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if err := c.Update(ctx, replacement); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	nodeName, err = requester.ReplacementNode(ctx, "node-1", requestID)
+	if err != nil || nodeName != "node-3" {
+		t.Fatalf("Expected replacement node node-3, got %q, %v", nodeName, err)
+	}
+
+	if err := requester.Cancel(ctx, "node-1", requestID); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if _, err := requester.ReplacementNode(ctx, "node-1", requestID); err == nil {
+		t.Error("Expected an error for a deleted replacement NodeClaim")
+	}
+
+	if err := requester.Cancel(ctx, "node-1", requestID); err != nil {
+		t.Errorf("Expected cancelling twice to succeed, got %v", err)
+	}
+}
+
+func TestKarpenterRequester_Release(t *testing.T) {
+	ctx := context.Background()
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+		Name:   "node-1",
+		Labels: map[string]string{NodePoolLabel: "gpu"},
+	}}
+	c := newFakeClient(t, newNodeClaim("gpu-abcde", "node-1"))
+	requester := NewKarpenterRequester(c)
+
+	requestID, err := requester.Request(ctx, node)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if err := requester.Release(ctx, "node-1", requestID); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	replacement := &unstructured.Unstructured{}
+	replacement.SetGroupVersionKind(NodeClaimGVK)
+
+	if err := c.Get(ctx, client.ObjectKey{Name: requestID}, replacement); err != nil {
+		t.Fatalf("Expected the released NodeClaim %q to exist: %v", requestID, err)
+	}
+
+	if _, found := replacement.GetAnnotations()[DoNotDisruptAnnotation]; found {
+		t.Errorf("Expected the released NodeClaim to be disruptable, got %v", replacement.GetAnnotations())
+	}
+
+	if replacement.GetAnnotations()["karpenter.sh/nodepool-hash"] != "12345" {
+		t.Errorf("Expected the NodePool hash to be kept, got %v", replacement.GetAnnotations())
+	}
+
+	if err := requester.Release(ctx, "node-1", requestID); err != nil {
+		t.Errorf("Expected releasing twice to succeed, got %v", err)
+	}
+
+	if err := requester.Cancel(ctx, "node-1", requestID); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if err := requester.Release(ctx, "node-1", requestID); err != nil {
+		t.Errorf("Expected releasing a deleted NodeClaim to succeed, got %v", err)
+	}
+}
+
+func TestKarpenterRequester_NotSupported(t *testing.T) {
+	testCases := []struct {
+		name string
+		node *corev1.Node
+	}{
+		{
+			name: "Should not replace nodes without NodePool",
+			node: &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}},
+		},
+		{
+			name: "Should not replace nodes without NodeClaim",
+			node: &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+				Name:   "node-3",
+				Labels: map[string]string{NodePoolLabel: "gpu"},
+			}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			requester := NewKarpenterRequester(newFakeClient(t, newNodeClaim("gpu-abcde", "node-1")))
+
+			_, err := requester.Request(context.Background(), tc.node)
+			if !errors.Is(err, ErrNotSupported) {
+				t.Errorf("Expected ErrNotSupported, got %v", err)
+			}
+		})
+	}
+}
+
+type fakeCSPClient struct {
+	cspv1alpha1.CSPProviderServiceClient
+	err error
+}
+
+func (f *fakeCSPClient) RequestReplacementNode(ctx context.Context, in *cspv1alpha1.RequestReplacementNodeRequest,
+	opts ...grpc.CallOption) (*cspv1alpha1.RequestReplacementNodeResponse, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	return &cspv1alpha1.RequestReplacementNodeResponse{RequestId: "request-" + in.GetNodeName()}, nil
+}
+
+func (f *fakeCSPClient) GetReplacementNode(ctx context.Context, in *cspv1alpha1.GetReplacementNodeRequest,
+	opts ...grpc.CallOption) (*cspv1alpha1.GetReplacementNodeResponse, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	return &cspv1alpha1.GetReplacementNodeResponse{ReplacementNodeName: "replacement-of-" + in.GetNodeName()}, nil
+}
+
+func (f *fakeCSPClient) CancelRequest(ctx context.Context, in *cspv1alpha1.CancelRequestRequest,
+	opts ...grpc.CallOption) (*cspv1alpha1.CancelRequestResponse, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	return &cspv1alpha1.CancelRequestResponse{}, nil
+}
+
+func TestProviderRequester(t *testing.T) {
+	ctx := context.Background()
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}}
+
+	requester := NewProviderRequester(&fakeCSPClient{})
+
+	requestID, err := requester.Request(ctx, node)
+	if err != nil || requestID != "request-node-1" {
+		t.Fatalf("Expected request ID request-node-1, got %q, %v", requestID, err)
+	}
+
+	nodeName, err := requester.ReplacementNode(ctx, "node-1", requestID)
+	if err != nil || nodeName != "replacement-of-node-1" {
+		t.Fatalf("Expected replacement node replacement-of-node-1, got %q, %v", nodeName, err)
+	}
+
+	if err := requester.Cancel(ctx, "node-1", requestID); err != nil {
+		t.Errorf("Expected the request to be cancelled, got %v", err)
+	}
+
+	if err := requester.Release(ctx, "node-1", requestID); err != nil {
+		t.Errorf("Expected the request to be released, got %v", err)
+	}
+
+	requester = NewProviderRequester(&fakeCSPClient{err: status.Error(codes.Unimplemented, "not implemented")})
+	if _, err := requester.Request(ctx, node); !errors.Is(err, ErrNotSupported) {
+		t.Errorf("Expected ErrNotSupported for unimplemented replacements, got %v", err)
+	}
+
+	if err := requester.Cancel(ctx, "node-1", requestID); err != nil {
+		t.Errorf("Expected no error when the provider cannot cancel requests, got %v", err)
+	}
+
+	requester = NewProviderRequester(&fakeCSPClient{err: status.Error(codes.Unavailable, "connection refused")})
+	if _, err := requester.Request(ctx, node); err == nil || errors.Is(err, ErrNotSupported) {
+		t.Errorf("Expected a retryable error for an unavailable provider, got %v", err)
+	}
+
+	if err := requester.Cancel(ctx, "node-1", requestID); err == nil {
+		t.Error("Expected an error when the provider is unavailable")
+	}
+}
@@ -17,8 +17,11 @@ package v1alpha1
 import (
 	"context"
 	"fmt"
+	"time"
 
+	admissionv1 "k8s.io/api/admission/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -38,6 +41,9 @@ const (
 	controllerTypeRebootNode    = "RebootNode"
 	controllerTypeTerminateNode = "TerminateNode"
 	controllerTypeGPUReset      = "GPUReset"
+
+	controllerTypeGPUServicesRestart = "GPUServicesRestart"
+	controllerTypeReimageNode        = "ReimageNode"
 )
 
 // SetupJanitorWebhookWithManager registers the webhook for CRs managed by Janitor.
@@ -75,6 +81,28 @@ func SetupJanitorWebhookWithManager(mgr ctrl.Manager, cfg *config.Config) error
 		return err
 	}
 
+	// Register webhook for GPUServicesRestart
+	if err := ctrl.NewWebhookManagedBy(mgr, &janitordgxcnvidiacomv1alpha1.GPUServicesRestart{}).
+		WithValidator(&gpuServicesRestartValidator{validator}).
+		Complete(); err != nil {
+		return err
+	}
+
+	// Register webhook for ReimageNode
+	if err := ctrl.NewWebhookManagedBy(mgr, &janitordgxcnvidiacomv1alpha1.ReimageNode{}).
+		WithValidator(&reimageNodeValidator{validator}).
+		Complete(); err != nil {
+		return err
+	}
+
+	// Register webhooks for MaintenanceApproval. The mutating webhook records the identity of the approver.
+	if err := ctrl.NewWebhookManagedBy(mgr, &janitordgxcnvidiacomv1alpha1.MaintenanceApproval{}).
+		WithDefaulter(&maintenanceApprovalDefaulter{validator}).
+		WithValidator(&maintenanceApprovalValidator{validator}).
+		Complete(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -87,6 +115,18 @@ func SetupJanitorWebhookWithManager(mgr ctrl.Manager, cfg *config.Config) error
 // nolint:lll
 // +kubebuilder:webhook:path=/validate-janitor-dgxc-nvidia-com-v1alpha1-gpureset,mutating=false,failurePolicy=fail,sideEffects=None,groups=janitor.dgxc.nvidia.com,resources=gpuresets,verbs=create;update;delete,versions=v1alpha1,name=vgpureset-v1alpha1.kb.io,admissionReviewVersions=v1
 
+// nolint:lll
+// +kubebuilder:webhook:path=/validate-janitor-dgxc-nvidia-com-v1alpha1-gpuservicesrestart,mutating=false,failurePolicy=fail,sideEffects=None,groups=janitor.dgxc.nvidia.com,resources=gpuservicesrestarts,verbs=create;update;delete,versions=v1alpha1,name=vgpuservicesrestart-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// nolint:lll
+// +kubebuilder:webhook:path=/validate-janitor-dgxc-nvidia-com-v1alpha1-reimagenode,mutating=false,failurePolicy=fail,sideEffects=None,groups=janitor.dgxc.nvidia.com,resources=reimagenodes,verbs=create;update;delete,versions=v1alpha1,name=vreimagenode-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// nolint:lll
+// +kubebuilder:webhook:path=/mutate-janitor-dgxc-nvidia-com-v1alpha1-maintenanceapproval,mutating=true,failurePolicy=fail,sideEffects=None,groups=janitor.dgxc.nvidia.com,resources=maintenanceapprovals,verbs=create,versions=v1alpha1,name=mmaintenanceapproval-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// nolint:lll
+// +kubebuilder:webhook:path=/validate-janitor-dgxc-nvidia-com-v1alpha1-maintenanceapproval,mutating=false,failurePolicy=fail,sideEffects=None,groups=janitor.dgxc.nvidia.com,resources=maintenanceapprovals,verbs=create;update,versions=v1alpha1,name=vmaintenanceapproval-v1alpha1.kb.io,admissionReviewVersions=v1
+
 // JanitorCustomValidator struct is responsible for validating all Janitor resources
 // when they are created, updated, or deleted.
 //
@@ -228,6 +268,56 @@ func (v *JanitorCustomValidator) validateNoActiveResetForSameGPU(ctx context.Con
 	return nil
 }
 
+// validateNoActiveGPUServicesRestart checks if there's already an active GPU services restart for the node
+func (v *JanitorCustomValidator) validateNoActiveGPUServicesRestart(ctx context.Context, nodeName string) error {
+	if v.Client == nil {
+		return fmt.Errorf("kubernetes client not available for GPU services restart validation")
+	}
+
+	var restartList janitordgxcnvidiacomv1alpha1.GPUServicesRestartList
+	if err := v.Client.List(ctx, &restartList); err != nil {
+		return fmt.Errorf("failed to list GPUServicesRestart resources: %w", err)
+	}
+
+	for _, restart := range restartList.Items {
+		if restart.Spec.NodeName != nodeName {
+			continue
+		}
+
+		if restart.Status.CompletionTime == nil {
+			return fmt.Errorf("node '%s' already has an active GPU services restart in progress (GPUServicesRestart: %s)",
+				nodeName, restart.Name)
+		}
+	}
+
+	return nil
+}
+
+// validateNoActiveReimage checks if there's already an active reimage for the node
+func (v *JanitorCustomValidator) validateNoActiveReimage(ctx context.Context, nodeName string) error {
+	if v.Client == nil {
+		return fmt.Errorf("kubernetes client not available for reimage validation")
+	}
+
+	var reimageList janitordgxcnvidiacomv1alpha1.ReimageNodeList
+	if err := v.Client.List(ctx, &reimageList); err != nil {
+		return fmt.Errorf("failed to list ReimageNode resources: %w", err)
+	}
+
+	for _, reimage := range reimageList.Items {
+		if reimage.Spec.NodeName != nodeName {
+			continue
+		}
+
+		if reimage.Status.CompletionTime == nil {
+			return fmt.Errorf("node '%s' already has an active reimage in progress (ReimageNode: %s)",
+				nodeName, reimage.Name)
+		}
+	}
+
+	return nil
+}
+
 func (v *JanitorCustomValidator) validateNodeAndGPUs(oldNodeName, newNodeName string,
 	oldUUIDs, newUUIDs []string) error {
 	if oldNodeName != newNodeName {
@@ -472,3 +562,190 @@ func (v *gpuResetValidator) ValidateDelete(_ context.Context,
 
 	return nil, nil
 }
+
+// --- GPUServicesRestart typed validator ---
+
+type gpuServicesRestartValidator struct{ *JanitorCustomValidator }
+
+func (v *gpuServicesRestartValidator) ValidateCreate(ctx context.Context,
+	obj *janitordgxcnvidiacomv1alpha1.GPUServicesRestart) (admission.Warnings, error) {
+	objName := obj.GetName()
+	nodeName := obj.Spec.NodeName
+
+	if v.Config == nil || !v.Config.GPUServicesRestart.Enabled {
+		janitorWebhookLog.Info("GPUServicesRestart controller is disabled, rejecting creation", "name", objName)
+		return nil, fmt.Errorf("GPUServicesRestart controller is disabled in configuration")
+	}
+
+	if obj.Spec.ReloadDriver && v.Config.GPUServicesRestart.ResolvedJobTemplate == nil {
+		return nil, fmt.Errorf("reloadDriver requires the driver reload job to be configured in " +
+			"gpuServicesRestartController.driverReloadJob")
+	}
+
+	if err := v.validateNoActiveGPUServicesRestart(ctx, nodeName); err != nil {
+		janitorWebhookLog.Info("Active GPU services restart validation failed",
+			"type", controllerTypeGPUServicesRestart, "name", objName, "nodeName", nodeName, "error", err.Error())
+
+		return nil, err
+	}
+
+	return v.validateNodeForCreate(ctx, controllerTypeGPUServicesRestart, objName, nodeName)
+}
+
+func (v *gpuServicesRestartValidator) ValidateUpdate(ctx context.Context,
+	oldObj, newObj *janitordgxcnvidiacomv1alpha1.GPUServicesRestart) (admission.Warnings, error) {
+	objName := newObj.GetName()
+	nodeName := newObj.Spec.NodeName
+
+	if v.Config == nil || !v.Config.GPUServicesRestart.Enabled {
+		janitorWebhookLog.Info("GPUServicesRestart controller is disabled, rejecting update", "name", objName)
+		return nil, fmt.Errorf("GPUServicesRestart controller is disabled in configuration")
+	}
+
+	if oldObj.Spec.NodeName != nodeName {
+		return nil, fmt.Errorf("nodeName cannot be changed after creation")
+	}
+
+	if oldObj.Spec.ReloadDriver != newObj.Spec.ReloadDriver {
+		return nil, fmt.Errorf("reloadDriver cannot be changed after creation")
+	}
+
+	// Like for GPUReset, the finalizer must be removable after the node was deleted.
+	return v.validateNodeForUpdate(ctx, controllerTypeGPUServicesRestart, objName, nodeName, true)
+}
+
+func (v *gpuServicesRestartValidator) ValidateDelete(_ context.Context,
+	obj *janitordgxcnvidiacomv1alpha1.GPUServicesRestart) (admission.Warnings, error) {
+	objName := obj.GetName()
+
+	if v.Config == nil || !v.Config.GPUServicesRestart.Enabled {
+		janitorWebhookLog.Info("GPUServicesRestart controller is disabled, rejecting deletion", "name", objName)
+		return nil, fmt.Errorf("GPUServicesRestart controller is disabled in configuration")
+	}
+
+	janitorWebhookLog.Info("Validation for Janitor CR upon deletion", "type", controllerTypeGPUServicesRestart,
+		"name", objName)
+
+	return nil, nil
+}
+
+// --- ReimageNode typed validator ---
+
+type reimageNodeValidator struct{ *JanitorCustomValidator }
+
+func (v *reimageNodeValidator) ValidateCreate(ctx context.Context,
+	obj *janitordgxcnvidiacomv1alpha1.ReimageNode) (admission.Warnings, error) {
+	objName := obj.GetName()
+	nodeName := obj.Spec.NodeName
+
+	if v.Config == nil || !v.Config.ReimageNode.Enabled {
+		janitorWebhookLog.Info("ReimageNode controller is disabled, rejecting creation", "name", objName)
+		return nil, fmt.Errorf("ReimageNode controller is disabled in configuration")
+	}
+
+	if err := v.validateNoActiveReimage(ctx, nodeName); err != nil {
+		janitorWebhookLog.Info("Active reimage validation failed",
+			"type", controllerTypeReimageNode, "name", objName, "nodeName", nodeName, "error", err.Error())
+
+		return nil, err
+	}
+
+	return v.validateNodeForCreate(ctx, controllerTypeReimageNode, objName, nodeName)
+}
+
+func (v *reimageNodeValidator) ValidateUpdate(ctx context.Context,
+	oldObj, newObj *janitordgxcnvidiacomv1alpha1.ReimageNode) (admission.Warnings, error) {
+	objName := newObj.GetName()
+	nodeName := newObj.Spec.NodeName
+
+	if v.Config == nil || !v.Config.ReimageNode.Enabled {
+		janitorWebhookLog.Info("ReimageNode controller is disabled, rejecting update", "name", objName)
+		return nil, fmt.Errorf("ReimageNode controller is disabled in configuration")
+	}
+
+	if oldObj.Spec.NodeName != nodeName {
+		return nil, fmt.Errorf("nodeName cannot be changed after creation")
+	}
+
+	return v.validateNodeForUpdate(ctx, controllerTypeReimageNode, objName, nodeName, false)
+}
+
+func (v *reimageNodeValidator) ValidateDelete(_ context.Context,
+	obj *janitordgxcnvidiacomv1alpha1.ReimageNode) (admission.Warnings, error) {
+	objName := obj.GetName()
+
+	if v.Config == nil || !v.Config.ReimageNode.Enabled {
+		janitorWebhookLog.Info("ReimageNode controller is disabled, rejecting deletion", "name", objName)
+		return nil, fmt.Errorf("ReimageNode controller is disabled in configuration")
+	}
+
+	janitorWebhookLog.Info("Validation for Janitor CR upon deletion", "type", controllerTypeReimageNode, "name", objName)
+
+	return nil, nil
+}
+
+// --- MaintenanceApproval typed defaulter and validator ---
+
+type maintenanceApprovalDefaulter struct{ *JanitorCustomValidator }
+
+// Default records the user creating the approval, overwriting any provided value, and sets the expiry time from the
+// configured approval TTL if none was provided.
+func (d *maintenanceApprovalDefaulter) Default(ctx context.Context,
+	obj *janitordgxcnvidiacomv1alpha1.MaintenanceApproval) error {
+	req, err := admission.RequestFromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get admission request: %w", err)
+	}
+
+	if req.Operation != admissionv1.Create {
+		return nil
+	}
+
+	obj.Spec.ApprovedBy = req.UserInfo.Username
+
+	if obj.Spec.ExpiresAt == nil && d.Config != nil && d.Config.Global.Approval.TTL > 0 {
+		expiresAt := metav1.NewTime(time.Now().Add(d.Config.Global.Approval.TTL))
+		obj.Spec.ExpiresAt = &expiresAt
+	}
+
+	janitorWebhookLog.Info("Recorded approver of MaintenanceApproval", "name", obj.GetName(),
+		"approvedBy", obj.Spec.ApprovedBy)
+
+	return nil
+}
+
+type maintenanceApprovalValidator struct{ *JanitorCustomValidator }
+
+func (v *maintenanceApprovalValidator) ValidateCreate(_ context.Context,
+	obj *janitordgxcnvidiacomv1alpha1.MaintenanceApproval) (admission.Warnings, error) {
+	if v.Config == nil || !v.Config.Global.Approval.Enabled {
+		janitorWebhookLog.Info("Approval workflow is disabled, rejecting creation", "name", obj.GetName())
+		return nil, fmt.Errorf("approval workflow is disabled in configuration")
+	}
+
+	if obj.Spec.Name == "" && obj.Spec.NodeName == "" {
+		return nil, fmt.Errorf("name or nodeName is required")
+	}
+
+	if obj.Spec.ExpiresAt != nil && !obj.Spec.ExpiresAt.After(time.Now()) {
+		return nil, fmt.Errorf("expiresAt %s is in the past", obj.Spec.ExpiresAt.UTC().Format(time.RFC3339))
+	}
+
+	return nil, nil
+}
+
+// ValidateUpdate rejects changes to the spec, so that the recorded approver always granted the approval as it is.
+// Approvals are revoked by deleting them.
+func (v *maintenanceApprovalValidator) ValidateUpdate(_ context.Context,
+	oldObj, newObj *janitordgxcnvidiacomv1alpha1.MaintenanceApproval) (admission.Warnings, error) {
+	if !equality.Semantic.DeepEqual(oldObj.Spec, newObj.Spec) {
+		return nil, fmt.Errorf("spec of a MaintenanceApproval cannot be changed after creation")
+	}
+
+	return nil, nil
+}
+
+func (v *maintenanceApprovalValidator) ValidateDelete(_ context.Context,
+	_ *janitordgxcnvidiacomv1alpha1.MaintenanceApproval) (admission.Warnings, error) {
+	return nil, nil
+}
@@ -22,12 +22,15 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	janitordgxcnvidiacomv1alpha1 "github.com/nvidia/nvsentinel/janitor/api/v1alpha1"
 	"github.com/nvidia/nvsentinel/janitor/pkg/config"
@@ -958,4 +961,254 @@ var _ = Describe("Janitor Webhook", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 	})
+
+	Context("When validating GPUServicesRestart", func() {
+		var restartVal *gpuServicesRestartValidator
+
+		newRestart := func(name string, reloadDriver bool) *janitordgxcnvidiacomv1alpha1.GPUServicesRestart {
+			return &janitordgxcnvidiacomv1alpha1.GPUServicesRestart{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: name,
+				},
+				Spec: janitordgxcnvidiacomv1alpha1.GPUServicesRestartSpec{
+					NodeName:     "test-node",
+					ReloadDriver: reloadDriver,
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			baseValidator = &JanitorCustomValidator{
+				Config: &config.Config{
+					GPUServicesRestart: config.GPUServicesRestartControllerConfig{
+						Enabled: true,
+					},
+				},
+				Client: fakeClient,
+			}
+			restartVal = &gpuServicesRestartValidator{baseValidator}
+		})
+
+		It("Should admit GPUServicesRestart creation when node exists", func() {
+			_, err := restartVal.ValidateCreate(ctx, newRestart("test-restart", false))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("Should reject GPUServicesRestart creation when controller disabled", func() {
+			baseValidator.Config.GPUServicesRestart.Enabled = false
+			_, err := restartVal.ValidateCreate(ctx, newRestart("test-restart", false))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("GPUServicesRestart controller is disabled in configuration"))
+		})
+
+		It("Should reject a driver reload when the driver reload job is not configured", func() {
+			_, err := restartVal.ValidateCreate(ctx, newRestart("test-restart", true))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("reloadDriver requires the driver reload job to be configured"))
+		})
+
+		It("Should reject GPUServicesRestart creation when an in-progress GPUServicesRestart exists", func() {
+			scheme := runtime.NewScheme()
+			Expect(corev1.AddToScheme(scheme)).To(Succeed())
+			Expect(janitordgxcnvidiacomv1alpha1.AddToScheme(scheme)).To(Succeed())
+			baseValidator.Client = fake.NewClientBuilder().WithScheme(scheme).
+				WithObjects(testNode, newRestart("test-restart-2", false)).Build()
+
+			_, err := restartVal.ValidateCreate(ctx, newRestart("test-restart", false))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("node 'test-node' already has an active GPU services restart in progress (GPUServicesRestart: test-restart-2)"))
+		})
+
+		It("Should reject GPUServicesRestart updates when reloadDriver changes", func() {
+			_, err := restartVal.ValidateUpdate(ctx, newRestart("test-restart", false), newRestart("test-restart", true))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("reloadDriver cannot be changed after creation"))
+		})
+
+		It("Should accept GPUServicesRestart updates when node does not exist", func() {
+			oldObj := newRestart("test-restart", false)
+			oldObj.Spec.NodeName = "deleted-node"
+			newObj := oldObj.DeepCopy()
+			newObj.Finalizers = nil
+
+			_, err := restartVal.ValidateUpdate(ctx, oldObj, newObj)
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+
+	Context("When validating ReimageNode", func() {
+		var reimageVal *reimageNodeValidator
+
+		newReimage := func(name string) *janitordgxcnvidiacomv1alpha1.ReimageNode {
+			return &janitordgxcnvidiacomv1alpha1.ReimageNode{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: name,
+				},
+				Spec: janitordgxcnvidiacomv1alpha1.ReimageNodeSpec{
+					NodeName: "test-node",
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			baseValidator = &JanitorCustomValidator{
+				Config: &config.Config{
+					ReimageNode: config.ReimageNodeControllerConfig{
+						Enabled: true,
+					},
+				},
+				Client: fakeClient,
+			}
+			reimageVal = &reimageNodeValidator{baseValidator}
+		})
+
+		It("Should admit ReimageNode creation when node exists", func() {
+			_, err := reimageVal.ValidateCreate(ctx, newReimage("test-reimage"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("Should reject ReimageNode creation when controller disabled", func() {
+			baseValidator.Config.ReimageNode.Enabled = false
+			_, err := reimageVal.ValidateCreate(ctx, newReimage("test-reimage"))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("ReimageNode controller is disabled in configuration"))
+		})
+
+		It("Should reject ReimageNode creation when an in-progress ReimageNode exists", func() {
+			scheme := runtime.NewScheme()
+			Expect(corev1.AddToScheme(scheme)).To(Succeed())
+			Expect(janitordgxcnvidiacomv1alpha1.AddToScheme(scheme)).To(Succeed())
+			baseValidator.Client = fake.NewClientBuilder().WithScheme(scheme).
+				WithObjects(testNode, newReimage("test-reimage-2")).Build()
+
+			_, err := reimageVal.ValidateCreate(ctx, newReimage("test-reimage"))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("node 'test-node' already has an active reimage in progress (ReimageNode: test-reimage-2)"))
+		})
+
+		It("Should reject ReimageNode updates when nodeName changes", func() {
+			newObj := newReimage("test-reimage")
+			newObj.Spec.NodeName = "other-node"
+
+			_, err := reimageVal.ValidateUpdate(ctx, newReimage("test-reimage"), newObj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("nodeName cannot be changed after creation"))
+		})
+	})
+
+	Context("When validating MaintenanceApproval", func() {
+		var (
+			defaulter   *maintenanceApprovalDefaulter
+			approvalVal *maintenanceApprovalValidator
+		)
+
+		newApproval := func() *janitordgxcnvidiacomv1alpha1.MaintenanceApproval {
+			return &janitordgxcnvidiacomv1alpha1.MaintenanceApproval{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-approval",
+				},
+				Spec: janitordgxcnvidiacomv1alpha1.MaintenanceApprovalSpec{
+					Kind:     janitordgxcnvidiacomv1alpha1.RebootNodeKind,
+					NodeName: "test-node",
+				},
+			}
+		}
+
+		requestContext := func(operation admissionv1.Operation) context.Context {
+			return admission.NewContextWithRequest(ctx, admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: operation,
+					UserInfo:  authenticationv1.UserInfo{Username: "alice"},
+				},
+			})
+		}
+
+		BeforeEach(func() {
+			baseValidator = &JanitorCustomValidator{
+				Config: &config.Config{
+					Global: config.GlobalConfig{
+						Approval: config.ApprovalConfig{Enabled: true, TTL: time.Hour},
+					},
+				},
+				Client: fakeClient,
+			}
+			defaulter = &maintenanceApprovalDefaulter{baseValidator}
+			approvalVal = &maintenanceApprovalValidator{baseValidator}
+		})
+
+		It("Should record the approver and default the expiry time on creation", func() {
+			approval := newApproval()
+			approval.Spec.ApprovedBy = "someone-else"
+
+			Expect(defaulter.Default(requestContext(admissionv1.Create), approval)).To(Succeed())
+			Expect(approval.Spec.ApprovedBy).To(Equal("alice"))
+			Expect(approval.Spec.ExpiresAt).NotTo(BeNil())
+			Expect(approval.Spec.ExpiresAt.Time).To(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+		})
+
+		It("Should keep a provided expiry time", func() {
+			approval := newApproval()
+			expiresAt := metav1.NewTime(time.Now().Add(10 * time.Minute).Truncate(time.Second))
+			approval.Spec.ExpiresAt = &expiresAt
+
+			Expect(defaulter.Default(requestContext(admissionv1.Create), approval)).To(Succeed())
+			Expect(approval.Spec.ExpiresAt.Time).To(Equal(expiresAt.Time))
+		})
+
+		It("Should not change the approver on updates", func() {
+			approval := newApproval()
+			approval.Spec.ApprovedBy = "bob"
+
+			Expect(defaulter.Default(requestContext(admissionv1.Update), approval)).To(Succeed())
+			Expect(approval.Spec.ApprovedBy).To(Equal("bob"))
+		})
+
+		It("Should admit MaintenanceApproval creation when the approval workflow is enabled", func() {
+			_, err := approvalVal.ValidateCreate(ctx, newApproval())
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("Should reject MaintenanceApproval creation when the approval workflow is disabled", func() {
+			baseValidator.Config.Global.Approval.Enabled = false
+			_, err := approvalVal.ValidateCreate(ctx, newApproval())
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("approval workflow is disabled in configuration"))
+		})
+
+		It("Should reject MaintenanceApproval creation without name and nodeName", func() {
+			approval := newApproval()
+			approval.Spec.NodeName = ""
+			_, err := approvalVal.ValidateCreate(ctx, approval)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("name or nodeName is required"))
+		})
+
+		It("Should reject MaintenanceApproval creation with an expiry time in the past", func() {
+			approval := newApproval()
+			expiresAt := metav1.NewTime(time.Now().Add(-time.Minute))
+			approval.Spec.ExpiresAt = &expiresAt
+			_, err := approvalVal.ValidateCreate(ctx, approval)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("is in the past"))
+		})
+
+		It("Should reject MaintenanceApproval spec changes", func() {
+			oldObj := newApproval()
+			newObj := oldObj.DeepCopy()
+			newObj.Spec.ApprovedBy = "mallory"
+
+			_, err := approvalVal.ValidateUpdate(ctx, oldObj, newObj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("cannot be changed after creation"))
+		})
+
+		It("Should accept MaintenanceApproval metadata changes", func() {
+			oldObj := newApproval()
+			newObj := oldObj.DeepCopy()
+			newObj.Labels = map[string]string{"team": "infra"}
+
+			_, err := approvalVal.ValidateUpdate(ctx, oldObj, newObj)
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
 })
@@ -24,7 +24,12 @@ import (
 	"syscall"
 	"time"
 
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
 	"github.com/nvidia/nvsentinel/commons/pkg/logger"
+	"github.com/nvidia/nvsentinel/data-models/pkg/model"
+	"github.com/nvidia/nvsentinel/metadata-collector/pkg/annotator"
 	"github.com/nvidia/nvsentinel/metadata-collector/pkg/collector"
 	"github.com/nvidia/nvsentinel/metadata-collector/pkg/mapper"
 	"github.com/nvidia/nvsentinel/metadata-collector/pkg/nvml"
@@ -136,5 +141,32 @@ func runCollector(ctx context.Context) error {
 
 	slog.Info("Successfully wrote GPU metadata", "output_path", *outputPath)
 
+	// The annotation only helps fault-remediation verify remediations, so failing to publish it is not fatal
+	if err := annotateNode(ctx, metadata); err != nil {
+		slog.Warn("Failed to publish GPU metadata annotation", "node", metadata.NodeName, "error", err)
+	}
+
+	return nil
+}
+
+func annotateNode(ctx context.Context, metadata *model.GPUMetadata) error {
+	clusterConfig, err := rest.InClusterConfig()
+	if err != nil {
+		return fmt.Errorf("failed to create in-cluster config: %w", err)
+	}
+
+	client, err := kubernetes.NewForConfig(clusterConfig)
+	if err != nil {
+		return fmt.Errorf("failed to create in-cluster client: %w", err)
+	}
+
+	if err := annotator.NewAnnotator(client).Annotate(ctx, metadata); err != nil {
+		return err
+	}
+
+	slog.Info("Published GPU metadata annotation",
+		"node", metadata.NodeName,
+		"annotation", model.GPUMetadataAnnotationName)
+
 	return nil
 }
@@ -0,0 +1,70 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package annotator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/nvidia/nvsentinel/data-models/pkg/model"
+)
+
+// Annotator publishes a summary of the collected GPU metadata on the node, which fault-remediation uses to
+// tell that a remediated node came back and how many GPUs it has
+type Annotator struct {
+	client kubernetes.Interface
+}
+
+func NewAnnotator(client kubernetes.Interface) *Annotator {
+	return &Annotator{client: client}
+}
+
+func (a *Annotator) Annotate(ctx context.Context, metadata *model.GPUMetadata) error {
+	collectedAt, err := time.Parse(time.RFC3339, metadata.Timestamp)
+	if err != nil {
+		collectedAt = time.Now().UTC()
+	}
+
+	value, err := json.Marshal(model.GPUMetadataAnnotation{
+		CollectedAt:   collectedAt,
+		GPUCount:      len(metadata.GPUs),
+		DriverVersion: metadata.DriverVersion,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal GPU metadata annotation: %w", err)
+	}
+
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]string{model.GPUMetadataAnnotationName: string(value)},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal GPU metadata annotation patch: %w", err)
+	}
+
+	if _, err := a.client.CoreV1().Nodes().Patch(ctx, metadata.NodeName, types.MergePatchType, patch,
+		metav1.PatchOptions{}); err != nil {
+		return fmt.Errorf("failed to annotate node %s with GPU metadata: %w", metadata.NodeName, err)
+	}
+
+	return nil
+}
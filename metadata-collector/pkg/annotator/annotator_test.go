@@ -0,0 +1,55 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package annotator
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/nvidia/nvsentinel/data-models/pkg/model"
+)
+
+func TestAnnotate(t *testing.T) {
+	ctx := context.Background()
+	client := fake.NewSimpleClientset(&corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-node", Annotations: map[string]string{"other": "value"}},
+	})
+
+	metadata := &model.GPUMetadata{
+		Timestamp:     "2026-01-02T03:04:05Z",
+		NodeName:      "test-node",
+		DriverVersion: "570.00",
+		GPUs:          []model.GPUInfo{{GPUID: 0}, {GPUID: 1}},
+	}
+
+	require.NoError(t, NewAnnotator(client).Annotate(ctx, metadata))
+
+	node, err := client.CoreV1().Nodes().Get(ctx, "test-node", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "value", node.Annotations["other"])
+
+	var annotation model.GPUMetadataAnnotation
+	require.NoError(t, json.Unmarshal([]byte(node.Annotations[model.GPUMetadataAnnotationName]), &annotation))
+	require.Equal(t, 2, annotation.GPUCount)
+	require.Equal(t, "570.00", annotation.DriverVersion)
+	require.True(t, annotation.CollectedAt.Equal(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)))
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NodeDrainPhase is the phase of a drain session
+type NodeDrainPhase string
+
+const (
+	// NodeDrainPhaseDraining means node-drainer is draining the node
+	NodeDrainPhaseDraining NodeDrainPhase = "Draining"
+	// NodeDrainPhaseSucceeded means the node was drained
+	NodeDrainPhaseSucceeded NodeDrainPhase = "Succeeded"
+	// NodeDrainPhaseFailed means the drain failed
+	NodeDrainPhaseFailed NodeDrainPhase = "Failed"
+	// NodeDrainPhaseCancelled means the health event was cancelled or the node unquarantined before the
+	// drain finished
+	NodeDrainPhaseCancelled NodeDrainPhase = "Cancelled"
+)
+
+// NodeDrainSpec identifies the drain session
+type NodeDrainSpec struct {
+	// NodeName is the name of the drained node
+	// +kubebuilder:validation:Required
+	NodeName string `json:"nodeName"`
+
+	// HealthEventID is the ID of the health event that triggered the drain
+	// +kubebuilder:validation:Required
+	HealthEventID string `json:"healthEventID"`
+
+	// CheckName is the health check that reported the fault
+	// +kubebuilder:validation:Optional
+	CheckName string `json:"checkName,omitempty"`
+
+	// PartialDrainEntity is the entity the drain is restricted to for partial drains, e.g. "GPU_UUID:GPU-..."
+	// +kubebuilder:validation:Optional
+	PartialDrainEntity string `json:"partialDrainEntity,omitempty"`
+}
+
+// NamespaceDrain is the drain of the pods of a namespace
+type NamespaceDrain struct {
+	// Name is the namespace
+	Name string `json:"name"`
+
+	// Modes are the modes chosen to drain the pods of the namespace: Immediate, DeleteAfterTimeout or
+	// AllowCompletion, or CustomDrain and DrainPlugin when the drain is handed over. A namespace has
+	// several modes when per-pod drain policies mix them.
+	Modes []string `json:"modes"`
+}
+
+// NodeDrainStatus defines the observed state of NodeDrain
+type NodeDrainStatus struct {
+	// Phase is the phase of the drain session
+	// +kubebuilder:validation:Enum=Draining;Succeeded;Failed;Cancelled
+	Phase NodeDrainPhase `json:"phase,omitempty"`
+
+	// StartTime is the time when node-drainer started draining the node
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime is the time when the drain session reached its final phase
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Namespaces are the namespaces drained so far with their modes
+	Namespaces []NamespaceDrain `json:"namespaces,omitempty"`
+
+	// RemainingPods is the number of pods still to be drained
+	RemainingPods int32 `json:"remainingPods"`
+
+	// RemainingPodNames lists the pods still to be drained as namespace/name, truncated to the first 50
+	RemainingPodNames []string `json:"remainingPodNames,omitempty"`
+
+	// EvictedPods is the number of pods node-drainer requested the eviction of
+	EvictedPods int32 `json:"evictedPods"`
+
+	// ForceDeletedPods is the number of pods node-drainer force deleted after their timeout
+	ForceDeletedPods int32 `json:"forceDeletedPods"`
+
+	// PDBBlockedPods lists the pods, as namespace/name, whose latest eviction was refused because of a
+	// PodDisruptionBudget, truncated to the first 50
+	PDBBlockedPods []string `json:"pdbBlockedPods,omitempty"`
+
+	// ForceDeleteDeadline is the time at which the next remaining pods are force deleted, for pods drained
+	// in DeleteAfterTimeout mode
+	ForceDeleteDeadline *metav1.Time `json:"forceDeleteDeadline,omitempty"`
+
+	// Message gives details on the phase
+	Message string `json:"message,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="Node",type="string",JSONPath=".spec.nodeName"
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Remaining",type="integer",JSONPath=".status.remainingPods"
+// +kubebuilder:printcolumn:name="Evicted",type="integer",JSONPath=".status.evictedPods"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// NodeDrain is the Schema for the nodedrains API. node-drainer maintains one NodeDrain per drain session
+// to show its progress.
+type NodeDrain struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NodeDrainSpec   `json:"spec,omitempty"`
+	Status NodeDrainStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NodeDrainList contains a list of NodeDrain
+type NodeDrainList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NodeDrain `json:"items"`
+}
+
+// IsFinished returns whether the drain session reached its final phase
+func (n *NodeDrain) IsFinished() bool {
+	return n.Status.Phase == NodeDrainPhaseSucceeded || n.Status.Phase == NodeDrainPhaseFailed ||
+		n.Status.Phase == NodeDrainPhaseCancelled
+}
+
+func init() {
+	SchemeBuilder.Register(&NodeDrain{}, &NodeDrainList{})
+}
@@ -154,6 +154,8 @@ func run() error {
 	}
 
 	ff.Set("custom_drain", components.CustomDrainEnabled)
+	ff.Set("drain_plugin", components.DrainPluginEnabled)
+	ff.Set("drain_status", components.DrainStatus != nil)
 
 	// Informers must sync before processing events
 	slog.InfoContext(gCtx, "Starting Kubernetes informers")
@@ -164,6 +166,13 @@ func run() error {
 
 	slog.InfoContext(gCtx, "Kubernetes informers started and synced")
 
+	if components.DrainStatus != nil {
+		g.Go(func() error {
+			components.DrainStatus.RunGarbageCollector(gCtx)
+			return nil
+		})
+	}
+
 	slog.InfoContext(gCtx, "Starting queue worker")
 	components.QueueManager.Start(gCtx)
 
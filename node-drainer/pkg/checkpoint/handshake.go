@@ -0,0 +1,316 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package checkpoint implements the handshake that gives workloads a chance to checkpoint before
+// node-drainer evicts their pods.
+//
+// Pods opt in with the HandshakeAnnotation or by declaring a CallbackAnnotation. node-drainer signals
+// such a pod by setting the RequestedAnnotation and DeadlineAnnotation, optionally a CheckpointRequested
+// pod condition, and by POSTing to the declared callback. The pod is evicted once the workload sets the
+// AcknowledgedAnnotation or the deadline passes. Pods that did not opt in are evicted right away.
+package checkpoint
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/config"
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/metrics"
+)
+
+const (
+	// HandshakeAnnotation set to "true" opts a pod into the checkpoint handshake
+	HandshakeAnnotation = "nvsentinel.nvidia.com/checkpoint-handshake"
+	// CallbackAnnotation declares an HTTP endpoint notified when a checkpoint is requested, as
+	// ":<port>/<path>" resolved against the pod IP. Other hosts are never contacted.
+	CallbackAnnotation = "nvsentinel.nvidia.com/checkpoint-callback"
+	// RequestedAnnotation records when node-drainer requested the checkpoint
+	RequestedAnnotation = "nvsentinel.nvidia.com/checkpoint-requested"
+	// DeadlineAnnotation records when the pod is evicted if it has not acknowledged the request
+	DeadlineAnnotation = "nvsentinel.nvidia.com/checkpoint-deadline"
+	// AcknowledgedAnnotation is set by the workload once its checkpoint is complete
+	AcknowledgedAnnotation = "nvsentinel.nvidia.com/checkpoint-acknowledged"
+
+	// RequestedCondition is the pod condition set on signalled pods when PodCondition is enabled
+	RequestedCondition v1.PodConditionType = "nvsentinel.nvidia.com/CheckpointRequested"
+
+	callbackTimeout = 10 * time.Second
+)
+
+type Outcome string
+
+const (
+	OutcomeAcknowledged     Outcome = "Acknowledged"
+	OutcomeDeadlineExceeded Outcome = "DeadlineExceeded"
+)
+
+// Result splits the pods of a handshake round
+type Result struct {
+	// Ready holds the pods that may be evicted now
+	Ready []*v1.Pod
+	// Pending holds the namespace/name of pods still checkpointing
+	Pending []string
+	// Outcomes holds how the handshake ended for pods that completed it, by namespace/name
+	Outcomes map[string]Outcome
+}
+
+type callbackRequest struct {
+	Namespace string    `json:"namespace"`
+	Pod       string    `json:"pod"`
+	Node      string    `json:"node"`
+	Deadline  time.Time `json:"deadline"`
+}
+
+type Handshaker struct {
+	clientset    kubernetes.Interface
+	httpClient   *http.Client
+	timeout      time.Duration
+	podCondition bool
+	dryRunMode   []string
+}
+
+// NewHandshaker returns a handshaker for the configuration, or nil when the handshake is disabled
+func NewHandshaker(cfg config.CheckpointConfig, clientset kubernetes.Interface, dryRun bool) *Handshaker {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	dryRunMode := []string{}
+	if dryRun {
+		dryRunMode = []string{metav1.DryRunAll}
+	}
+
+	return &Handshaker{
+		clientset:    clientset,
+		httpClient:   &http.Client{Timeout: callbackTimeout},
+		timeout:      cfg.Timeout.Duration,
+		podCondition: cfg.PodCondition,
+		dryRunMode:   dryRunMode,
+	}
+}
+
+// Run advances the handshake of every pod. Pods that opted in and have not been signalled since
+// the drain started are signalled now. Requests older than since belong to an earlier drain and
+// are sent again.
+func (h *Handshaker) Run(ctx context.Context, pods []*v1.Pod, since time.Time) (Result, error) {
+	result := Result{Outcomes: make(map[string]Outcome)}
+	now := time.Now()
+
+	for _, pod := range pods {
+		if !participates(pod) {
+			result.Ready = append(result.Ready, pod)
+			continue
+		}
+
+		key := pod.Namespace + "/" + pod.Name
+
+		deadline, requested := requestDeadline(pod, since)
+		if !requested {
+			if err := h.signal(ctx, pod, now.Add(h.timeout)); err != nil {
+				return result, err
+			}
+
+			// Dry-run patches are not persisted, so there is nothing to wait for
+			if len(h.dryRunMode) > 0 {
+				result.Ready = append(result.Ready, pod)
+			} else {
+				result.Pending = append(result.Pending, key)
+			}
+
+			continue
+		}
+
+		switch {
+		case pod.Annotations[AcknowledgedAnnotation] != "":
+			result.Outcomes[key] = OutcomeAcknowledged
+		case !now.Before(deadline):
+			result.Outcomes[key] = OutcomeDeadlineExceeded
+		default:
+			result.Pending = append(result.Pending, key)
+			continue
+		}
+
+		result.Ready = append(result.Ready, pod)
+	}
+
+	return result, nil
+}
+
+func participates(pod *v1.Pod) bool {
+	return pod.Annotations[HandshakeAnnotation] == "true" || pod.Annotations[CallbackAnnotation] != ""
+}
+
+// requestDeadline returns the deadline of a checkpoint requested at or after since
+func requestDeadline(pod *v1.Pod, since time.Time) (time.Time, bool) {
+	requestedAt, err := time.Parse(time.RFC3339, pod.Annotations[RequestedAnnotation])
+	if err != nil || requestedAt.Before(since.Truncate(time.Second)) {
+		return time.Time{}, false
+	}
+
+	deadline, err := time.Parse(time.RFC3339, pod.Annotations[DeadlineAnnotation])
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return deadline, true
+}
+
+func (h *Handshaker) signal(ctx context.Context, pod *v1.Pod, deadline time.Time) error {
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]any{
+				RequestedAnnotation:    time.Now().UTC().Format(time.RFC3339),
+				DeadlineAnnotation:     deadline.UTC().Format(time.RFC3339),
+				AcknowledgedAnnotation: nil,
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal checkpoint annotations patch: %w", err)
+	}
+
+	if _, err := h.clientset.CoreV1().Pods(pod.Namespace).Patch(ctx, pod.Name, types.MergePatchType, patch,
+		metav1.PatchOptions{DryRun: h.dryRunMode}); err != nil {
+		return fmt.Errorf("failed to request checkpoint from pod %s/%s: %w", pod.Namespace, pod.Name, err)
+	}
+
+	if h.podCondition {
+		h.setRequestedCondition(ctx, pod, deadline)
+	}
+
+	if callback := pod.Annotations[CallbackAnnotation]; callback != "" {
+		h.notifyCallback(ctx, pod, callback, deadline)
+	}
+
+	metrics.CheckpointHandshakes.WithLabelValues("requested").Inc()
+
+	slog.InfoContext(ctx, "Requested checkpoint from pod before eviction",
+		"pod", pod.Name,
+		"namespace", pod.Namespace,
+		"node", pod.Spec.NodeName,
+		"deadline", deadline)
+
+	return nil
+}
+
+// setRequestedCondition is best effort: the annotations already carry the request
+func (h *Handshaker) setRequestedCondition(ctx context.Context, pod *v1.Pod, deadline time.Time) {
+	patch, err := json.Marshal(map[string]any{
+		"status": map[string]any{
+			"conditions": []v1.PodCondition{{
+				Type:               RequestedCondition,
+				Status:             v1.ConditionTrue,
+				LastTransitionTime: metav1.Now(),
+				Reason:             "NodeDrain",
+				Message: fmt.Sprintf("Node %s is being drained; checkpoint before %s",
+					pod.Spec.NodeName, deadline.UTC().Format(time.RFC3339)),
+			}},
+		},
+	})
+	if err == nil {
+		_, err = h.clientset.CoreV1().Pods(pod.Namespace).Patch(ctx, pod.Name, types.StrategicMergePatchType, patch,
+			metav1.PatchOptions{DryRun: h.dryRunMode}, "status")
+	}
+
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to set checkpoint condition on pod",
+			"pod", pod.Name,
+			"namespace", pod.Namespace,
+			"error", err)
+		metrics.ProcessingErrors.WithLabelValues("checkpoint_condition_error", pod.Spec.NodeName).Inc()
+	}
+}
+
+// notifyCallback is best effort: a workload that misses the callback still sees the annotations
+func (h *Handshaker) notifyCallback(ctx context.Context, pod *v1.Pod, callback string, deadline time.Time) {
+	if len(h.dryRunMode) > 0 {
+		return
+	}
+
+	err := h.postCallback(ctx, pod, callback, deadline)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to notify pod checkpoint callback",
+			"pod", pod.Name,
+			"namespace", pod.Namespace,
+			"callback", callback,
+			"error", err)
+		metrics.ProcessingErrors.WithLabelValues("checkpoint_callback_error", pod.Spec.NodeName).Inc()
+	}
+}
+
+func (h *Handshaker) postCallback(ctx context.Context, pod *v1.Pod, callback string, deadline time.Time) error {
+	url, err := callbackURL(pod, callback)
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(callbackRequest{
+		Namespace: pod.Namespace,
+		Pod:       pod.Name,
+		Node:      pod.Spec.NodeName,
+		Deadline:  deadline.UTC(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal callback request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create callback request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := h.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("callback request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("callback returned status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// callbackURL resolves a ":<port>/<path>" callback against the pod IP. The annotation is writable by
+// anyone who can edit the pod, so it may only point at the pod itself.
+func callbackURL(pod *v1.Pod, callback string) (string, error) {
+	port, path, _ := strings.Cut(strings.TrimPrefix(callback, ":"), "/")
+
+	number, err := strconv.Atoi(port)
+	if !strings.HasPrefix(callback, ":") || err != nil || number < 1 || number > 65535 {
+		return "", fmt.Errorf("callback %q must have the form :<port>/<path>", callback)
+	}
+
+	if pod.Status.PodIP == "" {
+		return "", fmt.Errorf("pod has no IP to resolve callback %q", callback)
+	}
+
+	return "http://" + net.JoinHostPort(pod.Status.PodIP, port) + "/" + path, nil
+}
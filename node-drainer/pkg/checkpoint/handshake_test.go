@@ -0,0 +1,265 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checkpoint
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/config"
+)
+
+func newPod(name string, annotations map[string]string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "training", Annotations: annotations},
+		Spec:       v1.PodSpec{NodeName: "node-1"},
+	}
+}
+
+func newHandshaker(t *testing.T, pods ...*v1.Pod) (*Handshaker, *fake.Clientset) {
+	t.Helper()
+
+	clientset := fake.NewSimpleClientset()
+	for _, pod := range pods {
+		_, err := clientset.CoreV1().Pods(pod.Namespace).Create(context.Background(), pod, metav1.CreateOptions{})
+		require.NoError(t, err)
+	}
+
+	h := NewHandshaker(config.CheckpointConfig{
+		Enabled:      true,
+		Timeout:      config.Duration{Duration: 10 * time.Minute},
+		PodCondition: true,
+	}, clientset, false)
+	require.NotNil(t, h)
+
+	return h, clientset
+}
+
+func TestNewHandshaker_Disabled(t *testing.T) {
+	assert.Nil(t, NewHandshaker(config.CheckpointConfig{}, fake.NewSimpleClientset(), false))
+}
+
+func TestRun_SignalsOptedInPods(t *testing.T) {
+	ctx := context.Background()
+	optedIn := newPod("trainer", map[string]string{HandshakeAnnotation: "true"})
+	other := newPod("inference", nil)
+	h, clientset := newHandshaker(t, optedIn, other)
+
+	result, err := h.Run(ctx, []*v1.Pod{optedIn, other}, time.Now().Add(-time.Minute))
+	require.NoError(t, err)
+
+	assert.Equal(t, []*v1.Pod{other}, result.Ready)
+	assert.Equal(t, []string{"training/trainer"}, result.Pending)
+	assert.Empty(t, result.Outcomes)
+
+	signalled, err := clientset.CoreV1().Pods("training").Get(ctx, "trainer", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.NotEmpty(t, signalled.Annotations[RequestedAnnotation])
+	assert.NotEmpty(t, signalled.Annotations[DeadlineAnnotation])
+
+	var condition *v1.PodCondition
+
+	for i := range signalled.Status.Conditions {
+		if signalled.Status.Conditions[i].Type == RequestedCondition {
+			condition = &signalled.Status.Conditions[i]
+		}
+	}
+
+	require.NotNil(t, condition)
+	assert.Equal(t, v1.ConditionTrue, condition.Status)
+
+	untouched, err := clientset.CoreV1().Pods("training").Get(ctx, "inference", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, untouched.Annotations[RequestedAnnotation])
+}
+
+func TestRun_Outcomes(t *testing.T) {
+	since := time.Now().Add(-time.Hour)
+	requested := time.Now().Add(-time.Minute).UTC().Format(time.RFC3339)
+
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		ready       bool
+		outcome     Outcome
+	}{
+		{
+			name: "acknowledged",
+			annotations: map[string]string{
+				HandshakeAnnotation:    "true",
+				RequestedAnnotation:    requested,
+				DeadlineAnnotation:     time.Now().Add(time.Hour).UTC().Format(time.RFC3339),
+				AcknowledgedAnnotation: "true",
+			},
+			ready:   true,
+			outcome: OutcomeAcknowledged,
+		},
+		{
+			name: "deadline exceeded",
+			annotations: map[string]string{
+				HandshakeAnnotation: "true",
+				RequestedAnnotation: requested,
+				DeadlineAnnotation:  time.Now().Add(-time.Second).UTC().Format(time.RFC3339),
+			},
+			ready:   true,
+			outcome: OutcomeDeadlineExceeded,
+		},
+		{
+			name: "still checkpointing",
+			annotations: map[string]string{
+				HandshakeAnnotation: "true",
+				RequestedAnnotation: requested,
+				DeadlineAnnotation:  time.Now().Add(time.Hour).UTC().Format(time.RFC3339),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := newPod("trainer", tt.annotations)
+			h, _ := newHandshaker(t, pod)
+
+			result, err := h.Run(context.Background(), []*v1.Pod{pod}, since)
+			require.NoError(t, err)
+
+			if tt.ready {
+				assert.Len(t, result.Ready, 1)
+				assert.Empty(t, result.Pending)
+				assert.Equal(t, tt.outcome, result.Outcomes["training/trainer"])
+			} else {
+				assert.Empty(t, result.Ready)
+				assert.Equal(t, []string{"training/trainer"}, result.Pending)
+			}
+		})
+	}
+}
+
+func TestRun_StaleRequestIsSentAgain(t *testing.T) {
+	ctx := context.Background()
+	pod := newPod("trainer", map[string]string{
+		HandshakeAnnotation:    "true",
+		RequestedAnnotation:    time.Now().Add(-2 * time.Hour).UTC().Format(time.RFC3339),
+		DeadlineAnnotation:     time.Now().Add(-time.Hour).UTC().Format(time.RFC3339),
+		AcknowledgedAnnotation: "true",
+	})
+	h, clientset := newHandshaker(t, pod)
+
+	result, err := h.Run(ctx, []*v1.Pod{pod}, time.Now().Add(-time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"training/trainer"}, result.Pending)
+
+	signalled, err := clientset.CoreV1().Pods("training").Get(ctx, "trainer", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.NotContains(t, signalled.Annotations, AcknowledgedAnnotation)
+}
+
+func TestRun_NotifiesCallback(t *testing.T) {
+	received := make(chan callbackRequest, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req callbackRequest
+
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		received <- req
+	}))
+	defer server.Close()
+
+	host, port, err := net.SplitHostPort(strings.TrimPrefix(server.URL, "http://"))
+	require.NoError(t, err)
+
+	pod := newPod("trainer", map[string]string{CallbackAnnotation: ":" + port + "/checkpoint"})
+	pod.Status.PodIP = host
+	h, _ := newHandshaker(t, pod)
+
+	result, err := h.Run(context.Background(), []*v1.Pod{pod}, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"training/trainer"}, result.Pending)
+
+	select {
+	case req := <-received:
+		assert.Equal(t, "trainer", req.Pod)
+		assert.Equal(t, "training", req.Namespace)
+		assert.Equal(t, "node-1", req.Node)
+	default:
+		t.Fatal("callback was not notified")
+	}
+}
+
+func TestCallbackURL(t *testing.T) {
+	pod := newPod("trainer", nil)
+	pod.Status.PodIP = "10.0.0.7"
+
+	tests := []struct {
+		name     string
+		callback string
+		want     string
+		wantErr  bool
+	}{
+		{name: "port and path", callback: ":8080/checkpoint", want: "http://10.0.0.7:8080/checkpoint"},
+		{name: "port only", callback: ":8080", want: "http://10.0.0.7:8080/"},
+		{name: "absolute URL", callback: "http://metadata.internal/latest", wantErr: true},
+		{name: "host and port", callback: "10.0.0.8:8080/checkpoint", wantErr: true},
+		{name: "invalid port", callback: ":http/checkpoint", wantErr: true},
+		{name: "port out of range", callback: ":70000/checkpoint", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := callbackURL(pod, tt.callback)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRun_IgnoresCallbackOutsidePod(t *testing.T) {
+	called := make(chan struct{}, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called <- struct{}{}
+	}))
+	defer server.Close()
+
+	pod := newPod("trainer", map[string]string{CallbackAnnotation: server.URL + "/checkpoint"})
+	h, _ := newHandshaker(t, pod)
+
+	// The pod still takes part in the handshake through its annotations
+	result, err := h.Run(context.Background(), []*v1.Pod{pod}, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"training/trainer"}, result.Pending)
+
+	select {
+	case <-called:
+		t.Fatal("callback outside the pod was notified")
+	default:
+	}
+}
@@ -0,0 +1,240 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package concurrency limits how many nodes node-drainer drains at the same time.
+//
+// A node holds a drain slot from the moment its first drain action runs until all of its events
+// reach a terminal status. Nodes that cannot get a slot wait in arrival order; a waiting node is
+// admitted as soon as the global limit and the limits of all of its label groups have room, after
+// the nodes that arrived before it. A node waiting only on a full group does not block nodes of other
+// groups.
+package concurrency
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/config"
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/metrics"
+)
+
+// groupKey identifies the nodes of a group sharing a label value
+type groupKey struct {
+	group string
+	value string
+}
+
+type node struct {
+	name     string
+	groups   []groupKey
+	queuedAt time.Time // orders the waiting list, stable across restarts
+	waiting  time.Time
+}
+
+type Limiter struct {
+	mu sync.Mutex
+
+	maxConcurrent int
+	groups        []config.DrainConcurrencyGroup
+	groupLimits   map[string]int
+
+	active  map[string]*node
+	waiting []*node
+	inGroup map[groupKey]int // active nodes per group and label value
+}
+
+// NewLimiter returns a limiter for the configuration, or nil when drains are not limited
+func NewLimiter(cfg config.DrainConcurrencyConfig) *Limiter {
+	if cfg.MaxConcurrentDrains == 0 && len(cfg.Groups) == 0 {
+		return nil
+	}
+
+	groupLimits := make(map[string]int, len(cfg.Groups))
+	for _, group := range cfg.Groups {
+		groupLimits[group.Name] = group.MaxConcurrent
+	}
+
+	return &Limiter{
+		maxConcurrent: cfg.MaxConcurrentDrains,
+		groups:        cfg.Groups,
+		groupLimits:   groupLimits,
+		active:        make(map[string]*node),
+		inGroup:       make(map[groupKey]int),
+	}
+}
+
+// Acquire reports whether the node may drain now, admitting it if it is not draining yet. A node that
+// is not admitted joins the waiting list, and its 1-based position in that list is returned.
+// The waiting list is ordered by queuedAt, which callers derive from persisted state (the health
+// event's creation time) so that the order survives a restart; nodes with equal times keep their
+// arrival order. Nodes found draining after a restart are readmitted even if that exceeds the limits.
+func (l *Limiter) Acquire(
+	nodeName string, labels map[string]string, queuedAt time.Time, alreadyDraining bool,
+) (bool, int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if _, ok := l.active[nodeName]; ok {
+		return true, 0
+	}
+
+	candidate, position := l.findWaiting(nodeName)
+	if candidate == nil {
+		candidate = &node{name: nodeName, groups: l.groupKeys(labels), queuedAt: queuedAt, waiting: time.Now()}
+		position = l.enqueue(candidate)
+	}
+
+	if alreadyDraining || l.admissible(position) {
+		l.admit(position)
+		return true, 0
+	}
+
+	l.updateMetrics()
+
+	return false, position + 1
+}
+
+// Release frees the node's drain slot or removes it from the waiting list
+func (l *Limiter) Release(nodeName string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if active, ok := l.active[nodeName]; ok {
+		delete(l.active, nodeName)
+
+		for _, key := range active.groups {
+			l.inGroup[key]--
+			if l.inGroup[key] == 0 {
+				delete(l.inGroup, key)
+			}
+		}
+	}
+
+	if _, position := l.findWaiting(nodeName); position >= 0 {
+		l.waiting = append(l.waiting[:position], l.waiting[position+1:]...)
+	}
+
+	l.updateMetrics()
+}
+
+// IsWaiting reports whether the node is waiting for a drain slot
+func (l *Limiter) IsWaiting(nodeName string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	candidate, _ := l.findWaiting(nodeName)
+
+	return candidate != nil
+}
+
+// enqueue inserts the node behind every waiting node queued at or before it and returns its position
+func (l *Limiter) enqueue(candidate *node) int {
+	position := len(l.waiting)
+
+	for i, waiting := range l.waiting {
+		if waiting.queuedAt.After(candidate.queuedAt) {
+			position = i
+			break
+		}
+	}
+
+	l.waiting = slices.Insert(l.waiting, position, candidate)
+
+	return position
+}
+
+func (l *Limiter) findWaiting(nodeName string) (*node, int) {
+	for i, waiting := range l.waiting {
+		if waiting.name == nodeName {
+			return waiting, i
+		}
+	}
+
+	return nil, -1
+}
+
+func (l *Limiter) groupKeys(labels map[string]string) []groupKey {
+	var keys []groupKey
+
+	for _, group := range l.groups {
+		if value, ok := labels[group.LabelKey]; ok {
+			keys = append(keys, groupKey{group: group.Name, value: value})
+		}
+	}
+
+	return keys
+}
+
+// admissible reports whether the waiting node at position fits once every admissible node ahead of
+// it has been given a slot
+func (l *Limiter) admissible(position int) bool {
+	active := len(l.active)
+	inGroup := make(map[groupKey]int, len(l.inGroup))
+
+	for key, count := range l.inGroup {
+		inGroup[key] = count
+	}
+
+	for i := 0; i <= position; i++ {
+		if l.maxConcurrent > 0 && active >= l.maxConcurrent {
+			return false
+		}
+
+		waiting := l.waiting[i]
+		if !l.fitsGroups(waiting, inGroup) {
+			continue
+		}
+
+		if i == position {
+			return true
+		}
+
+		active++
+
+		for _, key := range waiting.groups {
+			inGroup[key]++
+		}
+	}
+
+	return false
+}
+
+func (l *Limiter) fitsGroups(n *node, inGroup map[groupKey]int) bool {
+	for _, key := range n.groups {
+		if inGroup[key] >= l.groupLimits[key.group] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (l *Limiter) admit(position int) {
+	admitted := l.waiting[position]
+	l.waiting = append(l.waiting[:position], l.waiting[position+1:]...)
+	l.active[admitted.name] = admitted
+
+	for _, key := range admitted.groups {
+		l.inGroup[key]++
+	}
+
+	metrics.DrainWaitDuration.Observe(time.Since(admitted.waiting).Seconds())
+	l.updateMetrics()
+}
+
+func (l *Limiter) updateMetrics() {
+	metrics.ActiveDrains.Set(float64(len(l.active)))
+	metrics.WaitingDrains.Set(float64(len(l.waiting)))
+}
@@ -0,0 +1,194 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package concurrency
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/config"
+)
+
+const rackLabel = "topology.kubernetes.io/rack"
+
+func rack(value string) map[string]string {
+	return map[string]string{rackLabel: value}
+}
+
+func TestNewLimiter_Unlimited(t *testing.T) {
+	assert.Nil(t, NewLimiter(config.DrainConcurrencyConfig{}))
+}
+
+func TestLimiter_GlobalLimitIsFIFO(t *testing.T) {
+	l := NewLimiter(config.DrainConcurrencyConfig{MaxConcurrentDrains: 1})
+	require.NotNil(t, l)
+
+	admitted, _ := l.Acquire("node-1", nil, time.Time{}, false)
+	assert.True(t, admitted)
+
+	admitted, position := l.Acquire("node-2", nil, time.Time{}, false)
+	assert.False(t, admitted)
+	assert.Equal(t, 1, position)
+
+	admitted, position = l.Acquire("node-3", nil, time.Time{}, false)
+	assert.False(t, admitted)
+	assert.Equal(t, 2, position)
+	assert.True(t, l.IsWaiting("node-3"))
+
+	// Retrying an admitted node keeps its slot
+	admitted, _ = l.Acquire("node-1", nil, time.Time{}, false)
+	assert.True(t, admitted)
+
+	l.Release("node-1")
+
+	// node-3 retries first but node-2 arrived earlier
+	admitted, position = l.Acquire("node-3", nil, time.Time{}, false)
+	assert.False(t, admitted)
+	assert.Equal(t, 2, position)
+
+	admitted, _ = l.Acquire("node-2", nil, time.Time{}, false)
+	assert.True(t, admitted)
+	assert.False(t, l.IsWaiting("node-2"))
+
+	l.Release("node-2")
+
+	admitted, _ = l.Acquire("node-3", nil, time.Time{}, false)
+	assert.True(t, admitted)
+}
+
+func TestLimiter_WaitingNodesAreOrderedByQueueTime(t *testing.T) {
+	l := NewLimiter(config.DrainConcurrencyConfig{MaxConcurrentDrains: 1})
+	require.NotNil(t, l)
+
+	start := time.Now()
+
+	admitted, _ := l.Acquire("node-1", nil, start, false)
+	assert.True(t, admitted)
+
+	// After a restart node-3 may be retried before node-2 even though its event is newer
+	_, position := l.Acquire("node-3", nil, start.Add(2*time.Minute), false)
+	assert.Equal(t, 1, position)
+
+	_, position = l.Acquire("node-2", nil, start.Add(time.Minute), false)
+	assert.Equal(t, 1, position)
+
+	l.Release("node-1")
+
+	admitted, _ = l.Acquire("node-3", nil, start.Add(2*time.Minute), false)
+	assert.False(t, admitted)
+
+	admitted, _ = l.Acquire("node-2", nil, start.Add(time.Minute), false)
+	assert.True(t, admitted)
+}
+
+func TestLimiter_GroupLimitDoesNotBlockOtherGroups(t *testing.T) {
+	l := NewLimiter(config.DrainConcurrencyConfig{
+		Groups: []config.DrainConcurrencyGroup{{Name: "rack", LabelKey: rackLabel, MaxConcurrent: 1}},
+	})
+	require.NotNil(t, l)
+
+	admitted, _ := l.Acquire("node-a1", rack("a"), time.Time{}, false)
+	assert.True(t, admitted)
+
+	admitted, position := l.Acquire("node-a2", rack("a"), time.Time{}, false)
+	assert.False(t, admitted)
+	assert.Equal(t, 1, position)
+
+	// node-a2 is ahead in line but only waits on rack a
+	admitted, _ = l.Acquire("node-b1", rack("b"), time.Time{}, false)
+	assert.True(t, admitted)
+
+	// Nodes without the group label are only subject to the global limit
+	admitted, _ = l.Acquire("node-x", nil, time.Time{}, false)
+	assert.True(t, admitted)
+
+	l.Release("node-a1")
+
+	admitted, _ = l.Acquire("node-a2", rack("a"), time.Time{}, false)
+	assert.True(t, admitted)
+}
+
+func TestLimiter_GlobalAndGroupLimits(t *testing.T) {
+	l := NewLimiter(config.DrainConcurrencyConfig{
+		MaxConcurrentDrains: 2,
+		Groups:              []config.DrainConcurrencyGroup{{Name: "rack", LabelKey: rackLabel, MaxConcurrent: 1}},
+	})
+	require.NotNil(t, l)
+
+	admitted, _ := l.Acquire("node-a1", rack("a"), time.Time{}, false)
+	assert.True(t, admitted)
+
+	admitted, _ = l.Acquire("node-a2", rack("a"), time.Time{}, false)
+	assert.False(t, admitted)
+
+	admitted, _ = l.Acquire("node-b1", rack("b"), time.Time{}, false)
+	assert.True(t, admitted)
+
+	// The global limit is reached even though rack c has room
+	admitted, position := l.Acquire("node-c1", rack("c"), time.Time{}, false)
+	assert.False(t, admitted)
+	assert.Equal(t, 2, position)
+
+	// Freeing a slot in rack b lets node-c1 in, since node-a2 still waits on rack a
+	l.Release("node-b1")
+
+	admitted, _ = l.Acquire("node-c1", rack("c"), time.Time{}, false)
+	assert.True(t, admitted)
+}
+
+func TestLimiter_AlreadyDrainingIsReadmitted(t *testing.T) {
+	l := NewLimiter(config.DrainConcurrencyConfig{MaxConcurrentDrains: 1})
+	require.NotNil(t, l)
+
+	admitted, _ := l.Acquire("node-1", nil, time.Time{}, true)
+	assert.True(t, admitted)
+
+	admitted, _ = l.Acquire("node-2", nil, time.Time{}, true)
+	assert.True(t, admitted)
+
+	admitted, _ = l.Acquire("node-3", nil, time.Time{}, false)
+	assert.False(t, admitted)
+
+	l.Release("node-1")
+
+	admitted, _ = l.Acquire("node-3", nil, time.Time{}, false)
+	assert.False(t, admitted, "node-2 still holds the only slot")
+
+	l.Release("node-2")
+
+	admitted, _ = l.Acquire("node-3", nil, time.Time{}, false)
+	assert.True(t, admitted)
+}
+
+func TestLimiter_ReleaseWaitingNode(t *testing.T) {
+	l := NewLimiter(config.DrainConcurrencyConfig{MaxConcurrentDrains: 1})
+	require.NotNil(t, l)
+
+	l.Acquire("node-1", nil, time.Time{}, false)
+	l.Acquire("node-2", nil, time.Time{}, false)
+	l.Acquire("node-3", nil, time.Time{}, false)
+
+	// A cancelled waiting node leaves the line
+	l.Release("node-2")
+	assert.False(t, l.IsWaiting("node-2"))
+
+	l.Release("node-1")
+
+	admitted, _ := l.Acquire("node-3", nil, time.Time{}, false)
+	assert.True(t, admitted)
+}
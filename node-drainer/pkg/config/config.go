@@ -20,6 +20,7 @@ import (
 	"time"
 
 	"github.com/BurntSushi/toml"
+	"k8s.io/apimachinery/pkg/labels"
 
 	"github.com/nvidia/nvsentinel/commons/pkg/statemanager"
 	"github.com/nvidia/nvsentinel/store-client/pkg/client"
@@ -56,15 +57,141 @@ type CustomDrainConfig struct {
 	StatusConditionStatus string   `toml:"statusConditionStatus"`
 }
 
+// DrainPluginConfig hands node drains over to a gRPC drain plugin implementing DrainPluginService,
+// as an alternative to customDrain that does not require the plugin to own a CRD
+type DrainPluginConfig struct {
+	Enabled bool `toml:"enabled"`
+	// Endpoint is the gRPC address of the plugin, e.g. "slinky-drainer.nvsentinel.svc:50051"
+	Endpoint string `toml:"endpoint"`
+	// CACertPath is the CA bundle the plugin certificate is verified against
+	CACertPath string `toml:"caCertPath"`
+	// ClientCertPath and ClientKeyPath are the certificate node-drainer presents to plugins that
+	// require mutual TLS
+	ClientCertPath string `toml:"clientCertPath"`
+	ClientKeyPath  string `toml:"clientKeyPath"`
+	// Insecure disables TLS towards the plugin
+	Insecure bool `toml:"insecure"`
+	// RequestTimeout bounds every call to the plugin
+	RequestTimeout Duration `toml:"requestTimeout"`
+}
+
+// DrainConcurrencyGroup limits concurrent drains among nodes sharing a value of LabelKey,
+// e.g. nodes of the same rack or zone
+type DrainConcurrencyGroup struct {
+	Name          string `toml:"name"`
+	LabelKey      string `toml:"labelKey"`
+	MaxConcurrent int    `toml:"maxConcurrent"`
+}
+
+type DrainConcurrencyConfig struct {
+	// MaxConcurrentDrains limits the nodes draining cluster-wide; 0 means unlimited
+	MaxConcurrentDrains int                     `toml:"maxConcurrentDrains"`
+	Groups              []DrainConcurrencyGroup `toml:"groups"`
+}
+
+// CheckpointConfig configures the handshake that asks opted-in pods to checkpoint before they leave a
+// drained node, in every eviction mode
+type CheckpointConfig struct {
+	Enabled bool `toml:"enabled"`
+	// Timeout is how long a pod may take to acknowledge the request before it is evicted anyway
+	Timeout Duration `toml:"timeout"`
+	// PodCondition additionally sets a CheckpointRequested condition on signalled pods
+	PodCondition bool `toml:"podCondition"`
+}
+
+type GangDrainMode string
+
+const (
+	// GangModeEvict evicts the gang members on other nodes together with the pods of the drained node
+	GangModeEvict GangDrainMode = "Evict"
+	// GangModeSignal annotates the gang members on other nodes so the workload can restart coherently
+	GangModeSignal GangDrainMode = "Signal"
+	// GangModeDelegate leaves the gang members alone and relies on the owning controller's gang restart policy
+	GangModeDelegate GangDrainMode = "Delegate"
+)
+
+// GangDiscoveryConfig mirrors the preflight gang discovery configuration. When empty, gangs are
+// discovered through the native Kubernetes workloadRef.
+type GangDiscoveryConfig struct {
+	Name           string    `toml:"name"`
+	AnnotationKeys []string  `toml:"annotationKeys"`
+	LabelKeys      []string  `toml:"labelKeys"`
+	PodGroupGVR    GVRConfig `toml:"podGroupGVR"`
+	MinCountExpr   string    `toml:"minCountExpr"`
+}
+
+type GVRConfig struct {
+	Group    string `toml:"group"`
+	Version  string `toml:"version"`
+	Resource string `toml:"resource"`
+}
+
+// GangDrainConfig makes node-drainer disrupt multi-node jobs as a whole when it evicts one of their pods
+type GangDrainConfig struct {
+	Enabled   bool                `toml:"enabled"`
+	Mode      GangDrainMode       `toml:"mode"`
+	Discovery GangDiscoveryConfig `toml:"discovery"`
+}
+
+// PodDrainPolicy selects the eviction mode of the pods matching a label selector, in place of the mode
+// of their namespace
+type PodDrainPolicy struct {
+	Selector string    `toml:"selector"`
+	Mode     EvictMode `toml:"mode"`
+}
+
+// PodDrainPolicyConfig configures eviction modes per pod. Policies are evaluated in order and the first
+// matching one wins; pods matching none keep the mode of their namespace.
+type PodDrainPolicyConfig struct {
+	Enabled  bool             `toml:"enabled"`
+	Policies []PodDrainPolicy `toml:"policies"`
+	// AllowAnnotationOverride lets pods choose their own mode and maximum wait through annotations
+	AllowAnnotationOverride bool `toml:"allowAnnotationOverride"`
+	// MaxWaitMinutes caps the maximum wait pods may request through annotations
+	MaxWaitMinutes int `toml:"maxWaitMinutes"`
+}
+
+type QueueSystem string
+
+const (
+	// QueueSystemKueue requeues the Kueue Workload owning evicted pods
+	QueueSystemKueue QueueSystem = "Kueue"
+	// QueueSystemVolcano restarts the Volcano Job owning evicted pods
+	QueueSystemVolcano QueueSystem = "Volcano"
+)
+
+// QueueIntegrationConfig makes node-drainer hand pods managed by a batch queue system back to that
+// system before evicting or force deleting them, so their jobs are requeued rather than failed
+type QueueIntegrationConfig struct {
+	Enabled bool          `toml:"enabled"`
+	Systems []QueueSystem `toml:"systems"`
+	// Timeout is how long the queue system may take to release the node before the pods are evicted anyway
+	Timeout Duration `toml:"timeout"`
+}
+
+// DrainStatusConfig makes node-drainer maintain a NodeDrain custom resource per drain session
+type DrainStatusConfig struct {
+	Enabled bool `toml:"enabled"`
+	// TTLHours is how long a NodeDrain is kept once its drain session finished
+	TTLHours int `toml:"ttlHours"`
+}
+
 type TomlConfig struct {
 	EvictionTimeoutInSeconds  Duration `toml:"evictionTimeoutInSeconds"`
 	SystemNamespaces          string   `toml:"systemNamespaces"`
 	DeleteAfterTimeoutMinutes int      `toml:"deleteAfterTimeoutMinutes"`
 	// NotReadyTimeoutMinutes is the time after which a pod in NotReady state is considered stuck
-	NotReadyTimeoutMinutes int               `toml:"notReadyTimeoutMinutes"`
-	UserNamespaces         []UserNamespace   `toml:"userNamespaces"`
-	CustomDrain            CustomDrainConfig `toml:"customDrain"`
-	PartialDrainEnabled    bool              `toml:"partialDrainEnabled"`
+	NotReadyTimeoutMinutes int                    `toml:"notReadyTimeoutMinutes"`
+	UserNamespaces         []UserNamespace        `toml:"userNamespaces"`
+	CustomDrain            CustomDrainConfig      `toml:"customDrain"`
+	DrainPlugin            DrainPluginConfig      `toml:"drainPlugin"`
+	PartialDrainEnabled    bool                   `toml:"partialDrainEnabled"`
+	DrainConcurrency       DrainConcurrencyConfig `toml:"drainConcurrency"`
+	Checkpoint             CheckpointConfig       `toml:"checkpoint"`
+	GangDrain              GangDrainConfig        `toml:"gangDrain"`
+	PodDrainPolicy         PodDrainPolicyConfig   `toml:"podDrainPolicy"`
+	QueueIntegration       QueueIntegrationConfig `toml:"queueIntegration"`
+	DrainStatus            DrainStatusConfig      `toml:"drainStatus"`
 }
 
 func (d *Duration) UnmarshalTOML(text any) error {
@@ -137,11 +264,177 @@ func validateCustomDrainConfig(config *TomlConfig) error {
 	return nil
 }
 
+func validateDrainPluginConfig(config *TomlConfig) error {
+	if !config.DrainPlugin.Enabled {
+		return nil
+	}
+
+	if config.CustomDrain.Enabled {
+		return fmt.Errorf("cannot use both drainPlugin.enabled=true and customDrain.enabled=true")
+	}
+
+	if len(config.UserNamespaces) > 0 {
+		return fmt.Errorf("cannot use both drainPlugin.enabled=true and userNamespaces configuration")
+	}
+
+	if config.DrainPlugin.Endpoint == "" {
+		return fmt.Errorf("drainPlugin.endpoint is required when drainPlugin.enabled=true")
+	}
+
+	if !config.DrainPlugin.Insecure && config.DrainPlugin.CACertPath == "" {
+		return fmt.Errorf("drainPlugin.caCertPath is required unless drainPlugin.insecure=true")
+	}
+
+	if (config.DrainPlugin.ClientCertPath == "") != (config.DrainPlugin.ClientKeyPath == "") {
+		return fmt.Errorf("drainPlugin.clientCertPath and drainPlugin.clientKeyPath must be set together")
+	}
+
+	if config.DrainPlugin.RequestTimeout.Duration == 0 {
+		config.DrainPlugin.RequestTimeout.Duration = 10 * time.Second
+	}
+
+	return nil
+}
+
+func validateDrainConcurrencyConfig(config *TomlConfig) error {
+	if config.DrainConcurrency.MaxConcurrentDrains < 0 {
+		return fmt.Errorf("drainConcurrency.maxConcurrentDrains must not be negative")
+	}
+
+	names := make(map[string]struct{}, len(config.DrainConcurrency.Groups))
+
+	for i, group := range config.DrainConcurrency.Groups {
+		if group.Name == "" || group.LabelKey == "" {
+			return fmt.Errorf("drainConcurrency.groups[%d]: name and labelKey are required", i)
+		}
+
+		if group.MaxConcurrent <= 0 {
+			return fmt.Errorf("drainConcurrency.groups[%d]: maxConcurrent must be a positive integer", i)
+		}
+
+		if _, exists := names[group.Name]; exists {
+			return fmt.Errorf("drainConcurrency.groups[%d]: duplicate group name %q", i, group.Name)
+		}
+
+		names[group.Name] = struct{}{}
+	}
+
+	return nil
+}
+
+func validateGangDrainConfig(config *TomlConfig) error {
+	if !config.GangDrain.Enabled {
+		return nil
+	}
+
+	switch config.GangDrain.Mode {
+	case "":
+		config.GangDrain.Mode = GangModeSignal
+	case GangModeEvict, GangModeSignal, GangModeDelegate:
+	default:
+		return fmt.Errorf("gangDrain.mode %q is invalid, must be one of %s, %s or %s",
+			config.GangDrain.Mode, GangModeEvict, GangModeSignal, GangModeDelegate)
+	}
+
+	return nil
+}
+
+func validateQueueIntegrationConfig(config *TomlConfig) error {
+	if !config.QueueIntegration.Enabled {
+		return nil
+	}
+
+	if len(config.QueueIntegration.Systems) == 0 {
+		config.QueueIntegration.Systems = []QueueSystem{QueueSystemKueue, QueueSystemVolcano}
+	}
+
+	for _, system := range config.QueueIntegration.Systems {
+		if system != QueueSystemKueue && system != QueueSystemVolcano {
+			return fmt.Errorf("queueIntegration.systems: %q is invalid, must be %s or %s",
+				system, QueueSystemKueue, QueueSystemVolcano)
+		}
+	}
+
+	if config.QueueIntegration.Timeout.Duration == 0 {
+		config.QueueIntegration.Timeout.Duration = 600 * time.Second
+	}
+
+	return nil
+}
+
+func validateEvictMode(mode EvictMode) error {
+	switch mode {
+	case ModeImmediateEvict, ModeAllowCompletion, ModeDeleteAfterTimeout:
+		return nil
+	default:
+		return fmt.Errorf("mode %q is invalid, must be one of %s, %s or %s",
+			mode, ModeImmediateEvict, ModeAllowCompletion, ModeDeleteAfterTimeout)
+	}
+}
+
+func validatePodDrainPolicyConfig(config *TomlConfig) error {
+	if !config.PodDrainPolicy.Enabled {
+		return nil
+	}
+
+	for i, policy := range config.PodDrainPolicy.Policies {
+		if policy.Selector == "" {
+			return fmt.Errorf("podDrainPolicy.policies[%d]: selector is required", i)
+		}
+
+		if _, err := labels.Parse(policy.Selector); err != nil {
+			return fmt.Errorf("podDrainPolicy.policies[%d]: invalid selector %q: %w", i, policy.Selector, err)
+		}
+
+		if err := validateEvictMode(policy.Mode); err != nil {
+			return fmt.Errorf("podDrainPolicy.policies[%d]: %w", i, err)
+		}
+	}
+
+	if config.PodDrainPolicy.MaxWaitMinutes == 0 {
+		config.PodDrainPolicy.MaxWaitMinutes = config.DeleteAfterTimeoutMinutes
+	}
+
+	if config.PodDrainPolicy.MaxWaitMinutes <= 0 {
+		return fmt.Errorf("podDrainPolicy.maxWaitMinutes must be a positive integer")
+	}
+
+	return nil
+}
+
 func validateAndSetDefaults(config *TomlConfig) (*TomlConfig, error) {
 	if err := validateCustomDrainConfig(config); err != nil {
 		return nil, err
 	}
 
+	if err := validateDrainPluginConfig(config); err != nil {
+		return nil, err
+	}
+
+	if err := validateDrainConcurrencyConfig(config); err != nil {
+		return nil, err
+	}
+
+	if err := validateGangDrainConfig(config); err != nil {
+		return nil, err
+	}
+
+	if err := validateQueueIntegrationConfig(config); err != nil {
+		return nil, err
+	}
+
+	if config.DrainStatus.Enabled && config.DrainStatus.TTLHours == 0 {
+		config.DrainStatus.TTLHours = 336 // Default: 14 days
+	}
+
+	if config.DrainStatus.TTLHours < 0 {
+		return nil, fmt.Errorf("drainStatus.ttlHours must be a positive integer")
+	}
+
+	if config.Checkpoint.Enabled && config.Checkpoint.Timeout.Duration == 0 {
+		config.Checkpoint.Timeout.Duration = 600 * time.Second
+	}
+
 	if config.DeleteAfterTimeoutMinutes == 0 {
 		config.DeleteAfterTimeoutMinutes = 60 // Default: 60 minutes
 	}
@@ -158,6 +451,10 @@ func validateAndSetDefaults(config *TomlConfig) (*TomlConfig, error) {
 		return nil, fmt.Errorf("notReadyTimeoutMinutes must be a positive integer")
 	}
 
+	if err := validatePodDrainPolicyConfig(config); err != nil {
+		return nil, err
+	}
+
 	return config, nil
 }
 
@@ -0,0 +1,428 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type validationTest struct {
+	name        string
+	config      TomlConfig
+	errorSubstr string
+	// check verifies the defaults applied to a valid config
+	check func(t *testing.T, config *TomlConfig)
+}
+
+func runValidationTests(t *testing.T, validate func(*TomlConfig) error, tests []validationTest) {
+	t.Helper()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validate(&tt.config)
+			if tt.errorSubstr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorSubstr)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			if tt.check != nil {
+				tt.check(t, &tt.config)
+			}
+		})
+	}
+}
+
+func validCustomDrain() CustomDrainConfig {
+	return CustomDrainConfig{
+		Enabled:               true,
+		TemplateMountPath:     "/templates",
+		TemplateFileName:      "drain.yaml",
+		Namespace:             "nvsentinel",
+		ApiGroup:              "drain.example.com",
+		Version:               "v1",
+		Kind:                  "DrainRequest",
+		StatusConditionType:   "Complete",
+		StatusConditionStatus: "True",
+	}
+}
+
+func TestValidateCustomDrainConfig(t *testing.T) {
+	missingKind := validCustomDrain()
+	missingKind.Kind = ""
+
+	runValidationTests(t, validateCustomDrainConfig, []validationTest{
+		{
+			name:   "disabled",
+			config: TomlConfig{CustomDrain: CustomDrainConfig{Kind: ""}},
+		},
+		{
+			name:   "valid config defaults the timeout",
+			config: TomlConfig{CustomDrain: validCustomDrain()},
+			check: func(t *testing.T, config *TomlConfig) {
+				assert.Equal(t, time.Hour, config.CustomDrain.Timeout.Duration)
+			},
+		},
+		{
+			name:        "missing required field",
+			config:      TomlConfig{CustomDrain: missingKind},
+			errorSubstr: "customDrain.kind is required",
+		},
+		{
+			name: "combined with userNamespaces",
+			config: TomlConfig{
+				CustomDrain:    validCustomDrain(),
+				UserNamespaces: []UserNamespace{{Name: "*", Mode: ModeImmediateEvict}},
+			},
+			errorSubstr: "cannot use both customDrain.enabled=true and userNamespaces",
+		},
+	})
+}
+
+func TestValidateDrainPluginConfig(t *testing.T) {
+	runValidationTests(t, validateDrainPluginConfig, []validationTest{
+		{
+			name:   "disabled",
+			config: TomlConfig{DrainPlugin: DrainPluginConfig{Endpoint: ""}},
+		},
+		{
+			name: "valid TLS config defaults the request timeout",
+			config: TomlConfig{DrainPlugin: DrainPluginConfig{
+				Enabled:    true,
+				Endpoint:   "plugin:50051",
+				CACertPath: "/certs/ca.crt",
+			}},
+			check: func(t *testing.T, config *TomlConfig) {
+				assert.Equal(t, 10*time.Second, config.DrainPlugin.RequestTimeout.Duration)
+			},
+		},
+		{
+			name: "insecure config does not need a CA",
+			config: TomlConfig{DrainPlugin: DrainPluginConfig{
+				Enabled:  true,
+				Endpoint: "plugin:50051",
+				Insecure: true,
+			}},
+		},
+		{
+			name: "mutual TLS config",
+			config: TomlConfig{DrainPlugin: DrainPluginConfig{
+				Enabled:        true,
+				Endpoint:       "plugin:50051",
+				CACertPath:     "/certs/ca.crt",
+				ClientCertPath: "/certs/tls.crt",
+				ClientKeyPath:  "/certs/tls.key",
+			}},
+		},
+		{
+			name: "combined with customDrain",
+			config: TomlConfig{
+				DrainPlugin: DrainPluginConfig{Enabled: true, Endpoint: "plugin:50051", Insecure: true},
+				CustomDrain: validCustomDrain(),
+			},
+			errorSubstr: "cannot use both drainPlugin.enabled=true and customDrain.enabled=true",
+		},
+		{
+			name: "combined with userNamespaces",
+			config: TomlConfig{
+				DrainPlugin:    DrainPluginConfig{Enabled: true, Endpoint: "plugin:50051", Insecure: true},
+				UserNamespaces: []UserNamespace{{Name: "*", Mode: ModeImmediateEvict}},
+			},
+			errorSubstr: "cannot use both drainPlugin.enabled=true and userNamespaces",
+		},
+		{
+			name:        "missing endpoint",
+			config:      TomlConfig{DrainPlugin: DrainPluginConfig{Enabled: true, Insecure: true}},
+			errorSubstr: "drainPlugin.endpoint is required",
+		},
+		{
+			name:        "TLS without a CA",
+			config:      TomlConfig{DrainPlugin: DrainPluginConfig{Enabled: true, Endpoint: "plugin:50051"}},
+			errorSubstr: "drainPlugin.caCertPath is required",
+		},
+		{
+			name: "client certificate without a key",
+			config: TomlConfig{DrainPlugin: DrainPluginConfig{
+				Enabled:        true,
+				Endpoint:       "plugin:50051",
+				CACertPath:     "/certs/ca.crt",
+				ClientCertPath: "/certs/tls.crt",
+			}},
+			errorSubstr: "must be set together",
+		},
+	})
+}
+
+func TestValidateDrainConcurrencyConfig(t *testing.T) {
+	runValidationTests(t, validateDrainConcurrencyConfig, []validationTest{
+		{
+			name:   "unlimited",
+			config: TomlConfig{},
+		},
+		{
+			name: "cluster and group limits",
+			config: TomlConfig{DrainConcurrency: DrainConcurrencyConfig{
+				MaxConcurrentDrains: 5,
+				Groups: []DrainConcurrencyGroup{
+					{Name: "rack", LabelKey: "topology.kubernetes.io/rack", MaxConcurrent: 1},
+					{Name: "zone", LabelKey: "topology.kubernetes.io/zone", MaxConcurrent: 2},
+				},
+			}},
+		},
+		{
+			name:        "negative cluster limit",
+			config:      TomlConfig{DrainConcurrency: DrainConcurrencyConfig{MaxConcurrentDrains: -1}},
+			errorSubstr: "maxConcurrentDrains must not be negative",
+		},
+		{
+			name: "group without a label key",
+			config: TomlConfig{DrainConcurrency: DrainConcurrencyConfig{
+				Groups: []DrainConcurrencyGroup{{Name: "rack", MaxConcurrent: 1}},
+			}},
+			errorSubstr: "groups[0]: name and labelKey are required",
+		},
+		{
+			name: "group without a positive limit",
+			config: TomlConfig{DrainConcurrency: DrainConcurrencyConfig{
+				Groups: []DrainConcurrencyGroup{{Name: "rack", LabelKey: "rack"}},
+			}},
+			errorSubstr: "groups[0]: maxConcurrent must be a positive integer",
+		},
+		{
+			name: "duplicate group names",
+			config: TomlConfig{DrainConcurrency: DrainConcurrencyConfig{
+				Groups: []DrainConcurrencyGroup{
+					{Name: "rack", LabelKey: "rack", MaxConcurrent: 1},
+					{Name: "rack", LabelKey: "row", MaxConcurrent: 1},
+				},
+			}},
+			errorSubstr: `groups[1]: duplicate group name "rack"`,
+		},
+	})
+}
+
+func TestValidateGangDrainConfig(t *testing.T) {
+	runValidationTests(t, validateGangDrainConfig, []validationTest{
+		{
+			name:   "disabled ignores the mode",
+			config: TomlConfig{GangDrain: GangDrainConfig{Mode: "Unknown"}},
+		},
+		{
+			name:   "mode defaults to Signal",
+			config: TomlConfig{GangDrain: GangDrainConfig{Enabled: true}},
+			check: func(t *testing.T, config *TomlConfig) {
+				assert.Equal(t, GangModeSignal, config.GangDrain.Mode)
+			},
+		},
+		{
+			name:   "Evict mode",
+			config: TomlConfig{GangDrain: GangDrainConfig{Enabled: true, Mode: GangModeEvict}},
+		},
+		{
+			name:   "Delegate mode",
+			config: TomlConfig{GangDrain: GangDrainConfig{Enabled: true, Mode: GangModeDelegate}},
+		},
+		{
+			name:        "invalid mode",
+			config:      TomlConfig{GangDrain: GangDrainConfig{Enabled: true, Mode: "Unknown"}},
+			errorSubstr: `gangDrain.mode "Unknown" is invalid`,
+		},
+	})
+}
+
+func TestValidateQueueIntegrationConfig(t *testing.T) {
+	runValidationTests(t, validateQueueIntegrationConfig, []validationTest{
+		{
+			name:   "disabled ignores the systems",
+			config: TomlConfig{QueueIntegration: QueueIntegrationConfig{Systems: []QueueSystem{"Slurm"}}},
+		},
+		{
+			name:   "defaults to every system and a ten minute timeout",
+			config: TomlConfig{QueueIntegration: QueueIntegrationConfig{Enabled: true}},
+			check: func(t *testing.T, config *TomlConfig) {
+				assert.Equal(t, []QueueSystem{QueueSystemKueue, QueueSystemVolcano}, config.QueueIntegration.Systems)
+				assert.Equal(t, 10*time.Minute, config.QueueIntegration.Timeout.Duration)
+			},
+		},
+		{
+			name: "explicit system and timeout are kept",
+			config: TomlConfig{QueueIntegration: QueueIntegrationConfig{
+				Enabled: true,
+				Systems: []QueueSystem{QueueSystemVolcano},
+				Timeout: Duration{Duration: time.Minute},
+			}},
+			check: func(t *testing.T, config *TomlConfig) {
+				assert.Equal(t, []QueueSystem{QueueSystemVolcano}, config.QueueIntegration.Systems)
+				assert.Equal(t, time.Minute, config.QueueIntegration.Timeout.Duration)
+			},
+		},
+		{
+			name: "unknown system",
+			config: TomlConfig{QueueIntegration: QueueIntegrationConfig{
+				Enabled: true,
+				Systems: []QueueSystem{QueueSystemKueue, "Slurm"},
+			}},
+			errorSubstr: `queueIntegration.systems: "Slurm" is invalid`,
+		},
+	})
+}
+
+func TestValidateEvictMode(t *testing.T) {
+	tests := []struct {
+		mode    EvictMode
+		wantErr bool
+	}{
+		{mode: ModeImmediateEvict},
+		{mode: ModeAllowCompletion},
+		{mode: ModeDeleteAfterTimeout},
+		{mode: "", wantErr: true},
+		{mode: "immediate", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.mode), func(t *testing.T) {
+			err := validateEvictMode(tt.mode)
+			if tt.wantErr {
+				assert.ErrorContains(t, err, "is invalid")
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidatePodDrainPolicyConfig(t *testing.T) {
+	runValidationTests(t, validatePodDrainPolicyConfig, []validationTest{
+		{
+			name:   "disabled ignores the policies",
+			config: TomlConfig{PodDrainPolicy: PodDrainPolicyConfig{Policies: []PodDrainPolicy{{}}}},
+		},
+		{
+			name: "maximum wait defaults to deleteAfterTimeoutMinutes",
+			config: TomlConfig{
+				DeleteAfterTimeoutMinutes: 45,
+				PodDrainPolicy: PodDrainPolicyConfig{
+					Enabled:  true,
+					Policies: []PodDrainPolicy{{Selector: "app=training", Mode: ModeAllowCompletion}},
+				},
+			},
+			check: func(t *testing.T, config *TomlConfig) {
+				assert.Equal(t, 45, config.PodDrainPolicy.MaxWaitMinutes)
+			},
+		},
+		{
+			name: "missing selector",
+			config: TomlConfig{PodDrainPolicy: PodDrainPolicyConfig{
+				Enabled:  true,
+				Policies: []PodDrainPolicy{{Mode: ModeImmediateEvict}},
+			}},
+			errorSubstr: "policies[0]: selector is required",
+		},
+		{
+			name: "invalid selector",
+			config: TomlConfig{PodDrainPolicy: PodDrainPolicyConfig{
+				Enabled:  true,
+				Policies: []PodDrainPolicy{{Selector: "app in (", Mode: ModeImmediateEvict}},
+			}},
+			errorSubstr: "policies[0]: invalid selector",
+		},
+		{
+			name: "invalid mode",
+			config: TomlConfig{PodDrainPolicy: PodDrainPolicyConfig{
+				Enabled:  true,
+				Policies: []PodDrainPolicy{{Selector: "app=training", Mode: "Never"}},
+			}},
+			errorSubstr: `policies[0]: mode "Never" is invalid`,
+		},
+		{
+			name: "negative maximum wait",
+			config: TomlConfig{PodDrainPolicy: PodDrainPolicyConfig{
+				Enabled:        true,
+				MaxWaitMinutes: -1,
+			}},
+			errorSubstr: "podDrainPolicy.maxWaitMinutes must be a positive integer",
+		},
+	})
+}
+
+func TestValidateAndSetDefaults(t *testing.T) {
+	runValidationTests(t, func(config *TomlConfig) error {
+		_, err := validateAndSetDefaults(config)
+		return err
+	}, []validationTest{
+		{
+			name: "defaults",
+			config: TomlConfig{
+				DrainStatus: DrainStatusConfig{Enabled: true},
+				Checkpoint:  CheckpointConfig{Enabled: true},
+			},
+			check: func(t *testing.T, config *TomlConfig) {
+				assert.Equal(t, 336, config.DrainStatus.TTLHours)
+				assert.Equal(t, 10*time.Minute, config.Checkpoint.Timeout.Duration)
+				assert.Equal(t, 60, config.DeleteAfterTimeoutMinutes)
+				assert.Equal(t, 5, config.NotReadyTimeoutMinutes)
+			},
+		},
+		{
+			name:        "negative drain status TTL",
+			config:      TomlConfig{DrainStatus: DrainStatusConfig{Enabled: true, TTLHours: -1}},
+			errorSubstr: "drainStatus.ttlHours must be a positive integer",
+		},
+		{
+			name:        "negative deleteAfterTimeout",
+			config:      TomlConfig{DeleteAfterTimeoutMinutes: -1},
+			errorSubstr: "deleteAfterTimeout must be a positive integer",
+		},
+		{
+			name:        "invalid nested config is reported",
+			config:      TomlConfig{GangDrain: GangDrainConfig{Enabled: true, Mode: "Unknown"}},
+			errorSubstr: "gangDrain.mode",
+		},
+	})
+}
+
+func TestLoadTomlConfigFromString(t *testing.T) {
+	config, err := LoadTomlConfigFromString(`
+evictionTimeoutInSeconds = "60"
+systemNamespaces = "kube-system"
+
+[drainConcurrency]
+maxConcurrentDrains = 2
+
+[[drainConcurrency.groups]]
+name = "rack"
+labelKey = "topology.kubernetes.io/rack"
+maxConcurrent = 1
+
+[gangDrain]
+enabled = true
+mode = "Evict"
+`)
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, config.EvictionTimeoutInSeconds.Duration)
+	assert.Equal(t, 2, config.DrainConcurrency.MaxConcurrentDrains)
+	assert.Equal(t, "topology.kubernetes.io/rack", config.DrainConcurrency.Groups[0].LabelKey)
+	assert.Equal(t, GangModeEvict, config.GangDrain.Mode)
+
+	_, err = LoadTomlConfigFromString(`evictionTimeoutInSeconds = "0"`)
+	assert.Error(t, err)
+}
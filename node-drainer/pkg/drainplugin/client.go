@@ -0,0 +1,185 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package drainplugin is the node-drainer side of the drain plugin protocol: a gRPC API through which
+// node-drainer hands the drain of a node over to an external plugin, the way customDrain does through a
+// templated CR.
+package drainplugin
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	v1 "k8s.io/api/core/v1"
+
+	drainpluginv1alpha1 "github.com/nvidia/nvsentinel/api/gen/go/drainplugin/v1alpha1"
+	"github.com/nvidia/nvsentinel/data-models/pkg/protos"
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/config"
+)
+
+type Client struct {
+	conn    *grpc.ClientConn
+	plugin  drainpluginv1alpha1.DrainPluginServiceClient
+	timeout time.Duration
+}
+
+// NewClient creates a client for the plugin at cfg.Endpoint. The connection is established lazily, so
+// a plugin that is not up yet does not keep node-drainer from starting.
+func NewClient(cfg config.DrainPluginConfig) (*Client, error) {
+	dialOpts, err := dialOptions(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := grpc.NewClient(cfg.Endpoint, dialOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create drain plugin client for %s: %w", cfg.Endpoint, err)
+	}
+
+	return NewClientFromConn(conn, cfg.RequestTimeout.Duration), nil
+}
+
+// NewClientFromConn creates a client using an existing connection to the plugin
+func NewClientFromConn(conn *grpc.ClientConn, timeout time.Duration) *Client {
+	return &Client{
+		conn:    conn,
+		plugin:  drainpluginv1alpha1.NewDrainPluginServiceClient(conn),
+		timeout: timeout,
+	}
+}
+
+func dialOptions(cfg config.DrainPluginConfig) ([]grpc.DialOption, error) {
+	if cfg.Insecure {
+		return []grpc.DialOption{
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		}, nil
+	}
+
+	caPEM, err := os.ReadFile(cfg.CACertPath)
+	if err != nil {
+		return nil, fmt.Errorf("reading drain plugin CA bundle %q: %w", cfg.CACertPath, err)
+	}
+
+	certPool := x509.NewCertPool()
+	if !certPool.AppendCertsFromPEM(caPEM) {
+		return nil, fmt.Errorf("failed to parse drain plugin CA bundle from %q", cfg.CACertPath)
+	}
+
+	tlsConfig := &tls.Config{
+		RootCAs:    certPool,
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if cfg.ClientCertPath != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.ClientCertPath, cfg.ClientKeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("loading drain plugin client certificate: %w", err)
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return []grpc.DialOption{
+		grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)),
+	}, nil
+}
+
+// StartDrain asks the plugin to drain the node for the health event, returning the state of the drain
+func (c *Client) StartDrain(ctx context.Context, request *drainpluginv1alpha1.StartDrainRequest) (
+	drainpluginv1alpha1.DrainState, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	resp, err := c.plugin.StartDrain(ctx, request)
+	if err != nil {
+		return drainpluginv1alpha1.DrainState_DRAIN_STATE_UNSPECIFIED,
+			fmt.Errorf("failed to start drain of node %s: %w", request.GetNodeName(), err)
+	}
+
+	return resp.GetState(), nil
+}
+
+// GetDrainStatus returns the state of the drain of the node for the health event, or the aggregate state
+// of all drains of the node when eventID is empty
+func (c *Client) GetDrainStatus(ctx context.Context, nodeName, eventID string) (
+	drainpluginv1alpha1.DrainState, string, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	resp, err := c.plugin.GetDrainStatus(ctx, &drainpluginv1alpha1.GetDrainStatusRequest{
+		NodeName: nodeName,
+		EventId:  eventID,
+	})
+	if err != nil {
+		return drainpluginv1alpha1.DrainState_DRAIN_STATE_UNSPECIFIED, "",
+			fmt.Errorf("failed to get drain status of node %s: %w", nodeName, err)
+	}
+
+	return resp.GetState(), resp.GetMessage(), nil
+}
+
+// CancelDrain stops the drain of the node for the health event and releases the plugin state for it
+func (c *Client) CancelDrain(ctx context.Context, nodeName, eventID string) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	if _, err := c.plugin.CancelDrain(ctx, &drainpluginv1alpha1.CancelDrainRequest{
+		NodeName: nodeName,
+		EventId:  eventID,
+	}); err != nil {
+		return fmt.Errorf("failed to cancel drain of node %s: %w", nodeName, err)
+	}
+
+	return nil
+}
+
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// NewStartDrainRequest builds the StartDrain request for a health event and the evictable pods of the node
+func NewStartDrainRequest(healthEvent *protos.HealthEvent, eventID string,
+	pods []*v1.Pod) *drainpluginv1alpha1.StartDrainRequest {
+	request := &drainpluginv1alpha1.StartDrainRequest{
+		NodeName:          healthEvent.NodeName,
+		EventId:           eventID,
+		CheckName:         healthEvent.CheckName,
+		RecommendedAction: healthEvent.RecommendedAction.String(),
+		ErrorCodes:        healthEvent.ErrorCode,
+		Reason:            healthEvent.Message,
+	}
+
+	for _, entity := range healthEvent.EntitiesImpacted {
+		request.ImpactedEntities = append(request.ImpactedEntities, &drainpluginv1alpha1.Entity{
+			Type:  entity.EntityType,
+			Value: entity.EntityValue,
+		})
+	}
+
+	for _, pod := range pods {
+		request.Pods = append(request.Pods, &drainpluginv1alpha1.PodReference{
+			Namespace: pod.Namespace,
+			Name:      pod.Name,
+		})
+	}
+
+	return request
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drainplugin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	drainpluginv1alpha1 "github.com/nvidia/nvsentinel/api/gen/go/drainplugin/v1alpha1"
+	"github.com/nvidia/nvsentinel/data-models/pkg/protos"
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/config"
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/drainplugin/fakeplugin"
+)
+
+func newTestClient(t *testing.T) (*Client, *fakeplugin.Plugin) {
+	t.Helper()
+
+	server, err := fakeplugin.NewServer()
+	require.NoError(t, err)
+	t.Cleanup(server.Stop)
+
+	return NewClientFromConn(server.Conn, 5*time.Second), server.Plugin
+}
+
+func TestNewClient_RequiresReadableCA(t *testing.T) {
+	_, err := NewClient(config.DrainPluginConfig{
+		Enabled:    true,
+		Endpoint:   "localhost:50051",
+		CACertPath: "/nonexistent/ca.crt",
+	})
+	assert.Error(t, err)
+
+	client, err := NewClient(config.DrainPluginConfig{
+		Enabled:  true,
+		Endpoint: "localhost:50051",
+		Insecure: true,
+	})
+	require.NoError(t, err)
+	assert.NoError(t, client.Close())
+}
+
+func TestClient_DrainLifecycle(t *testing.T) {
+	ctx := context.Background()
+	client, plugin := newTestClient(t)
+
+	state, _, err := client.GetDrainStatus(ctx, "node-1", "event-1")
+	require.NoError(t, err)
+	assert.Equal(t, drainpluginv1alpha1.DrainState_DRAIN_STATE_NOT_FOUND, state)
+
+	state, err = client.StartDrain(ctx, &drainpluginv1alpha1.StartDrainRequest{NodeName: "node-1", EventId: "event-1"})
+	require.NoError(t, err)
+	assert.Equal(t, drainpluginv1alpha1.DrainState_DRAIN_STATE_IN_PROGRESS, state)
+
+	state, _, err = client.GetDrainStatus(ctx, "node-1", "")
+	require.NoError(t, err)
+	assert.Equal(t, drainpluginv1alpha1.DrainState_DRAIN_STATE_IN_PROGRESS, state, "aggregate state of the node")
+
+	plugin.SetState("node-1", "event-1", drainpluginv1alpha1.DrainState_DRAIN_STATE_FAILED, "pods stuck")
+
+	state, message, err := client.GetDrainStatus(ctx, "node-1", "event-1")
+	require.NoError(t, err)
+	assert.Equal(t, drainpluginv1alpha1.DrainState_DRAIN_STATE_FAILED, state)
+	assert.Equal(t, "pods stuck", message)
+
+	require.NoError(t, client.CancelDrain(ctx, "node-1", "event-1"))
+
+	state, _, err = client.GetDrainStatus(ctx, "node-1", "event-1")
+	require.NoError(t, err)
+	assert.Equal(t, drainpluginv1alpha1.DrainState_DRAIN_STATE_NOT_FOUND, state)
+
+	require.Len(t, plugin.CancelRequests(), 1)
+	assert.Equal(t, "event-1", plugin.CancelRequests()[0].GetEventId())
+}
+
+func TestNewStartDrainRequest(t *testing.T) {
+	healthEvent := &protos.HealthEvent{
+		NodeName:          "node-1",
+		CheckName:         "GpuXidError",
+		Message:           "XID 79",
+		RecommendedAction: protos.RecommendedAction_RESTART_BM,
+		ErrorCode:         []string{"79"},
+		EntitiesImpacted:  []*protos.Entity{{EntityType: "GPU_UUID", EntityValue: "GPU-123"}},
+	}
+	pods := []*v1.Pod{{ObjectMeta: metav1.ObjectMeta{Namespace: "slinky", Name: "slurmd-0"}}}
+
+	request := NewStartDrainRequest(healthEvent, "event-1", pods)
+
+	assert.Equal(t, "node-1", request.GetNodeName())
+	assert.Equal(t, "event-1", request.GetEventId())
+	assert.Equal(t, "GpuXidError", request.GetCheckName())
+	assert.Equal(t, "XID 79", request.GetReason())
+	assert.Equal(t, "RESTART_BM", request.GetRecommendedAction())
+	assert.Equal(t, []string{"79"}, request.GetErrorCodes())
+	require.Len(t, request.GetImpactedEntities(), 1)
+	assert.Equal(t, "GPU-123", request.GetImpactedEntities()[0].GetValue())
+	require.Len(t, request.GetPods(), 1)
+	assert.Equal(t, "slinky", request.GetPods()[0].GetNamespace())
+	assert.Equal(t, "slurmd-0", request.GetPods()[0].GetName())
+}
@@ -0,0 +1,185 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fakeplugin is an in-memory drain plugin for tests. Drains stay in progress until the test moves
+// them to another state with SetState.
+package fakeplugin
+
+import (
+	"context"
+	"log/slog"
+	"net"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+
+	drainpluginv1alpha1 "github.com/nvidia/nvsentinel/api/gen/go/drainplugin/v1alpha1"
+)
+
+type drainKey struct {
+	nodeName string
+	eventID  string
+}
+
+type drain struct {
+	state   drainpluginv1alpha1.DrainState
+	message string
+}
+
+type Plugin struct {
+	drainpluginv1alpha1.UnimplementedDrainPluginServiceServer
+
+	mu        sync.Mutex
+	drains    map[drainKey]*drain
+	started   []*drainpluginv1alpha1.StartDrainRequest
+	cancelled []*drainpluginv1alpha1.CancelDrainRequest
+}
+
+func NewPlugin() *Plugin {
+	return &Plugin{drains: make(map[drainKey]*drain)}
+}
+
+// SetState moves the drain of the node for the event to the given state, creating it if needed
+func (p *Plugin) SetState(nodeName, eventID string, state drainpluginv1alpha1.DrainState, message string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.drains[drainKey{nodeName: nodeName, eventID: eventID}] = &drain{state: state, message: message}
+}
+
+// StartRequests returns the StartDrain requests received so far
+func (p *Plugin) StartRequests() []*drainpluginv1alpha1.StartDrainRequest {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return append([]*drainpluginv1alpha1.StartDrainRequest{}, p.started...)
+}
+
+// CancelRequests returns the CancelDrain requests received so far
+func (p *Plugin) CancelRequests() []*drainpluginv1alpha1.CancelDrainRequest {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return append([]*drainpluginv1alpha1.CancelDrainRequest{}, p.cancelled...)
+}
+
+func (p *Plugin) StartDrain(_ context.Context,
+	req *drainpluginv1alpha1.StartDrainRequest) (*drainpluginv1alpha1.StartDrainResponse, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.started = append(p.started, proto.Clone(req).(*drainpluginv1alpha1.StartDrainRequest))
+
+	key := drainKey{nodeName: req.GetNodeName(), eventID: req.GetEventId()}
+	if _, ok := p.drains[key]; !ok {
+		p.drains[key] = &drain{state: drainpluginv1alpha1.DrainState_DRAIN_STATE_IN_PROGRESS}
+	}
+
+	return &drainpluginv1alpha1.StartDrainResponse{State: p.drains[key].state}, nil
+}
+
+func (p *Plugin) GetDrainStatus(_ context.Context,
+	req *drainpluginv1alpha1.GetDrainStatusRequest) (*drainpluginv1alpha1.GetDrainStatusResponse, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if req.GetEventId() != "" {
+		d, ok := p.drains[drainKey{nodeName: req.GetNodeName(), eventID: req.GetEventId()}]
+		if !ok {
+			return &drainpluginv1alpha1.GetDrainStatusResponse{
+				State: drainpluginv1alpha1.DrainState_DRAIN_STATE_NOT_FOUND,
+			}, nil
+		}
+
+		return &drainpluginv1alpha1.GetDrainStatusResponse{State: d.state, Message: d.message}, nil
+	}
+
+	state := drainpluginv1alpha1.DrainState_DRAIN_STATE_NOT_FOUND
+
+	for key, d := range p.drains {
+		if key.nodeName != req.GetNodeName() {
+			continue
+		}
+
+		if d.state == drainpluginv1alpha1.DrainState_DRAIN_STATE_IN_PROGRESS {
+			return &drainpluginv1alpha1.GetDrainStatusResponse{State: d.state}, nil
+		}
+
+		if d.state == drainpluginv1alpha1.DrainState_DRAIN_STATE_COMPLETED {
+			state = d.state
+		}
+	}
+
+	return &drainpluginv1alpha1.GetDrainStatusResponse{State: state}, nil
+}
+
+func (p *Plugin) CancelDrain(_ context.Context,
+	req *drainpluginv1alpha1.CancelDrainRequest) (*drainpluginv1alpha1.CancelDrainResponse, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.cancelled = append(p.cancelled, proto.Clone(req).(*drainpluginv1alpha1.CancelDrainRequest))
+	delete(p.drains, drainKey{nodeName: req.GetNodeName(), eventID: req.GetEventId()})
+
+	return &drainpluginv1alpha1.CancelDrainResponse{}, nil
+}
+
+// Server serves a Plugin over an in-memory connection
+type Server struct {
+	Plugin *Plugin
+	Conn   *grpc.ClientConn
+
+	grpcServer *grpc.Server
+}
+
+// NewServer starts serving a new Plugin and returns a client connection to it
+func NewServer() (*Server, error) {
+	lis := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	plugin := NewPlugin()
+
+	drainpluginv1alpha1.RegisterDrainPluginServiceServer(server, plugin)
+
+	go func() {
+		if err := server.Serve(lis); err != nil {
+			slog.Error("Fake drain plugin server exited with error", "error", err)
+		}
+	}()
+
+	conn, err := grpc.NewClient(
+		"passthrough://bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return lis.Dial()
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		server.Stop()
+		return nil, err
+	}
+
+	return &Server{
+		Plugin:     plugin,
+		Conn:       conn,
+		grpcServer: server,
+	}, nil
+}
+
+func (s *Server) Stop() {
+	s.Conn.Close()
+	s.grpcServer.GracefulStop()
+}
@@ -0,0 +1,463 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package drainstatus maintains a NodeDrain custom resource per drain session, so that the progress of
+// a drain can be followed in one place rather than across the datastore, node events and node labels.
+package drainstatus
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	drainv1alpha1 "github.com/nvidia/nvsentinel/node-drainer/api/v1alpha1"
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/config"
+)
+
+// Annotation keys shared with the janitor TTL reconciler of maintenance CRs
+const (
+	// TTLAnnotation is the time-to-live of a finished NodeDrain, e.g. "336h"
+	TTLAnnotation = "nvsentinel.nvidia.com/ttl"
+	// ExpiryAnnotation is the RFC3339 time after which a finished NodeDrain is deleted
+	ExpiryAnnotation = "nvsentinel.nvidia.com/expiry"
+	// PreserveAnnotation, when set to "true", prevents the deletion of a NodeDrain
+	PreserveAnnotation = "nvsentinel.nvidia.com/preserve"
+)
+
+const (
+	// maxListedPods bounds the pod lists of the status so that large nodes do not bloat the resource
+	maxListedPods = 50
+
+	gcInterval = 10 * time.Minute
+
+	// maxNameLength is the maximum length of the name of a cluster-scoped resource
+	maxNameLength = 253
+)
+
+// Progress is the state of a drain session observed by one pass of the reconciler
+type Progress struct {
+	NodeName           string
+	HealthEventID      string
+	CheckName          string
+	PartialDrainEntity string
+	// NamespaceModes are the modes chosen for the namespaces drained by this pass
+	NamespaceModes map[string]string
+	// RemainingPods are the pods still to be drained, as namespace/name
+	RemainingPods []string
+	// ForceDeleteDeadline is the time at which the next remaining pods are force deleted, if any
+	ForceDeleteDeadline time.Time
+	Message             string
+}
+
+// session holds the pods node-drainer acted on during a drain session, as namespace/name
+type session struct {
+	evicted      map[string]struct{}
+	forceDeleted map[string]struct{}
+	pdbBlocked   map[string]struct{}
+}
+
+func newSession() *session {
+	return &session{
+		evicted:      make(map[string]struct{}),
+		forceDeleted: make(map[string]struct{}),
+		pdbBlocked:   make(map[string]struct{}),
+	}
+}
+
+// Recorder writes the NodeDrain resources of drain sessions. It observes the evictions and force
+// deletions of the informers to count the pods acted on.
+type Recorder struct {
+	client client.Client
+	ttl    time.Duration
+	clock  clock.Clock
+
+	mu       sync.Mutex
+	sessions map[string]map[string]*session // node -> health event ID -> session
+}
+
+func NewRecorder(cfg config.DrainStatusConfig, c client.Client) *Recorder {
+	return &Recorder{
+		client:   c,
+		ttl:      time.Duration(cfg.TTLHours) * time.Hour,
+		clock:    clock.RealClock{},
+		sessions: make(map[string]map[string]*session),
+	}
+}
+
+// Name returns the name of the NodeDrain of a drain session
+func Name(nodeName, healthEventID string) string {
+	suffix := "-" + strings.ToLower(healthEventID)
+	if len(nodeName)+len(suffix) > maxNameLength {
+		nodeName = nodeName[:maxNameLength-len(suffix)]
+	}
+
+	return nodeName + suffix
+}
+
+func (r *Recorder) PodEvicted(pod *v1.Pod) {
+	r.observe(pod, func(s *session, key string) {
+		s.evicted[key] = struct{}{}
+		delete(s.pdbBlocked, key)
+	})
+}
+
+func (r *Recorder) PodEvictionBlocked(pod *v1.Pod) {
+	r.observe(pod, func(s *session, key string) {
+		s.pdbBlocked[key] = struct{}{}
+	})
+}
+
+func (r *Recorder) PodForceDeleted(pod *v1.Pod) {
+	r.observe(pod, func(s *session, key string) {
+		s.forceDeleted[key] = struct{}{}
+		delete(s.pdbBlocked, key)
+	})
+}
+
+// observe applies fn to the active sessions of the node of the pod
+func (r *Recorder) observe(pod *v1.Pod, fn func(s *session, key string)) {
+	key := pod.Namespace + "/" + pod.Name
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, s := range r.sessions[pod.Spec.NodeName] {
+		fn(s, key)
+	}
+}
+
+// Update creates or updates the NodeDrain of the drain session with its progress. The NodeDrain of a
+// finished session is left as is.
+func (r *Recorder) Update(ctx context.Context, progress Progress) error {
+	r.mu.Lock()
+
+	nodeSessions, ok := r.sessions[progress.NodeName]
+	if !ok {
+		nodeSessions = make(map[string]*session)
+		r.sessions[progress.NodeName] = nodeSessions
+	}
+
+	if _, ok := nodeSessions[progress.HealthEventID]; !ok {
+		nodeSessions[progress.HealthEventID] = newSession()
+	}
+
+	r.mu.Unlock()
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		nodeDrain, err := r.getOrCreate(ctx, progress)
+		if err != nil {
+			return err
+		}
+
+		if nodeDrain.IsFinished() {
+			return nil
+		}
+
+		status := nodeDrain.Status.DeepCopy()
+		status.Phase = drainv1alpha1.NodeDrainPhaseDraining
+		status.Message = progress.Message
+
+		if status.StartTime == nil {
+			now := metav1.NewTime(r.clock.Now())
+			status.StartTime = &now
+		}
+
+		status.Namespaces = mergeNamespaceModes(status.Namespaces, progress.NamespaceModes)
+		status.RemainingPods = count(len(progress.RemainingPods))
+		status.RemainingPodNames = truncate(progress.RemainingPods)
+		status.ForceDeleteDeadline = nil
+
+		if !progress.ForceDeleteDeadline.IsZero() {
+			deadline := metav1.NewTime(progress.ForceDeleteDeadline)
+			status.ForceDeleteDeadline = &deadline
+		}
+
+		r.setPodCounts(status, progress.NodeName, progress.HealthEventID)
+
+		if equality.Semantic.DeepEqual(status, &nodeDrain.Status) {
+			return nil
+		}
+
+		nodeDrain.Status = *status
+
+		return r.client.Status().Update(ctx, nodeDrain)
+	})
+}
+
+// Finish records the final phase of the drain session and starts the TTL of its NodeDrain. Nothing is
+// recorded for sessions without a NodeDrain, e.g. events whose node was already drained.
+func (r *Recorder) Finish(ctx context.Context, nodeName, healthEventID string,
+	phase drainv1alpha1.NodeDrainPhase, message string) error {
+	name := Name(nodeName, healthEventID)
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		nodeDrain := &drainv1alpha1.NodeDrain{}
+		if err := r.client.Get(ctx, client.ObjectKey{Name: name}, nodeDrain); err != nil {
+			return err
+		}
+
+		if !nodeDrain.IsFinished() {
+			now := metav1.NewTime(r.clock.Now())
+
+			nodeDrain.Status.Phase = phase
+			nodeDrain.Status.Message = message
+			nodeDrain.Status.CompletionTime = &now
+			nodeDrain.Status.ForceDeleteDeadline = nil
+
+			if phase == drainv1alpha1.NodeDrainPhaseSucceeded {
+				nodeDrain.Status.RemainingPods = 0
+				nodeDrain.Status.RemainingPodNames = nil
+				nodeDrain.Status.PDBBlockedPods = nil
+			}
+
+			r.setPodCounts(&nodeDrain.Status, nodeName, healthEventID)
+
+			if err := r.client.Status().Update(ctx, nodeDrain); err != nil {
+				return err
+			}
+		}
+
+		return r.setExpiry(ctx, nodeDrain)
+	})
+
+	r.mu.Lock()
+	delete(r.sessions[nodeName], healthEventID)
+
+	if len(r.sessions[nodeName]) == 0 {
+		delete(r.sessions, nodeName)
+	}
+
+	r.mu.Unlock()
+
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to record the %s phase of NodeDrain %s: %w", phase, name, err)
+	}
+
+	return nil
+}
+
+// RunGarbageCollector deletes the expired NodeDrains until ctx is cancelled
+func (r *Recorder) RunGarbageCollector(ctx context.Context) {
+	ticker := time.NewTicker(gcInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := r.collectGarbage(ctx); err != nil {
+			slog.WarnContext(ctx, "Failed to delete expired NodeDrains", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *Recorder) collectGarbage(ctx context.Context) error {
+	nodeDrains := &drainv1alpha1.NodeDrainList{}
+	if err := r.client.List(ctx, nodeDrains); err != nil {
+		return fmt.Errorf("failed to list NodeDrains: %w", err)
+	}
+
+	for i := range nodeDrains.Items {
+		nodeDrain := &nodeDrains.Items[i]
+
+		if !r.isExpired(ctx, nodeDrain) {
+			continue
+		}
+
+		slog.InfoContext(ctx, "Deleting expired NodeDrain",
+			"name", nodeDrain.Name,
+			"expiry", nodeDrain.Annotations[ExpiryAnnotation])
+
+		if err := r.client.Delete(ctx, nodeDrain); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete expired NodeDrain %s: %w", nodeDrain.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *Recorder) isExpired(ctx context.Context, nodeDrain *drainv1alpha1.NodeDrain) bool {
+	if nodeDrain.Annotations[PreserveAnnotation] == "true" || !nodeDrain.DeletionTimestamp.IsZero() {
+		return false
+	}
+
+	value, ok := nodeDrain.Annotations[ExpiryAnnotation]
+	if !ok {
+		return false
+	}
+
+	expiry, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		slog.WarnContext(ctx, "Invalid NodeDrain expiry, ignoring",
+			"name", nodeDrain.Name,
+			"expiry", value,
+			"error", err)
+
+		return false
+	}
+
+	return !r.clock.Now().Before(expiry)
+}
+
+func (r *Recorder) getOrCreate(ctx context.Context, progress Progress) (*drainv1alpha1.NodeDrain, error) {
+	nodeDrain := &drainv1alpha1.NodeDrain{}
+
+	err := r.client.Get(ctx, client.ObjectKey{Name: Name(progress.NodeName, progress.HealthEventID)}, nodeDrain)
+	if err == nil {
+		return nodeDrain, nil
+	}
+
+	if !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	nodeDrain = &drainv1alpha1.NodeDrain{
+		ObjectMeta: metav1.ObjectMeta{Name: Name(progress.NodeName, progress.HealthEventID)},
+		Spec: drainv1alpha1.NodeDrainSpec{
+			NodeName:           progress.NodeName,
+			HealthEventID:      progress.HealthEventID,
+			CheckName:          progress.CheckName,
+			PartialDrainEntity: progress.PartialDrainEntity,
+		},
+	}
+
+	if err := r.client.Create(ctx, nodeDrain); err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "Created NodeDrain", "name", nodeDrain.Name, "node", progress.NodeName)
+
+	return nodeDrain, nil
+}
+
+// setExpiry annotates the finished NodeDrain with its TTL and expiry, unless an expiry was set already.
+// An explicit TTL annotation takes precedence over the configured TTL.
+func (r *Recorder) setExpiry(ctx context.Context, nodeDrain *drainv1alpha1.NodeDrain) error {
+	if _, ok := nodeDrain.Annotations[ExpiryAnnotation]; ok {
+		return nil
+	}
+
+	finishedAt := r.clock.Now()
+	if nodeDrain.Status.CompletionTime != nil {
+		finishedAt = nodeDrain.Status.CompletionTime.Time
+	}
+
+	ttl := r.ttl
+
+	if value, ok := nodeDrain.Annotations[TTLAnnotation]; ok {
+		if d, err := time.ParseDuration(value); err == nil && d > 0 {
+			ttl = d
+		}
+	}
+
+	if nodeDrain.Annotations == nil {
+		nodeDrain.Annotations = make(map[string]string)
+	}
+
+	nodeDrain.Annotations[TTLAnnotation] = ttl.String()
+	nodeDrain.Annotations[ExpiryAnnotation] = finishedAt.Add(ttl).UTC().Format(time.RFC3339)
+
+	return r.client.Update(ctx, nodeDrain)
+}
+
+// setPodCounts sets the pods acted on by the session. The counts recorded before a restart of
+// node-drainer are kept when the session saw fewer pods since.
+func (r *Recorder) setPodCounts(status *drainv1alpha1.NodeDrainStatus, nodeName, healthEventID string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	s, ok := r.sessions[nodeName][healthEventID]
+	if !ok {
+		return
+	}
+
+	status.EvictedPods = max(status.EvictedPods, count(len(s.evicted)))
+	status.ForceDeletedPods = max(status.ForceDeletedPods, count(len(s.forceDeleted)))
+
+	if status.Phase != drainv1alpha1.NodeDrainPhaseSucceeded {
+		status.PDBBlockedPods = truncate(sortedKeys(s.pdbBlocked))
+	}
+}
+
+// mergeNamespaceModes adds the modes chosen by a pass to the namespaces drained so far
+func mergeNamespaceModes(namespaces []drainv1alpha1.NamespaceDrain,
+	modes map[string]string) []drainv1alpha1.NamespaceDrain {
+	for namespace, mode := range modes {
+		i := slices.IndexFunc(namespaces, func(n drainv1alpha1.NamespaceDrain) bool { return n.Name == namespace })
+		if i < 0 {
+			namespaces = append(namespaces, drainv1alpha1.NamespaceDrain{Name: namespace})
+			i = len(namespaces) - 1
+		}
+
+		if !slices.Contains(namespaces[i].Modes, mode) {
+			namespaces[i].Modes = append(namespaces[i].Modes, mode)
+		}
+	}
+
+	sort.Slice(namespaces, func(i, j int) bool { return namespaces[i].Name < namespaces[j].Name })
+
+	return namespaces
+}
+
+func count(n int) int32 {
+	if n > math.MaxInt32 {
+		return math.MaxInt32
+	}
+
+	return int32(n)
+}
+
+func sortedKeys(set map[string]struct{}) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+func truncate(pods []string) []string {
+	if len(pods) == 0 {
+		return nil
+	}
+
+	if len(pods) > maxListedPods {
+		return pods[:maxListedPods]
+	}
+
+	return pods
+}
@@ -0,0 +1,182 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drainstatus
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clocktesting "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	drainv1alpha1 "github.com/nvidia/nvsentinel/node-drainer/api/v1alpha1"
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/config"
+)
+
+func newTestRecorder(t *testing.T) (*Recorder, client.Client, *clocktesting.FakeClock) {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, drainv1alpha1.AddToScheme(scheme))
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithStatusSubresource(&drainv1alpha1.NodeDrain{}).
+		Build()
+	clock := clocktesting.NewFakeClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	r := NewRecorder(config.DrainStatusConfig{Enabled: true, TTLHours: 24}, c)
+	r.clock = clock
+
+	return r, c, clock
+}
+
+func testPod(namespace, name string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec:       v1.PodSpec{NodeName: "node-1"},
+	}
+}
+
+func getNodeDrain(t *testing.T, c client.Client) *drainv1alpha1.NodeDrain {
+	t.Helper()
+
+	nodeDrain := &drainv1alpha1.NodeDrain{}
+	require.NoError(t, c.Get(context.Background(), client.ObjectKey{Name: "node-1-event-1"}, nodeDrain))
+
+	return nodeDrain
+}
+
+func TestRecorder_DrainSession(t *testing.T) {
+	ctx := context.Background()
+	r, c, clock := newTestRecorder(t)
+
+	deadline := clock.Now().Add(time.Hour)
+
+	require.NoError(t, r.Update(ctx, Progress{
+		NodeName:            "node-1",
+		HealthEventID:       "EVENT-1",
+		CheckName:           "GpuXidError",
+		NamespaceModes:      map[string]string{"team-b": "DeleteAfterTimeout", "team-a": "Immediate"},
+		RemainingPods:       []string{"team-a/train-0", "team-a/train-1", "team-b/infer-0"},
+		ForceDeleteDeadline: deadline,
+	}))
+
+	nodeDrain := getNodeDrain(t, c)
+	assert.Equal(t, "node-1", nodeDrain.Spec.NodeName)
+	assert.Equal(t, "EVENT-1", nodeDrain.Spec.HealthEventID)
+	assert.Equal(t, "GpuXidError", nodeDrain.Spec.CheckName)
+	assert.Equal(t, drainv1alpha1.NodeDrainPhaseDraining, nodeDrain.Status.Phase)
+	assert.Equal(t, int32(3), nodeDrain.Status.RemainingPods)
+	assert.Equal(t, []drainv1alpha1.NamespaceDrain{
+		{Name: "team-a", Modes: []string{"Immediate"}},
+		{Name: "team-b", Modes: []string{"DeleteAfterTimeout"}},
+	}, nodeDrain.Status.Namespaces)
+	require.NotNil(t, nodeDrain.Status.ForceDeleteDeadline)
+	assert.True(t, deadline.Equal(nodeDrain.Status.ForceDeleteDeadline.Time))
+
+	r.PodEvicted(testPod("team-a", "train-0"))
+	r.PodEvictionBlocked(testPod("team-a", "train-1"))
+	r.PodForceDeleted(testPod("team-b", "infer-0"))
+	r.PodEvicted(testPod("other", "pod-on-other-node"))
+
+	require.NoError(t, r.Update(ctx, Progress{
+		NodeName:       "node-1",
+		HealthEventID:  "EVENT-1",
+		NamespaceModes: map[string]string{"team-a": "AllowCompletion"},
+		RemainingPods:  []string{"team-a/train-1"},
+	}))
+
+	nodeDrain = getNodeDrain(t, c)
+	assert.Equal(t, int32(1), nodeDrain.Status.RemainingPods)
+	assert.Equal(t, []string{"team-a/train-1"}, nodeDrain.Status.RemainingPodNames)
+	assert.Equal(t, int32(2), nodeDrain.Status.EvictedPods)
+	assert.Equal(t, int32(1), nodeDrain.Status.ForceDeletedPods)
+	assert.Equal(t, []string{"team-a/train-1"}, nodeDrain.Status.PDBBlockedPods)
+	assert.Equal(t, []string{"Immediate", "AllowCompletion"}, nodeDrain.Status.Namespaces[0].Modes)
+	assert.Nil(t, nodeDrain.Status.ForceDeleteDeadline)
+
+	clock.Step(10 * time.Minute)
+	require.NoError(t, r.Finish(ctx, "node-1", "EVENT-1", drainv1alpha1.NodeDrainPhaseSucceeded, ""))
+
+	nodeDrain = getNodeDrain(t, c)
+	assert.Equal(t, drainv1alpha1.NodeDrainPhaseSucceeded, nodeDrain.Status.Phase)
+	assert.Equal(t, int32(0), nodeDrain.Status.RemainingPods)
+	assert.Empty(t, nodeDrain.Status.PDBBlockedPods)
+	require.NotNil(t, nodeDrain.Status.CompletionTime)
+	assert.Equal(t, "24h0m0s", nodeDrain.Annotations[TTLAnnotation])
+	assert.Equal(t, "2026-01-02T00:10:00Z", nodeDrain.Annotations[ExpiryAnnotation])
+
+	// A finished session is not reopened by a late update
+	require.NoError(t, r.Update(ctx, Progress{NodeName: "node-1", HealthEventID: "EVENT-1"}))
+	assert.Equal(t, drainv1alpha1.NodeDrainPhaseSucceeded, getNodeDrain(t, c).Status.Phase)
+}
+
+func TestRecorder_FinishWithoutSession(t *testing.T) {
+	r, c, _ := newTestRecorder(t)
+
+	require.NoError(t, r.Finish(context.Background(), "node-1", "event-1", drainv1alpha1.NodeDrainPhaseCancelled, ""))
+
+	list := &drainv1alpha1.NodeDrainList{}
+	require.NoError(t, c.List(context.Background(), list))
+	assert.Empty(t, list.Items)
+}
+
+func TestRecorder_CollectGarbage(t *testing.T) {
+	ctx := context.Background()
+	r, c, clock := newTestRecorder(t)
+
+	for _, eventID := range []string{"event-1", "event-2", "event-3"} {
+		require.NoError(t, r.Update(ctx, Progress{NodeName: "node-1", HealthEventID: eventID}))
+	}
+
+	require.NoError(t, r.Finish(ctx, "node-1", "event-1", drainv1alpha1.NodeDrainPhaseFailed, "timed out"))
+	require.NoError(t, r.Finish(ctx, "node-1", "event-2", drainv1alpha1.NodeDrainPhaseSucceeded, ""))
+
+	preserved := &drainv1alpha1.NodeDrain{}
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Name: "node-1-event-2"}, preserved))
+	preserved.Annotations[PreserveAnnotation] = "true"
+	require.NoError(t, c.Update(ctx, preserved))
+
+	require.NoError(t, r.collectGarbage(ctx))
+	assert.NoError(t, c.Get(ctx, client.ObjectKey{Name: "node-1-event-1"}, &drainv1alpha1.NodeDrain{}))
+
+	clock.Step(25 * time.Hour)
+	require.NoError(t, r.collectGarbage(ctx))
+
+	err := c.Get(ctx, client.ObjectKey{Name: "node-1-event-1"}, &drainv1alpha1.NodeDrain{})
+	assert.True(t, apierrors.IsNotFound(err), "expired NodeDrain should be deleted")
+	assert.NoError(t, c.Get(ctx, client.ObjectKey{Name: "node-1-event-2"}, &drainv1alpha1.NodeDrain{}),
+		"preserved NodeDrain should be kept")
+	assert.NoError(t, c.Get(ctx, client.ObjectKey{Name: "node-1-event-3"}, &drainv1alpha1.NodeDrain{}),
+		"NodeDrain of an active session should be kept")
+}
+
+func TestName(t *testing.T) {
+	assert.Equal(t, "node-1-65a1b2", Name("node-1", "65A1B2"))
+
+	long := Name(strings.Repeat("n", 300), "event-1")
+	assert.Len(t, long, maxNameLength)
+	assert.Contains(t, long, "-event-1")
+}
@@ -0,0 +1,146 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluator
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	drainpluginv1alpha1 "github.com/nvidia/nvsentinel/api/gen/go/drainplugin/v1alpha1"
+	"github.com/nvidia/nvsentinel/commons/pkg/tracing"
+	"github.com/nvidia/nvsentinel/data-models/pkg/model"
+	"github.com/nvidia/nvsentinel/data-models/pkg/protos"
+)
+
+// evaluatePluginDrain is evaluateCustomDrain for drain plugins: the drain of the event is started on the
+// plugin unless the plugin already drains the node for another event, and is polled until it completes.
+func (e *NodeDrainEvaluator) evaluatePluginDrain(ctx context.Context, healthEvent model.HealthEventWithStatus,
+	partialDrainEntity *protos.Entity) (*DrainActionResult, error) {
+	nodeName := healthEvent.HealthEvent.NodeName
+	eventID := healthEvent.HealthEvent.Id
+
+	if eventID == "" {
+		return nil, fmt.Errorf("health event for node %s is missing Id, cannot identify the plugin drain", nodeName)
+	}
+
+	span := tracing.SpanFromContext(ctx)
+
+	state, message, err := e.drainPluginClient.GetDrainStatus(ctx, nodeName, eventID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get drain status from drain plugin",
+			"node", nodeName,
+			"error", err)
+		span.SetAttributes(attribute.String("node_drainer.drain_plugin.state", "error"))
+
+		return &DrainActionResult{
+			Action:    ActionWait,
+			WaitDelay: customDrainPollInterval,
+		}, nil
+	}
+
+	span.SetAttributes(attribute.String("node_drainer.drain_plugin.state", state.String()))
+
+	switch state {
+	case drainpluginv1alpha1.DrainState_DRAIN_STATE_NOT_FOUND:
+		return e.evaluateUnstartedPluginDrain(ctx, nodeName, partialDrainEntity)
+
+	case drainpluginv1alpha1.DrainState_DRAIN_STATE_COMPLETED:
+		slog.InfoContext(ctx, "Drain plugin completed the drain", "node", nodeName)
+
+		return &DrainActionResult{
+			Action: ActionMarkAlreadyDrained,
+			Status: model.AlreadyDrained,
+		}, nil
+
+	case drainpluginv1alpha1.DrainState_DRAIN_STATE_FAILED:
+		slog.ErrorContext(ctx, "Drain plugin failed to drain the node",
+			"node", nodeName,
+			"message", message)
+
+		return &DrainActionResult{
+			Action: ActionUpdateStatus,
+			Status: model.StatusFailed,
+		}, nil
+
+	case drainpluginv1alpha1.DrainState_DRAIN_STATE_IN_PROGRESS:
+		slog.DebugContext(ctx, "Plugin drain in progress",
+			"node", nodeName,
+			"message", message)
+
+	case drainpluginv1alpha1.DrainState_DRAIN_STATE_UNSPECIFIED:
+		fallthrough
+	default:
+		slog.WarnContext(ctx, "Drain plugin returned an unexpected drain state",
+			"node", nodeName,
+			"state", state.String())
+	}
+
+	return &DrainActionResult{
+		Action:    ActionWait,
+		WaitDelay: customDrainPollInterval,
+	}, nil
+}
+
+// evaluateUnstartedPluginDrain handles an event whose drain the plugin does not know: the drain is started
+// unless the plugin already drains or drained the node for another event.
+func (e *NodeDrainEvaluator) evaluateUnstartedPluginDrain(ctx context.Context, nodeName string,
+	partialDrainEntity *protos.Entity) (*DrainActionResult, error) {
+	nodeState, _, err := e.drainPluginClient.GetDrainStatus(ctx, nodeName, "")
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get node drain status from drain plugin",
+			"node", nodeName,
+			"error", err)
+
+		return &DrainActionResult{
+			Action:    ActionWait,
+			WaitDelay: customDrainPollInterval,
+		}, nil
+	}
+
+	if nodeState == drainpluginv1alpha1.DrainState_DRAIN_STATE_IN_PROGRESS {
+		slog.InfoContext(ctx, "Drain plugin is draining the node for another event, waiting", "node", nodeName)
+
+		return &DrainActionResult{
+			Action:    ActionWait,
+			WaitDelay: customDrainPollInterval,
+		}, nil
+	}
+
+	if nodeState == drainpluginv1alpha1.DrainState_DRAIN_STATE_COMPLETED {
+		slog.InfoContext(ctx, "Drain plugin drained the node for another event, marking as already drained",
+			"node", nodeName)
+
+		return &DrainActionResult{
+			Action: ActionMarkAlreadyDrained,
+			Status: model.AlreadyDrained,
+		}, nil
+	}
+
+	namespaces, err := e.informers.GetNamespacesMatchingPattern(ctx, "*", e.config.SystemNamespaces, nodeName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user namespaces: %w", err)
+	}
+
+	slog.InfoContext(ctx, "Starting drain on drain plugin", "node", nodeName)
+
+	return &DrainActionResult{
+		Action:             ActionStartPluginDrain,
+		Namespaces:         namespaces,
+		PartialDrainEntity: partialDrainEntity,
+	}, nil
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+
+	drainpluginv1alpha1 "github.com/nvidia/nvsentinel/api/gen/go/drainplugin/v1alpha1"
+	"github.com/nvidia/nvsentinel/data-models/pkg/model"
+	"github.com/nvidia/nvsentinel/data-models/pkg/protos"
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/config"
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/drainplugin"
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/drainplugin/fakeplugin"
+)
+
+type pluginTestInformers struct {
+	InformersInterface
+}
+
+func (pluginTestInformers) GetNamespacesMatchingPattern(context.Context, string, string, string) ([]string, error) {
+	return []string{"slinky"}, nil
+}
+
+func (pluginTestInformers) FindEvictablePodsInNamespaceAndNode(string, string, *protos.Entity) ([]*v1.Pod, error) {
+	return nil, nil
+}
+
+func newPluginDrainEvaluator(t *testing.T) (*NodeDrainEvaluator, *fakeplugin.Plugin) {
+	t.Helper()
+
+	server, err := fakeplugin.NewServer()
+	require.NoError(t, err)
+	t.Cleanup(server.Stop)
+
+	return &NodeDrainEvaluator{
+		config: config.TomlConfig{
+			DrainPlugin: config.DrainPluginConfig{Enabled: true, Endpoint: "bufnet", Insecure: true},
+		},
+		informers:         pluginTestInformers{},
+		drainPluginClient: drainplugin.NewClientFromConn(server.Conn, 5*time.Second),
+	}, server.Plugin
+}
+
+func pluginHealthEvent(eventID string) model.HealthEventWithStatus {
+	return model.HealthEventWithStatus{
+		HealthEvent: &protos.HealthEvent{NodeName: "node-1", Id: eventID},
+	}
+}
+
+func TestEvaluatePluginDrain(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("starts the drain when the plugin does not know it", func(t *testing.T) {
+		e, _ := newPluginDrainEvaluator(t)
+
+		result, err := e.evaluatePluginDrain(ctx, pluginHealthEvent("event-1"), nil)
+		require.NoError(t, err)
+		assert.Equal(t, ActionStartPluginDrain, result.Action)
+		assert.Equal(t, []string{"slinky"}, result.Namespaces)
+	})
+
+	t.Run("waits while the drain is in progress", func(t *testing.T) {
+		e, plugin := newPluginDrainEvaluator(t)
+		plugin.SetState("node-1", "event-1", drainpluginv1alpha1.DrainState_DRAIN_STATE_IN_PROGRESS, "")
+
+		result, err := e.evaluatePluginDrain(ctx, pluginHealthEvent("event-1"), nil)
+		require.NoError(t, err)
+		assert.Equal(t, ActionWait, result.Action)
+	})
+
+	t.Run("waits while the node is drained for another event", func(t *testing.T) {
+		e, plugin := newPluginDrainEvaluator(t)
+		plugin.SetState("node-1", "event-0", drainpluginv1alpha1.DrainState_DRAIN_STATE_IN_PROGRESS, "")
+
+		result, err := e.evaluatePluginDrain(ctx, pluginHealthEvent("event-1"), nil)
+		require.NoError(t, err)
+		assert.Equal(t, ActionWait, result.Action)
+		assert.Empty(t, plugin.StartRequests())
+	})
+
+	t.Run("marks the node drained once the drain completed", func(t *testing.T) {
+		e, plugin := newPluginDrainEvaluator(t)
+		plugin.SetState("node-1", "event-1", drainpluginv1alpha1.DrainState_DRAIN_STATE_COMPLETED, "")
+
+		result, err := e.evaluatePluginDrain(ctx, pluginHealthEvent("event-1"), nil)
+		require.NoError(t, err)
+		assert.Equal(t, ActionMarkAlreadyDrained, result.Action)
+		assert.Equal(t, model.AlreadyDrained, result.Status)
+	})
+
+	t.Run("fails the drain when the plugin gave up", func(t *testing.T) {
+		e, plugin := newPluginDrainEvaluator(t)
+		plugin.SetState("node-1", "event-1", drainpluginv1alpha1.DrainState_DRAIN_STATE_FAILED, "timed out")
+
+		result, err := e.evaluatePluginDrain(ctx, pluginHealthEvent("event-1"), nil)
+		require.NoError(t, err)
+		assert.Equal(t, ActionUpdateStatus, result.Action)
+		assert.Equal(t, model.StatusFailed, result.Status)
+	})
+
+	t.Run("rejects events without ID", func(t *testing.T) {
+		e, _ := newPluginDrainEvaluator(t)
+
+		_, err := e.evaluatePluginDrain(ctx, pluginHealthEvent(""), nil)
+		assert.Error(t, err)
+	})
+}
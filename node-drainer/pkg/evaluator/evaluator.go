@@ -30,6 +30,7 @@ import (
 	annotation "github.com/nvidia/nvsentinel/fault-quarantine/pkg/healthEventsAnnotation"
 	"github.com/nvidia/nvsentinel/node-drainer/pkg/config"
 	"github.com/nvidia/nvsentinel/node-drainer/pkg/customdrain"
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/podpolicy"
 	"github.com/nvidia/nvsentinel/node-drainer/pkg/queue"
 	"github.com/nvidia/nvsentinel/store-client/pkg/datastore"
 	"github.com/nvidia/nvsentinel/store-client/pkg/query"
@@ -43,11 +44,19 @@ func NewNodeDrainEvaluator(
 	cfg config.TomlConfig,
 	informers InformersInterface,
 	customDrainClient CustomDrainClientInterface,
+	drainPluginClient DrainPluginClientInterface,
 ) DrainEvaluator {
+	podPolicy, err := podpolicy.NewResolver(cfg)
+	if err != nil {
+		slog.Error("Invalid pod drain policy configuration, per-pod drain policies are disabled", "error", err)
+	}
+
 	return &NodeDrainEvaluator{
 		config:            cfg,
 		informers:         informers,
 		customDrainClient: customDrainClient,
+		drainPluginClient: drainPluginClient,
+		podPolicy:         podPolicy,
 	}
 }
 
@@ -134,6 +143,11 @@ func (e *NodeDrainEvaluator) EvaluateEventWithDatabase(ctx context.Context, heal
 		return r, err
 	}
 
+	if e.config.DrainPlugin.Enabled && e.drainPluginClient != nil {
+		r, err := e.evaluatePluginDrain(ctx, healthEvent, partialDrainEntity)
+		return r, err
+	}
+
 	r, err := e.evaluateUserNamespaceActions(ctx, healthEvent, partialDrainEntity)
 
 	return r, err
@@ -189,6 +203,8 @@ func (e *NodeDrainEvaluator) evaluateUserNamespaceActions(ctx context.Context,
 			"node", nodeName)
 	}
 
+	namespaceModes := make(map[string]config.EvictMode)
+
 	for _, userNamespace := range e.config.UserNamespaces {
 		matchedNamespaces, err := e.informers.GetNamespacesMatchingPattern(ctx,
 			userNamespace.Name, systemNamespaces, nodeName)
@@ -204,6 +220,16 @@ func (e *NodeDrainEvaluator) evaluateUserNamespaceActions(ctx context.Context,
 		}
 
 		mapUserNamespacesToMode(ctx, &ns, forceImmediateEviction, userNamespace, matchedNamespaces)
+
+		for _, namespace := range matchedNamespaces {
+			if _, ok := namespaceModes[namespace]; !ok {
+				namespaceModes[namespace] = userNamespace.Mode
+			}
+		}
+	}
+
+	if e.podPolicy != nil && !forceImmediateEviction {
+		return e.getPodPolicyAction(ctx, namespaceModes, nodeName, partialDrainEntity), nil
 	}
 
 	return e.getAction(ctx, ns, nodeName, partialDrainEntity), nil
@@ -0,0 +1,170 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluator
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/nvidia/nvsentinel/data-models/pkg/model"
+	"github.com/nvidia/nvsentinel/data-models/pkg/protos"
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/config"
+)
+
+// podsByMode holds the evictable pods of a node grouped by their resolved eviction mode
+type podsByMode struct {
+	immediate          []*v1.Pod
+	stuckImmediate     []*v1.Pod // Immediate pods stuck terminating, force deleted after the eviction timeout
+	allowCompletion    []*v1.Pod
+	deleteAfterTimeout []*v1.Pod
+	timeouts           map[string]time.Duration
+}
+
+// getPodPolicyAction is getAction for per-pod drain policies: every pod is drained in the mode resolved
+// from the selector policies and its annotations rather than in the mode of its namespace. The priority
+// between modes is the same as in getAction.
+func (e *NodeDrainEvaluator) getPodPolicyAction(ctx context.Context, namespaceModes map[string]config.EvictMode,
+	nodeName string, partialDrainEntity *protos.Entity) *DrainActionResult {
+	pods, err := e.classifyPods(ctx, namespaceModes, nodeName, partialDrainEntity)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to resolve pod drain policies on node",
+			"node", nodeName,
+			"error", err)
+
+		return &DrainActionResult{
+			Action:    ActionWait,
+			WaitDelay: time.Minute,
+		}
+	}
+
+	timeout := e.config.EvictionTimeoutInSeconds.Duration
+	immediate := append(append([]*v1.Pod{}, pods.immediate...), pods.stuckImmediate...)
+
+	if len(immediate) > 0 && !e.informers.CheckIfPodsAreEvictedInImmediateMode(ctx, immediate, nodeName, timeout) {
+		slog.InfoContext(ctx, "Performing immediate eviction for node",
+			"node", nodeName,
+			"pods", len(immediate))
+
+		// Pods is never nil here, so that only these pods and not their whole namespaces are evicted
+		return &DrainActionResult{
+			Action:             ActionEvictImmediate,
+			Namespaces:         namespacesOf(immediate),
+			Timeout:            timeout,
+			PartialDrainEntity: partialDrainEntity,
+			Pods:               append([]*v1.Pod{}, pods.immediate...),
+		}
+	}
+
+	if len(pods.deleteAfterTimeout) > 0 {
+		slog.InfoContext(ctx, "Deleting pods after timeout for DeleteAfterTimeout pods on node",
+			"node", nodeName,
+			"pods", len(pods.deleteAfterTimeout))
+
+		return &DrainActionResult{
+			Action:             ActionEvictWithTimeout,
+			Namespaces:         namespacesOf(pods.deleteAfterTimeout),
+			Timeout:            time.Duration(e.config.DeleteAfterTimeoutMinutes) * time.Minute,
+			PartialDrainEntity: partialDrainEntity,
+			Pods:               pods.deleteAfterTimeout,
+			PodTimeouts:        pods.timeouts,
+		}
+	}
+
+	if len(pods.allowCompletion) > 0 {
+		slog.InfoContext(ctx, "Checking pod completion status for AllowCompletion pods on node",
+			"node", nodeName,
+			"pods", len(pods.allowCompletion))
+
+		return &DrainActionResult{
+			Action:             ActionCheckCompletion,
+			Namespaces:         namespacesOf(pods.allowCompletion),
+			PartialDrainEntity: partialDrainEntity,
+			Pods:               pods.allowCompletion,
+		}
+	}
+
+	slog.InfoContext(ctx, "All pods evicted successfully on node", "node", nodeName)
+
+	return &DrainActionResult{
+		Action: ActionUpdateStatus,
+		Status: model.StatusSucceeded,
+	}
+}
+
+func (e *NodeDrainEvaluator) classifyPods(ctx context.Context, namespaceModes map[string]config.EvictMode,
+	nodeName string, partialDrainEntity *protos.Entity) (podsByMode, error) {
+	result := podsByMode{timeouts: make(map[string]time.Duration)}
+
+	namespaces := make([]string, 0, len(namespaceModes))
+	for namespace := range namespaceModes {
+		namespaces = append(namespaces, namespace)
+	}
+
+	sort.Strings(namespaces)
+
+	for _, namespace := range namespaces {
+		pods, err := e.informers.FindEvictablePodsInNamespaceAndNode(namespace, nodeName, partialDrainEntity)
+		if err != nil {
+			return result, err
+		}
+
+		for _, pod := range pods {
+			policy := e.podPolicy.Resolve(ctx, pod, namespaceModes[namespace])
+
+			switch policy.Mode {
+			case config.ModeImmediateEvict:
+				result.immediate = append(result.immediate, pod)
+			case config.ModeAllowCompletion:
+				result.allowCompletion = append(result.allowCompletion, pod)
+			case config.ModeDeleteAfterTimeout:
+				result.deleteAfterTimeout = append(result.deleteAfterTimeout, pod)
+				result.timeouts[pod.Namespace+"/"+pod.Name] = policy.Timeout
+			default:
+				slog.ErrorContext(ctx, "unsupported mode", "mode", policy.Mode)
+			}
+		}
+
+		stuck, err := e.informers.FindStuckTerminatingPodsInNamespaceAndNode(namespace, nodeName, partialDrainEntity)
+		if err != nil {
+			return result, err
+		}
+
+		for _, pod := range stuck {
+			if e.podPolicy.Resolve(ctx, pod, namespaceModes[namespace]).Mode == config.ModeImmediateEvict {
+				result.stuckImmediate = append(result.stuckImmediate, pod)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func namespacesOf(pods []*v1.Pod) []string {
+	seen := make(map[string]struct{})
+	namespaces := make([]string, 0)
+
+	for _, pod := range pods {
+		if _, ok := seen[pod.Namespace]; !ok {
+			seen[pod.Namespace] = struct{}{}
+			namespaces = append(namespaces, pod.Namespace)
+		}
+	}
+
+	return namespaces
+}
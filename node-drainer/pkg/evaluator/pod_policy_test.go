@@ -0,0 +1,166 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/nvidia/nvsentinel/data-models/pkg/model"
+	"github.com/nvidia/nvsentinel/data-models/pkg/protos"
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/config"
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/podpolicy"
+)
+
+type podPolicyTestInformers struct {
+	InformersInterface
+	pods    []*v1.Pod
+	stuck   []*v1.Pod
+	evicted bool
+	checked []*v1.Pod
+}
+
+func (i *podPolicyTestInformers) FindEvictablePodsInNamespaceAndNode(namespace, _ string,
+	_ *protos.Entity) ([]*v1.Pod, error) {
+	return podsInNamespace(i.pods, namespace), nil
+}
+
+func (i *podPolicyTestInformers) FindStuckTerminatingPodsInNamespaceAndNode(namespace, _ string,
+	_ *protos.Entity) ([]*v1.Pod, error) {
+	return podsInNamespace(i.stuck, namespace), nil
+}
+
+func (i *podPolicyTestInformers) CheckIfPodsAreEvictedInImmediateMode(_ context.Context, pods []*v1.Pod, _ string,
+	_ time.Duration) bool {
+	i.checked = pods
+
+	return i.evicted
+}
+
+func podsInNamespace(pods []*v1.Pod, namespace string) []*v1.Pod {
+	var result []*v1.Pod
+
+	for _, pod := range pods {
+		if pod.Namespace == namespace {
+			result = append(result, pod)
+		}
+	}
+
+	return result
+}
+
+func policyPod(name, workload string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ml", Labels: map[string]string{"workload": workload}},
+	}
+}
+
+func newPodPolicyEvaluator(t *testing.T, informers *podPolicyTestInformers) *NodeDrainEvaluator {
+	t.Helper()
+
+	cfg := config.TomlConfig{
+		EvictionTimeoutInSeconds:  config.Duration{Duration: 30 * time.Second},
+		DeleteAfterTimeoutMinutes: 60,
+		PodDrainPolicy: config.PodDrainPolicyConfig{
+			Enabled: true,
+			Policies: []config.PodDrainPolicy{
+				{Selector: "workload=inference", Mode: config.ModeImmediateEvict},
+				{Selector: "workload=training", Mode: config.ModeDeleteAfterTimeout},
+				{Selector: "workload=batch", Mode: config.ModeAllowCompletion},
+			},
+		},
+	}
+
+	resolver, err := podpolicy.NewResolver(cfg)
+	require.NoError(t, err)
+
+	return &NodeDrainEvaluator{config: cfg, informers: informers, podPolicy: resolver}
+}
+
+func TestGetPodPolicyAction(t *testing.T) {
+	ctx := context.Background()
+	modes := map[string]config.EvictMode{"ml": config.ModeAllowCompletion}
+
+	inference := policyPod("inference", "inference")
+	training := policyPod("training", "training")
+	batch := policyPod("batch", "batch")
+
+	t.Run("evicts Immediate pods first", func(t *testing.T) {
+		informers := &podPolicyTestInformers{pods: []*v1.Pod{batch, training, inference}}
+		e := newPodPolicyEvaluator(t, informers)
+
+		result := e.getPodPolicyAction(ctx, modes, "node-1", nil)
+		assert.Equal(t, ActionEvictImmediate, result.Action)
+		assert.Equal(t, []*v1.Pod{inference}, result.Pods)
+		assert.Equal(t, []string{"ml"}, result.Namespaces)
+	})
+
+	t.Run("deletes DeleteAfterTimeout pods before waiting for AllowCompletion pods", func(t *testing.T) {
+		informers := &podPolicyTestInformers{pods: []*v1.Pod{batch, training}}
+		e := newPodPolicyEvaluator(t, informers)
+
+		result := e.getPodPolicyAction(ctx, modes, "node-1", nil)
+		assert.Equal(t, ActionEvictWithTimeout, result.Action)
+		assert.Equal(t, []*v1.Pod{training}, result.Pods)
+		assert.Equal(t, time.Hour, result.PodTimeouts["ml/training"])
+	})
+
+	t.Run("waits for AllowCompletion pods", func(t *testing.T) {
+		informers := &podPolicyTestInformers{pods: []*v1.Pod{batch}}
+		e := newPodPolicyEvaluator(t, informers)
+
+		result := e.getPodPolicyAction(ctx, modes, "node-1", nil)
+		assert.Equal(t, ActionCheckCompletion, result.Action)
+		assert.Equal(t, []*v1.Pod{batch}, result.Pods)
+	})
+
+	t.Run("succeeds once no pods are left", func(t *testing.T) {
+		e := newPodPolicyEvaluator(t, &podPolicyTestInformers{})
+
+		result := e.getPodPolicyAction(ctx, modes, "node-1", nil)
+		assert.Equal(t, ActionUpdateStatus, result.Action)
+		assert.Equal(t, model.StatusSucceeded, result.Status)
+	})
+
+	t.Run("keeps evicting while Immediate pods are stuck terminating", func(t *testing.T) {
+		stuck := policyPod("stuck", "inference")
+		informers := &podPolicyTestInformers{stuck: []*v1.Pod{stuck, policyPod("stuck-batch", "batch")}}
+		e := newPodPolicyEvaluator(t, informers)
+
+		result := e.getPodPolicyAction(ctx, modes, "node-1", nil)
+		assert.Equal(t, ActionEvictImmediate, result.Action)
+		assert.Equal(t, []*v1.Pod{stuck}, informers.checked)
+		assert.NotNil(t, result.Pods)
+		assert.Empty(t, result.Pods)
+	})
+
+	t.Run("moves on once stuck Immediate pods are force deleted", func(t *testing.T) {
+		informers := &podPolicyTestInformers{
+			pods:    []*v1.Pod{batch},
+			stuck:   []*v1.Pod{policyPod("stuck", "inference")},
+			evicted: true,
+		}
+		e := newPodPolicyEvaluator(t, informers)
+
+		result := e.getPodPolicyAction(ctx, modes, "node-1", nil)
+		assert.Equal(t, ActionCheckCompletion, result.Action)
+	})
+}
@@ -20,9 +20,11 @@ import (
 
 	v1 "k8s.io/api/core/v1"
 
+	drainpluginv1alpha1 "github.com/nvidia/nvsentinel/api/gen/go/drainplugin/v1alpha1"
 	"github.com/nvidia/nvsentinel/data-models/pkg/model"
 	"github.com/nvidia/nvsentinel/data-models/pkg/protos"
 	"github.com/nvidia/nvsentinel/node-drainer/pkg/config"
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/podpolicy"
 	"github.com/nvidia/nvsentinel/node-drainer/pkg/queue"
 	"github.com/nvidia/nvsentinel/store-client/pkg/datastore"
 )
@@ -37,12 +39,16 @@ type NodeDrainEvaluator struct {
 	config            config.TomlConfig
 	informers         InformersInterface
 	customDrainClient CustomDrainClientInterface
+	drainPluginClient DrainPluginClientInterface
+	podPolicy         *podpolicy.Resolver
 }
 
 type InformersInterface interface {
 	GetNamespacesMatchingPattern(context.Context, string, string, string) ([]string, error)
 	CheckIfAllPodsAreEvictedInImmediateMode(context.Context, []string, string, time.Duration, *protos.Entity) bool
+	CheckIfPodsAreEvictedInImmediateMode(context.Context, []*v1.Pod, string, time.Duration) bool
 	FindEvictablePodsInNamespaceAndNode(string, string, *protos.Entity) ([]*v1.Pod, error)
+	FindStuckTerminatingPodsInNamespaceAndNode(string, string, *protos.Entity) ([]*v1.Pod, error)
 	GetNode(string) (*v1.Node, error)
 }
 
@@ -51,12 +57,17 @@ type CustomDrainClientInterface interface {
 	GetCRStatus(ctx context.Context, crName string) (found bool, complete bool, err error)
 }
 
+type DrainPluginClientInterface interface {
+	GetDrainStatus(ctx context.Context, nodeName, eventID string) (drainpluginv1alpha1.DrainState, string, error)
+}
+
 type DrainAction int
 
 const (
 	ActionSkip DrainAction = iota
 	ActionWait
 	ActionCreateCR
+	ActionStartPluginDrain
 	ActionEvictImmediate
 	ActionEvictWithTimeout
 	ActionCheckCompletion
@@ -71,6 +82,11 @@ type DrainActionResult struct {
 	WaitDelay          time.Duration // For ActionWait
 	Status             model.Status  // For ActionUpdateStatus
 	PartialDrainEntity *protos.Entity
+	// Pods restricts the action to these pods of Namespaces when per-pod drain policies are enabled
+	Pods []*v1.Pod
+	// PodTimeouts holds the timeouts of the pods of an ActionEvictWithTimeout, keyed by namespace/name,
+	// when per-pod drain policies are enabled
+	PodTimeouts map[string]time.Duration
 }
 
 func (a DrainAction) String() string {
@@ -81,6 +97,8 @@ func (a DrainAction) String() string {
 		return "Wait"
 	case ActionCreateCR:
 		return "CreateCR"
+	case ActionStartPluginDrain:
+		return "StartPluginDrain"
 	case ActionEvictImmediate:
 		return "EvictImmediate"
 	case ActionEvictWithTimeout:
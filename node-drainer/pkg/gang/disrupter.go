@@ -0,0 +1,226 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package gang makes node drains disrupt multi-node jobs as a whole. When a drained pod belongs to a
+// gang, found with the preflight gang discoverers, the members of that gang on other nodes are evicted
+// or signalled together, or left to the owning controller, depending on the configured mode.
+package gang
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/config"
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/metrics"
+	preflightconfig "github.com/nvidia/nvsentinel/preflight/pkg/config"
+	preflightgang "github.com/nvidia/nvsentinel/preflight/pkg/gang"
+)
+
+const (
+	// DisruptedNodeAnnotation is set on gang members in Signal mode to the node whose drain disrupted the gang
+	DisruptedNodeAnnotation = "nvsentinel.nvidia.com/gang-disrupted-node"
+	// DisruptedAtAnnotation is set on gang members in Signal mode to the time the gang was disrupted
+	DisruptedAtAnnotation = "nvsentinel.nvidia.com/gang-disrupted-at"
+)
+
+type PodEvictor interface {
+	EvictPods(ctx context.Context, namespace string, timeout time.Duration, pods []*v1.Pod) error
+}
+
+// Gang describes a gang disrupted by a node drain
+type Gang struct {
+	ID string
+	// Members is the number of gang members discovered across all nodes
+	Members int
+	// RemoteMembers holds the namespace/name of the members on other nodes
+	RemoteMembers []string
+}
+
+type Disrupter struct {
+	discoverer preflightgang.GangDiscoverer
+	clientset  kubernetes.Interface
+	evictor    PodEvictor
+	mode       config.GangDrainMode
+	dryRunMode []string
+}
+
+// NewDiscoverer creates the preflight gang discoverer for the configuration
+func NewDiscoverer(cfg config.GangDiscoveryConfig, c client.Client,
+	restMapper meta.RESTMapper) (preflightgang.GangDiscoverer, error) {
+	return preflightgang.NewDiscovererFromConfig(preflightconfig.GangDiscoveryConfig{
+		Name:           cfg.Name,
+		AnnotationKeys: cfg.AnnotationKeys,
+		LabelKeys:      cfg.LabelKeys,
+		PodGroupGVR: preflightconfig.GVRConfig{
+			Group:    cfg.PodGroupGVR.Group,
+			Version:  cfg.PodGroupGVR.Version,
+			Resource: cfg.PodGroupGVR.Resource,
+		},
+		MinCountExpr: cfg.MinCountExpr,
+	}, c, restMapper)
+}
+
+func NewDisrupter(cfg config.GangDrainConfig, discoverer preflightgang.GangDiscoverer,
+	clientset kubernetes.Interface, evictor PodEvictor, dryRun bool) *Disrupter {
+	dryRunMode := []string{}
+	if dryRun {
+		dryRunMode = []string{metav1.DryRunAll}
+	}
+
+	return &Disrupter{
+		discoverer: discoverer,
+		clientset:  clientset,
+		evictor:    evictor,
+		mode:       cfg.Mode,
+		dryRunMode: dryRunMode,
+	}
+}
+
+func (d *Disrupter) Mode() config.GangDrainMode {
+	return d.mode
+}
+
+// Disrupt disrupts the gangs of the given pods of the drained node, skipping the gang IDs in handled.
+// It returns the gangs it disrupted.
+func (d *Disrupter) Disrupt(ctx context.Context, nodeName string, pods []*v1.Pod, timeout time.Duration,
+	handled map[string]struct{}) ([]Gang, error) {
+	var gangs []Gang
+
+	seen := make(map[string]struct{}, len(handled))
+	for gangID := range handled {
+		seen[gangID] = struct{}{}
+	}
+
+	for _, pod := range pods {
+		if !d.discoverer.CanHandle(pod) {
+			continue
+		}
+
+		gangID := d.discoverer.ExtractGangID(pod)
+		if _, ok := seen[gangID]; ok || gangID == "" {
+			continue
+		}
+
+		seen[gangID] = struct{}{}
+
+		info, err := d.discoverer.DiscoverPeers(ctx, pod)
+		if err != nil {
+			return gangs, fmt.Errorf("failed to discover gang of pod %s/%s: %w", pod.Namespace, pod.Name, err)
+		}
+
+		if info == nil {
+			continue
+		}
+
+		remote := remotePeers(info.Peers, nodeName)
+
+		if err := d.disruptPeers(ctx, nodeName, remote, timeout); err != nil {
+			return gangs, fmt.Errorf("failed to disrupt gang %s: %w", gangID, err)
+		}
+
+		gang := Gang{ID: info.GangID, Members: len(info.Peers)}
+		for _, peer := range remote {
+			gang.RemoteMembers = append(gang.RemoteMembers, peer.Namespace+"/"+peer.Name)
+		}
+
+		gangs = append(gangs, gang)
+		metrics.GangDisruptions.WithLabelValues(string(d.mode)).Inc()
+
+		slog.InfoContext(ctx, "Disrupted gang of drained pod",
+			"node", nodeName,
+			"pod", pod.Name,
+			"namespace", pod.Namespace,
+			"gangID", info.GangID,
+			"mode", d.mode,
+			"members", len(info.Peers),
+			"remoteMembers", len(remote))
+	}
+
+	return gangs, nil
+}
+
+// remotePeers returns the gang members on other nodes as minimal pod objects
+func remotePeers(peers []preflightgang.PeerInfo, nodeName string) []*v1.Pod {
+	var pods []*v1.Pod
+
+	for _, peer := range peers {
+		if peer.NodeName == nodeName {
+			continue
+		}
+
+		pods = append(pods, &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: peer.PodName, Namespace: peer.Namespace},
+			Spec:       v1.PodSpec{NodeName: peer.NodeName},
+		})
+	}
+
+	return pods
+}
+
+func (d *Disrupter) disruptPeers(ctx context.Context, nodeName string, peers []*v1.Pod, timeout time.Duration) error {
+	switch d.mode {
+	case config.GangModeEvict:
+		byNamespace := make(map[string][]*v1.Pod)
+		for _, peer := range peers {
+			byNamespace[peer.Namespace] = append(byNamespace[peer.Namespace], peer)
+		}
+
+		for namespace, pods := range byNamespace {
+			if err := d.evictor.EvictPods(ctx, namespace, timeout, pods); err != nil {
+				return err
+			}
+		}
+	case config.GangModeSignal:
+		for _, peer := range peers {
+			if err := d.signal(ctx, nodeName, peer); err != nil {
+				return err
+			}
+		}
+	case config.GangModeDelegate:
+		// The owning controller restarts the gang when the drained node's pods are evicted
+	}
+
+	return nil
+}
+
+func (d *Disrupter) signal(ctx context.Context, nodeName string, pod *v1.Pod) error {
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]string{
+				DisruptedNodeAnnotation: nodeName,
+				DisruptedAtAnnotation:   time.Now().UTC().Format(time.RFC3339),
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal gang disruption patch: %w", err)
+	}
+
+	if _, err := d.clientset.CoreV1().Pods(pod.Namespace).Patch(ctx, pod.Name, types.MergePatchType, patch,
+		metav1.PatchOptions{DryRun: d.dryRunMode}); err != nil {
+		return fmt.Errorf("failed to signal gang member %s/%s: %w", pod.Namespace, pod.Name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,169 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gang
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/config"
+	preflightgang "github.com/nvidia/nvsentinel/preflight/pkg/gang"
+)
+
+const gangLabel = "volcano.sh/job-name"
+
+// labelDiscoverer groups pods by a label and returns the configured peers
+type labelDiscoverer struct {
+	peers       []preflightgang.PeerInfo
+	discoveries int
+}
+
+func (d *labelDiscoverer) Name() string { return "test" }
+
+func (d *labelDiscoverer) CanHandle(pod *v1.Pod) bool { return pod.Labels[gangLabel] != "" }
+
+func (d *labelDiscoverer) ExtractGangID(pod *v1.Pod) string {
+	return "test-" + pod.Namespace + "-" + pod.Labels[gangLabel]
+}
+
+func (d *labelDiscoverer) DiscoverPeers(_ context.Context, pod *v1.Pod) (*preflightgang.GangInfo, error) {
+	d.discoveries++
+
+	return &preflightgang.GangInfo{
+		GangID:           d.ExtractGangID(pod),
+		ExpectedMinCount: len(d.peers),
+		Peers:            d.peers,
+	}, nil
+}
+
+type recordingEvictor struct {
+	evicted []string
+}
+
+func (e *recordingEvictor) EvictPods(_ context.Context, _ string, _ time.Duration, pods []*v1.Pod) error {
+	for _, pod := range pods {
+		e.evicted = append(e.evicted, pod.Namespace+"/"+pod.Name)
+	}
+
+	return nil
+}
+
+func newPod(name, node string, labels map[string]string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "training", Labels: labels},
+		Spec:       v1.PodSpec{NodeName: node},
+	}
+}
+
+func setup(t *testing.T, mode config.GangDrainMode) (*Disrupter, *labelDiscoverer, *recordingEvictor,
+	*fake.Clientset, []*v1.Pod) {
+	t.Helper()
+
+	labels := map[string]string{gangLabel: "job"}
+	pods := []*v1.Pod{
+		newPod("worker-0", "node-1", labels),
+		newPod("worker-1", "node-1", labels),
+		newPod("worker-2", "node-2", labels),
+		newPod("worker-3", "node-3", labels),
+	}
+
+	clientset := fake.NewSimpleClientset()
+	for _, pod := range pods {
+		_, err := clientset.CoreV1().Pods(pod.Namespace).Create(context.Background(), pod, metav1.CreateOptions{})
+		require.NoError(t, err)
+	}
+
+	discoverer := &labelDiscoverer{}
+	for _, pod := range pods {
+		discoverer.peers = append(discoverer.peers, preflightgang.PeerInfo{
+			PodName: pod.Name, Namespace: pod.Namespace, NodeName: pod.Spec.NodeName,
+		})
+	}
+
+	evictor := &recordingEvictor{}
+	d := NewDisrupter(config.GangDrainConfig{Enabled: true, Mode: mode}, discoverer, clientset, evictor, false)
+
+	return d, discoverer, evictor, clientset, pods[:2]
+}
+
+func TestDisrupt_EvictMode(t *testing.T) {
+	d, discoverer, evictor, _, localPods := setup(t, config.GangModeEvict)
+
+	gangs, err := d.Disrupt(context.Background(), "node-1", localPods, time.Minute, nil)
+	require.NoError(t, err)
+
+	require.Len(t, gangs, 1)
+	assert.Equal(t, "test-training-job", gangs[0].ID)
+	assert.Equal(t, 4, gangs[0].Members)
+	assert.ElementsMatch(t, []string{"training/worker-2", "training/worker-3"}, gangs[0].RemoteMembers)
+	assert.ElementsMatch(t, []string{"training/worker-2", "training/worker-3"}, evictor.evicted)
+	assert.Equal(t, 1, discoverer.discoveries, "the gang is discovered once for both local pods")
+}
+
+func TestDisrupt_SignalMode(t *testing.T) {
+	ctx := context.Background()
+	d, _, evictor, clientset, localPods := setup(t, config.GangModeSignal)
+
+	gangs, err := d.Disrupt(ctx, "node-1", localPods, time.Minute, nil)
+	require.NoError(t, err)
+	require.Len(t, gangs, 1)
+	assert.Empty(t, evictor.evicted)
+
+	for _, name := range []string{"worker-2", "worker-3"} {
+		pod, err := clientset.CoreV1().Pods("training").Get(ctx, name, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "node-1", pod.Annotations[DisruptedNodeAnnotation])
+		assert.NotEmpty(t, pod.Annotations[DisruptedAtAnnotation])
+	}
+
+	local, err := clientset.CoreV1().Pods("training").Get(ctx, "worker-0", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, local.Annotations[DisruptedNodeAnnotation])
+}
+
+func TestDisrupt_DelegateMode(t *testing.T) {
+	ctx := context.Background()
+	d, _, evictor, clientset, localPods := setup(t, config.GangModeDelegate)
+
+	gangs, err := d.Disrupt(ctx, "node-1", localPods, time.Minute, nil)
+	require.NoError(t, err)
+	require.Len(t, gangs, 1)
+	assert.Empty(t, evictor.evicted)
+
+	pod, err := clientset.CoreV1().Pods("training").Get(ctx, "worker-2", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, pod.Annotations[DisruptedNodeAnnotation])
+}
+
+func TestDisrupt_SkipsHandledAndNonGangPods(t *testing.T) {
+	d, discoverer, evictor, _, localPods := setup(t, config.GangModeEvict)
+
+	pods := append([]*v1.Pod{newPod("standalone", "node-1", nil)}, localPods...)
+
+	gangs, err := d.Disrupt(context.Background(), "node-1", pods, time.Minute,
+		map[string]struct{}{"test-training-job": {}})
+	require.NoError(t, err)
+
+	assert.Empty(t, gangs)
+	assert.Empty(t, evictor.evicted)
+	assert.Zero(t, discoverer.discoveries)
+}
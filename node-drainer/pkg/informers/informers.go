@@ -47,6 +47,14 @@ const (
 	NodeEventReasonIndex = "node-event-reason"
 )
 
+// EvictionObserver is notified of the evictions and force deletions of pods
+type EvictionObserver interface {
+	PodEvicted(pod *v1.Pod)
+	// PodEvictionBlocked is called when the eviction of the pod was refused because of a PodDisruptionBudget
+	PodEvictionBlocked(pod *v1.Pod)
+	PodForceDeleted(pod *v1.Pod)
+}
+
 type Informers struct {
 	podInformer            cache.SharedIndexInformer
 	eventInformer          cache.SharedIndexInformer
@@ -55,6 +63,7 @@ type Informers struct {
 	notReadyTimeoutMinutes *int
 	dryRunMode             []string
 	namespace              string
+	evictionObserver       EvictionObserver // nil when no observer is set
 }
 
 func NewInformers(clientset kubernetes.Interface, resyncPeriod time.Duration,
@@ -109,6 +118,11 @@ func NewInformers(clientset kubernetes.Interface, resyncPeriod time.Duration,
 	}, nil
 }
 
+// SetEvictionObserver sets the observer notified of the evictions and force deletions of pods
+func (i *Informers) SetEvictionObserver(observer EvictionObserver) {
+	i.evictionObserver = observer
+}
+
 func (i *Informers) HasSynced() bool {
 	return i.podInformer.HasSynced() && i.eventInformer.HasSynced() && i.nodeInformer.HasSynced()
 }
@@ -197,6 +211,38 @@ func (i *Informers) FindEvictablePodsInNamespaceAndNode(namespace, nodeName stri
 	return pods, nil
 }
 
+// FindStuckTerminatingPodsInNamespaceAndNode returns the pods that FindEvictablePodsInNamespaceAndNode leaves
+// out because they are stuck terminating past their grace period
+func (i *Informers) FindStuckTerminatingPodsInNamespaceAndNode(namespace, nodeName string,
+	partialDrainEntity *protos.Entity) ([]*v1.Pod, error) {
+	compositeKey := fmt.Sprintf("%s/%s", namespace, nodeName)
+
+	objs, err := i.podInformer.GetIndexer().ByIndex(NamespaceNodeIndex, compositeKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pods by index: %w", err)
+	}
+
+	pods := make([]*v1.Pod, 0)
+
+	for _, obj := range objs {
+		pod, ok := obj.(*v1.Pod)
+		if !ok || i.isDaemonSetPod(pod) || pod.Status.Phase == v1.PodSucceeded || pod.Status.Phase == v1.PodFailed {
+			continue
+		}
+
+		if i.isPodStuckInTerminating(pod) {
+			pods = append(pods, pod)
+		}
+	}
+
+	pods, err = i.filterPodsUsingEntity(pods, partialDrainEntity, nodeName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to filter pods using entity: %w", err)
+	}
+
+	return pods, nil
+}
+
 /*
 This function will filter pods which are using the provided partialDrainEntity. If the partialDrainEntity is nil, all
 pods will be returned which corresponds to a full drain. The partialDrainEntity is initialized by calling
@@ -450,6 +496,15 @@ func (i *Informers) EvictAllPodsInImmediateMode(ctx context.Context,
 	return nil
 }
 
+// EvictPods sends eviction requests for the given pods of a namespace
+func (i *Informers) EvictPods(ctx context.Context, namespace string, timeout time.Duration, pods []*v1.Pod) error {
+	if len(pods) == 0 {
+		return nil
+	}
+
+	return i.evictPodsInNamespaceAndNode(ctx, namespace, timeout, pods)
+}
+
 func (i *Informers) evictPodsInNamespaceAndNode(ctx context.Context,
 	namespace string, timeout time.Duration, pods []*v1.Pod) error {
 	var wg sync.WaitGroup
@@ -518,11 +573,19 @@ func (i *Informers) sendEvictionRequestForPod(ctx context.Context, namespace str
 
 		if errors.IsTooManyRequests(err) {
 			metrics.ProcessingErrors.WithLabelValues("PDB_blocking_eviction_error", pod.Spec.NodeName).Inc()
+
+			if i.evictionObserver != nil {
+				i.evictionObserver.PodEvictionBlocked(pod)
+			}
 		}
 
 		return fmt.Errorf("error evicting pod %s from namespace %s: %w", pod.Name, pod.Namespace, err)
 	}
 
+	if i.evictionObserver != nil {
+		i.evictionObserver.PodEvicted(pod)
+	}
+
 	return nil
 }
 
@@ -694,6 +757,73 @@ func (i *Informers) DeletePodsAfterTimeout(ctx context.Context, nodeName string,
 		len(remainingPods), drainTimeout, nodeName)
 }
 
+// DeletePodsAfterTimeouts is DeletePodsAfterTimeout for pods with individual timeouts, keyed by
+// namespace/name: each pod is force deleted once its own timeout since the health event has passed
+func (i *Informers) DeletePodsAfterTimeouts(ctx context.Context, nodeName string, pods []*v1.Pod,
+	timeouts map[string]time.Duration, event *model.HealthEventWithStatus) error {
+	if len(pods) == 0 {
+		slog.InfoContext(ctx, "All pods on node have been deleted", "node", nodeName)
+		metrics.NodeDrainTimeout.WithLabelValues(nodeName).Set(0)
+
+		return nil
+	}
+
+	var expiredPods []*v1.Pod
+
+	waiting := make([]string, 0, len(pods))
+	nextDeadline := time.Time{}
+
+	for _, pod := range pods {
+		deadline := event.CreatedAt.Add(timeouts[pod.Namespace+"/"+pod.Name])
+		if !time.Now().Before(deadline) {
+			expiredPods = append(expiredPods, pod)
+			continue
+		}
+
+		if nextDeadline.IsZero() || deadline.Before(nextDeadline) {
+			nextDeadline = deadline
+		}
+
+		waiting = append(waiting, fmt.Sprintf("%s/%s (%s)", pod.Namespace, pod.Name,
+			deadline.UTC().Format(time.RFC3339)))
+	}
+
+	if len(expiredPods) > 0 {
+		slog.InfoContext(ctx, "Timeout reached for pods on node, force deleting them",
+			"node", nodeName,
+			"count", len(expiredPods))
+
+		for _, pod := range expiredPods {
+			metrics.NodeDrainTimeoutReached.WithLabelValues(nodeName, pod.Namespace).Inc()
+		}
+
+		if err := i.forceDeletePods(ctx, expiredPods); err != nil {
+			slog.ErrorContext(ctx, "Failed to force delete pods on node",
+				"node", nodeName,
+				"error", err)
+
+			return fmt.Errorf("failed to force delete pods on node %s: %w", nodeName, err)
+		}
+
+		return fmt.Errorf("force deleted %d pods, requeuing to verify deletion on node %s", len(expiredPods), nodeName)
+	}
+
+	metrics.NodeDrainTimeout.WithLabelValues(nodeName).Set(1)
+
+	sort.Strings(waiting)
+
+	message := fmt.Sprintf("Waiting for following pods to finish or be force deleted on their deadline: %v", waiting)
+
+	if err := i.UpdateNodeEvent(ctx, nodeName, "WaitingBeforeForceDelete", message); err != nil {
+		slog.ErrorContext(ctx, "Failed to update node event",
+			"node", nodeName,
+			"error", err)
+	}
+
+	return fmt.Errorf("waiting for %d pods to complete or timeout (%v remaining) on node %s",
+		len(waiting), time.Until(nextDeadline), nodeName)
+}
+
 func (i *Informers) getNodeDrainTimeout(timeout int,
 	event *model.HealthEventWithStatus) (time.Duration, error) {
 	elapsed := time.Since(event.CreatedAt)
@@ -737,6 +867,10 @@ func (i *Informers) forceDeletePods(ctx context.Context, pods []*v1.Pod) error {
 				slog.InfoContext(ctx, "Force deleted pod in namespace",
 					"pod", p.Name,
 					"namespace", p.Namespace)
+
+				if i.evictionObserver != nil {
+					i.evictionObserver.PodForceDeleted(p)
+				}
 			}
 		}(pod)
 	}
@@ -861,6 +995,70 @@ func (i *Informers) checkIfPodsPresentInNamespaceAndNode(namespaces []string, no
 	return allEvicted, remainingPods
 }
 
+// CheckIfPodsAreEvictedInImmediateMode is CheckIfAllPodsAreEvictedInImmediateMode for the given pods rather
+// than every pod of some namespaces: the pods still terminating past their grace period and the eviction
+// timeout are force deleted, and the pods are evicted once none of them is left in the cache
+func (i *Informers) CheckIfPodsAreEvictedInImmediateMode(ctx context.Context, pods []*v1.Pod, nodeName string,
+	timeout time.Duration) bool {
+	remainingPods := i.presentPods(pods)
+	if len(remainingPods) == 0 {
+		return true
+	}
+
+	if !shouldForceDeletePods(remainingPods, timeout) {
+		return false
+	}
+
+	slog.InfoContext(ctx, "Pods on node exceeded timeout, attempting force deletion",
+		"node", nodeName)
+
+	if err := i.forceDeletePods(ctx, remainingPods); err != nil {
+		metrics.ProcessingErrors.WithLabelValues("pods_force_deletion_error", nodeName).Inc()
+		slog.ErrorContext(ctx, "Failed to force delete pods on node",
+			"node", nodeName,
+			"error", err)
+
+		return false
+	}
+
+	return len(i.presentPods(remainingPods)) == 0
+}
+
+// presentPods returns the pods that are still in the pod cache
+func (i *Informers) presentPods(pods []*v1.Pod) []*v1.Pod {
+	present := make([]*v1.Pod, 0, len(pods))
+
+	for _, pod := range pods {
+		if _, exists, err := i.podInformer.GetIndexer().Get(pod); err != nil || exists {
+			present = append(present, pod)
+		}
+	}
+
+	return present
+}
+
+// shouldForceDeletePods reports whether any pod has been terminating past its grace period and the timeout
+func shouldForceDeletePods(pods []*v1.Pod, timeout time.Duration) bool {
+	now := time.Now()
+
+	for _, pod := range pods {
+		if pod.DeletionTimestamp == nil {
+			continue
+		}
+
+		gracePeriod := 30 * time.Second
+		if pod.Spec.TerminationGracePeriodSeconds != nil {
+			gracePeriod = time.Duration(*pod.Spec.TerminationGracePeriodSeconds) * time.Second
+		}
+
+		if now.After(pod.DeletionTimestamp.Add(gracePeriod).Add(timeout)) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (i *Informers) CheckIfAllPodsAreEvictedInImmediateMode(ctx context.Context,
 	namespaces []string, nodeName string, timeout time.Duration, partialDrainEntity *protos.Entity) bool {
 	allEvicted, remainingPods := i.checkIfPodsPresentInNamespaceAndNode(namespaces, nodeName, partialDrainEntity)
@@ -873,25 +1071,7 @@ func (i *Informers) CheckIfAllPodsAreEvictedInImmediateMode(ctx context.Context,
 		return true
 	}
 
-	now := time.Now()
-	shouldForceDelete := false
-
-	for _, pod := range remainingPods {
-		if pod.DeletionTimestamp != nil {
-			gracePeriod := 30 * time.Second
-			if pod.Spec.TerminationGracePeriodSeconds != nil {
-				gracePeriod = time.Duration(*pod.Spec.TerminationGracePeriodSeconds) * time.Second
-			}
-
-			deletionDeadline := pod.DeletionTimestamp.Add(gracePeriod).Add(timeout)
-			if now.After(deletionDeadline) {
-				shouldForceDelete = true
-				break
-			}
-		}
-	}
-
-	if shouldForceDelete {
+	if shouldForceDeletePods(remainingPods, timeout) {
 		slog.InfoContext(ctx, "Pods on node exceeded timeout, attempting force deletion",
 			"node", nodeName)
 
@@ -0,0 +1,152 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package informers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	"github.com/nvidia/nvsentinel/data-models/pkg/model"
+	"github.com/nvidia/nvsentinel/data-models/pkg/protos"
+)
+
+func testPod(name string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ml"},
+		Spec:       v1.PodSpec{NodeName: "node-1"},
+		Status:     v1.PodStatus{Phase: v1.PodRunning},
+	}
+}
+
+func newTestInformers(t *testing.T, pods ...*v1.Pod) (*Informers, *fake.Clientset) {
+	t.Helper()
+
+	clientset := fake.NewSimpleClientset(&v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}})
+	for _, pod := range pods {
+		_, err := clientset.CoreV1().Pods(pod.Namespace).Create(context.Background(), pod, metav1.CreateOptions{})
+		require.NoError(t, err)
+	}
+
+	i, err := NewInformers(clientset, time.Minute, ptr.To(5), false)
+	require.NoError(t, err)
+	require.NoError(t, i.Run(t.Context()))
+
+	return i, clientset
+}
+
+func podExists(t *testing.T, clientset *fake.Clientset, name string) bool {
+	t.Helper()
+
+	_, err := clientset.CoreV1().Pods("ml").Get(context.Background(), name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return false
+	}
+
+	require.NoError(t, err)
+
+	return true
+}
+
+func TestDeletePodsAfterTimeouts(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("succeeds when no pods are left", func(t *testing.T) {
+		i, _ := newTestInformers(t)
+		event := &model.HealthEventWithStatus{CreatedAt: time.Now(), HealthEvent: &protos.HealthEvent{}}
+
+		assert.NoError(t, i.DeletePodsAfterTimeouts(ctx, "node-1", nil, nil, event))
+	})
+
+	t.Run("force deletes only the pods whose own timeout passed", func(t *testing.T) {
+		expired := testPod("expired")
+		waiting := testPod("waiting")
+		i, clientset := newTestInformers(t, expired, waiting)
+
+		event := &model.HealthEventWithStatus{
+			CreatedAt:   time.Now().Add(-30 * time.Minute),
+			HealthEvent: &protos.HealthEvent{NodeName: "node-1"},
+		}
+		timeouts := map[string]time.Duration{"ml/expired": 10 * time.Minute, "ml/waiting": time.Hour}
+
+		err := i.DeletePodsAfterTimeouts(ctx, "node-1", []*v1.Pod{expired, waiting}, timeouts, event)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "force deleted 1 pods")
+
+		assert.False(t, podExists(t, clientset, "expired"))
+		assert.True(t, podExists(t, clientset, "waiting"))
+	})
+
+	t.Run("waits while every pod is within its timeout", func(t *testing.T) {
+		pod := testPod("training")
+		i, clientset := newTestInformers(t, pod)
+
+		event := &model.HealthEventWithStatus{
+			CreatedAt:   time.Now(),
+			HealthEvent: &protos.HealthEvent{NodeName: "node-1"},
+		}
+
+		err := i.DeletePodsAfterTimeouts(ctx, "node-1", []*v1.Pod{pod},
+			map[string]time.Duration{"ml/training": time.Hour}, event)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "waiting for 1 pods to complete or timeout")
+		assert.True(t, podExists(t, clientset, "training"))
+	})
+}
+
+func TestCheckIfPodsAreEvictedInImmediateMode(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("evicted once the pods are gone", func(t *testing.T) {
+		i, _ := newTestInformers(t)
+
+		assert.True(t, i.CheckIfPodsAreEvictedInImmediateMode(ctx, []*v1.Pod{testPod("gone")}, "node-1", time.Minute))
+	})
+
+	t.Run("force deletes pods stuck terminating past the timeout", func(t *testing.T) {
+		stuck := testPod("stuck")
+		stuck.DeletionTimestamp = ptr.To(metav1.NewTime(time.Now().Add(-time.Hour)))
+		stuck.Spec.TerminationGracePeriodSeconds = ptr.To(int64(30))
+		i, clientset := newTestInformers(t, stuck)
+
+		stuckPods, err := i.FindStuckTerminatingPodsInNamespaceAndNode("ml", "node-1", nil)
+		require.NoError(t, err)
+		require.Len(t, stuckPods, 1)
+
+		evictable, err := i.FindEvictablePodsInNamespaceAndNode("ml", "node-1", nil)
+		require.NoError(t, err)
+		assert.Empty(t, evictable)
+
+		i.CheckIfPodsAreEvictedInImmediateMode(ctx, stuckPods, "node-1", time.Minute)
+		assert.False(t, podExists(t, clientset, "stuck"))
+	})
+
+	t.Run("waits for pods still within the timeout", func(t *testing.T) {
+		pod := testPod("terminating")
+		pod.DeletionTimestamp = ptr.To(metav1.NewTime(time.Now()))
+		i, clientset := newTestInformers(t, pod)
+
+		assert.False(t, i.CheckIfPodsAreEvictedInImmediateMode(ctx, []*v1.Pod{pod}, "node-1", time.Minute))
+		assert.True(t, podExists(t, clientset, "terminating"))
+	})
+}
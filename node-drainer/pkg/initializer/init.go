@@ -24,6 +24,7 @@ import (
 	"net/http"
 	"time"
 
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/discovery/cached/memory"
 	"k8s.io/client-go/dynamic"
@@ -31,10 +32,14 @@ import (
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/restmapper"
 	"k8s.io/client-go/tools/clientcmd"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/nvidia/nvsentinel/commons/pkg/auditlogger"
 	"github.com/nvidia/nvsentinel/commons/pkg/statemanager"
+	drainv1alpha1 "github.com/nvidia/nvsentinel/node-drainer/api/v1alpha1"
 	"github.com/nvidia/nvsentinel/node-drainer/pkg/config"
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/drainstatus"
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/gang"
 	"github.com/nvidia/nvsentinel/node-drainer/pkg/informers"
 	"github.com/nvidia/nvsentinel/node-drainer/pkg/queue"
 	"github.com/nvidia/nvsentinel/node-drainer/pkg/reconciler"
@@ -63,6 +68,8 @@ type Components struct {
 	DatabaseClient     client.DatabaseClient
 	DataStore          datastore.DataStore
 	CustomDrainEnabled bool
+	DrainPluginEnabled bool
+	DrainStatus        *drainstatus.Recorder // nil when NodeDrain status resources are disabled
 }
 
 // InitializeAll creates all node-drainer runtime dependencies from the given params and returns them as Components.
@@ -142,6 +149,33 @@ func InitializeAll(ctx context.Context, params InitializationParams) (*Component
 		return nil, fmt.Errorf("failed to initialize reconciler: %w", err)
 	}
 
+	if configs.tomlCfg.GangDrain.Enabled {
+		disrupter, err := initializeGangDisrupter(configs.tomlCfg.GangDrain, restConfig, restMapper,
+			clientSet, informersInstance, params.DryRun)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize gang-aware drain: %w", err)
+		}
+
+		reconcilerInstance.SetGangDisrupter(disrupter)
+
+		slog.InfoContext(ctx, "Running with gang-aware drain enabled", "mode", configs.tomlCfg.GangDrain.Mode)
+	}
+
+	var drainStatusRecorder *drainstatus.Recorder
+
+	if configs.tomlCfg.DrainStatus.Enabled {
+		drainStatusRecorder, err = initializeDrainStatusRecorder(configs.tomlCfg.DrainStatus, restConfig, restMapper)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize drain status: %w", err)
+		}
+
+		reconcilerInstance.SetDrainStatusRecorder(drainStatusRecorder)
+		informersInstance.SetEvictionObserver(drainStatusRecorder)
+
+		slog.InfoContext(ctx, "Running with NodeDrain status resources enabled",
+			"ttlHours", configs.tomlCfg.DrainStatus.TTLHours)
+	}
+
 	queueManager := reconcilerInstance.GetQueueManager()
 
 	slog.InfoContext(ctx, "Initialization completed successfully")
@@ -156,6 +190,8 @@ func InitializeAll(ctx context.Context, params InitializationParams) (*Component
 		DatabaseClient:     dsComponents.databaseClient,
 		DataStore:          ds,
 		CustomDrainEnabled: configs.tomlCfg.CustomDrain.Enabled,
+		DrainPluginEnabled: configs.tomlCfg.DrainPlugin.Enabled,
+		DrainStatus:        drainStatusRecorder,
 	}, nil
 }
 
@@ -226,6 +262,45 @@ func initializeDynamicClientAndMapper(
 	return dynamicClient, restMapper, nil
 }
 
+func initializeGangDisrupter(
+	cfg config.GangDrainConfig,
+	restConfig *rest.Config,
+	restMapper *restmapper.DeferredDiscoveryRESTMapper,
+	clientSet kubernetes.Interface,
+	informersInstance *informers.Informers,
+	dryRun bool,
+) (*gang.Disrupter, error) {
+	c, err := ctrlclient.New(restConfig, ctrlclient.Options{Mapper: restMapper})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create controller-runtime client: %w", err)
+	}
+
+	discoverer, err := gang.NewDiscoverer(cfg.Discovery, c, restMapper)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gang discoverer: %w", err)
+	}
+
+	return gang.NewDisrupter(cfg, discoverer, clientSet, informersInstance, dryRun), nil
+}
+
+func initializeDrainStatusRecorder(
+	cfg config.DrainStatusConfig,
+	restConfig *rest.Config,
+	restMapper *restmapper.DeferredDiscoveryRESTMapper,
+) (*drainstatus.Recorder, error) {
+	scheme := runtime.NewScheme()
+	if err := drainv1alpha1.AddToScheme(scheme); err != nil {
+		return nil, fmt.Errorf("failed to add NodeDrain types to scheme: %w", err)
+	}
+
+	c, err := ctrlclient.New(restConfig, ctrlclient.Options{Scheme: scheme, Mapper: restMapper})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create controller-runtime client: %w", err)
+	}
+
+	return drainstatus.NewRecorder(cfg, c), nil
+}
+
 type datastoreComponents struct {
 	databaseClient client.DatabaseClient
 	eventWatcher   client.ChangeStreamWatcher
@@ -119,6 +119,67 @@ var (
 		},
 	)
 
+	// ActiveDrains tracks the nodes holding a drain slot
+	ActiveDrains = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "node_drainer_active_drains",
+			Help: "Number of nodes currently draining under the drain concurrency limits.",
+		},
+	)
+
+	// WaitingDrains tracks the nodes waiting for a drain slot
+	WaitingDrains = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "node_drainer_waiting_drains",
+			Help: "Number of nodes waiting for a drain slot under the drain concurrency limits.",
+		},
+	)
+
+	// DrainWaitDuration tracks how long nodes waited for a drain slot
+	DrainWaitDuration = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "node_drainer_drain_wait_duration_seconds",
+			Help:    "Time nodes waited for a drain slot before draining started.",
+			Buckets: prometheus.ExponentialBuckets(1, 2, 15),
+		},
+	)
+
+	// CheckpointHandshakes tracks checkpoint requests sent to pods and how their handshakes ended
+	CheckpointHandshakes = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "node_drainer_checkpoint_handshakes_total",
+			Help: "Total number of pod checkpoint handshakes by outcome (requested, acknowledged, deadline_exceeded).",
+		},
+		[]string{"outcome"},
+	)
+
+	// GangDisruptions tracks gangs disrupted by node drains
+	GangDisruptions = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "node_drainer_gang_disruptions_total",
+			Help: "Total number of multi-node gangs disrupted by node drains, by gang drain mode.",
+		},
+		[]string{"mode"},
+	)
+
+	// WorkloadRequeues tracks workloads handed back to their batch queue system before eviction
+	WorkloadRequeues = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "node_drainer_workload_requeues_total",
+			Help: "Total number of workloads handed back to their queue system before eviction, by system and outcome.",
+		},
+		[]string{"system", "outcome"},
+	)
+
+	// RejectedDrainAnnotations tracks pod drain-policy annotations that were ignored or clamped
+	RejectedDrainAnnotations = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "node_drainer_rejected_drain_annotations_total",
+			Help: "Total number of pod drain-policy annotations ignored or clamped, by reason.",
+		},
+		[]string{"reason"},
+	)
+
 	// CustomDrainCRDNotFound tracks failures when custom drain CRD is not found
 	CustomDrainCRDNotFound = promauto.NewCounterVec(
 		prometheus.CounterOpts{
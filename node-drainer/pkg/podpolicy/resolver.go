@@ -0,0 +1,137 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package podpolicy resolves the eviction mode of individual pods from label selector policies and
+// pod annotations, so that one namespace can mix pods drained in different modes.
+package podpolicy
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/config"
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/metrics"
+)
+
+const (
+	// ModeAnnotation overrides the eviction mode of a pod
+	ModeAnnotation = "nvsentinel.nvidia.com/drain-mode"
+	// MaxWaitAnnotation sets how long, as a Go duration, a pod may keep running after the health event
+	// before it is force deleted
+	MaxWaitAnnotation = "nvsentinel.nvidia.com/drain-max-wait"
+)
+
+// Policy is the resolved drain policy of a pod
+type Policy struct {
+	Mode config.EvictMode
+	// Timeout is the time since the health event after which a DeleteAfterTimeout pod is force deleted
+	Timeout time.Duration
+}
+
+type selectorPolicy struct {
+	selector labels.Selector
+	mode     config.EvictMode
+}
+
+type Resolver struct {
+	policies         []selectorPolicy
+	allowAnnotations bool
+	defaultTimeout   time.Duration
+	maxWait          time.Duration
+}
+
+// NewResolver creates the resolver for the configuration, or returns nil when per-pod drain policies
+// are disabled
+func NewResolver(cfg config.TomlConfig) (*Resolver, error) {
+	if !cfg.PodDrainPolicy.Enabled {
+		return nil, nil
+	}
+
+	r := &Resolver{
+		allowAnnotations: cfg.PodDrainPolicy.AllowAnnotationOverride,
+		defaultTimeout:   time.Duration(cfg.DeleteAfterTimeoutMinutes) * time.Minute,
+		maxWait:          time.Duration(cfg.PodDrainPolicy.MaxWaitMinutes) * time.Minute,
+	}
+
+	for i, policy := range cfg.PodDrainPolicy.Policies {
+		selector, err := labels.Parse(policy.Selector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid selector %q of pod drain policy %d: %w", policy.Selector, i, err)
+		}
+
+		r.policies = append(r.policies, selectorPolicy{selector: selector, mode: policy.Mode})
+	}
+
+	return r, nil
+}
+
+// Resolve returns the drain policy of the pod. The pod's annotations take precedence over the first
+// matching selector policy, which takes precedence over the mode of the pod's namespace. A maximum wait
+// turns an AllowCompletion pod into a DeleteAfterTimeout one.
+func (r *Resolver) Resolve(ctx context.Context, pod *v1.Pod, namespaceMode config.EvictMode) Policy {
+	policy := Policy{Mode: namespaceMode, Timeout: r.defaultTimeout}
+
+	for _, p := range r.policies {
+		if p.selector.Matches(labels.Set(pod.Labels)) {
+			policy.Mode = p.mode
+			break
+		}
+	}
+
+	if !r.allowAnnotations {
+		return policy
+	}
+
+	if mode, ok := pod.Annotations[ModeAnnotation]; ok {
+		switch config.EvictMode(mode) {
+		case config.ModeImmediateEvict, config.ModeAllowCompletion, config.ModeDeleteAfterTimeout:
+			policy.Mode = config.EvictMode(mode)
+		default:
+			r.reject(ctx, pod, "invalid_mode", "Ignoring invalid drain mode annotation", mode)
+		}
+	}
+
+	if value, ok := pod.Annotations[MaxWaitAnnotation]; ok && policy.Mode != config.ModeImmediateEvict {
+		maxWait, err := time.ParseDuration(value)
+
+		switch {
+		case err != nil || maxWait <= 0:
+			r.reject(ctx, pod, "invalid_max_wait", "Ignoring invalid drain max wait annotation", value)
+		case maxWait > r.maxWait:
+			r.reject(ctx, pod, "max_wait_exceeded", "Clamping drain max wait annotation to the cluster maximum", value)
+
+			policy.Mode = config.ModeDeleteAfterTimeout
+			policy.Timeout = r.maxWait
+		default:
+			policy.Mode = config.ModeDeleteAfterTimeout
+			policy.Timeout = maxWait
+		}
+	}
+
+	return policy
+}
+
+func (r *Resolver) reject(ctx context.Context, pod *v1.Pod, reason, msg, value string) {
+	metrics.RejectedDrainAnnotations.WithLabelValues(reason).Inc()
+	slog.WarnContext(ctx, msg,
+		"pod", pod.Name,
+		"namespace", pod.Namespace,
+		"value", value,
+		"maxWait", r.maxWait)
+}
@@ -0,0 +1,154 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package podpolicy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/config"
+)
+
+func newResolver(t *testing.T, allowAnnotations bool) *Resolver {
+	t.Helper()
+
+	r, err := NewResolver(config.TomlConfig{
+		DeleteAfterTimeoutMinutes: 60,
+		PodDrainPolicy: config.PodDrainPolicyConfig{
+			Enabled: true,
+			Policies: []config.PodDrainPolicy{
+				{Selector: "workload=inference", Mode: config.ModeImmediateEvict},
+				{Selector: "workload in (training, inference)", Mode: config.ModeAllowCompletion},
+			},
+			AllowAnnotationOverride: allowAnnotations,
+			MaxWaitMinutes:          120,
+		},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, r)
+
+	return r
+}
+
+func newPod(labels, annotations map[string]string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "ml", Labels: labels, Annotations: annotations},
+	}
+}
+
+func TestNewResolver_Disabled(t *testing.T) {
+	r, err := NewResolver(config.TomlConfig{})
+	require.NoError(t, err)
+	assert.Nil(t, r)
+}
+
+func TestNewResolver_InvalidSelector(t *testing.T) {
+	_, err := NewResolver(config.TomlConfig{
+		PodDrainPolicy: config.PodDrainPolicyConfig{
+			Enabled:  true,
+			Policies: []config.PodDrainPolicy{{Selector: "workload in (", Mode: config.ModeImmediateEvict}},
+		},
+	})
+	assert.Error(t, err)
+}
+
+func TestResolve(t *testing.T) {
+	tests := []struct {
+		name             string
+		allowAnnotations bool
+		labels           map[string]string
+		annotations      map[string]string
+		expected         Policy
+	}{
+		{
+			name:     "no matching policy keeps namespace mode",
+			labels:   map[string]string{"workload": "batch"},
+			expected: Policy{Mode: config.ModeDeleteAfterTimeout, Timeout: time.Hour},
+		},
+		{
+			name:     "first matching policy wins",
+			labels:   map[string]string{"workload": "inference"},
+			expected: Policy{Mode: config.ModeImmediateEvict, Timeout: time.Hour},
+		},
+		{
+			name:     "later policy matches",
+			labels:   map[string]string{"workload": "training"},
+			expected: Policy{Mode: config.ModeAllowCompletion, Timeout: time.Hour},
+		},
+		{
+			name:        "annotations ignored unless allowed",
+			labels:      map[string]string{"workload": "training"},
+			annotations: map[string]string{ModeAnnotation: "Immediate"},
+			expected:    Policy{Mode: config.ModeAllowCompletion, Timeout: time.Hour},
+		},
+		{
+			name:             "mode annotation overrides selector",
+			allowAnnotations: true,
+			labels:           map[string]string{"workload": "inference"},
+			annotations:      map[string]string{ModeAnnotation: "AllowCompletion"},
+			expected:         Policy{Mode: config.ModeAllowCompletion, Timeout: time.Hour},
+		},
+		{
+			name:             "invalid mode annotation is ignored",
+			allowAnnotations: true,
+			labels:           map[string]string{"workload": "inference"},
+			annotations:      map[string]string{ModeAnnotation: "Never"},
+			expected:         Policy{Mode: config.ModeImmediateEvict, Timeout: time.Hour},
+		},
+		{
+			name:             "max wait bounds allow completion",
+			allowAnnotations: true,
+			labels:           map[string]string{"workload": "training"},
+			annotations:      map[string]string{MaxWaitAnnotation: "90m"},
+			expected:         Policy{Mode: config.ModeDeleteAfterTimeout, Timeout: 90 * time.Minute},
+		},
+		{
+			name:             "max wait above cluster maximum is clamped",
+			allowAnnotations: true,
+			labels:           map[string]string{"workload": "training"},
+			annotations:      map[string]string{MaxWaitAnnotation: "24h"},
+			expected:         Policy{Mode: config.ModeDeleteAfterTimeout, Timeout: 2 * time.Hour},
+		},
+		{
+			name:             "invalid max wait is ignored",
+			allowAnnotations: true,
+			labels:           map[string]string{"workload": "training"},
+			annotations:      map[string]string{MaxWaitAnnotation: "forever"},
+			expected:         Policy{Mode: config.ModeAllowCompletion, Timeout: time.Hour},
+		},
+		{
+			name:             "max wait does not delay immediate eviction",
+			allowAnnotations: true,
+			labels:           map[string]string{"workload": "inference"},
+			annotations:      map[string]string{MaxWaitAnnotation: "30m"},
+			expected:         Policy{Mode: config.ModeImmediateEvict, Timeout: time.Hour},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newResolver(t, tt.allowAnnotations)
+
+			policy := r.Resolve(context.Background(), newPod(tt.labels, tt.annotations), config.ModeDeleteAfterTimeout)
+			assert.Equal(t, tt.expected, policy)
+		})
+	}
+}
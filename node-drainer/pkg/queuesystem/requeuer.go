@@ -0,0 +1,482 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package queuesystem hands pods managed by a batch queue system back to that system before node-drainer
+// evicts them. The owning Kueue Workload is evicted and the owning Volcano Job restarted, so that the queue
+// system requeues the job with its priority and queue instead of the job failing.
+package queuesystem
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/config"
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/metrics"
+)
+
+const (
+	// RequestedAnnotation is set on the Kueue Workload or Volcano Job to the time its requeue was requested
+	RequestedAnnotation = "nvsentinel.nvidia.com/requeue-requested"
+	// NodeAnnotation is set on the Kueue Workload or Volcano Job to the node whose drain requested the requeue
+	NodeAnnotation = "nvsentinel.nvidia.com/requeue-node"
+	// OutcomeAnnotation is set on the Kueue Workload or Volcano Job once the requeue was released or timed out
+	OutcomeAnnotation = "nvsentinel.nvidia.com/requeue-outcome"
+
+	OutcomeReleased = "released"
+	OutcomeTimeout  = "timeout"
+
+	// VolcanoJobNameKey is the label and annotation Volcano sets on the pods of a Job
+	VolcanoJobNameKey = "volcano.sh/job-name"
+
+	kueueEvictedCondition       = "Evicted"
+	kueueQuotaReservedCondition = "QuotaReserved"
+	requeueReason               = "NodeDrain"
+)
+
+var (
+	KueueWorkloadGVR = schema.GroupVersionResource{
+		Group: "kueue.x-k8s.io", Version: "v1beta1", Resource: "workloads",
+	}
+	VolcanoJobGVR = schema.GroupVersionResource{
+		Group: "batch.volcano.sh", Version: "v1alpha1", Resource: "jobs",
+	}
+	VolcanoCommandGVR = schema.GroupVersionResource{
+		Group: "bus.volcano.sh", Version: "v1alpha1", Resource: "commands",
+	}
+)
+
+// Result of a requeue pass over the pods of a drained node
+type Result struct {
+	// Ready holds the pods node-drainer evicts itself: pods no queue system manages, and pods whose
+	// workload was released by its queue system or did not release the node in time
+	Ready []*v1.Pod
+	// Pending holds the workloads, as system/namespace/name, whose queue system has not released the node yet
+	Pending []string
+}
+
+type workload struct {
+	system config.QueueSystem
+	obj    *unstructured.Unstructured
+}
+
+func (w workload) key() string {
+	return fmt.Sprintf("%s/%s/%s", w.system, w.obj.GetNamespace(), w.obj.GetName())
+}
+
+type Requeuer struct {
+	clientset     kubernetes.Interface
+	dynamicClient dynamic.Interface
+	systems       []config.QueueSystem
+	timeout       time.Duration
+	dryRun        bool
+	dryRunMode    []string
+}
+
+// NewRequeuer creates the requeuer for the configuration, or returns nil when the queue-system
+// integration is disabled
+func NewRequeuer(cfg config.QueueIntegrationConfig, clientset kubernetes.Interface,
+	dynamicClient dynamic.Interface, dryRun bool) *Requeuer {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	dryRunMode := []string{}
+	if dryRun {
+		dryRunMode = []string{metav1.DryRunAll}
+	}
+
+	return &Requeuer{
+		clientset:     clientset,
+		dynamicClient: dynamicClient,
+		systems:       cfg.Systems,
+		timeout:       cfg.Timeout.Duration,
+		dryRun:        dryRun,
+		dryRunMode:    dryRunMode,
+	}
+}
+
+// Run requests the requeue of the workloads owning the pods of the drained node, once per drain started
+// at since, and reports which pods are ready to be evicted
+func (q *Requeuer) Run(ctx context.Context, nodeName string, pods []*v1.Pod, since time.Time) (Result, error) {
+	var result Result
+
+	workloads := make(map[string]*workload)
+	ready := make(map[string]bool)
+	kueueWorkloads := make(map[string][]unstructured.Unstructured)
+
+	for _, pod := range pods {
+		w, err := q.findWorkload(ctx, pod, kueueWorkloads)
+		if err != nil {
+			return result, err
+		}
+
+		if w == nil {
+			result.Ready = append(result.Ready, pod)
+			continue
+		}
+
+		key := w.key()
+		if _, seen := workloads[key]; !seen {
+			workloads[key] = w
+
+			released, err := q.requeue(ctx, nodeName, w, since)
+			if err != nil {
+				return result, fmt.Errorf("failed to requeue %s: %w", key, err)
+			}
+
+			ready[key] = released
+			if !released {
+				result.Pending = append(result.Pending, key)
+			}
+		}
+
+		if ready[key] {
+			result.Ready = append(result.Ready, pod)
+		}
+	}
+
+	return result, nil
+}
+
+// requeue requests the requeue of the workload unless already requested for this drain, and returns
+// whether node-drainer may evict its pods
+func (q *Requeuer) requeue(ctx context.Context, nodeName string, w *workload, since time.Time) (bool, error) {
+	requested, err := time.Parse(time.RFC3339, w.obj.GetAnnotations()[RequestedAnnotation])
+	if err != nil || requested.Before(since.Truncate(time.Second)) {
+		if err := q.request(ctx, nodeName, w); err != nil {
+			return false, err
+		}
+
+		metrics.WorkloadRequeues.WithLabelValues(string(w.system), "requested").Inc()
+
+		slog.InfoContext(ctx, "Requested requeue of workload before eviction",
+			"node", nodeName,
+			"system", w.system,
+			"namespace", w.obj.GetNamespace(),
+			"workload", w.obj.GetName())
+
+		// Nothing is persisted in dry-run mode, so the pods are evicted as if the workload was released
+		return q.dryRun, nil
+	}
+
+	if outcome := w.obj.GetAnnotations()[OutcomeAnnotation]; outcome != "" {
+		return true, nil
+	}
+
+	outcome := ""
+
+	switch {
+	case q.released(w, requested):
+		outcome = OutcomeReleased
+	case time.Since(requested) > q.timeout:
+		outcome = OutcomeTimeout
+
+		slog.WarnContext(ctx, "Queue system did not release workload in time, evicting its pods",
+			"node", nodeName,
+			"system", w.system,
+			"namespace", w.obj.GetNamespace(),
+			"workload", w.obj.GetName(),
+			"timeout", q.timeout)
+	default:
+		return false, nil
+	}
+
+	metrics.WorkloadRequeues.WithLabelValues(string(w.system), outcome).Inc()
+
+	if _, err := q.annotate(ctx, w, map[string]string{OutcomeAnnotation: outcome}); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (q *Requeuer) request(ctx context.Context, nodeName string, w *workload) error {
+	obj, err := q.annotate(ctx, w, map[string]string{
+		RequestedAnnotation: time.Now().UTC().Format(time.RFC3339),
+		NodeAnnotation:      nodeName,
+		OutcomeAnnotation:   "",
+	})
+	if err != nil {
+		return err
+	}
+
+	message := fmt.Sprintf("Node %s is being drained by NVSentinel", nodeName)
+
+	switch w.system {
+	case config.QueueSystemKueue:
+		return q.evictKueueWorkload(ctx, obj, message)
+	case config.QueueSystemVolcano:
+		return q.restartVolcanoJob(ctx, obj, message)
+	}
+
+	return nil
+}
+
+// annotate sets the annotations on the workload, removing those set to an empty value
+func (q *Requeuer) annotate(ctx context.Context, w *workload,
+	annotations map[string]string) (*unstructured.Unstructured, error) {
+	values := make(map[string]any, len(annotations))
+
+	for key, value := range annotations {
+		if value == "" {
+			values[key] = nil
+		} else {
+			values[key] = value
+		}
+	}
+
+	patch, err := (&unstructured.Unstructured{Object: map[string]any{
+		"metadata": map[string]any{"annotations": values},
+	}}).MarshalJSON()
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal annotation patch: %w", err)
+	}
+
+	obj, err := q.dynamicClient.Resource(gvrFor(w.system)).Namespace(w.obj.GetNamespace()).Patch(ctx,
+		w.obj.GetName(), types.MergePatchType, patch, metav1.PatchOptions{DryRun: q.dryRunMode})
+	if err != nil {
+		return nil, fmt.Errorf("failed to annotate %s: %w", w.key(), err)
+	}
+
+	return obj, nil
+}
+
+// evictKueueWorkload sets the Evicted condition on the Workload. Kueue then suspends the job, releases its
+// quota and requeues the Workload, which keeps its queue and priority.
+func (q *Requeuer) evictKueueWorkload(ctx context.Context, obj *unstructured.Unstructured, message string) error {
+	conditions, _, err := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	if err != nil {
+		return fmt.Errorf("failed to read conditions of workload %s/%s: %w", obj.GetNamespace(), obj.GetName(), err)
+	}
+
+	evicted := map[string]any{
+		"type":               kueueEvictedCondition,
+		"status":             string(metav1.ConditionTrue),
+		"reason":             requeueReason,
+		"message":            message,
+		"lastTransitionTime": time.Now().UTC().Format(time.RFC3339),
+		"observedGeneration": obj.GetGeneration(),
+	}
+
+	updated := make([]any, 0, len(conditions)+1)
+
+	for _, condition := range conditions {
+		if c, ok := condition.(map[string]any); ok && c["type"] == kueueEvictedCondition {
+			continue
+		}
+
+		updated = append(updated, condition)
+	}
+
+	if err := unstructured.SetNestedSlice(obj.Object, append(updated, evicted), "status", "conditions"); err != nil {
+		return fmt.Errorf("failed to set Evicted condition: %w", err)
+	}
+
+	if _, err := q.dynamicClient.Resource(KueueWorkloadGVR).Namespace(obj.GetNamespace()).UpdateStatus(ctx, obj,
+		metav1.UpdateOptions{DryRun: q.dryRunMode}); err != nil {
+		return fmt.Errorf("failed to evict workload %s/%s: %w", obj.GetNamespace(), obj.GetName(), err)
+	}
+
+	return nil
+}
+
+// restartVolcanoJob creates a RestartJob command for the Job. Volcano then kills its pods and requeues the
+// Job through its PodGroup, which keeps its queue and priority.
+func (q *Requeuer) restartVolcanoJob(ctx context.Context, obj *unstructured.Unstructured, message string) error {
+	target := map[string]any{
+		"apiVersion": obj.GetAPIVersion(),
+		"kind":       obj.GetKind(),
+		"name":       obj.GetName(),
+		"uid":        string(obj.GetUID()),
+		"controller": true,
+	}
+
+	command := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": VolcanoCommandGVR.GroupVersion().String(),
+		"kind":       "Command",
+		"metadata": map[string]any{
+			"generateName":    obj.GetName() + "-",
+			"namespace":       obj.GetNamespace(),
+			"ownerReferences": []any{target},
+		},
+		"action":  "RestartJob",
+		"target":  target,
+		"reason":  requeueReason,
+		"message": message,
+	}}
+
+	if _, err := q.dynamicClient.Resource(VolcanoCommandGVR).Namespace(obj.GetNamespace()).Create(ctx, command,
+		metav1.CreateOptions{DryRun: q.dryRunMode}); err != nil {
+		return fmt.Errorf("failed to restart job %s/%s: %w", obj.GetNamespace(), obj.GetName(), err)
+	}
+
+	return nil
+}
+
+// released reports whether the queue system released the workload's pods since the requeue request
+func (q *Requeuer) released(w *workload, requested time.Time) bool {
+	switch w.system {
+	case config.QueueSystemKueue:
+		reserved, since := condition(w.obj, kueueQuotaReservedCondition)
+		// The Workload may have been readmitted elsewhere already
+		return !reserved || !since.Before(requested)
+	case config.QueueSystemVolcano:
+		phase, _, _ := unstructured.NestedString(w.obj.Object, "status", "state", "phase")
+		transition, _, _ := unstructured.NestedString(w.obj.Object, "status", "state", "lastTransitionTime")
+		since, _ := time.Parse(time.RFC3339, transition)
+
+		switch phase {
+		case "Pending", "Aborted", "Completed", "Failed", "Terminated":
+			return true
+		case "Running":
+			return !since.Before(requested)
+		}
+	}
+
+	return false
+}
+
+// condition returns whether the condition of the object is true and when it last changed
+func condition(obj *unstructured.Unstructured, conditionType string) (bool, time.Time) {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+
+	for _, condition := range conditions {
+		c, ok := condition.(map[string]any)
+		if !ok || c["type"] != conditionType {
+			continue
+		}
+
+		transition, _ := c["lastTransitionTime"].(string)
+		since, _ := time.Parse(time.RFC3339, transition)
+
+		return c["status"] == string(metav1.ConditionTrue), since
+	}
+
+	return false, time.Time{}
+}
+
+func (q *Requeuer) findWorkload(ctx context.Context, pod *v1.Pod,
+	kueueWorkloads map[string][]unstructured.Unstructured) (*workload, error) {
+	for _, system := range q.systems {
+		var (
+			w   *workload
+			err error
+		)
+
+		switch system {
+		case config.QueueSystemKueue:
+			w, err = q.findKueueWorkload(ctx, pod, kueueWorkloads)
+		case config.QueueSystemVolcano:
+			w, err = q.findVolcanoJob(ctx, pod)
+		}
+
+		if err != nil || w != nil {
+			return w, err
+		}
+	}
+
+	return nil, nil
+}
+
+// findKueueWorkload finds the Workload owned by the pod, its controller, or the controller of its Job
+func (q *Requeuer) findKueueWorkload(ctx context.Context, pod *v1.Pod,
+	cache map[string][]unstructured.Unstructured) (*workload, error) {
+	owners := map[types.UID]struct{}{pod.UID: {}}
+
+	if controller := metav1.GetControllerOf(pod); controller != nil {
+		owners[controller.UID] = struct{}{}
+
+		if controller.Kind == "Job" && strings.HasPrefix(controller.APIVersion, "batch/") {
+			job, err := q.clientset.BatchV1().Jobs(pod.Namespace).Get(ctx, controller.Name, metav1.GetOptions{})
+
+			switch {
+			case errors.IsNotFound(err):
+			case err != nil:
+				return nil, fmt.Errorf("failed to get job %s/%s: %w", pod.Namespace, controller.Name, err)
+			default:
+				if jobController := metav1.GetControllerOf(job); jobController != nil {
+					owners[jobController.UID] = struct{}{}
+				}
+			}
+		}
+	}
+
+	workloads, ok := cache[pod.Namespace]
+	if !ok {
+		list, err := q.dynamicClient.Resource(KueueWorkloadGVR).Namespace(pod.Namespace).List(ctx, metav1.ListOptions{})
+
+		switch {
+		case errors.IsNotFound(err):
+			// Kueue is not installed
+		case err != nil:
+			return nil, fmt.Errorf("failed to list workloads in namespace %s: %w", pod.Namespace, err)
+		default:
+			workloads = list.Items
+		}
+
+		cache[pod.Namespace] = workloads
+	}
+
+	for i := range workloads {
+		for _, ref := range workloads[i].GetOwnerReferences() {
+			if _, ok := owners[ref.UID]; ok {
+				return &workload{system: config.QueueSystemKueue, obj: &workloads[i]}, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func (q *Requeuer) findVolcanoJob(ctx context.Context, pod *v1.Pod) (*workload, error) {
+	name := pod.Annotations[VolcanoJobNameKey]
+	if name == "" {
+		name = pod.Labels[VolcanoJobNameKey]
+	}
+
+	if name == "" {
+		return nil, nil
+	}
+
+	job, err := q.dynamicClient.Resource(VolcanoJobGVR).Namespace(pod.Namespace).Get(ctx, name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get volcano job %s/%s: %w", pod.Namespace, name, err)
+	}
+
+	return &workload{system: config.QueueSystemVolcano, obj: job}, nil
+}
+
+func gvrFor(system config.QueueSystem) schema.GroupVersionResource {
+	if system == config.QueueSystemVolcano {
+		return VolcanoJobGVR
+	}
+
+	return KueueWorkloadGVR
+}
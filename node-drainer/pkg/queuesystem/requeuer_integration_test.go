@@ -0,0 +1,231 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build integration
+// +build integration
+
+package queuesystem
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/config"
+)
+
+const testNamespace = "default"
+
+func setupTestEnvironment(t *testing.T) (kubernetes.Interface, dynamic.Interface) {
+	t.Helper()
+
+	testEnv := &envtest.Environment{
+		CRDDirectoryPaths:     []string{filepath.Join("testdata", "crds")},
+		ErrorIfCRDPathMissing: true,
+	}
+
+	cfg, err := testEnv.Start()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, testEnv.Stop())
+	})
+
+	clientset, err := kubernetes.NewForConfig(cfg)
+	require.NoError(t, err)
+
+	dynamicClient, err := dynamic.NewForConfig(cfg)
+	require.NoError(t, err)
+
+	return clientset, dynamicClient
+}
+
+func createWithStatus(t *testing.T, dynamicClient dynamic.Interface, gvr schema.GroupVersionResource,
+	obj *unstructured.Unstructured) *unstructured.Unstructured {
+	t.Helper()
+
+	ctx := context.Background()
+	status := obj.Object["status"]
+
+	created, err := dynamicClient.Resource(gvr).Namespace(testNamespace).Create(ctx, obj, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	created.Object["status"] = status
+
+	updated, err := dynamicClient.Resource(gvr).Namespace(testNamespace).UpdateStatus(ctx, created,
+		metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	return updated
+}
+
+func TestRequeuer_Integration(t *testing.T) {
+	clientset, dynamicClient := setupTestEnvironment(t)
+
+	q := NewRequeuer(config.QueueIntegrationConfig{
+		Enabled: true,
+		Systems: []config.QueueSystem{config.QueueSystemKueue, config.QueueSystemVolcano},
+		Timeout: config.Duration{Duration: 10 * time.Minute},
+	}, clientset, dynamicClient, false)
+	require.NotNil(t, q)
+
+	t.Run("KueueWorkload", func(t *testing.T) {
+		testKueueWorkload(t, q, clientset, dynamicClient)
+	})
+
+	t.Run("VolcanoJob", func(t *testing.T) {
+		testVolcanoJob(t, q, dynamicClient)
+	})
+}
+
+func testKueueWorkload(t *testing.T, q *Requeuer, clientset kubernetes.Interface, dynamicClient dynamic.Interface) {
+	ctx := context.Background()
+	since := time.Now().Add(-time.Minute)
+
+	job, err := clientset.BatchV1().Jobs(testNamespace).Create(ctx, &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: "trainer", Namespace: testNamespace},
+		Spec: batchv1.JobSpec{
+			Template: v1.PodTemplateSpec{
+				Spec: v1.PodSpec{
+					RestartPolicy: v1.RestartPolicyNever,
+					Containers:    []v1.Container{{Name: "trainer", Image: "trainer:latest"}},
+				},
+			},
+		},
+	}, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	wl := newKueueWorkload(job.UID, time.Now().Add(-time.Hour))
+	wl.SetNamespace(testNamespace)
+
+	conditions, _, _ := unstructured.NestedSlice(wl.Object, "status", "conditions")
+	conditions[0].(map[string]any)["message"] = "Quota reserved in ClusterQueue cluster-queue"
+	require.NoError(t, unstructured.SetNestedSlice(wl.Object, conditions, "status", "conditions"))
+
+	createWithStatus(t, dynamicClient, KueueWorkloadGVR, wl)
+
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "trainer-0",
+			Namespace: testNamespace,
+			UID:       "trainer-0-uid",
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: "batch/v1", Kind: "Job", Name: job.Name, UID: job.UID, Controller: ptr.To(true),
+			}},
+		},
+		Spec: v1.PodSpec{NodeName: "node-1"},
+	}
+
+	result, err := q.Run(ctx, "node-1", []*v1.Pod{pod}, since)
+	require.NoError(t, err)
+	assert.Empty(t, result.Ready)
+	assert.Equal(t, []string{"Kueue/default/job-trainer-1a2b3"}, result.Pending)
+
+	workloads := dynamicClient.Resource(KueueWorkloadGVR).Namespace(testNamespace)
+	w, err := workloads.Get(ctx, "job-trainer-1a2b3", metav1.GetOptions{})
+	require.NoError(t, err)
+
+	evicted, _ := condition(w, kueueEvictedCondition)
+	assert.True(t, evicted, "the Evicted condition is written through the status subresource")
+	assert.Equal(t, "node-1", w.GetAnnotations()[NodeAnnotation])
+	assert.NotContains(t, w.GetAnnotations(), OutcomeAnnotation)
+
+	// Kueue readmits the requeued Workload
+	conditions, _, _ = unstructured.NestedSlice(w.Object, "status", "conditions")
+	for _, c := range conditions {
+		if c.(map[string]any)["type"] == kueueQuotaReservedCondition {
+			c.(map[string]any)["lastTransitionTime"] = time.Now().Add(time.Second).UTC().Format(time.RFC3339)
+		}
+	}
+
+	require.NoError(t, unstructured.SetNestedSlice(w.Object, conditions, "status", "conditions"))
+	_, err = workloads.UpdateStatus(ctx, w, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	result, err = q.Run(ctx, "node-1", []*v1.Pod{pod}, since)
+	require.NoError(t, err)
+	assert.Equal(t, []*v1.Pod{pod}, result.Ready)
+	assert.Empty(t, result.Pending)
+
+	w, err = workloads.Get(ctx, "job-trainer-1a2b3", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, OutcomeReleased, w.GetAnnotations()[OutcomeAnnotation])
+}
+
+func testVolcanoJob(t *testing.T, q *Requeuer, dynamicClient dynamic.Interface) {
+	ctx := context.Background()
+	since := time.Now().Add(-time.Minute)
+
+	job := newVolcanoJob("Running")
+	job.SetNamespace(testNamespace)
+	job.SetUID("")
+
+	created := createWithStatus(t, dynamicClient, VolcanoJobGVR, job)
+
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "mpi-worker-0",
+			Namespace:   testNamespace,
+			Annotations: map[string]string{VolcanoJobNameKey: "mpi"},
+		},
+		Spec: v1.PodSpec{NodeName: "node-1"},
+	}
+
+	result, err := q.Run(ctx, "node-1", []*v1.Pod{pod}, since)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Volcano/default/mpi"}, result.Pending)
+
+	commands, err := dynamicClient.Resource(VolcanoCommandGVR).Namespace(testNamespace).List(ctx,
+		metav1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, commands.Items, 1)
+	assert.Equal(t, "RestartJob", commands.Items[0].Object["action"])
+
+	targetUID, _, _ := unstructured.NestedString(commands.Items[0].Object, "target", "uid")
+	assert.Equal(t, string(created.GetUID()), targetUID)
+
+	// Requesting again within the same drain does not create another command
+	_, err = q.Run(ctx, "node-1", []*v1.Pod{pod}, since)
+	require.NoError(t, err)
+
+	commands, err = dynamicClient.Resource(VolcanoCommandGVR).Namespace(testNamespace).List(ctx,
+		metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Len(t, commands.Items, 1)
+
+	// Volcano kills the pods and puts the Job back in the queue
+	jobs := dynamicClient.Resource(VolcanoJobGVR).Namespace(testNamespace)
+	current, err := jobs.Get(ctx, "mpi", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.NoError(t, unstructured.SetNestedField(current.Object, "Pending", "status", "state", "phase"))
+	_, err = jobs.UpdateStatus(ctx, current, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	result, err = q.Run(ctx, "node-1", []*v1.Pod{pod}, since)
+	require.NoError(t, err)
+	assert.Equal(t, []*v1.Pod{pod}, result.Ready)
+	assert.Empty(t, result.Pending)
+}
@@ -0,0 +1,217 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queuesystem
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/config"
+)
+
+func newRequeuer(t *testing.T, objects ...runtime.Object) (*Requeuer, *dynamicfake.FakeDynamicClient) {
+	t.Helper()
+
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			KueueWorkloadGVR:  "WorkloadList",
+			VolcanoJobGVR:     "JobList",
+			VolcanoCommandGVR: "CommandList",
+		}, objects...)
+
+	q := NewRequeuer(config.QueueIntegrationConfig{
+		Enabled: true,
+		Systems: []config.QueueSystem{config.QueueSystemKueue, config.QueueSystemVolcano},
+		Timeout: config.Duration{Duration: 10 * time.Minute},
+	}, fake.NewSimpleClientset(), dynamicClient, false)
+	require.NotNil(t, q)
+
+	return q, dynamicClient
+}
+
+func newKueueWorkload(ownerUID types.UID, reservedAt time.Time) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "kueue.x-k8s.io/v1beta1",
+		"kind":       "Workload",
+		"metadata": map[string]any{
+			"name":      "job-trainer-1a2b3",
+			"namespace": "ml",
+			"ownerReferences": []any{map[string]any{
+				"apiVersion": "batch/v1", "kind": "Job", "name": "trainer", "uid": string(ownerUID),
+			}},
+		},
+		"status": map[string]any{
+			"conditions": []any{map[string]any{
+				"type":               kueueQuotaReservedCondition,
+				"status":             "True",
+				"reason":             "QuotaReserved",
+				"lastTransitionTime": reservedAt.UTC().Format(time.RFC3339),
+			}},
+		},
+	}}
+}
+
+func newVolcanoJob(phase string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "batch.volcano.sh/v1alpha1",
+		"kind":       "Job",
+		"metadata":   map[string]any{"name": "mpi", "namespace": "ml", "uid": "volcano-job-uid"},
+		"status": map[string]any{
+			"state": map[string]any{
+				"phase":              phase,
+				"lastTransitionTime": time.Now().Add(-3 * time.Hour).UTC().Format(time.RFC3339),
+			},
+		},
+	}}
+}
+
+func newPod(name string, mutate func(*v1.Pod)) *v1.Pod {
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ml", UID: types.UID(name + "-uid")},
+		Spec:       v1.PodSpec{NodeName: "node-1"},
+	}
+
+	if mutate != nil {
+		mutate(pod)
+	}
+
+	return pod
+}
+
+func ownedByJob(uid types.UID) func(*v1.Pod) {
+	return func(pod *v1.Pod) {
+		pod.OwnerReferences = []metav1.OwnerReference{{
+			APIVersion: "batch/v1", Kind: "Job", Name: "trainer", UID: uid, Controller: ptr.To(true),
+		}}
+	}
+}
+
+func TestNewRequeuer_Disabled(t *testing.T) {
+	assert.Nil(t, NewRequeuer(config.QueueIntegrationConfig{}, nil, nil, false))
+}
+
+func TestRun_UnmanagedPodsAreReady(t *testing.T) {
+	q, _ := newRequeuer(t)
+	pod := newPod("standalone", nil)
+
+	result, err := q.Run(context.Background(), "node-1", []*v1.Pod{pod}, time.Now())
+	require.NoError(t, err)
+
+	assert.Equal(t, []*v1.Pod{pod}, result.Ready)
+	assert.Empty(t, result.Pending)
+}
+
+func TestRun_KueueWorkloadIsEvictedAndReleased(t *testing.T) {
+	ctx := context.Background()
+	since := time.Now().Add(-time.Minute)
+	q, dynamicClient := newRequeuer(t, newKueueWorkload("job-uid", time.Now().Add(-time.Hour)))
+	pods := []*v1.Pod{newPod("trainer-0", ownedByJob("job-uid")), newPod("trainer-1", ownedByJob("job-uid"))}
+
+	result, err := q.Run(ctx, "node-1", pods, since)
+	require.NoError(t, err)
+	assert.Empty(t, result.Ready)
+	assert.Equal(t, []string{"Kueue/ml/job-trainer-1a2b3"}, result.Pending)
+
+	workloads := dynamicClient.Resource(KueueWorkloadGVR).Namespace("ml")
+	w, err := workloads.Get(ctx, "job-trainer-1a2b3", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.NotEmpty(t, w.GetAnnotations()[RequestedAnnotation])
+	assert.Equal(t, "node-1", w.GetAnnotations()[NodeAnnotation])
+
+	evicted, _ := condition(w, kueueEvictedCondition)
+	assert.True(t, evicted)
+
+	// Still admitted: the queue system has not released the node yet
+	result, err = q.Run(ctx, "node-1", pods, since)
+	require.NoError(t, err)
+	assert.Empty(t, result.Ready)
+	assert.Len(t, result.Pending, 1)
+
+	// Kueue releases the quota reservation
+	conditions, _, _ := unstructured.NestedSlice(w.Object, "status", "conditions")
+	for _, c := range conditions {
+		if c.(map[string]any)["type"] == kueueQuotaReservedCondition {
+			c.(map[string]any)["status"] = "False"
+		}
+	}
+
+	require.NoError(t, unstructured.SetNestedSlice(w.Object, conditions, "status", "conditions"))
+	_, err = workloads.UpdateStatus(ctx, w, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	result, err = q.Run(ctx, "node-1", pods, since)
+	require.NoError(t, err)
+	assert.Len(t, result.Ready, 2)
+	assert.Empty(t, result.Pending)
+
+	w, err = workloads.Get(ctx, "job-trainer-1a2b3", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, OutcomeReleased, w.GetAnnotations()[OutcomeAnnotation])
+}
+
+func TestRun_VolcanoJobIsRestarted(t *testing.T) {
+	ctx := context.Background()
+	q, dynamicClient := newRequeuer(t, newVolcanoJob("Running"))
+	pod := newPod("mpi-worker-0", func(p *v1.Pod) {
+		p.Annotations = map[string]string{VolcanoJobNameKey: "mpi"}
+	})
+
+	result, err := q.Run(ctx, "node-1", []*v1.Pod{pod}, time.Now().Add(-time.Minute))
+	require.NoError(t, err)
+	assert.Empty(t, result.Ready)
+	assert.Equal(t, []string{"Volcano/ml/mpi"}, result.Pending)
+
+	commands, err := dynamicClient.Resource(VolcanoCommandGVR).Namespace("ml").List(ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, commands.Items, 1)
+	assert.Equal(t, "RestartJob", commands.Items[0].Object["action"])
+
+	target, _, _ := unstructured.NestedString(commands.Items[0].Object, "target", "name")
+	assert.Equal(t, "mpi", target)
+}
+
+func TestRun_TimeoutEvictsPods(t *testing.T) {
+	ctx := context.Background()
+	job := newVolcanoJob("Running")
+	job.SetAnnotations(map[string]string{
+		RequestedAnnotation: time.Now().Add(-time.Hour).UTC().Format(time.RFC3339),
+	})
+	q, dynamicClient := newRequeuer(t, job)
+	pod := newPod("mpi-worker-0", func(p *v1.Pod) {
+		p.Labels = map[string]string{VolcanoJobNameKey: "mpi"}
+	})
+
+	result, err := q.Run(ctx, "node-1", []*v1.Pod{pod}, time.Now().Add(-2*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, []*v1.Pod{pod}, result.Ready)
+	assert.Empty(t, result.Pending)
+
+	updated, err := dynamicClient.Resource(VolcanoJobGVR).Namespace("ml").Get(ctx, "mpi", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, OutcomeTimeout, updated.GetAnnotations()[OutcomeAnnotation])
+}
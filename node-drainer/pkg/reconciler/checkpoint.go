@@ -0,0 +1,174 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reconciler
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/nvidia/nvsentinel/commons/pkg/tracing"
+	"github.com/nvidia/nvsentinel/data-models/pkg/model"
+	"github.com/nvidia/nvsentinel/data-models/pkg/protos"
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/checkpoint"
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/evaluator"
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/metrics"
+)
+
+// executeCheckpointedEviction evicts the pods whose checkpoint handshake is complete, or that did not
+// opt into it, and requeues while other pods are still checkpointing
+func (r *Reconciler) executeCheckpointedEviction(ctx context.Context, action *evaluator.DrainActionResult,
+	healthEvent model.HealthEventWithStatus, partialDrainEntity *protos.Entity) error {
+	pending, err := r.runCheckpointHandshake(ctx, action, healthEvent, partialDrainEntity, true)
+	if err != nil {
+		return err
+	}
+
+	return r.awaitCheckpoints(ctx, healthEvent.HealthEvent.NodeName, pending)
+}
+
+// runCheckpointHandshake advances the checkpoint handshake of the action's pods and returns the pods
+// still checkpointing. With evictReady, the pods that are done are evicted right away; otherwise the
+// caller's mode decides when they leave the node.
+func (r *Reconciler) runCheckpointHandshake(ctx context.Context, action *evaluator.DrainActionResult,
+	healthEvent model.HealthEventWithStatus, partialDrainEntity *protos.Entity, evictReady bool) ([]string, error) {
+	span := tracing.SpanFromContext(ctx)
+	nodeName := healthEvent.HealthEvent.NodeName
+
+	var pending []string
+
+	for _, namespace := range action.Namespaces {
+		pods, err := r.podsForAction(action, namespace, nodeName, partialDrainEntity)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find evictable pods in namespace %s on node %s: %w",
+				namespace, nodeName, err)
+		}
+
+		result, err := r.checkpointHandshake.Run(ctx, pods, healthEvent.CreatedAt)
+		if err != nil {
+			metrics.ProcessingErrors.WithLabelValues("checkpoint_request_error", nodeName).Inc()
+			tracing.RecordError(span, err)
+			span.SetAttributes(
+				attribute.String("node_drainer.error.type", "checkpoint_request_error"),
+				attribute.String("node_drainer.error.message", err.Error()),
+			)
+
+			return nil, fmt.Errorf("failed checkpoint handshake for namespace %s on node %s: %w",
+				namespace, nodeName, err)
+		}
+
+		r.storeCheckpointOutcomes(nodeName, result.Outcomes)
+
+		pending = append(pending, result.Pending...)
+
+		if !evictReady {
+			continue
+		}
+
+		if err := r.informers.EvictPods(ctx, namespace, action.Timeout, result.Ready); err != nil {
+			metrics.ProcessingErrors.WithLabelValues("immediate_eviction_error", nodeName).Inc()
+			tracing.RecordError(span, err)
+			span.SetAttributes(
+				attribute.String("node_drainer.error.type", "immediate_eviction_error"),
+				attribute.String("node_drainer.error.message", err.Error()),
+			)
+
+			return nil, fmt.Errorf("failed immediate eviction for namespace %s on node %s: %w",
+				namespace, nodeName, err)
+		}
+	}
+
+	return pending, nil
+}
+
+// awaitCheckpoints requeues the event while pods are still checkpointing
+func (r *Reconciler) awaitCheckpoints(ctx context.Context, nodeName string, pending []string) error {
+	if len(pending) == 0 {
+		return nil
+	}
+
+	sort.Strings(pending)
+
+	message := fmt.Sprintf("Waiting for following pods to checkpoint: %v", pending)
+	if err := r.informers.UpdateNodeEvent(ctx, nodeName, "AwaitingPodCheckpoint", message); err != nil {
+		slog.ErrorContext(ctx, "Failed to update node event",
+			"node", nodeName,
+			"error", err)
+	}
+
+	return fmt.Errorf("waiting for checkpoint acknowledgement: %d pods remaining", len(pending))
+}
+
+func (r *Reconciler) storeCheckpointOutcomes(nodeName string, outcomes map[string]checkpoint.Outcome) {
+	if len(outcomes) == 0 {
+		return
+	}
+
+	r.nodeEventsMapMu.Lock()
+	defer r.nodeEventsMapMu.Unlock()
+
+	if _, ok := r.checkpointOutcomes[nodeName]; !ok {
+		r.checkpointOutcomes[nodeName] = make(map[string]checkpoint.Outcome)
+	}
+
+	for pod, outcome := range outcomes {
+		if _, seen := r.checkpointOutcomes[nodeName][pod]; !seen {
+			label := "acknowledged"
+			if outcome == checkpoint.OutcomeDeadlineExceeded {
+				label = "deadline_exceeded"
+			}
+
+			metrics.CheckpointHandshakes.WithLabelValues(label).Inc()
+		}
+
+		r.checkpointOutcomes[nodeName][pod] = outcome
+	}
+}
+
+// recordCheckpointOutcomes appends the handshake outcomes of the node's pods to the eviction status message
+func (r *Reconciler) recordCheckpointOutcomes(nodeName string, healthEvent *model.HealthEventWithStatus) {
+	if healthEvent.HealthEventStatus == nil || healthEvent.HealthEventStatus.UserPodsEvictionStatus == nil {
+		return
+	}
+
+	r.nodeEventsMapMu.Lock()
+	outcomes := r.checkpointOutcomes[nodeName]
+
+	entries := make([]string, 0, len(outcomes))
+	for pod, outcome := range outcomes {
+		entries = append(entries, fmt.Sprintf("%s=%s", pod, outcome))
+	}
+	r.nodeEventsMapMu.Unlock()
+
+	if len(entries) == 0 {
+		return
+	}
+
+	sort.Strings(entries)
+
+	status := healthEvent.HealthEventStatus.UserPodsEvictionStatus
+	summary := "Checkpoint handshake: " + strings.Join(entries, ", ")
+
+	switch {
+	case status.Message == "":
+		status.Message = summary
+	case !strings.Contains(status.Message, summary):
+		status.Message += "; " + summary
+	}
+}
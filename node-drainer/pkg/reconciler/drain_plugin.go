@@ -0,0 +1,121 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reconciler
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"go.opentelemetry.io/otel/attribute"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/nvidia/nvsentinel/commons/pkg/tracing"
+	"github.com/nvidia/nvsentinel/data-models/pkg/model"
+	"github.com/nvidia/nvsentinel/data-models/pkg/protos"
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/drainplugin"
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/evaluator"
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/metrics"
+	"github.com/nvidia/nvsentinel/store-client/pkg/datastore"
+	"github.com/nvidia/nvsentinel/store-client/pkg/utils"
+)
+
+// executePluginDrain hands the drain of the node over to the drain plugin. The evaluator then polls the
+// plugin until the drain completes.
+func (r *Reconciler) executePluginDrain(ctx context.Context, action *evaluator.DrainActionResult,
+	healthEvent model.HealthEventWithStatus, event datastore.Event, partialDrainEntity *protos.Entity) error {
+	ctx, span := tracing.StartSpan(ctx, "node_drainer.execute_plugin_drain")
+	defer span.End()
+
+	nodeName := healthEvent.HealthEvent.NodeName
+
+	eventID, err := utils.ExtractDocumentID(event)
+	if err != nil {
+		return fmt.Errorf("failed to extract document ID for plugin drain: %w", err)
+	}
+
+	var pods []*v1.Pod
+
+	for _, ns := range action.Namespaces {
+		nsPods, err := r.informers.FindEvictablePodsInNamespaceAndNode(ns, nodeName, partialDrainEntity)
+		if err != nil {
+			slog.WarnContext(ctx, "Failed to find evictable pods",
+				"namespace", ns,
+				"node", nodeName,
+				"error", err)
+
+			continue
+		}
+
+		pods = append(pods, nsPods...)
+	}
+
+	state, err := r.drainPluginClient.StartDrain(ctx,
+		drainplugin.NewStartDrainRequest(healthEvent.HealthEvent, eventID, pods))
+	if err != nil {
+		metrics.ProcessingErrors.WithLabelValues("drain_plugin_start_error", nodeName).Inc()
+		tracing.RecordError(span, err)
+		span.SetAttributes(
+			attribute.String("node_drainer.error.type", "drain_plugin_start_error"),
+			attribute.String("node_drainer.error.message", err.Error()),
+		)
+
+		return err
+	}
+
+	slog.InfoContext(ctx, "Started drain on drain plugin",
+		"node", nodeName,
+		"pods", len(pods),
+		"state", state.String())
+
+	span.SetAttributes(attribute.String("node_drainer.drain_plugin.state", state.String()))
+
+	return fmt.Errorf("waiting for drain plugin to complete the drain of node %s", nodeName)
+}
+
+// cancelPluginDrainIfEnabled releases the plugin drain of the event once the event is cancelled or its
+// drain is recorded as completed
+func (r *Reconciler) cancelPluginDrainIfEnabled(ctx context.Context, nodeName string, event datastore.Event) {
+	if !r.Config.TomlConfig.DrainPlugin.Enabled || r.drainPluginClient == nil {
+		return
+	}
+
+	ctx, span := tracing.StartSpan(ctx, "node_drainer.cancel_plugin_drain")
+	defer span.End()
+
+	eventID, err := utils.ExtractDocumentID(event)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to extract document ID for plugin drain cancellation",
+			"node", nodeName,
+			"error", err)
+		tracing.RecordError(span, err)
+
+		return
+	}
+
+	if err := r.drainPluginClient.CancelDrain(ctx, nodeName, eventID); err != nil {
+		slog.WarnContext(ctx, "Failed to cancel plugin drain",
+			"node", nodeName,
+			"error", err)
+		span.SetAttributes(
+			attribute.String("node_drainer.error.type", "drain_plugin_cancel_error"),
+			attribute.String("node_drainer.error.message", err.Error()),
+		)
+
+		return
+	}
+
+	slog.InfoContext(ctx, "Cancelled plugin drain", "node", nodeName)
+}
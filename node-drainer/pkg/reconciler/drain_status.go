@@ -0,0 +1,210 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reconciler
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/nvidia/nvsentinel/data-models/pkg/model"
+	drainv1alpha1 "github.com/nvidia/nvsentinel/node-drainer/api/v1alpha1"
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/config"
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/drainstatus"
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/evaluator"
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/metrics"
+)
+
+const (
+	drainModeCustomDrain = "CustomDrain"
+	drainModeDrainPlugin = "DrainPlugin"
+)
+
+// SetDrainStatusRecorder enables the NodeDrain resources reporting the progress of drain sessions
+func (r *Reconciler) SetDrainStatusRecorder(recorder *drainstatus.Recorder) {
+	r.drainStatusRecorder = recorder
+}
+
+// recordDrainProgress reports the drain action about to be executed, and the pods still on the node, on
+// the NodeDrain of the event. Failures are logged only: the drain does not depend on its NodeDrain.
+func (r *Reconciler) recordDrainProgress(ctx context.Context, action *evaluator.DrainActionResult,
+	healthEvent model.HealthEventWithStatus) {
+	if r.drainStatusRecorder == nil {
+		return
+	}
+
+	nodeName := healthEvent.HealthEvent.NodeName
+
+	progress := drainstatus.Progress{
+		NodeName:            nodeName,
+		HealthEventID:       healthEvent.HealthEvent.Id,
+		CheckName:           healthEvent.HealthEvent.CheckName,
+		NamespaceModes:      namespaceDrainModes(action),
+		ForceDeleteDeadline: forceDeleteDeadline(action, healthEvent),
+		Message:             drainProgressMessage(action.Action),
+	}
+
+	if entity := action.PartialDrainEntity; entity != nil {
+		progress.PartialDrainEntity = fmt.Sprintf("%s:%s", entity.EntityType, entity.EntityValue)
+	}
+
+	remainingPods, err := r.remainingPods(ctx, action, nodeName)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to list remaining pods for drain status",
+			"node", nodeName,
+			"error", err)
+	}
+
+	progress.RemainingPods = remainingPods
+
+	if err := r.drainStatusRecorder.Update(ctx, progress); err != nil {
+		slog.WarnContext(ctx, "Failed to update drain status",
+			"node", nodeName,
+			"eventID", progress.HealthEventID,
+			"error", err)
+		metrics.ProcessingErrors.WithLabelValues("drain_status_update_error", nodeName).Inc()
+	}
+}
+
+// finishDrainStatus closes the NodeDrain of the event with its final phase
+func (r *Reconciler) finishDrainStatus(ctx context.Context, healthEvent model.HealthEventWithStatus,
+	phase drainv1alpha1.NodeDrainPhase, message string) {
+	if r.drainStatusRecorder == nil {
+		return
+	}
+
+	nodeName := healthEvent.HealthEvent.NodeName
+
+	if err := r.drainStatusRecorder.Finish(ctx, nodeName, healthEvent.HealthEvent.Id, phase, message); err != nil {
+		slog.WarnContext(ctx, "Failed to finish drain status",
+			"node", nodeName,
+			"eventID", healthEvent.HealthEvent.Id,
+			"phase", phase,
+			"error", err)
+		metrics.ProcessingErrors.WithLabelValues("drain_status_update_error", nodeName).Inc()
+	}
+}
+
+// finishUpdatedDrainStatus closes the NodeDrain of an event whose drain status was updated to succeeded or
+// failed
+func (r *Reconciler) finishUpdatedDrainStatus(ctx context.Context, healthEvent model.HealthEventWithStatus,
+	status model.Status) {
+	if status == model.StatusFailed {
+		r.finishDrainStatus(ctx, healthEvent, drainv1alpha1.NodeDrainPhaseFailed, "Drain failed")
+		return
+	}
+
+	r.finishDrainStatus(ctx, healthEvent, drainv1alpha1.NodeDrainPhaseSucceeded, "All pods were drained")
+}
+
+// remainingPods returns the evictable pods of the node, as namespace/name, that the drain still has to
+// remove
+func (r *Reconciler) remainingPods(ctx context.Context, action *evaluator.DrainActionResult,
+	nodeName string) ([]string, error) {
+	namespaces, err := r.informers.GetNamespacesMatchingPattern(ctx, "*",
+		r.Config.TomlConfig.SystemNamespaces, nodeName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user namespaces: %w", err)
+	}
+
+	var remaining []string
+
+	for _, namespace := range namespaces {
+		pods, err := r.informers.FindEvictablePodsInNamespaceAndNode(namespace, nodeName, action.PartialDrainEntity)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find evictable pods in namespace %s: %w", namespace, err)
+		}
+
+		for _, pod := range pods {
+			remaining = append(remaining, pod.Namespace+"/"+pod.Name)
+		}
+	}
+
+	sort.Strings(remaining)
+
+	return remaining, nil
+}
+
+func namespaceDrainModes(action *evaluator.DrainActionResult) map[string]string {
+	var mode string
+
+	switch action.Action {
+	case evaluator.ActionEvictImmediate:
+		mode = string(config.ModeImmediateEvict)
+	case evaluator.ActionEvictWithTimeout:
+		mode = string(config.ModeDeleteAfterTimeout)
+	case evaluator.ActionCheckCompletion:
+		mode = string(config.ModeAllowCompletion)
+	case evaluator.ActionCreateCR:
+		mode = drainModeCustomDrain
+	case evaluator.ActionStartPluginDrain:
+		mode = drainModeDrainPlugin
+	case evaluator.ActionSkip, evaluator.ActionWait, evaluator.ActionMarkAlreadyDrained,
+		evaluator.ActionUpdateStatus:
+		return nil
+	}
+
+	modes := make(map[string]string, len(action.Namespaces))
+	for _, namespace := range action.Namespaces {
+		modes[namespace] = mode
+	}
+
+	return modes
+}
+
+// forceDeleteDeadline returns when the pods of a DeleteAfterTimeout action are force deleted: the
+// earliest deadline of its pods when per-pod drain policies set their own timeouts
+func forceDeleteDeadline(action *evaluator.DrainActionResult, healthEvent model.HealthEventWithStatus) time.Time {
+	if action.Action != evaluator.ActionEvictWithTimeout {
+		return time.Time{}
+	}
+
+	if action.PodTimeouts == nil {
+		return healthEvent.CreatedAt.Add(action.Timeout)
+	}
+
+	var deadline time.Time
+
+	for _, pod := range action.Pods {
+		podDeadline := healthEvent.CreatedAt.Add(action.PodTimeouts[pod.Namespace+"/"+pod.Name])
+		if deadline.IsZero() || podDeadline.Before(deadline) {
+			deadline = podDeadline
+		}
+	}
+
+	return deadline
+}
+
+func drainProgressMessage(action evaluator.DrainAction) string {
+	switch action {
+	case evaluator.ActionEvictImmediate:
+		return "Evicting pods"
+	case evaluator.ActionEvictWithTimeout:
+		return "Evicting pods, remaining pods are force deleted after the timeout"
+	case evaluator.ActionCheckCompletion:
+		return "Waiting for pods to complete"
+	case evaluator.ActionCreateCR:
+		return "Waiting for the custom drain to complete"
+	case evaluator.ActionStartPluginDrain:
+		return "Waiting for the drain plugin to complete the drain"
+	case evaluator.ActionSkip, evaluator.ActionWait, evaluator.ActionMarkAlreadyDrained,
+		evaluator.ActionUpdateStatus:
+		return ""
+	}
+
+	return ""
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reconciler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	crfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/nvidia/nvsentinel/data-models/pkg/model"
+	drainv1alpha1 "github.com/nvidia/nvsentinel/node-drainer/api/v1alpha1"
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/config"
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/drainstatus"
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/evaluator"
+	"github.com/nvidia/nvsentinel/store-client/pkg/datastore"
+)
+
+func TestDrainStatus_Phases(t *testing.T) {
+	ctx := context.Background()
+
+	r, _ := newTestReconciler(t, config.TomlConfig{
+		EvictionTimeoutInSeconds: config.Duration{Duration: 30 * time.Second},
+	}, testNode("node-1"), testPod("training", "worker", "node-1", nil))
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, drainv1alpha1.AddToScheme(scheme))
+
+	c := crfake.NewClientBuilder().
+		WithScheme(scheme).
+		WithStatusSubresource(&drainv1alpha1.NodeDrain{}).
+		Build()
+	recorder := drainstatus.NewRecorder(config.DrainStatusConfig{Enabled: true, TTLHours: 24}, c)
+	r.SetDrainStatusRecorder(recorder)
+	r.informers.SetEvictionObserver(recorder)
+
+	name := drainstatus.Name("node-1", "event-1")
+	getNodeDrain := func() *drainv1alpha1.NodeDrain {
+		nodeDrain := &drainv1alpha1.NodeDrain{}
+		require.NoError(t, c.Get(ctx, client.ObjectKey{Name: name}, nodeDrain))
+
+		return nodeDrain
+	}
+
+	t.Log("The first drain pass creates the NodeDrain")
+
+	event := testHealthEvent("node-1", "event-1", time.Now().Add(-10*time.Minute))
+	err := r.executeAction(ctx, &evaluator.DrainActionResult{
+		Action:     evaluator.ActionCheckCompletion,
+		Namespaces: []string{"training"},
+	}, event, nil, &fakeDataStore{}, "event-1")
+	require.Error(t, err, "the drain is requeued while pods are left")
+
+	nodeDrain := getNodeDrain()
+	assert.Equal(t, "node-1", nodeDrain.Spec.NodeName)
+	assert.Equal(t, "event-1", nodeDrain.Spec.HealthEventID)
+	assert.Equal(t, "GpuXidError", nodeDrain.Spec.CheckName)
+	assert.Equal(t, drainv1alpha1.NodeDrainPhaseDraining, nodeDrain.Status.Phase)
+	assert.Equal(t, "Waiting for pods to complete", nodeDrain.Status.Message)
+	assert.NotNil(t, nodeDrain.Status.StartTime)
+	assert.Equal(t, int32(1), nodeDrain.Status.RemainingPods)
+	assert.Equal(t, []string{"training/worker"}, nodeDrain.Status.RemainingPodNames)
+	assert.Equal(t, []drainv1alpha1.NamespaceDrain{
+		{Name: "training", Modes: []string{string(config.ModeAllowCompletion)}},
+	}, nodeDrain.Status.Namespaces)
+	assert.Nil(t, nodeDrain.Status.ForceDeleteDeadline)
+
+	// An explicit TTL on the NodeDrain takes precedence over the configured one
+	nodeDrain.Annotations = map[string]string{drainstatus.TTLAnnotation: "1s"}
+	require.NoError(t, c.Update(ctx, nodeDrain))
+
+	t.Log("A later pass in another mode records its progress")
+
+	err = r.executeAction(ctx, &evaluator.DrainActionResult{
+		Action:     evaluator.ActionEvictWithTimeout,
+		Namespaces: []string{"training"},
+		Timeout:    5 * time.Minute,
+	}, event, nil, &fakeDataStore{}, "event-1")
+	require.Error(t, err, "the drain is requeued to verify the force deletion")
+
+	nodeDrain = getNodeDrain()
+	assert.Equal(t, drainv1alpha1.NodeDrainPhaseDraining, nodeDrain.Status.Phase)
+	assert.Equal(t, []drainv1alpha1.NamespaceDrain{
+		{Name: "training", Modes: []string{string(config.ModeAllowCompletion), string(config.ModeDeleteAfterTimeout)}},
+	}, nodeDrain.Status.Namespaces)
+	require.NotNil(t, nodeDrain.Status.ForceDeleteDeadline)
+	assert.WithinDuration(t, event.CreatedAt.Add(5*time.Minute), nodeDrain.Status.ForceDeleteDeadline.Time, time.Second)
+
+	t.Log("The final outcome closes the NodeDrain and starts its TTL")
+
+	err = r.executeAction(ctx, &evaluator.DrainActionResult{
+		Action: evaluator.ActionUpdateStatus,
+		Status: model.StatusSucceeded,
+	}, event, datastore.Event{"_id": "event-1"}, &fakeDataStore{}, "event-1")
+	require.NoError(t, err)
+
+	nodeDrain = getNodeDrain()
+	assert.Equal(t, drainv1alpha1.NodeDrainPhaseSucceeded, nodeDrain.Status.Phase)
+	assert.Equal(t, "All pods were drained", nodeDrain.Status.Message)
+	assert.Zero(t, nodeDrain.Status.RemainingPods)
+	assert.Empty(t, nodeDrain.Status.RemainingPodNames)
+	assert.Equal(t, int32(1), nodeDrain.Status.ForceDeletedPods)
+	assert.Nil(t, nodeDrain.Status.ForceDeleteDeadline)
+	require.NotNil(t, nodeDrain.Status.CompletionTime)
+	assert.Equal(t, "1s", nodeDrain.Annotations[drainstatus.TTLAnnotation])
+
+	expiry, err := time.Parse(time.RFC3339, nodeDrain.Annotations[drainstatus.ExpiryAnnotation])
+	require.NoError(t, err)
+	assert.WithinDuration(t, nodeDrain.Status.CompletionTime.Add(time.Second), expiry, time.Second)
+
+	t.Log("The NodeDrain is deleted once its TTL expired")
+
+	require.Eventually(t, func() bool {
+		// A cancelled context runs a single garbage collection
+		gcCtx, cancel := context.WithCancel(ctx)
+		cancel()
+		recorder.RunGarbageCollector(gcCtx)
+
+		err := c.Get(ctx, client.ObjectKey{Name: name}, &drainv1alpha1.NodeDrain{})
+
+		return apierrors.IsNotFound(err)
+	}, 5*time.Second, 100*time.Millisecond)
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reconciler
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/nvidia/nvsentinel/commons/pkg/tracing"
+	"github.com/nvidia/nvsentinel/data-models/pkg/model"
+	"github.com/nvidia/nvsentinel/data-models/pkg/protos"
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/evaluator"
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/metrics"
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/queue"
+)
+
+// disruptGangs disrupts the gangs of the pods about to be evicted from the node, once per gang and drain,
+// and records them in the drain session
+func (r *Reconciler) disruptGangs(ctx context.Context, action *evaluator.DrainActionResult, nodeName string,
+	partialDrainEntity *protos.Entity) error {
+	var pods []*v1.Pod
+
+	for _, namespace := range action.Namespaces {
+		nsPods, err := r.podsForAction(action, namespace, nodeName, partialDrainEntity)
+		if err != nil {
+			return fmt.Errorf("failed to find evictable pods in namespace %s on node %s: %w", namespace, nodeName, err)
+		}
+
+		pods = append(pods, nsPods...)
+	}
+
+	return r.disruptPodGangs(ctx, nodeName, pods, action.Timeout)
+}
+
+// disruptExpiredGangs disrupts the gangs of the pods whose DeleteAfterTimeout deadline has passed, right
+// before they are force deleted. Pods still within their timeout may yet complete with their gang.
+func (r *Reconciler) disruptExpiredGangs(ctx context.Context, action *evaluator.DrainActionResult,
+	healthEvent model.HealthEventWithStatus, partialDrainEntity *protos.Entity) error {
+	expired, err := r.expiredTimeoutPods(action, healthEvent, partialDrainEntity)
+	if err != nil || len(expired) == 0 {
+		return err
+	}
+
+	return r.disruptPodGangs(ctx, healthEvent.HealthEvent.NodeName, expired,
+		r.Config.TomlConfig.EvictionTimeoutInSeconds.Duration)
+}
+
+// disruptPodGangs disrupts the gangs of the pods, skipping the gangs already disrupted during this drain
+func (r *Reconciler) disruptPodGangs(ctx context.Context, nodeName string, pods []*v1.Pod,
+	timeout time.Duration) error {
+	span := tracing.SpanFromContext(ctx)
+
+	r.nodeEventsMapMu.Lock()
+	handled := make(map[string]struct{}, len(r.disruptedGangs[nodeName]))
+
+	for gangID := range r.disruptedGangs[nodeName] {
+		handled[gangID] = struct{}{}
+	}
+	r.nodeEventsMapMu.Unlock()
+
+	gangs, err := r.gangDisrupter.Disrupt(ctx, nodeName, pods, timeout, handled)
+
+	r.nodeEventsMapMu.Lock()
+	if _, ok := r.disruptedGangs[nodeName]; !ok && len(gangs) > 0 {
+		r.disruptedGangs[nodeName] = make(map[string]struct{})
+	}
+
+	for _, g := range gangs {
+		r.disruptedGangs[nodeName][g.ID] = struct{}{}
+	}
+
+	gangIDs := make([]string, 0, len(r.disruptedGangs[nodeName]))
+	for gangID := range r.disruptedGangs[nodeName] {
+		gangIDs = append(gangIDs, gangID)
+	}
+	r.nodeEventsMapMu.Unlock()
+
+	if ds := queue.DrainSessionFromContext(ctx); ds != nil && ds.DrainSessionSpan != nil && len(gangs) > 0 {
+		sort.Strings(gangIDs)
+
+		ds.DrainSessionSpan.SetAttributes(
+			attribute.String("node_drainer.gang.mode", string(r.gangDisrupter.Mode())),
+			attribute.StringSlice("node_drainer.gang.ids", gangIDs),
+		)
+
+		for _, g := range gangs {
+			ds.DrainSessionSpan.AddEvent("gang_disrupted", trace.WithAttributes(
+				attribute.String("node_drainer.gang.id", g.ID),
+				attribute.Int("node_drainer.gang.members", g.Members),
+				attribute.StringSlice("node_drainer.gang.remote_members", g.RemoteMembers),
+			))
+		}
+	}
+
+	if err != nil {
+		metrics.ProcessingErrors.WithLabelValues("gang_disruption_error", nodeName).Inc()
+		tracing.RecordError(span, err)
+		span.SetAttributes(
+			attribute.String("node_drainer.error.type", "gang_disruption_error"),
+			attribute.String("node_drainer.error.message", err.Error()),
+		)
+
+		return fmt.Errorf("failed to disrupt gangs on node %s: %w", nodeName, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reconciler
+
+import (
+	"fmt"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/nvidia/nvsentinel/data-models/pkg/model"
+	"github.com/nvidia/nvsentinel/data-models/pkg/protos"
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/evaluator"
+)
+
+// podsForAction returns the pods of the namespace the action applies to: the pods selected by per-pod
+// drain policies, or all evictable pods of the namespace on the node
+func (r *Reconciler) podsForAction(action *evaluator.DrainActionResult, namespace, nodeName string,
+	partialDrainEntity *protos.Entity) ([]*v1.Pod, error) {
+	if action.Pods == nil {
+		return r.informers.FindEvictablePodsInNamespaceAndNode(namespace, nodeName, partialDrainEntity)
+	}
+
+	return podsInNamespace(action.Pods, namespace), nil
+}
+
+func podsInNamespace(pods []*v1.Pod, namespace string) []*v1.Pod {
+	result := make([]*v1.Pod, 0, len(pods))
+
+	for _, pod := range pods {
+		if pod.Namespace == namespace {
+			result = append(result, pod)
+		}
+	}
+
+	return result
+}
+
+func namespacesOfPods(pods []*v1.Pod) []string {
+	seen := make(map[string]struct{})
+	namespaces := make([]string, 0)
+
+	for _, pod := range pods {
+		if _, ok := seen[pod.Namespace]; !ok {
+			seen[pod.Namespace] = struct{}{}
+			namespaces = append(namespaces, pod.Namespace)
+		}
+	}
+
+	return namespaces
+}
+
+// expiredTimeoutPods returns the pods of a DeleteAfterTimeout action whose timeout since the health event
+// has passed, that is the pods about to be force deleted
+func (r *Reconciler) expiredTimeoutPods(action *evaluator.DrainActionResult,
+	healthEvent model.HealthEventWithStatus, partialDrainEntity *protos.Entity) ([]*v1.Pod, error) {
+	nodeName := healthEvent.HealthEvent.NodeName
+	now := time.Now()
+
+	var expired []*v1.Pod
+
+	for _, namespace := range action.Namespaces {
+		pods, err := r.podsForAction(action, namespace, nodeName, partialDrainEntity)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find evictable pods in namespace %s on node %s: %w",
+				namespace, nodeName, err)
+		}
+
+		for _, pod := range pods {
+			timeout := action.Timeout
+			if action.PodTimeouts != nil {
+				timeout = action.PodTimeouts[pod.Namespace+"/"+pod.Name]
+			}
+
+			if !now.Before(healthEvent.CreatedAt.Add(timeout)) {
+				expired = append(expired, pod)
+			}
+		}
+	}
+
+	return expired, nil
+}
@@ -36,12 +36,19 @@ import (
 	"github.com/nvidia/nvsentinel/commons/pkg/tracing"
 	"github.com/nvidia/nvsentinel/data-models/pkg/model"
 	"github.com/nvidia/nvsentinel/data-models/pkg/protos"
+	drainv1alpha1 "github.com/nvidia/nvsentinel/node-drainer/api/v1alpha1"
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/checkpoint"
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/concurrency"
 	"github.com/nvidia/nvsentinel/node-drainer/pkg/config"
 	"github.com/nvidia/nvsentinel/node-drainer/pkg/customdrain"
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/drainplugin"
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/drainstatus"
 	"github.com/nvidia/nvsentinel/node-drainer/pkg/evaluator"
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/gang"
 	"github.com/nvidia/nvsentinel/node-drainer/pkg/informers"
 	"github.com/nvidia/nvsentinel/node-drainer/pkg/metrics"
 	"github.com/nvidia/nvsentinel/node-drainer/pkg/queue"
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/queuesystem"
 	"github.com/nvidia/nvsentinel/store-client/pkg/client"
 	"github.com/nvidia/nvsentinel/store-client/pkg/datastore"
 	"github.com/nvidia/nvsentinel/store-client/pkg/utils"
@@ -61,8 +68,16 @@ type Reconciler struct {
 	databaseClient      queue.DataStore
 	healthEventStore    datastore.HealthEventStore
 	customDrainClient   *customdrain.Client
+	drainPluginClient   *drainplugin.Client
+	drainLimiter        *concurrency.Limiter   // nil when concurrent drains are not limited
+	checkpointHandshake *checkpoint.Handshaker // nil when the checkpoint handshake is disabled
+	gangDisrupter       *gang.Disrupter        // nil when gang-aware drain is disabled
+	workloadRequeuer    *queuesystem.Requeuer  // nil when the queue-system integration is disabled
+	drainStatusRecorder *drainstatus.Recorder  // nil when NodeDrain status resources are disabled
 	nodeEventsMap       map[string]eventStatusMap
 	cancelledNodes      map[string]struct{}
+	checkpointOutcomes  map[string]map[string]checkpoint.Outcome // node -> pod -> handshake outcome
+	disruptedGangs      map[string]map[string]struct{}           // node -> IDs of gangs already disrupted
 	nodeEventsMapMu     sync.Mutex
 }
 
@@ -89,7 +104,21 @@ func NewReconciler(
 		}
 	}
 
-	drainEvaluator := evaluator.NewNodeDrainEvaluator(cfg.TomlConfig, informersInstance, customDrainClient)
+	var drainPluginClient *drainplugin.Client
+
+	if cfg.TomlConfig.DrainPlugin.Enabled {
+		var err error
+
+		drainPluginClient, err = drainplugin.NewClient(cfg.TomlConfig.DrainPlugin)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize drain plugin client: %w", err)
+		}
+	}
+
+	drainEvaluator := evaluator.NewNodeDrainEvaluator(cfg.TomlConfig, informersInstance, customDrainClient,
+		drainPluginClient)
+	workloadRequeuer := queuesystem.NewRequeuer(cfg.TomlConfig.QueueIntegration, kubeClient, dynamicClient,
+		dryRunEnabled)
 
 	reconciler := &Reconciler{
 		Config:              cfg,
@@ -102,8 +131,14 @@ func NewReconciler(
 		databaseClient:      databaseClient,
 		healthEventStore:    healthEventStore,
 		customDrainClient:   customDrainClient,
+		drainPluginClient:   drainPluginClient,
+		drainLimiter:        concurrency.NewLimiter(cfg.TomlConfig.DrainConcurrency),
+		checkpointHandshake: checkpoint.NewHandshaker(cfg.TomlConfig.Checkpoint, kubeClient, dryRunEnabled),
+		workloadRequeuer:    workloadRequeuer,
 		nodeEventsMap:       make(map[string]eventStatusMap),
 		cancelledNodes:      make(map[string]struct{}),
+		checkpointOutcomes:  make(map[string]map[string]checkpoint.Outcome),
+		disruptedGangs:      make(map[string]map[string]struct{}),
 	}
 
 	queueManager.SetDataStoreEventProcessor(reconciler)
@@ -119,8 +154,19 @@ func (r *Reconciler) GetCustomDrainClient() *customdrain.Client {
 	return r.customDrainClient
 }
 
+// SetGangDisrupter enables gang-aware drain
+func (r *Reconciler) SetGangDisrupter(disrupter *gang.Disrupter) {
+	r.gangDisrupter = disrupter
+}
+
 func (r *Reconciler) Shutdown() {
 	r.queueManager.Shutdown()
+
+	if r.drainPluginClient != nil {
+		if err := r.drainPluginClient.Close(); err != nil {
+			slog.Warn("Failed to close drain plugin connection", "error", err)
+		}
+	}
 }
 
 // PreprocessAndEnqueueEvent preprocesses an event from the change stream before enqueueing it.
@@ -327,6 +373,10 @@ func isRequeueSignal(err error) bool {
 		return false
 	}
 
+	if errors.Is(err, ErrWaitingForDrainSlot) || errors.Is(err, ErrWaitingForWorkloadRelease) {
+		return true
+	}
+
 	message := err.Error()
 
 	return strings.Contains(message, "requeu") || strings.HasPrefix(message, "waiting for")
@@ -442,6 +492,14 @@ func (r *Reconciler) executeAction(ctx context.Context, action *evaluator.DrainA
 
 	r.updateDrainSessionTracing(ctx, action, healthEvent)
 
+	if isDrainAction(action.Action) {
+		if err := r.acquireDrainSlot(ctx, nodeName, healthEvent.CreatedAt); err != nil {
+			return err
+		}
+
+		r.recordDrainProgress(ctx, action, healthEvent)
+	}
+
 	switch action.Action {
 	case evaluator.ActionSkip:
 		r.clearEventStatus(eventID, nodeName)
@@ -458,6 +516,10 @@ func (r *Reconciler) executeAction(ctx context.Context, action *evaluator.DrainA
 		r.updateNodeDrainStatus(ctx, nodeName, &healthEvent, true)
 		return r.executeCustomDrain(ctx, action, healthEvent, event, database, action.PartialDrainEntity)
 
+	case evaluator.ActionStartPluginDrain:
+		r.updateNodeDrainStatus(ctx, nodeName, &healthEvent, true)
+		return r.executePluginDrain(ctx, action, healthEvent, event, action.PartialDrainEntity)
+
 	case evaluator.ActionEvictImmediate:
 		r.updateNodeDrainStatus(ctx, nodeName, &healthEvent, true)
 		return r.executeImmediateEviction(ctx, action, healthEvent, action.PartialDrainEntity)
@@ -476,14 +538,76 @@ func (r *Reconciler) executeAction(ctx context.Context, action *evaluator.DrainA
 		return r.handleMarkAlreadyDrained(ctx, eventID, nodeName, healthEvent, event, database, action.Status)
 
 	case evaluator.ActionUpdateStatus:
+		r.recordCheckpointOutcomes(nodeName, &healthEvent)
 		r.clearEventStatus(eventID, nodeName)
-		return r.executeUpdateStatus(ctx, healthEvent, event, database, action.Status)
+
+		err := r.executeUpdateStatus(ctx, healthEvent, event, database, action.Status)
+		if err == nil {
+			r.finishUpdatedDrainStatus(ctx, healthEvent, action.Status)
+		}
+
+		return err
 
 	default:
 		return fmt.Errorf("unknown action: %s", action.Action.String())
 	}
 }
 
+func isDrainAction(action evaluator.DrainAction) bool {
+	switch action {
+	case evaluator.ActionCreateCR, evaluator.ActionStartPluginDrain, evaluator.ActionEvictImmediate,
+		evaluator.ActionEvictWithTimeout, evaluator.ActionCheckCompletion:
+		return true
+	default:
+		return false
+	}
+}
+
+// ErrWaitingForDrainSlot is returned while a node waits for a drain slot. It only requeues the event
+// and is not reported as an action failure.
+var ErrWaitingForDrainSlot = errors.New("waiting for drain slot")
+
+// acquireDrainSlot holds the node back while the drain concurrency limits are reached. A waiting node
+// is labeled drain-waiting and requeued until a slot is released by a node whose drain completed.
+// Waiting nodes are ordered by the creation time of their health event, which is persisted, so the
+// order is rebuilt the same way after a restart.
+func (r *Reconciler) acquireDrainSlot(ctx context.Context, nodeName string, queuedAt time.Time) error {
+	if r.drainLimiter == nil {
+		return nil
+	}
+
+	var labels map[string]string
+
+	if node, err := r.informers.GetNode(nodeName); err == nil {
+		labels = node.Labels
+	}
+
+	stateLabel := labels[statemanager.NVSentinelStateLabelKey]
+
+	// A node already draining before a restart keeps draining even if that exceeds the limits
+	admitted, position := r.drainLimiter.Acquire(nodeName, labels, queuedAt,
+		stateLabel == string(statemanager.DrainingLabelValue))
+	if admitted {
+		return nil
+	}
+
+	if stateLabel != string(statemanager.DrainWaitingLabelValue) {
+		if _, err := r.Config.StateManager.UpdateNVSentinelStateNodeLabel(ctx,
+			nodeName, statemanager.DrainWaitingLabelValue, false); err != nil {
+			slog.ErrorContext(ctx, "Failed to update node label to drain-waiting",
+				"node", nodeName,
+				"error", err)
+			metrics.ProcessingErrors.WithLabelValues("label_update_error", nodeName).Inc()
+		}
+	}
+
+	slog.InfoContext(ctx, "Drain concurrency limit reached, node is waiting for a drain slot",
+		"node", nodeName,
+		"position", position)
+
+	return fmt.Errorf("%w: position %d", ErrWaitingForDrainSlot, position)
+}
+
 func (r *Reconciler) updateDrainSessionTracing(
 	ctx context.Context, action *evaluator.DrainActionResult, healthEvent model.HealthEventWithStatus,
 ) {
@@ -521,6 +645,8 @@ func (r *Reconciler) handleMarkAlreadyDrained(ctx context.Context, eventID, node
 	err := r.executeMarkAlreadyDrained(ctx, healthEvent, event, database, status)
 	if err == nil {
 		r.deleteCustomDrainCRIfEnabled(ctx, nodeName, event)
+		r.cancelPluginDrainIfEnabled(ctx, nodeName, event)
+		r.finishDrainStatus(ctx, healthEvent, drainv1alpha1.NodeDrainPhaseSucceeded, "Node already drained")
 	}
 
 	return err
@@ -562,6 +688,8 @@ func (r *Reconciler) executeSkip(ctx context.Context,
 		slog.InfoContext(ctx, "Updated MongoDB status for unquarantined node",
 			"node", nodeName,
 			"status", "succeeded")
+
+		r.finishDrainStatus(ctx, healthEvent, drainv1alpha1.NodeDrainPhaseCancelled, "Node was unquarantined")
 	}
 
 	r.updateNodeDrainStatus(ctx, nodeName, &healthEvent, false)
@@ -573,9 +701,49 @@ func (r *Reconciler) executeImmediateEviction(ctx context.Context, action *evalu
 	healthEvent model.HealthEventWithStatus, partialDrainEntity *protos.Entity) error {
 	nodeName := healthEvent.HealthEvent.NodeName
 
+	var pendingWorkloads []string
+
+	if r.workloadRequeuer != nil {
+		var err error
+
+		action, pendingWorkloads, err = r.requeueWorkloads(ctx, action, healthEvent, partialDrainEntity)
+		if err != nil {
+			return err
+		}
+	}
+
+	if r.gangDisrupter != nil {
+		if err := r.disruptGangs(ctx, action, nodeName, partialDrainEntity); err != nil {
+			return err
+		}
+	}
+
+	if r.checkpointHandshake != nil {
+		if err := r.executeCheckpointedEviction(ctx, action, healthEvent, partialDrainEntity); err != nil {
+			return err
+		}
+	} else if err := r.evictPods(ctx, action, nodeName, partialDrainEntity); err != nil {
+		return err
+	}
+
+	if len(pendingWorkloads) > 0 {
+		return r.awaitWorkloadRelease(ctx, nodeName, pendingWorkloads)
+	}
+
+	return fmt.Errorf("immediate eviction completed, requeuing for status verification")
+}
+
+func (r *Reconciler) evictPods(ctx context.Context, action *evaluator.DrainActionResult, nodeName string,
+	partialDrainEntity *protos.Entity) error {
 	for _, namespace := range action.Namespaces {
-		if err := r.informers.EvictAllPodsInImmediateMode(ctx, namespace, nodeName, action.Timeout,
-			partialDrainEntity); err != nil {
+		var err error
+		if action.Pods != nil {
+			err = r.informers.EvictPods(ctx, namespace, action.Timeout, podsInNamespace(action.Pods, namespace))
+		} else {
+			err = r.informers.EvictAllPodsInImmediateMode(ctx, namespace, nodeName, action.Timeout, partialDrainEntity)
+		}
+
+		if err != nil {
 			metrics.ProcessingErrors.WithLabelValues("immediate_eviction_error", nodeName).Inc()
 
 			span := tracing.SpanFromContext(ctx)
@@ -589,7 +757,7 @@ func (r *Reconciler) executeImmediateEviction(ctx context.Context, action *evalu
 		}
 	}
 
-	return fmt.Errorf("immediate eviction completed, requeuing for status verification")
+	return nil
 }
 
 func (r *Reconciler) executeTimeoutEviction(ctx context.Context, action *evaluator.DrainActionResult,
@@ -609,8 +777,39 @@ func (r *Reconciler) executeTimeoutEviction(ctx context.Context, action *evaluat
 		return nil
 	}
 
-	if err := r.informers.DeletePodsAfterTimeout(ctx,
-		nodeName, action.Namespaces, timeoutMinutes, &healthEvent, partialDrainEntity); err != nil {
+	// Pods are not force deleted while their checkpoint is still in progress
+	if r.checkpointHandshake != nil {
+		pending, err := r.runCheckpointHandshake(ctx, action, healthEvent, partialDrainEntity, false)
+		if err != nil {
+			return err
+		}
+
+		if err := r.awaitCheckpoints(ctx, nodeName, pending); err != nil {
+			return err
+		}
+	}
+
+	if r.workloadRequeuer != nil {
+		if err := r.requeueExpiredWorkloads(ctx, action, healthEvent, partialDrainEntity); err != nil {
+			return err
+		}
+	}
+
+	if r.gangDisrupter != nil {
+		if err := r.disruptExpiredGangs(ctx, action, healthEvent, partialDrainEntity); err != nil {
+			return err
+		}
+	}
+
+	var err error
+	if action.PodTimeouts != nil {
+		err = r.informers.DeletePodsAfterTimeouts(ctx, nodeName, action.Pods, action.PodTimeouts, &healthEvent)
+	} else {
+		err = r.informers.DeletePodsAfterTimeout(ctx,
+			nodeName, action.Namespaces, timeoutMinutes, &healthEvent, partialDrainEntity)
+	}
+
+	if err != nil {
 		if r.isTimeoutEvictionCancelled(ctx, eventID, nodeName) {
 			return nil
 		}
@@ -649,12 +848,19 @@ func (r *Reconciler) executeCheckCompletion(ctx context.Context, action *evaluat
 	span := tracing.SpanFromContext(ctx)
 	nodeName := healthEvent.HealthEvent.NodeName
 
+	// Pods left to complete are asked to checkpoint so they can finish early
+	if r.checkpointHandshake != nil {
+		if _, err := r.runCheckpointHandshake(ctx, action, healthEvent, partialDrainEntity, false); err != nil {
+			return err
+		}
+	}
+
 	allPodsComplete := true
 
 	var remainingPods []string
 
 	for _, namespace := range action.Namespaces {
-		pods, err := r.informers.FindEvictablePodsInNamespaceAndNode(namespace, nodeName, partialDrainEntity)
+		pods, err := r.podsForAction(action, namespace, nodeName, partialDrainEntity)
 		if err != nil {
 			tracing.RecordError(span, err)
 			span.SetAttributes(
@@ -1033,10 +1239,16 @@ func (r *Reconciler) clearEventStatus(eventID string, nodeName string) {
 
 	// Clean up the node entry when no events remain.
 	// This also clears the node-level cancellation flag since all queued events
-	// have been processed and handled the cancellation.
+	// have been processed and handled the cancellation, and frees the node's drain slot.
 	if len(eventsMap) == 0 {
 		delete(r.nodeEventsMap, nodeName)
 		delete(r.cancelledNodes, nodeName)
+		delete(r.checkpointOutcomes, nodeName)
+		delete(r.disruptedGangs, nodeName)
+
+		if r.drainLimiter != nil {
+			r.drainLimiter.Release(nodeName)
+		}
 	}
 }
 
@@ -1073,6 +1285,8 @@ func (r *Reconciler) handleCancelledEvent(ctx context.Context, nodeName string,
 	}
 
 	r.deleteCustomDrainCRIfEnabled(ctx, nodeName, event)
+	r.cancelPluginDrainIfEnabled(ctx, nodeName, event)
+	r.finishDrainStatus(ctx, *healthEvent, drainv1alpha1.NodeDrainPhaseCancelled, "Health event was cancelled")
 
 	if _, err := r.Config.StateManager.UpdateNVSentinelStateNodeLabel(ctx,
 		nodeName, statemanager.DrainingLabelValue, true); err != nil {
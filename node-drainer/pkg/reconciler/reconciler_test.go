@@ -0,0 +1,245 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reconciler
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/utils/ptr"
+
+	"github.com/nvidia/nvsentinel/commons/pkg/statemanager"
+	"github.com/nvidia/nvsentinel/data-models/pkg/model"
+	"github.com/nvidia/nvsentinel/data-models/pkg/protos"
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/config"
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/evaluator"
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/gang"
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/informers"
+	preflightgang "github.com/nvidia/nvsentinel/preflight/pkg/gang"
+	"github.com/nvidia/nvsentinel/store-client/pkg/client"
+)
+
+// fakeDataStore accepts every status update
+type fakeDataStore struct {
+	mu      sync.Mutex
+	updates int
+}
+
+func (d *fakeDataStore) UpdateDocument(context.Context, interface{}, interface{}) (*client.UpdateResult, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.updates++
+
+	return &client.UpdateResult{MatchedCount: 1, ModifiedCount: 1}, nil
+}
+
+func (d *fakeDataStore) FindDocument(context.Context, interface{},
+	*client.FindOneOptions) (client.SingleResult, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (d *fakeDataStore) FindDocuments(context.Context, interface{}, *client.FindOptions) (client.Cursor, error) {
+	return nil, errors.New("not implemented")
+}
+
+func testNode(name string) *v1.Node {
+	return &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{}}}
+}
+
+func testPod(namespace, name, nodeName string, labels map[string]string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, Labels: labels},
+		Spec:       v1.PodSpec{NodeName: nodeName},
+		Status:     v1.PodStatus{Phase: v1.PodRunning},
+	}
+}
+
+func testHealthEvent(nodeName, eventID string, createdAt time.Time) model.HealthEventWithStatus {
+	return model.HealthEventWithStatus{
+		CreatedAt: createdAt,
+		HealthEvent: &protos.HealthEvent{
+			Id:        eventID,
+			NodeName:  nodeName,
+			CheckName: "GpuXidError",
+		},
+		HealthEventStatus: &protos.HealthEventStatus{
+			UserPodsEvictionStatus: &protos.OperationStatus{Status: string(model.StatusInProgress)},
+		},
+	}
+}
+
+// newTestReconciler creates a reconciler backed by a fake clientset holding objects, with synced informers
+func newTestReconciler(t *testing.T, tomlConfig config.TomlConfig,
+	objects ...runtime.Object) (*Reconciler, *fake.Clientset) {
+	t.Helper()
+
+	clientset := fake.NewSimpleClientset(objects...)
+
+	informersInstance, err := informers.NewInformers(clientset, time.Minute, ptr.To(2), false)
+	require.NoError(t, err)
+	require.NoError(t, informersInstance.Run(t.Context()))
+
+	if tomlConfig.SystemNamespaces == "" {
+		tomlConfig.SystemNamespaces = "kube-*"
+	}
+
+	r, err := NewReconciler(config.ReconcilerConfig{
+		TomlConfig:   tomlConfig,
+		StateManager: statemanager.NewStateManager(clientset),
+	}, false, clientset, informersInstance, &fakeDataStore{}, nil, nil, nil)
+	require.NoError(t, err)
+
+	return r, clientset
+}
+
+func nodeStateLabel(t *testing.T, clientset *fake.Clientset, nodeName string) string {
+	t.Helper()
+
+	node, err := clientset.CoreV1().Nodes().Get(context.Background(), nodeName, metav1.GetOptions{})
+	require.NoError(t, err)
+
+	return node.Labels[statemanager.NVSentinelStateLabelKey]
+}
+
+func TestAcquireDrainSlot(t *testing.T) {
+	ctx := context.Background()
+	r, clientset := newTestReconciler(t, config.TomlConfig{
+		DrainConcurrency: config.DrainConcurrencyConfig{MaxConcurrentDrains: 1},
+	}, testNode("node-1"), testNode("node-2"), testPod("training", "worker", "node-2", nil))
+
+	queuedAt := time.Now().Add(-time.Minute)
+	event1 := testHealthEvent("node-1", "event-1", queuedAt)
+	event2 := testHealthEvent("node-2", "event-2", queuedAt.Add(time.Second))
+
+	require.NoError(t, r.acquireDrainSlot(ctx, "node-1", event1.CreatedAt))
+	r.markEventInProgress(ctx, "event-1", "node-1")
+
+	t.Log("The second node waits while the only drain slot is taken")
+
+	err := r.executeAction(ctx, &evaluator.DrainActionResult{
+		Action:     evaluator.ActionEvictImmediate,
+		Namespaces: []string{"training"},
+		Timeout:    time.Minute,
+	}, event2, nil, &fakeDataStore{}, "event-2")
+	require.ErrorIs(t, err, ErrWaitingForDrainSlot)
+	assert.Contains(t, err.Error(), "position 1")
+	assert.True(t, isRequeueSignal(err), "waiting for a drain slot only requeues the event")
+	assert.Equal(t, string(statemanager.DrainWaitingLabelValue), nodeStateLabel(t, clientset, "node-2"))
+
+	_, err = clientset.CoreV1().Pods("training").Get(ctx, "worker", metav1.GetOptions{})
+	require.NoError(t, err, "pods of a waiting node are not evicted")
+
+	t.Log("The slot is released once the first node has no events left")
+	r.clearEventStatus("event-1", "node-1")
+
+	require.NoError(t, r.acquireDrainSlot(ctx, "node-2", event2.CreatedAt))
+}
+
+// recordingDiscoverer groups pods by the job-name label into a gang spanning node-1 and node-2
+type recordingDiscoverer struct {
+	peers []preflightgang.PeerInfo
+}
+
+func (d *recordingDiscoverer) Name() string { return "test" }
+
+func (d *recordingDiscoverer) CanHandle(pod *v1.Pod) bool { return pod.Labels["job-name"] != "" }
+
+func (d *recordingDiscoverer) ExtractGangID(pod *v1.Pod) string {
+	return pod.Namespace + "/" + pod.Labels["job-name"]
+}
+
+func (d *recordingDiscoverer) DiscoverPeers(_ context.Context, pod *v1.Pod) (*preflightgang.GangInfo, error) {
+	return &preflightgang.GangInfo{
+		GangID:           d.ExtractGangID(pod),
+		ExpectedMinCount: len(d.peers),
+		Peers:            d.peers,
+	}, nil
+}
+
+// operationLog records the gang evictions and pod deletions in the order they happen
+type operationLog struct {
+	mu         sync.Mutex
+	operations []string
+}
+
+func (l *operationLog) add(operation string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.operations = append(l.operations, operation)
+}
+
+func (l *operationLog) EvictPods(_ context.Context, _ string, _ time.Duration, pods []*v1.Pod) error {
+	for _, pod := range pods {
+		l.add("gang-evict " + pod.Namespace + "/" + pod.Name)
+	}
+
+	return nil
+}
+
+func TestExecuteTimeoutEviction_DisruptsGangsBeforeForceDeletion(t *testing.T) {
+	ctx := context.Background()
+	labels := map[string]string{"job-name": "train"}
+	local := testPod("training", "worker-0", "node-1", labels)
+	remote := testPod("training", "worker-1", "node-2", labels)
+
+	r, clientset := newTestReconciler(t, config.TomlConfig{
+		EvictionTimeoutInSeconds: config.Duration{Duration: 30 * time.Second},
+	}, testNode("node-1"), testNode("node-2"), local, remote)
+
+	operations := &operationLog{}
+	clientset.PrependReactor("delete", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		operations.add("force-delete " + action.GetNamespace() + "/" + action.(k8stesting.DeleteAction).GetName())
+		return false, nil, nil
+	})
+
+	discoverer := &recordingDiscoverer{peers: []preflightgang.PeerInfo{
+		{PodName: local.Name, Namespace: local.Namespace, NodeName: "node-1"},
+		{PodName: remote.Name, Namespace: remote.Namespace, NodeName: "node-2"},
+	}}
+	r.SetGangDisrupter(gang.NewDisrupter(config.GangDrainConfig{Enabled: true, Mode: config.GangModeEvict},
+		discoverer, clientset, operations, false))
+
+	action := &evaluator.DrainActionResult{
+		Action:     evaluator.ActionEvictWithTimeout,
+		Namespaces: []string{"training"},
+		Timeout:    5 * time.Minute,
+	}
+
+	t.Log("Nothing is disrupted while the pods are within their timeout")
+
+	err := r.executeTimeoutEviction(ctx, action, testHealthEvent("node-1", "event-1", time.Now()), "event-1", nil)
+	require.Error(t, err)
+	assert.Empty(t, operations.operations)
+
+	t.Log("Once the timeout passed, the gang is disrupted before the local pod is force deleted")
+
+	expired := testHealthEvent("node-1", "event-1", time.Now().Add(-10*time.Minute))
+	err = r.executeTimeoutEviction(ctx, action, expired, "event-1", nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "force deleted 1 pods")
+	assert.Equal(t, []string{"gang-evict training/worker-1", "force-delete training/worker-0"}, operations.operations)
+}
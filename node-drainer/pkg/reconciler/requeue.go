@@ -0,0 +1,116 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reconciler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+
+	"go.opentelemetry.io/otel/attribute"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/nvidia/nvsentinel/commons/pkg/tracing"
+	"github.com/nvidia/nvsentinel/data-models/pkg/model"
+	"github.com/nvidia/nvsentinel/data-models/pkg/protos"
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/evaluator"
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/metrics"
+)
+
+// requeueWorkloads hands the pods about to be evicted back to their queue system. It returns the action
+// restricted to the pods node-drainer still evicts itself, and the workloads not released yet.
+func (r *Reconciler) requeueWorkloads(ctx context.Context, action *evaluator.DrainActionResult,
+	healthEvent model.HealthEventWithStatus, partialDrainEntity *protos.Entity) (
+	*evaluator.DrainActionResult, []string, error) {
+	span := tracing.SpanFromContext(ctx)
+	nodeName := healthEvent.HealthEvent.NodeName
+
+	ready := []*v1.Pod{}
+
+	var pending []string
+
+	for _, namespace := range action.Namespaces {
+		pods, err := r.podsForAction(action, namespace, nodeName, partialDrainEntity)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to find evictable pods in namespace %s on node %s: %w",
+				namespace, nodeName, err)
+		}
+
+		result, err := r.workloadRequeuer.Run(ctx, nodeName, pods, healthEvent.CreatedAt)
+		if err != nil {
+			metrics.ProcessingErrors.WithLabelValues("workload_requeue_error", nodeName).Inc()
+			tracing.RecordError(span, err)
+			span.SetAttributes(
+				attribute.String("node_drainer.error.type", "workload_requeue_error"),
+				attribute.String("node_drainer.error.message", err.Error()),
+			)
+
+			return nil, nil, fmt.Errorf("failed to requeue workloads in namespace %s on node %s: %w",
+				namespace, nodeName, err)
+		}
+
+		ready = append(ready, result.Ready...)
+		pending = append(pending, result.Pending...)
+	}
+
+	restricted := *action
+	restricted.Pods = ready
+
+	return &restricted, pending, nil
+}
+
+// ErrWaitingForWorkloadRelease is returned while queue systems have not released the node yet. It only
+// requeues the event and is not reported as an action failure.
+var ErrWaitingForWorkloadRelease = errors.New("waiting for queue system to release node")
+
+// requeueExpiredWorkloads hands the workloads of the DeleteAfterTimeout pods whose timeout has passed back to
+// their queue system before those pods are force deleted
+func (r *Reconciler) requeueExpiredWorkloads(ctx context.Context, action *evaluator.DrainActionResult,
+	healthEvent model.HealthEventWithStatus, partialDrainEntity *protos.Entity) error {
+	expired, err := r.expiredTimeoutPods(action, healthEvent, partialDrainEntity)
+	if err != nil || len(expired) == 0 {
+		return err
+	}
+
+	restricted := *action
+	restricted.Pods = expired
+	restricted.Namespaces = namespacesOfPods(expired)
+
+	_, pending, err := r.requeueWorkloads(ctx, &restricted, healthEvent, partialDrainEntity)
+	if err != nil {
+		return err
+	}
+
+	if len(pending) > 0 {
+		return r.awaitWorkloadRelease(ctx, healthEvent.HealthEvent.NodeName, pending)
+	}
+
+	return nil
+}
+
+func (r *Reconciler) awaitWorkloadRelease(ctx context.Context, nodeName string, pending []string) error {
+	sort.Strings(pending)
+
+	message := fmt.Sprintf("Waiting for queue systems to requeue following workloads: %v", pending)
+	if err := r.informers.UpdateNodeEvent(ctx, nodeName, "AwaitingWorkloadRequeue", message); err != nil {
+		slog.ErrorContext(ctx, "Failed to update node event",
+			"node", nodeName,
+			"error", err)
+	}
+
+	return fmt.Errorf("%w: %d workloads remaining", ErrWaitingForWorkloadRelease, len(pending))
+}
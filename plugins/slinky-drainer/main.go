@@ -15,18 +15,23 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"log/slog"
+	"net"
 	"os"
 	"time"
 
 	"github.com/go-logr/logr"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
 	"github.com/nvidia/nvsentinel/commons/pkg/logger"
@@ -55,6 +60,11 @@ func main() {
 		metricsAddr      string
 		probeAddr        string
 		slinkyNamespace  string
+		pluginAddr       string
+		pluginCertFile   string
+		pluginKeyFile    string
+		pluginClientCA   string
+		pluginInsecure   bool
 	)
 
 	flag.DurationVar(&podCheckInterval, "pod-check-interval", 5*time.Second, "Polling interval for pod conditions")
@@ -62,6 +72,14 @@ func main() {
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "Address for metrics endpoint")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "Address for health probe endpoint")
 	flag.StringVar(&slinkyNamespace, "slinky-namespace", "slinky", "Namespace where Slinky workload pods run")
+	flag.StringVar(&pluginAddr, "plugin-bind-address", "",
+		"Address for the node-drainer drain plugin gRPC API; empty disables it")
+	flag.StringVar(&pluginCertFile, "plugin-tls-cert-file", "", "TLS certificate of the drain plugin gRPC API")
+	flag.StringVar(&pluginKeyFile, "plugin-tls-key-file", "", "TLS key of the drain plugin gRPC API")
+	flag.StringVar(&pluginClientCA, "plugin-tls-client-ca-file", "",
+		"CA bundle that client certificates of the drain plugin gRPC API must be signed by (mutual TLS)")
+	flag.BoolVar(&pluginInsecure, "plugin-insecure", false,
+		"Serve the drain plugin gRPC API without TLS (for local development only)")
 	flag.Parse()
 
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
@@ -74,13 +92,33 @@ func main() {
 		os.Exit(1)
 	}
 
-	if err := controller.NewDrainRequestReconciler(mgr,
-		podCheckInterval,
-		drainTimeout, slinkyNamespace).SetupWithManager(mgr); err != nil {
+	reconciler := controller.NewDrainRequestReconciler(mgr, podCheckInterval, drainTimeout, slinkyNamespace)
+	if err := reconciler.SetupWithManager(mgr); err != nil {
 		slog.Error("Unable to create controller", "error", err)
 		os.Exit(1)
 	}
 
+	if pluginAddr != "" {
+		var creds credentials.TransportCredentials
+
+		if pluginInsecure {
+			slog.Warn("Serving the drain plugin API without TLS")
+		} else {
+			tlsConfig, err := controller.PluginServerTLSConfig(pluginCertFile, pluginKeyFile, pluginClientCA)
+			if err != nil {
+				slog.Error("Unable to load drain plugin TLS configuration", "error", err)
+				os.Exit(1)
+			}
+
+			creds = credentials.NewTLS(tlsConfig)
+		}
+
+		if err := addPluginServer(mgr, reconciler, pluginAddr, creds); err != nil {
+			slog.Error("Unable to set up drain plugin server", "error", err)
+			os.Exit(1)
+		}
+	}
+
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		slog.Error("Unable to set up health check", "error", err)
 		os.Exit(1)
@@ -94,10 +132,33 @@ func main() {
 	slog.Info("Starting Slinky Drainer controller",
 		"slinkyNamespace", slinkyNamespace,
 		"podCheckInterval", podCheckInterval,
-		"drainTimeout", drainTimeout)
+		"drainTimeout", drainTimeout,
+		"pluginBindAddress", pluginAddr)
 
 	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
 		slog.Error("Problem running manager", "error", err)
 		os.Exit(1)
 	}
 }
+
+// addPluginServer serves the drain plugin API next to the DrainRequest controller. Nil creds serve it
+// without TLS.
+func addPluginServer(mgr ctrl.Manager, reconciler *controller.DrainRequestReconciler,
+	addr string, creds credentials.TransportCredentials) error {
+	var opts []grpc.ServerOption
+
+	if creds != nil {
+		opts = append(opts, grpc.Creds(creds))
+	}
+
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	server := controller.NewPluginServer(reconciler)
+
+	return mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		return server.Serve(ctx, lis, opts...)
+	}))
+}
@@ -93,67 +93,106 @@ func (r *DrainRequestReconciler) Reconcile(ctx context.Context, req ctrl.Request
 			fmt.Errorf("failed to add finalizer to DrainRequest %s/%s: %w", drainReq.Namespace, drainReq.Name, err)
 	}
 
-	if err := r.setNodeAnnotation(ctx, drainReq); err != nil {
-		slog.Error("Failed to set node annotation", "drainrequest", req.NamespacedName, "error", err)
+	outcome, err := r.drainNode(ctx, &drainReq.Spec)
+	if err != nil {
+		slog.Error("Failed to drain node", "drainrequest", req.NamespacedName, "error", err)
 		return ctrl.Result{RequeueAfter: 10 * time.Second}, err
 	}
 
-	pods, err := r.getSlinkyPods(ctx, drainReq.Spec.NodeName)
+	if !outcome.complete {
+		return ctrl.Result{RequeueAfter: r.PodCheckInterval}, nil
+	}
+
+	return r.markDrainComplete(ctx, drainReq, outcome.reason, outcome.message)
+}
+
+// drainOutcome is the result of one drain attempt; reason and message describe a completed drain
+type drainOutcome struct {
+	complete bool
+	reason   string
+	message  string
+}
+
+// drainNode makes one attempt at draining the node of the request: the node is annotated so that Slinky
+// drains its Slurm node, and the Slinky pods are deleted once Slurm reports them drained. It is shared by
+// the DrainRequest controller and the drain plugin server.
+func (r *DrainRequestReconciler) drainNode(ctx context.Context,
+	spec *drainv1alpha1.DrainRequestSpec) (drainOutcome, error) {
+	if err := r.setNodeAnnotation(ctx, spec); err != nil {
+		return drainOutcome{}, fmt.Errorf("failed to set node annotation: %w", err)
+	}
+
+	pods, err := r.getSlinkyPods(ctx, spec.NodeName)
 	if err != nil {
-		slog.Error("Failed to list Slinky pods", "drainrequest", req.NamespacedName, "error", err)
-		return ctrl.Result{RequeueAfter: 10 * time.Second}, err
+		return drainOutcome{}, fmt.Errorf("failed to list Slinky pods: %w", err)
 	}
 
 	if len(pods) == 0 {
-		slog.Info("No Slinky pods found on node, marking complete", "drainrequest", req.NamespacedName)
-		return r.markDrainComplete(ctx, drainReq, "NoPods", "No Slinky pods found on node")
+		slog.Info("No Slinky pods found on node, marking complete", "node", spec.NodeName)
+
+		return drainOutcome{complete: true, reason: "NoPods", message: "No Slinky pods found on node"}, nil
 	}
 
 	allDrained, notReadyPods := r.checkPodsFullyDrained(pods)
 	if !allDrained {
 		slog.Info("Waiting for pods to be fully drained",
-			"drainrequest", req.NamespacedName,
+			"node", spec.NodeName,
 			"total", len(pods),
 			"notReady", len(notReadyPods),
 			"notReadyPods", notReadyPods)
 
-		return ctrl.Result{RequeueAfter: r.PodCheckInterval}, nil
+		return drainOutcome{}, nil
 	}
 
 	if err := r.deleteSlinkyPods(ctx, pods); err != nil {
-		slog.Error("Failed to delete Slinky pods", "drainrequest", req.NamespacedName, "error", err)
-		return ctrl.Result{RequeueAfter: 10 * time.Second}, err
+		return drainOutcome{}, fmt.Errorf("failed to delete Slinky pods: %w", err)
 	}
 
-	slog.Info("Successfully drained all Slinky pods", "drainrequest", req.NamespacedName, "count", len(pods))
+	slog.Info("Successfully drained all Slinky pods", "node", spec.NodeName, "count", len(pods))
 
-	return r.markDrainComplete(ctx, drainReq, "DrainComplete", "All Slinky pods drained successfully")
+	return drainOutcome{complete: true, reason: "DrainComplete", message: "All Slinky pods drained successfully"}, nil
 }
 
 func (r *DrainRequestReconciler) reconcileCompleted(
 	ctx context.Context,
 	drainReq *drainv1alpha1.DrainRequest,
 ) (ctrl.Result, error) {
+	released, err := r.releaseNode(ctx, drainReq.Spec.NodeName)
+	if err != nil {
+		slog.Error("Failed to release node", "node", drainReq.Spec.NodeName, "error", err)
+
+		// Return nil error to use fixed requeue interval instead of exponential backoff.
+		return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+	}
+
+	if !released {
+		return ctrl.Result{}, nil
+	}
+
+	return r.removeFinalizer(ctx, drainReq)
+}
+
+// releaseNode removes the cordon annotation once NVSentinel no longer holds the node. It returns false
+// while the node is still held.
+func (r *DrainRequestReconciler) releaseNode(ctx context.Context, nodeName string) (bool, error) {
 	node := &corev1.Node{}
-	if err := r.Get(ctx, client.ObjectKey{Name: drainReq.Spec.NodeName}, node); err != nil {
+	if err := r.Get(ctx, client.ObjectKey{Name: nodeName}, node); err != nil {
 		if apierrors.IsNotFound(err) {
-			return r.removeFinalizer(ctx, drainReq)
+			return true, nil
 		}
 
-		// Return nil error to use fixed requeue interval instead of exponential backoff.
-		return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+		return false, fmt.Errorf("failed to get node: %w", err)
 	}
 
 	if !shouldRemoveAnnotation(node) {
-		return ctrl.Result{}, nil
+		return false, nil
 	}
 
 	if err := r.removeNodeAnnotation(ctx, node); err != nil {
-		slog.Error("Failed to remove node annotation", "node", node.Name, "error", err)
-		return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+		return false, err
 	}
 
-	return r.removeFinalizer(ctx, drainReq)
+	return true, nil
 }
 
 func (r *DrainRequestReconciler) ensureFinalizer(ctx context.Context, drainReq *drainv1alpha1.DrainRequest) error {
@@ -209,23 +248,23 @@ func (r *DrainRequestReconciler) removeNodeAnnotation(ctx context.Context, node
 
 func (r *DrainRequestReconciler) setNodeAnnotation(
 	ctx context.Context,
-	drainReq *drainv1alpha1.DrainRequest,
+	spec *drainv1alpha1.DrainRequestSpec,
 ) error {
 	node := &corev1.Node{}
-	if err := r.Get(ctx, client.ObjectKey{Name: drainReq.Spec.NodeName}, node); err != nil {
+	if err := r.Get(ctx, client.ObjectKey{Name: spec.NodeName}, node); err != nil {
 		return fmt.Errorf("failed to get node: %w", err)
 	}
 
 	if node.Annotations != nil {
 		if _, ok := node.Annotations[annotationKey]; ok {
-			slog.Info("Node already has annotation, skipping", "node", drainReq.Spec.NodeName)
+			slog.Info("Node already has annotation, skipping", "node", spec.NodeName)
 			return nil
 		}
 	}
 
-	reason := buildCordonReason(drainReq)
+	reason := buildCordonReason(spec)
 
-	slog.Info("Setting node annotation", "node", drainReq.Spec.NodeName, "reason", reason)
+	slog.Info("Setting node annotation", "node", spec.NodeName, "reason", reason)
 
 	if node.Annotations == nil {
 		node.Annotations = make(map[string]string)
@@ -240,23 +279,23 @@ func (r *DrainRequestReconciler) setNodeAnnotation(
 	return nil
 }
 
-func buildCordonReason(dr *drainv1alpha1.DrainRequest) string {
+func buildCordonReason(spec *drainv1alpha1.DrainRequestSpec) string {
 	var parts []string
 
 	parts = append(parts, annotationPrefix)
 
-	if len(dr.Spec.ErrorCode) > 0 {
-		parts = append(parts, strings.Join(dr.Spec.ErrorCode, ","))
+	if len(spec.ErrorCode) > 0 {
+		parts = append(parts, strings.Join(spec.ErrorCode, ","))
 	}
 
-	entities := formatEntitiesImpacted(dr.Spec.EntitiesImpacted)
+	entities := formatEntitiesImpacted(spec.EntitiesImpacted)
 	if entities != "" {
 		parts = append(parts, entities)
 	}
 
-	message := dr.Spec.Reason
+	message := spec.Reason
 	if message == "" {
-		message = dr.Spec.CheckName
+		message = spec.CheckName
 	}
 
 	if message == "" {
@@ -0,0 +1,285 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+
+	drainpluginv1alpha1 "github.com/nvidia/nvsentinel/api/gen/go/drainplugin/v1alpha1"
+	drainv1alpha1 "github.com/nvidia/nvsentinel/plugins/slinky-drainer/api/v1alpha1"
+)
+
+type drainKey struct {
+	nodeName string
+	eventID  string
+}
+
+type pluginDrain struct {
+	state   drainpluginv1alpha1.DrainState
+	message string
+	cancel  context.CancelFunc
+}
+
+// PluginServer serves the drain plugin API so that node-drainer can drain Slinky nodes without a
+// DrainRequest CR. Drains are held in memory: node-drainer starts again the drains a restarted server
+// reports as not found, and nodes still carrying the cordon annotation are released on startup.
+type PluginServer struct {
+	drainpluginv1alpha1.UnimplementedDrainPluginServiceServer
+
+	reconciler *DrainRequestReconciler
+
+	mu      sync.Mutex
+	baseCtx context.Context
+	drains  map[drainKey]*pluginDrain
+}
+
+func NewPluginServer(reconciler *DrainRequestReconciler) *PluginServer {
+	return &PluginServer{
+		reconciler: reconciler,
+		baseCtx:    context.Background(),
+		drains:     make(map[drainKey]*pluginDrain),
+	}
+}
+
+// Serve serves the drain plugin API on lis until ctx is cancelled. It is meant to be added to the
+// manager as a Runnable so that the client cache is started.
+func (s *PluginServer) Serve(ctx context.Context, lis net.Listener, opts ...grpc.ServerOption) error {
+	s.mu.Lock()
+	s.baseCtx = ctx
+	s.mu.Unlock()
+
+	server := grpc.NewServer(opts...)
+	drainpluginv1alpha1.RegisterDrainPluginServiceServer(server, s)
+
+	if err := s.resumeReleases(ctx); err != nil {
+		slog.Error("Failed to resume release of cordoned nodes", "error", err)
+	}
+
+	go func() {
+		<-ctx.Done()
+		server.GracefulStop()
+	}()
+
+	slog.Info("Serving drain plugin API", "address", lis.Addr().String())
+
+	return server.Serve(lis)
+}
+
+func (s *PluginServer) StartDrain(_ context.Context,
+	req *drainpluginv1alpha1.StartDrainRequest) (*drainpluginv1alpha1.StartDrainResponse, error) {
+	if req.GetNodeName() == "" || req.GetEventId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "node_name and event_id are required")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := drainKey{nodeName: req.GetNodeName(), eventID: req.GetEventId()}
+	if d, ok := s.drains[key]; ok {
+		return &drainpluginv1alpha1.StartDrainResponse{State: d.state}, nil
+	}
+
+	ctx, cancel := context.WithCancel(s.baseCtx)
+	s.drains[key] = &pluginDrain{
+		state:  drainpluginv1alpha1.DrainState_DRAIN_STATE_IN_PROGRESS,
+		cancel: cancel,
+	}
+
+	slog.Info("Starting drain", "node", key.nodeName, "eventID", key.eventID)
+
+	go s.run(ctx, key, drainRequestSpec(req))
+
+	return &drainpluginv1alpha1.StartDrainResponse{State: drainpluginv1alpha1.DrainState_DRAIN_STATE_IN_PROGRESS}, nil
+}
+
+func (s *PluginServer) GetDrainStatus(_ context.Context,
+	req *drainpluginv1alpha1.GetDrainStatusRequest) (*drainpluginv1alpha1.GetDrainStatusResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if req.GetEventId() != "" {
+		d, ok := s.drains[drainKey{nodeName: req.GetNodeName(), eventID: req.GetEventId()}]
+		if !ok {
+			return &drainpluginv1alpha1.GetDrainStatusResponse{
+				State: drainpluginv1alpha1.DrainState_DRAIN_STATE_NOT_FOUND,
+			}, nil
+		}
+
+		return &drainpluginv1alpha1.GetDrainStatusResponse{State: d.state, Message: d.message}, nil
+	}
+
+	state := drainpluginv1alpha1.DrainState_DRAIN_STATE_NOT_FOUND
+
+	for key, d := range s.drains {
+		if key.nodeName != req.GetNodeName() {
+			continue
+		}
+
+		if d.state == drainpluginv1alpha1.DrainState_DRAIN_STATE_IN_PROGRESS {
+			return &drainpluginv1alpha1.GetDrainStatusResponse{State: d.state}, nil
+		}
+
+		if d.state == drainpluginv1alpha1.DrainState_DRAIN_STATE_COMPLETED {
+			state = d.state
+		}
+	}
+
+	return &drainpluginv1alpha1.GetDrainStatusResponse{State: state}, nil
+}
+
+func (s *PluginServer) CancelDrain(_ context.Context,
+	req *drainpluginv1alpha1.CancelDrainRequest) (*drainpluginv1alpha1.CancelDrainResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := drainKey{nodeName: req.GetNodeName(), eventID: req.GetEventId()}
+	if d, ok := s.drains[key]; ok {
+		d.cancel()
+		delete(s.drains, key)
+
+		slog.Info("Cancelled drain", "node", key.nodeName, "eventID", key.eventID)
+	}
+
+	return &drainpluginv1alpha1.CancelDrainResponse{}, nil
+}
+
+// run drains the node until the drain completes, times out or is cancelled, and then releases the node
+// the way the controller does for a completed DrainRequest
+func (s *PluginServer) run(ctx context.Context, key drainKey, spec *drainv1alpha1.DrainRequestSpec) {
+	s.drain(ctx, key, spec)
+
+	s.mu.Lock()
+	baseCtx := s.baseCtx
+	s.mu.Unlock()
+
+	s.release(baseCtx, key.nodeName)
+}
+
+func (s *PluginServer) drain(ctx context.Context, key drainKey, spec *drainv1alpha1.DrainRequestSpec) {
+	deadline := time.Now().Add(s.reconciler.DrainTimeout)
+
+	for {
+		outcome, err := s.reconciler.drainNode(ctx, spec)
+		if err != nil {
+			slog.Error("Failed to drain node", "node", key.nodeName, "eventID", key.eventID, "error", err)
+		} else if outcome.complete {
+			s.setState(key, drainpluginv1alpha1.DrainState_DRAIN_STATE_COMPLETED, outcome.message)
+			return
+		}
+
+		if s.reconciler.DrainTimeout > 0 && time.Now().After(deadline) {
+			s.setState(key, drainpluginv1alpha1.DrainState_DRAIN_STATE_FAILED,
+				fmt.Sprintf("Slinky pods were not drained within %s", s.reconciler.DrainTimeout))
+
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(s.reconciler.PodCheckInterval):
+		}
+	}
+}
+
+// release waits until NVSentinel no longer holds the node and removes the cordon annotation
+func (s *PluginServer) release(ctx context.Context, nodeName string) {
+	for {
+		released, err := s.reconciler.releaseNode(ctx, nodeName)
+		if err != nil {
+			slog.Error("Failed to release node", "node", nodeName, "error", err)
+		} else if released {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(s.reconciler.PodCheckInterval):
+		}
+	}
+}
+
+// resumeReleases releases the nodes cordoned by drains that a previous instance of the server did not
+// release before it stopped
+func (s *PluginServer) resumeReleases(ctx context.Context) error {
+	nodes := &corev1.NodeList{}
+	if err := s.reconciler.List(ctx, nodes); err != nil {
+		return fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	for _, node := range nodes.Items {
+		if strings.HasPrefix(node.Annotations[annotationKey], annotationPrefix) {
+			slog.Info("Resuming release of cordoned node", "node", node.Name)
+
+			go s.release(ctx, node.Name)
+		}
+	}
+
+	return nil
+}
+
+func (s *PluginServer) setState(key drainKey, state drainpluginv1alpha1.DrainState, message string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// The drain may have been cancelled meanwhile
+	if d, ok := s.drains[key]; ok {
+		d.state = state
+		d.message = message
+	}
+
+	slog.Info("Drain finished", "node", key.nodeName, "eventID", key.eventID, "state", state.String(),
+		"message", message)
+}
+
+func drainRequestSpec(req *drainpluginv1alpha1.StartDrainRequest) *drainv1alpha1.DrainRequestSpec {
+	spec := &drainv1alpha1.DrainRequestSpec{
+		NodeName:          req.GetNodeName(),
+		CheckName:         req.GetCheckName(),
+		RecommendedAction: req.GetRecommendedAction(),
+		ErrorCode:         req.GetErrorCodes(),
+		HealthEventID:     req.GetEventId(),
+		Reason:            req.GetReason(),
+	}
+
+	for _, entity := range req.GetImpactedEntities() {
+		spec.EntitiesImpacted = append(spec.EntitiesImpacted, drainv1alpha1.EntityImpacted{
+			Type:  entity.GetType(),
+			Value: entity.GetValue(),
+		})
+	}
+
+	for _, pod := range req.GetPods() {
+		if spec.PodsToDrain == nil {
+			spec.PodsToDrain = make(map[string][]string)
+		}
+
+		spec.PodsToDrain[pod.GetNamespace()] = append(spec.PodsToDrain[pod.GetNamespace()], pod.GetName())
+	}
+
+	return spec
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	drainpluginv1alpha1 "github.com/nvidia/nvsentinel/api/gen/go/drainplugin/v1alpha1"
+)
+
+func setupPluginServer(t *testing.T, tc *testEnvContext) drainpluginv1alpha1.DrainPluginServiceClient {
+	t.Helper()
+
+	server := NewPluginServer(&DrainRequestReconciler{
+		Client:           tc.client,
+		PodCheckInterval: 1 * time.Second,
+		DrainTimeout:     5 * time.Minute,
+		SlinkyNamespace:  testSlinkyNamespace,
+	})
+
+	lis := bufconn.Listen(1024 * 1024)
+	ctx, cancel := context.WithCancel(tc.ctx)
+	serveDone := make(chan struct{})
+
+	go func() {
+		defer close(serveDone)
+
+		if err := server.Serve(ctx, lis); err != nil {
+			t.Logf("plugin server exited: %v", err)
+		}
+	}()
+
+	conn, err := grpc.NewClient(
+		"passthrough://bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return lis.Dial()
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		conn.Close()
+		cancel()
+		<-serveDone
+	})
+
+	return drainpluginv1alpha1.NewDrainPluginServiceClient(conn)
+}
+
+func waitForPluginDrainState(t *testing.T, plugin drainpluginv1alpha1.DrainPluginServiceClient,
+	nodeName, eventID string, state drainpluginv1alpha1.DrainState) {
+	t.Helper()
+
+	require.Eventually(t, func() bool {
+		resp, err := plugin.GetDrainStatus(context.Background(), &drainpluginv1alpha1.GetDrainStatusRequest{
+			NodeName: nodeName,
+			EventId:  eventID,
+		})
+
+		return err == nil && resp.GetState() == state
+	}, testTimeout, testPollInterval, "drain of node %s did not reach state %s", nodeName, state)
+}
+
+func TestPluginServer_FullDrainCycle(t *testing.T) {
+	tc := setupTestEnv(t, "plugin-full-cycle")
+	plugin := setupPluginServer(t, tc)
+	ctx := context.Background()
+
+	node := createNode(t, tc, "test-node-plugin-cycle", nil, map[string]string{
+		nvsentinelStateLabelKey: "draining",
+	})
+	pod := createSlinkyPod(t, tc, node.Name)
+	markPodReady(t, tc, pod.Name, pod.Namespace)
+
+	resp, err := plugin.StartDrain(ctx, &drainpluginv1alpha1.StartDrainRequest{
+		NodeName:         node.Name,
+		EventId:          "event-1",
+		ErrorCodes:       []string{"79"},
+		ImpactedEntities: []*drainpluginv1alpha1.Entity{{Type: "GPU", Value: "0"}},
+		Reason:           "GPU has fallen off the bus",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, drainpluginv1alpha1.DrainState_DRAIN_STATE_IN_PROGRESS, resp.GetState())
+
+	assertNodeAnnotation(t, tc, node.Name, "[J] [NVSentinel] 79 GPU:0 - GPU has fallen off the bus")
+
+	// Starting the same drain again does not start another one
+	resp, err = plugin.StartDrain(ctx, &drainpluginv1alpha1.StartDrainRequest{NodeName: node.Name, EventId: "event-1"})
+	require.NoError(t, err)
+	assert.Equal(t, drainpluginv1alpha1.DrainState_DRAIN_STATE_IN_PROGRESS, resp.GetState())
+
+	markPodDrained(t, tc, pod.Name, pod.Namespace)
+
+	waitForPluginDrainState(t, plugin, node.Name, "event-1", drainpluginv1alpha1.DrainState_DRAIN_STATE_COMPLETED)
+	waitForPodDeletion(t, tc, pod.Name, pod.Namespace)
+	waitForPluginDrainState(t, plugin, node.Name, "", drainpluginv1alpha1.DrainState_DRAIN_STATE_COMPLETED)
+
+	_, err = plugin.CancelDrain(ctx, &drainpluginv1alpha1.CancelDrainRequest{NodeName: node.Name, EventId: "event-1"})
+	require.NoError(t, err)
+	waitForPluginDrainState(t, plugin, node.Name, "event-1", drainpluginv1alpha1.DrainState_DRAIN_STATE_NOT_FOUND)
+
+	// The annotation stays until NVSentinel releases the node
+	assertNodeAnnotation(t, tc, node.Name, "[J] [NVSentinel] 79 GPU:0 - GPU has fallen off the bus")
+
+	removeNodeLabel(t, tc, node.Name, nvsentinelStateLabelKey)
+
+	waitForAnnotationRemoved(t, tc, node.Name)
+}
+
+func TestPluginServer_StartDrainRequiresEvent(t *testing.T) {
+	tc := setupTestEnv(t, "plugin-invalid")
+	plugin := setupPluginServer(t, tc)
+
+	_, err := plugin.StartDrain(context.Background(), &drainpluginv1alpha1.StartDrainRequest{NodeName: "node-1"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,75 @@
+#!/usr/bin/env bash
+
+# Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+#===============================================================================
+# Cluster API Test CRD Update Script
+#===============================================================================
+#
+# Purpose:
+#   Vendors the upstream Cluster API and Metal3 CRDs the janitor-provider capi
+#   integration tests run against, so that the tests exercise the real schemas.
+#
+# Usage:
+#   ./scripts/update-capi-test-crds.sh
+#   CAPI_VERSION=v1.10.4 ./scripts/update-capi-test-crds.sh
+#
+# What it does:
+#   Downloads the Machine, Metal3Machine and BareMetalHost CRDs of the pinned
+#   releases into janitor-provider/pkg/csp/capi/testdata/crds, each prefixed
+#   with the URL it was downloaded from.
+#
+# Exit Codes:
+#   0  - Update successful
+#   1  - Update failed
+#
+#===============================================================================
+
+set -euo pipefail
+
+CAPI_VERSION="${CAPI_VERSION:-v1.10.4}"
+CAPM3_VERSION="${CAPM3_VERSION:-v1.10.1}"
+BMO_VERSION="${BMO_VERSION:-v0.10.2}"
+
+SCRIPT_DIR="$(cd "$(dirname "${BASH_SOURCE[0]}")" && pwd)"
+REPO_ROOT="$(cd "${SCRIPT_DIR}/.." && pwd)"
+CRD_DIR="${REPO_ROOT}/janitor-provider/pkg/csp/capi/testdata/crds"
+
+download() {
+    local url="$1"
+    local file="$2"
+    local tmp
+
+    tmp="$(mktemp)"
+
+    echo "Downloading ${url}"
+    curl -fsSL -o "${tmp}" "${url}"
+
+    {
+        echo "# Source: ${url}"
+        cat "${tmp}"
+    } > "${CRD_DIR}/${file}"
+
+    rm -f "${tmp}"
+}
+
+download "https://raw.githubusercontent.com/kubernetes-sigs/cluster-api/${CAPI_VERSION}/config/crd/bases/cluster.x-k8s.io_machines.yaml" \
+    cluster.x-k8s.io_machines.yaml
+download "https://raw.githubusercontent.com/metal3-io/cluster-api-provider-metal3/${CAPM3_VERSION}/config/crd/bases/infrastructure.cluster.x-k8s.io_metal3machines.yaml" \
+    infrastructure.cluster.x-k8s.io_metal3machines.yaml
+download "https://raw.githubusercontent.com/metal3-io/baremetal-operator/${BMO_VERSION}/config/base/crds/bases/metal3.io_baremetalhosts.yaml" \
+    metal3.io_baremetalhosts.yaml
+
+echo "Updated the Cluster API test CRDs in ${CRD_DIR}"
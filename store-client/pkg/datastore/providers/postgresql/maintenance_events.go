@@ -83,8 +83,13 @@ func (p *PostgreSQLMaintenanceEventStore) upsertMaintenanceEventWithResult(
 			scheduled_start_time = EXCLUDED.scheduled_start_time,
 			actual_end_time = EXCLUDED.actual_end_time,
 			last_updated_timestamp = EXCLUDED.last_updated_timestamp,
-			-- Update document.status to match the column status determined above
-			document = jsonb_set(
+			-- Update document.status to match the column status determined above, and keep the expedite
+			-- outcome which is recorded by the monitor and never part of a CSP event update
+			document = jsonb_strip_nulls(jsonb_build_object(
+				'expediteStatus', maintenance_events.document->'expediteStatus',
+				'expediteMessage', maintenance_events.document->'expediteMessage',
+				'expediteRequestedAt', maintenance_events.document->'expediteRequestedAt'
+			)) || jsonb_set(
 				EXCLUDED.document, 
 				'{status}', 
 				to_jsonb(CASE
@@ -31,6 +31,8 @@ func main() {
 
 	handler.NewGCPHandler(eventStore).RegisterRoutes(mux)
 	handler.NewAWSHandler(eventStore).RegisterRoutes(mux)
+	handler.NewAzureHandler(eventStore).RegisterRoutes(mux)
+	handler.NewOCIHandler(eventStore).RegisterRoutes(mux)
 
 	mux.HandleFunc("/healthz", func(w http.ResponseWriter, _ *http.Request) {
 		w.WriteHeader(http.StatusOK)
@@ -0,0 +1,210 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"csp-api-mock/pkg/store"
+)
+
+type AzureHandler struct {
+	store       *store.EventStore
+	incarnation atomic.Int64
+	ackCount    atomic.Int64
+}
+
+func NewAzureHandler(eventStore *store.EventStore) *AzureHandler {
+	return &AzureHandler{store: eventStore}
+}
+
+func (h *AzureHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("/azure/metadata/scheduledevents", h.handleScheduledEvents)
+	mux.HandleFunc("/azure/inject", h.handleInject)
+	mux.HandleFunc("/azure/events", h.handleListEvents)
+	mux.HandleFunc("/azure/events/clear", h.handleClear)
+	mux.HandleFunc("/azure/stats", h.handleStats)
+	mux.HandleFunc("/azure/stats/reset", h.handleResetStats)
+}
+
+func (h *AzureHandler) handleScheduledEvents(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Metadata") != "true" {
+		http.Error(w, "Required metadata header not specified", http.StatusBadRequest)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		h.getScheduledEvents(w)
+	case http.MethodPost:
+		h.startEvents(w, r)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (h *AzureHandler) getScheduledEvents(w http.ResponseWriter) {
+	h.store.IncrementPollCount(store.CSPAzure)
+
+	events := h.store.ListByCSP(store.CSPAzure)
+	azureEvents := make([]map[string]interface{}, 0, len(events))
+
+	for _, e := range events {
+		// Azure removes events from the document once the maintenance is over
+		if e.Status == "Completed" {
+			continue
+		}
+		azureEvents = append(azureEvents, h.toAzureEvent(e))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"DocumentIncarnation": h.incarnation.Load(),
+		"Events":              azureEvents,
+	})
+}
+
+func (h *AzureHandler) startEvents(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		StartRequests []struct {
+			EventID string `json:"EventId"`
+		} `json:"StartRequests"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	for _, startRequest := range req.StartRequests {
+		e, exists := h.store.Get(startRequest.EventID)
+		if !exists || e.CSP != store.CSPAzure {
+			continue
+		}
+		e.Status = "Started"
+		h.store.Update(e)
+		h.ackCount.Add(1)
+		log.Printf("Azure: Started event %s", e.ID)
+	}
+
+	h.incarnation.Add(1)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *AzureHandler) toAzureEvent(e *store.MaintenanceEvent) map[string]interface{} {
+	var notBefore string
+	if e.Status == "Scheduled" && e.ScheduledStart != nil {
+		notBefore = e.ScheduledStart.UTC().Format(http.TimeFormat)
+	}
+
+	duration := -1
+	if e.ScheduledStart != nil && e.ScheduledEnd != nil {
+		duration = int(e.ScheduledEnd.Sub(*e.ScheduledStart).Seconds())
+	}
+
+	return map[string]interface{}{
+		"EventId":           e.ID,
+		"EventType":         e.EventTypeCode,
+		"ResourceType":      "VirtualMachine",
+		"Resources":         e.AffectedEntities,
+		"EventStatus":       e.Status,
+		"NotBefore":         notBefore,
+		"Description":       e.Description,
+		"EventSource":       "Platform",
+		"DurationInSeconds": duration,
+	}
+}
+
+func (h *AzureHandler) handleInject(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req store.MaintenanceEvent
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if req.ID == "" {
+		req.ID = fmt.Sprintf("azure-event-%d", time.Now().UnixNano())
+	}
+	req.CSP = store.CSPAzure
+
+	existing, exists := h.store.Get(req.ID)
+	statusCode := http.StatusCreated
+
+	if exists {
+		mergeEvent(existing, &req)
+		existing.AffectedEntities = []string{existing.InstanceID}
+		h.store.Update(existing)
+		statusCode = http.StatusOK
+		log.Printf("Azure: Updated event %s", existing.ID)
+	} else {
+		if req.Status == "" {
+			req.Status = "Scheduled"
+		}
+		if req.EventTypeCode == "" {
+			req.EventTypeCode = "Reboot"
+		}
+		if req.ScheduledStart == nil {
+			notBefore := time.Now().UTC().Add(15 * time.Minute)
+			req.ScheduledStart = &notBefore
+		}
+		// The instance ID is the resource name of the VM, e.g. <scale set>_<instance ID>
+		req.AffectedEntities = []string{req.InstanceID}
+		h.store.Add(&req)
+		log.Printf("Azure: Created event %s", req.ID)
+	}
+
+	h.incarnation.Add(1)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(map[string]string{"eventId": req.ID})
+}
+
+func (h *AzureHandler) handleListEvents(w http.ResponseWriter, r *http.Request) {
+	events := h.store.ListByCSP(store.CSPAzure)
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(events)
+}
+
+func (h *AzureHandler) handleClear(w http.ResponseWriter, r *http.Request) {
+	h.store.ClearByCSP(store.CSPAzure)
+	h.incarnation.Add(1)
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{"status": "cleared"})
+}
+
+func (h *AzureHandler) handleStats(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]int64{
+		"pollCount":         h.store.GetPollCount(store.CSPAzure),
+		"startRequestCount": h.ackCount.Load(),
+	})
+}
+
+func (h *AzureHandler) handleResetStats(w http.ResponseWriter, r *http.Request) {
+	h.store.ResetPollCount(store.CSPAzure)
+	h.ackCount.Store(0)
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{"status": "reset"})
+}
@@ -0,0 +1,158 @@
+// Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"csp-api-mock/pkg/store"
+)
+
+type OCIHandler struct {
+	store *store.EventStore
+}
+
+func NewOCIHandler(eventStore *store.EventStore) *OCIHandler {
+	return &OCIHandler{store: eventStore}
+}
+
+func (h *OCIHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("/20160918/instanceMaintenanceEvents", h.handleListMaintenanceEvents)
+	mux.HandleFunc("/oci/inject", h.handleInject)
+	mux.HandleFunc("/oci/events", h.handleListEvents)
+	mux.HandleFunc("/oci/events/clear", h.handleClear)
+	mux.HandleFunc("/oci/stats", h.handleStats)
+	mux.HandleFunc("/oci/stats/reset", h.handleResetStats)
+}
+
+func (h *OCIHandler) handleListMaintenanceEvents(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	h.store.IncrementPollCount(store.CSPOCI)
+
+	compartmentID := r.URL.Query().Get("compartmentId")
+	events := h.store.ListByCSP(store.CSPOCI)
+	ociEvents := make([]map[string]interface{}, 0, len(events))
+
+	for _, e := range events {
+		// The project ID of injected OCI events holds their compartment OCID
+		if compartmentID != "" && e.ProjectID != "" && e.ProjectID != compartmentID {
+			continue
+		}
+		ociEvents = append(ociEvents, h.toOCIEvent(e))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("opc-request-id", fmt.Sprintf("mock-%d", time.Now().UnixNano()))
+	json.NewEncoder(w).Encode(ociEvents)
+}
+
+func (h *OCIHandler) toOCIEvent(e *store.MaintenanceEvent) map[string]interface{} {
+	event := map[string]interface{}{
+		"id":                  e.ID,
+		"instanceId":          e.InstanceID,
+		"compartmentId":       e.ProjectID,
+		"maintenanceCategory": "FLEXIBLE",
+		"maintenanceReason":   e.EventTypeCode,
+		"instanceAction":      "REBOOT_MIGRATION",
+		"lifecycleState":      e.Status,
+		"timeCreated":         e.CreatedAt.Format(time.RFC3339),
+		"description":         e.Description,
+	}
+
+	if e.MaintenanceType != "" {
+		event["maintenanceCategory"] = e.MaintenanceType
+	}
+	if e.ScheduledStart != nil {
+		event["timeWindowStart"] = e.ScheduledStart.UTC().Format(time.RFC3339)
+	}
+	if e.ScheduledStart != nil && e.ScheduledEnd != nil {
+		minutes := int(e.ScheduledEnd.Sub(*e.ScheduledStart).Minutes())
+		event["estimatedDuration"] = fmt.Sprintf("PT%dM", minutes)
+	}
+
+	return event
+}
+
+func (h *OCIHandler) handleInject(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req store.MaintenanceEvent
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if req.ID == "" {
+		req.ID = fmt.Sprintf("ocid1.instancemaintenanceevent.oc1..mock%d", time.Now().UnixNano())
+	}
+	req.CSP = store.CSPOCI
+
+	existing, exists := h.store.Get(req.ID)
+	statusCode := http.StatusCreated
+
+	if exists {
+		mergeEvent(existing, &req)
+		h.store.Update(existing)
+		statusCode = http.StatusOK
+		log.Printf("OCI: Updated event %s", existing.ID)
+	} else {
+		if req.Status == "" {
+			req.Status = "SCHEDULED"
+		}
+		if req.EventTypeCode == "" {
+			req.EventTypeCode = "HARDWARE_REPLACEMENT"
+		}
+		h.store.Add(&req)
+		log.Printf("OCI: Created event %s", req.ID)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(map[string]string{"eventId": req.ID})
+}
+
+func (h *OCIHandler) handleListEvents(w http.ResponseWriter, r *http.Request) {
+	events := h.store.ListByCSP(store.CSPOCI)
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(events)
+}
+
+func (h *OCIHandler) handleClear(w http.ResponseWriter, r *http.Request) {
+	h.store.ClearByCSP(store.CSPOCI)
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{"status": "cleared"})
+}
+
+func (h *OCIHandler) handleStats(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]int64{"pollCount": h.store.GetPollCount(store.CSPOCI)})
+}
+
+func (h *OCIHandler) handleResetStats(w http.ResponseWriter, r *http.Request) {
+	h.store.ResetPollCount(store.CSPOCI)
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{"status": "reset"})
+}
@@ -22,8 +22,10 @@ import (
 type CSPType string
 
 const (
-	CSPGCP CSPType = "gcp"
-	CSPAWS CSPType = "aws"
+	CSPGCP   CSPType = "gcp"
+	CSPAWS   CSPType = "aws"
+	CSPAzure CSPType = "azure"
+	CSPOCI   CSPType = "oci"
 )
 
 type MaintenanceEvent struct {